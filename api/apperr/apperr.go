@@ -0,0 +1,275 @@
+// Package apperr defines the error taxonomy shared by the HTTP middleware and
+// the GraphQL resolvers. Every error carries a stable code that is reported in
+// the GraphQL extensions.code field and maps onto an HTTP status.
+package apperr
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type Code string
+
+const (
+	CodeUnauthenticated Code = "UNAUTHENTICATED"
+	CodeForbidden       Code = "FORBIDDEN"
+	CodeBadUserInput    Code = "BAD_USER_INPUT"
+	CodeNotFound        Code = "NOT_FOUND"
+	CodeRateLimited     Code = "RATE_LIMITED"
+	CodeInvalidField    Code = "INVALID_FIELD"
+	CodeConflict        Code = "CONFLICT"
+	// CodeInsufficientStock rejects drawing more from storage than it holds.
+	CodeInsufficientStock Code = "INSUFFICIENT_STOCK"
+)
+
+// Status returns the HTTP status used when the code rejects a whole request.
+func (c Code) Status() int {
+	switch c {
+	case CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case CodeForbidden:
+		return http.StatusForbidden
+	case CodeBadUserInput, CodeInvalidField:
+		return http.StatusBadRequest
+	case CodeConflict, CodeInsufficientStock:
+		return http.StatusConflict
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeRateLimited:
+		return http.StatusTooManyRequests
+	}
+	return http.StatusInternalServerError
+}
+
+// Error is an error with a code clients can rely on.
+type Error struct {
+	Code    Code
+	Message string
+	// Err is the underlying cause, if any.
+	Err error
+	// Extensions are reported next to the code, such as the limit an invalid
+	// field broke.
+	Extensions map[string]any
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func Unauthenticated(message string) *Error {
+	return &Error{Code: CodeUnauthenticated, Message: message}
+}
+
+func Forbidden(message string) *Error {
+	return &Error{Code: CodeForbidden, Message: message}
+}
+
+func BadUserInput(message string) *Error {
+	return &Error{Code: CodeBadUserInput, Message: message}
+}
+
+func NotFound(message string) *Error {
+	return &Error{Code: CodeNotFound, Message: message}
+}
+
+func RateLimited(message string) *Error {
+	return &Error{Code: CodeRateLimited, Message: message}
+}
+
+func Conflict(message string) *Error {
+	return &Error{Code: CodeConflict, Message: message}
+}
+
+func InsufficientStock(message string) *Error {
+	return &Error{Code: CodeInsufficientStock, Message: message}
+}
+
+// From extracts the coded error from err. Privacy denials that don't carry a
+// code of their own are reported as forbidden, and ent's validation,
+// constraint and not found errors get codes of their own.
+func From(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+	if errors.Is(err, privacy.Deny) {
+		return &Error{Code: CodeForbidden, Message: "forbidden", Err: err}, true
+	}
+	var validationErr *ent.ValidationError
+	if errors.As(err, &validationErr) {
+		return invalidField(validationErr), true
+	}
+	var constraintErr *ent.ConstraintError
+	if errors.As(err, &constraintErr) {
+		return &Error{Code: CodeConflict, Message: "conflicts with an existing record", Err: err}, true
+	}
+	var notFoundErr *ent.NotFoundError
+	if errors.As(err, &notFoundErr) {
+		return &Error{Code: CodeNotFound, Message: strings.TrimPrefix(notFoundErr.Error(), "ent: "), Err: err}, true
+	}
+	return nil, false
+}
+
+// invalidField reports the field a validator rejected and, for the schema's
+// limit validators, the limit it broke.
+func invalidField(err *ent.ValidationError) *Error {
+	e := &Error{
+		Code:       CodeInvalidField,
+		Message:    err.Name + " is invalid",
+		Err:        err,
+		Extensions: map[string]any{"field": err.Name},
+	}
+	var limitErr *schematype.LimitError
+	if errors.As(err, &limitErr) {
+		e.Message = err.Name + " " + limitErr.Error()
+		e.Extensions["rule"] = limitErr.Rule
+		e.Extensions["limit"] = limitErr.Limit
+	}
+	return e
+}
+
+// GQLError converts the error into a GraphQL error tagged with its code.
+func (e *Error) GQLError() *gqlerror.Error {
+	extensions := map[string]interface{}{
+		"code": e.Code,
+	}
+	for key, value := range e.Extensions {
+		extensions[key] = value
+	}
+	return &gqlerror.Error{
+		Err:        e,
+		Message:    e.Message,
+		Extensions: extensions,
+	}
+}
+
+// Write responds to a rejected HTTP request with the status for the error's
+// code and a GraphQL-compliant JSON body.
+func Write(w http.ResponseWriter, e *Error) {
+	body, err := json.Marshal(graphql.Response{
+		Errors: gqlerror.List{e.GQLError()},
+	})
+	if err != nil {
+		log.Println("encoding error response", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.Code.Status())
+	_, _ = w.Write(body)
+}
+
+type statusKey struct{}
+
+// Status lets GraphQL responses that were rejected outright for an auth reason
+// report the matching HTTP status instead of 200. Mount it in front of the
+// GraphQL handler and register AroundResponses on the server.
+func Status(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w}
+		ctx := context.WithValue(r.Context(), statusKey{}, sw)
+		next.ServeHTTP(sw, r.WithContext(ctx))
+	})
+}
+
+// AroundResponses records the status for operations whose root fields all
+// resolved to null because of a single auth error code.
+func AroundResponses(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	sw, ok := ctx.Value(statusKey{}).(*statusWriter)
+	if !ok || resp == nil || len(resp.Errors) == 0 || !allNull(resp.Data) {
+		return resp
+	}
+	var code Code
+	for _, gqlErr := range resp.Errors {
+		c, _ := gqlErr.Extensions["code"].(Code)
+		if c == "" || (code != "" && c != code) {
+			return resp
+		}
+		code = c
+	}
+	if code == CodeUnauthenticated || code == CodeForbidden {
+		sw.status = code.Status()
+	}
+	return resp
+}
+
+// Reject fails a whole operation with e from an operation interceptor. The
+// response carries e's HTTP status when Status is mounted.
+func Reject(ctx context.Context, e *Error) graphql.ResponseHandler {
+	if sw, ok := ctx.Value(statusKey{}).(*statusWriter); ok {
+		sw.status = e.Code.Status()
+	}
+	return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{e.GQLError()}})
+}
+
+func allNull(data json.RawMessage) bool {
+	if len(data) == 0 || string(data) == "null" {
+		return true
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false
+	}
+	for _, v := range fields {
+		if string(v) != "null" {
+			return false
+		}
+	}
+	return true
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if status == http.StatusOK && w.status != 0 {
+		status = w.status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush and Hijack keep the streaming and websocket transports working behind
+// Status. Unwrap lets http.ResponseController reach the rest.
+func (w *statusWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,47 @@
+package apperr_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/failuretoload/datamonster/apperr"
+)
+
+// TestStatusStreams checks that the writer Status wraps requests in can still
+// flush and hijack, which the multipart and websocket transports need.
+func TestStatusStreams(t *testing.T) {
+	rec := httptest.NewRecorder()
+	apperr.Status(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("flush: %v", err)
+		}
+	})).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !rec.Flushed || rec.Code != http.StatusOK {
+		t.Errorf("flushed %t with status %d", rec.Flushed, rec.Code)
+	}
+
+	srv := httptest.NewServer(apperr.Status(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, buf, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		defer conn.Close()
+		buf.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n\r\n")
+		buf.Flush()
+	})))
+	defer srv.Close()
+	conn, err := http.DefaultTransport.(*http.Transport).DialContext(t.Context(), "tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("GET / HTTP/1.1\r\nHost: datamonster\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n"))
+	status, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || !strings.Contains(status, "101") {
+		t.Errorf("upgrade: %q %v", status, err)
+	}
+}
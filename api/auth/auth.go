@@ -0,0 +1,160 @@
+// Package auth resolves the user behind a GraphQL request. The provider is
+// picked per deployment so the API can run against Clerk, a self-hosted
+// OIDC issuer, or fixed dev tokens.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var (
+	ErrNoCredentials      = errors.New("missing bearer token")
+	ErrInvalidCredentials = errors.New("invalid bearer token")
+)
+
+// Identity is the caller a bearer token resolves to.
+type Identity struct {
+	UserID string
+	// ReadOnly identities may run queries but not mutations.
+	ReadOnly bool
+	// AccessToken marks identities authenticated with a personal access
+	// token rather than a session.
+	AccessToken bool
+}
+
+// Authenticator verifies a bearer token and returns the identity it belongs to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Identity, error)
+}
+
+// FromConfig builds the authenticator selected by config.AuthProvider.
+func FromConfig() (Authenticator, error) {
+	switch provider := config.AuthProvider(); provider {
+	case "clerk":
+		return NewClerk(config.Key()), nil
+	case "jwks":
+		return NewJWKS(JWKSOptions{
+			URL:      config.JWKSURL(),
+			File:     config.JWKSFile(),
+			Issuer:   config.JWTIssuer(),
+			Audience: config.JWTAudience(),
+		})
+	case "static":
+		return ParseStatic(config.DevTokens())
+	default:
+		return nil, fmt.Errorf("unknown auth provider %q", provider)
+	}
+}
+
+// Middleware authenticates requests and stores the user id under
+// config.UserIDKey, rejecting invalid credentials with a 401. Requests without
+// credentials continue anonymously so public fields such as publicSettlement
+// work; AllowAnonymous rejects the rest with a 401 once they're parsed.
+func Middleware(a Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			token := BearerToken(req)
+			if token == "" {
+				next.ServeHTTP(rw, req)
+				return
+			}
+			identity, err := a.Authenticate(req.Context(), token)
+			if err != nil {
+				Unauthorized(rw, err)
+				return
+			}
+			if identity.UserID == "" {
+				Unauthorized(rw, errors.New("user id not found"))
+				return
+			}
+			ctx := context.WithValue(req.Context(), config.UserIDKey, identity.UserID)
+			if identity.ReadOnly {
+				ctx = context.WithValue(ctx, readOnlyKey{}, true)
+			}
+			if identity.AccessToken {
+				ctx = context.WithValue(ctx, accessTokenKey{}, true)
+			}
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
+
+type readOnlyKey struct{}
+
+// ReadOnly reports whether the request was authenticated with a read-only credential.
+func ReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+type accessTokenKey struct{}
+
+// ViaAccessToken reports whether the request was authenticated with a personal
+// access token.
+func ViaAccessToken(ctx context.Context) bool {
+	viaAccessToken, _ := ctx.Value(accessTokenKey{}).(bool)
+	return viaAccessToken
+}
+
+// EnforceScopes is a gqlgen operation interceptor that stops read-only
+// credentials from running mutations.
+func EnforceScopes(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if ReadOnly(ctx) && oc.Operation != nil && oc.Operation.Operation == ast.Mutation {
+		return apperr.Reject(ctx, apperr.Forbidden("read-only credentials can't run mutations"))
+	}
+	return next(ctx)
+}
+
+// AllowAnonymous returns a gqlgen operation interceptor that rejects
+// operations from callers without credentials with a 401 unless every root
+// field they select is one of fields.
+func AllowAnonymous(fields ...string) graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		if _, ok := config.UserID(ctx); ok {
+			return next(ctx)
+		}
+		oc := graphql.GetOperationContext(ctx)
+		if oc.Operation == nil {
+			return next(ctx)
+		}
+		root := map[ast.Operation]string{ast.Query: "Query", ast.Mutation: "Mutation", ast.Subscription: "Subscription"}
+		for _, f := range graphql.CollectFields(oc, oc.Operation.SelectionSet, []string{root[oc.Operation.Operation]}) {
+			if f.Name != "__typename" && !slices.Contains(fields, f.Name) {
+				log.Println("unauthorized:", ErrNoCredentials)
+				return apperr.Reject(ctx, apperr.Unauthenticated("unauthorized: "+ErrNoCredentials.Error()))
+			}
+		}
+		return next(ctx)
+	}
+}
+
+// BearerToken extracts the token from the Authorization header.
+func BearerToken(req *http.Request) string {
+	authorization := strings.TrimSpace(req.Header.Get("Authorization"))
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func Unauthorized(w http.ResponseWriter, err error) {
+	log.Println("unauthorized:", err)
+	reason := ErrInvalidCredentials
+	if errors.Is(err, ErrNoCredentials) {
+		reason = ErrNoCredentials
+	}
+	apperr.Write(w, apperr.Unauthenticated("unauthorized: "+reason.Error()))
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/jwks"
+	"github.com/clerk/clerk-sdk-go/v2/jwt"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	clerkKeyTTL     = time.Hour
+	clerkMinRefresh = time.Minute
+)
+
+// Clerk verifies Clerk session tokens, caching the signing keys it fetches
+// from the Clerk API.
+type Clerk struct {
+	client *jwks.Client
+
+	// loads lets one caller reload the key set while the others keep
+	// verifying tokens against the keys already loaded. attempted throttles
+	// reloads, failed ones included, so tokens with made-up kids can't make
+	// every request call the Clerk API.
+	loads     singleflight.Group
+	mu        sync.RWMutex
+	keys      map[string]*clerk.JSONWebKey
+	fetched   time.Time
+	attempted time.Time
+}
+
+func NewClerk(secretKey string) *Clerk {
+	clerk.SetKey(secretKey)
+	return &Clerk{client: &jwks.Client{Backend: clerk.GetBackend()}}
+}
+
+func (c *Clerk) Authenticate(ctx context.Context, token string) (Identity, error) {
+	decoded, err := jwt.Decode(ctx, &jwt.DecodeParams{Token: token})
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	key, err := c.key(ctx, decoded.KeyID)
+	if err != nil {
+		return Identity{}, err
+	}
+	claims, err := jwt.Verify(ctx, &jwt.VerifyParams{Token: token, JWK: key})
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	return Identity{UserID: claims.Subject}, nil
+}
+
+// key looks up the signing key. The key set is reloaded periodically, and at
+// most once a minute when the kid is unknown, so rotated keys are picked up
+// without a restart. Only callers that need a key missing from the set wait for
+// the reload.
+func (c *Clerk) key(ctx context.Context, kid string) (*clerk.JSONWebKey, error) {
+	c.mu.RLock()
+	key := c.keys[kid]
+	age := time.Since(c.fetched)
+	sinceAttempt := time.Since(c.attempted)
+	c.mu.RUnlock()
+	switch {
+	case key != nil && age > clerkKeyTTL && sinceAttempt > clerkMinRefresh:
+		go func() {
+			if err := c.refresh(context.Background()); err != nil {
+				log.Println("refreshing clerk signing keys", err)
+			}
+		}()
+	case key == nil && sinceAttempt > clerkMinRefresh:
+		if err := c.refresh(ctx); err != nil {
+			return nil, err
+		}
+		c.mu.RLock()
+		key = c.keys[kid]
+		c.mu.RUnlock()
+	}
+	if key == nil {
+		return nil, fmt.Errorf("%w: unknown signing key %q", ErrInvalidCredentials, kid)
+	}
+	return key, nil
+}
+
+// refresh reloads the key set, sharing a reload already in flight.
+func (c *Clerk) refresh(ctx context.Context) error {
+	_, err, _ := c.loads.Do("jwks", func() (any, error) {
+		return nil, c.load(ctx)
+	})
+	return err
+}
+
+func (c *Clerk) load(ctx context.Context) error {
+	c.mu.Lock()
+	c.attempted = time.Now()
+	c.mu.Unlock()
+	set, err := c.client.Get(ctx, &jwks.GetParams{})
+	if err != nil {
+		return fmt.Errorf("fetching clerk signing keys: %w", err)
+	}
+	keys := make(map[string]*clerk.JSONWebKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k != nil {
+			keys[k.KeyID] = k
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys = keys
+	c.fetched = time.Now()
+	return nil
+}
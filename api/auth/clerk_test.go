@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/jwks"
+)
+
+// TestClerkKeys checks that tokens with made-up kids don't each cost a Clerk
+// API call, and that a slow fetch only holds up the kids it's fetched for.
+func TestClerkKeys(t *testing.T) {
+	k1, k2 := newSigningKey(t, "k1"), newSigningKey(t, "k2")
+	var fetches atomic.Int64
+	rotated := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		select {
+		case <-rotated:
+			<-release
+			_, _ = w.Write(keySet(t, k1, k2))
+		default:
+			_, _ = w.Write(keySet(t, k1))
+		}
+	}))
+	defer srv.Close()
+	c := &Clerk{client: &jwks.Client{Backend: clerk.NewBackend(&clerk.BackendConfig{URL: clerk.String(srv.URL), Key: clerk.String("sk_test")})}}
+	ctx := context.Background()
+
+	if _, err := c.key(ctx, "k1"); err != nil {
+		t.Fatal(err)
+	}
+	for i := range 20 {
+		if _, err := c.key(ctx, fmt.Sprintf("made-up-%d", i)); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("made-up kid: got %v, want invalid credentials", err)
+		}
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("%d fetches, want 1", n)
+	}
+
+	// Once the throttle has passed, an unknown kid reloads the key set.
+	close(rotated)
+	c.mu.Lock()
+	c.attempted = time.Now().Add(-2 * clerkMinRefresh)
+	c.mu.Unlock()
+	done := make(chan error)
+	go func() {
+		_, err := c.key(ctx, "k2")
+		done <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	loaded := make(chan error)
+	go func() {
+		_, err := c.key(ctx, "k1")
+		loaded <- err
+	}()
+	select {
+	case err := <-loaded:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("a loaded key waited for the key set fetch")
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("rotated key: %v", err)
+	}
+	if n := fetches.Load(); n != 2 {
+		t.Fatalf("%d fetches, want 2", n)
+	}
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	jwksRefreshInterval = 15 * time.Minute
+	jwksMinRefresh      = time.Minute
+	jwksLeeway          = 30 * time.Second
+)
+
+// JWKSOptions configures the generic OIDC/JWT provider. Exactly one of URL or
+// File must be set. Issuer and Audience are only checked when set.
+type JWKSOptions struct {
+	URL      string
+	File     string
+	Issuer   string
+	Audience string
+	// Client fetches URL, defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// JWKS verifies JWTs signed by any key in a JSON Web Key Set, such as the one
+// published by a self-hosted OIDC issuer. The token subject is the user id.
+type JWKS struct {
+	opts JWKSOptions
+
+	// loads lets one caller reload the key set while the others keep
+	// verifying tokens against the keys already loaded.
+	loads   singleflight.Group
+	mu      sync.RWMutex
+	keys    jose.JSONWebKeySet
+	fetched time.Time
+}
+
+func NewJWKS(opts JWKSOptions) (*JWKS, error) {
+	if (opts.URL == "") == (opts.File == "") {
+		return nil, errors.New("jwks provider needs exactly one of JWKS_URL or JWKS_FILE")
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	j := &JWKS{opts: opts}
+	if err := j.refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *JWKS) Authenticate(ctx context.Context, token string) (Identity, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if len(parsed.Headers) == 0 {
+		return Identity{}, fmt.Errorf("%w: missing jwt headers", ErrInvalidCredentials)
+	}
+	key, err := j.key(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return Identity{}, err
+	}
+	claims := jwt.Claims{}
+	if err := parsed.Claims(key.Key, &claims); err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	expected := jwt.Expected{Issuer: j.opts.Issuer, Time: time.Now()}
+	if j.opts.Audience != "" {
+		expected.Audience = jwt.Audience{j.opts.Audience}
+	}
+	// ValidateWithLeeway only checks the expiry when the claim is present.
+	if claims.Expiry == nil {
+		return Identity{}, fmt.Errorf("%w: token has no expiry", ErrInvalidCredentials)
+	}
+	if err := claims.ValidateWithLeeway(expected, jwksLeeway); err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	return Identity{UserID: claims.Subject}, nil
+}
+
+// key looks up the signing key. The key set is reloaded periodically, and early
+// when the kid is unknown, so rotated keys are picked up without a restart.
+// Only callers that need a key missing from the set wait for the reload.
+func (j *JWKS) key(ctx context.Context, kid string) (jose.JSONWebKey, error) {
+	j.mu.RLock()
+	keys := j.keys.Key(kid)
+	age := time.Since(j.fetched)
+	j.mu.RUnlock()
+	switch {
+	case len(keys) > 0 && age > jwksRefreshInterval:
+		go func() {
+			if err := j.refresh(context.Background()); err != nil {
+				log.Println("refreshing jwks", err)
+			}
+		}()
+	case len(keys) == 0 && age > jwksMinRefresh:
+		if err := j.refresh(ctx); err != nil {
+			return jose.JSONWebKey{}, err
+		}
+		j.mu.RLock()
+		keys = j.keys.Key(kid)
+		j.mu.RUnlock()
+	}
+	if len(keys) == 0 {
+		return jose.JSONWebKey{}, fmt.Errorf("%w: unknown signing key %q", ErrInvalidCredentials, kid)
+	}
+	return keys[0], nil
+}
+
+// refresh reloads the key set, sharing a reload already in flight.
+func (j *JWKS) refresh(ctx context.Context) error {
+	_, err, _ := j.loads.Do("jwks", func() (any, error) {
+		return nil, j.load(ctx)
+	})
+	return err
+}
+
+func (j *JWKS) load(ctx context.Context) error {
+	var (
+		raw []byte
+		err error
+	)
+	if j.opts.File != "" {
+		raw, err = os.ReadFile(j.opts.File)
+	} else {
+		raw, err = j.fetch(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("loading jwks: %w", err)
+	}
+	keys := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		return fmt.Errorf("decoding jwks: %w", err)
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.keys = keys
+	j.fetched = time.Now()
+	return nil
+}
+
+func (j *JWKS) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.opts.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := j.opts.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s from %s", res.Status, j.opts.URL)
+	}
+	var body json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
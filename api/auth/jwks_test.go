@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+)
+
+type signingKey struct {
+	t      *testing.T
+	kid    string
+	signer jose.Signer
+	public jose.JSONWebKey
+}
+
+func newSigningKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: k, KeyID: kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	public := jose.JSONWebKey{Key: &k.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"}
+	return signingKey{t: t, kid: kid, signer: signer, public: public}
+}
+
+func (k signingKey) sign(claims jwt.Claims) string {
+	k.t.Helper()
+	token, err := jwt.Signed(k.signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		k.t.Fatal(err)
+	}
+	return token
+}
+
+func keySet(t *testing.T, keys ...signingKey) []byte {
+	t.Helper()
+	set := jose.JSONWebKeySet{}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, k.public)
+	}
+	raw, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func inAnHour() *jwt.NumericDate {
+	return jwt.NewNumericDate(time.Now().Add(time.Hour))
+}
+
+func TestJWKSClaims(t *testing.T) {
+	k := newSigningKey(t, "k1")
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, keySet(t, k), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	j, err := NewJWKS(JWKSOptions{File: file, Issuer: "https://id.example.com", Audience: "datamonster"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := jwt.Claims{Subject: "alice", Issuer: "https://id.example.com", Audience: jwt.Audience{"datamonster"}, Expiry: inAnHour()}
+	identity, err := j.Authenticate(context.Background(), k.sign(valid))
+	if err != nil || identity.UserID != "alice" {
+		t.Fatalf("valid token: %+v %v", identity, err)
+	}
+
+	for name, claims := range map[string]jwt.Claims{
+		"other issuer":   {Subject: "alice", Issuer: "https://evil.example.com", Audience: jwt.Audience{"datamonster"}, Expiry: inAnHour()},
+		"other audience": {Subject: "alice", Issuer: "https://id.example.com", Audience: jwt.Audience{"other"}, Expiry: inAnHour()},
+		"expired":        {Subject: "alice", Issuer: "https://id.example.com", Audience: jwt.Audience{"datamonster"}, Expiry: jwt.NewNumericDate(time.Now().Add(-time.Hour))},
+		"no expiry":      {Subject: "alice", Issuer: "https://id.example.com", Audience: jwt.Audience{"datamonster"}},
+	} {
+		if _, err := j.Authenticate(context.Background(), k.sign(claims)); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("%s: got %v, want invalid credentials", name, err)
+		}
+	}
+
+	unknown := newSigningKey(t, "k2")
+	if _, err := j.Authenticate(context.Background(), unknown.sign(valid)); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("unknown key: got %v, want invalid credentials", err)
+	}
+}
+
+// TestJWKSSlowFetch checks that a slow key set fetch only holds up the tokens
+// signed with a key that isn't loaded yet.
+func TestJWKSSlowFetch(t *testing.T) {
+	k1, k2 := newSigningKey(t, "k1"), newSigningKey(t, "k2")
+	release := make(chan struct{})
+	fetches := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		if fetches > 1 {
+			<-release
+			_, _ = w.Write(keySet(t, k1, k2))
+			return
+		}
+		_, _ = w.Write(keySet(t, k1))
+	}))
+	defer srv.Close()
+	j, err := NewJWKS(JWKSOptions{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.mu.Lock()
+	j.fetched = time.Now().Add(-2 * jwksMinRefresh)
+	j.mu.Unlock()
+
+	rotated := make(chan error)
+	go func() {
+		_, err := j.Authenticate(context.Background(), k2.sign(jwt.Claims{Subject: "bob", Expiry: inAnHour()}))
+		rotated <- err
+	}()
+	// Give the rotated token time to start the fetch.
+	time.Sleep(50 * time.Millisecond)
+
+	done := make(chan error)
+	go func() {
+		_, err := j.Authenticate(context.Background(), k1.sign(jwt.Claims{Subject: "alice", Expiry: inAnHour()}))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("a token signed with a loaded key waited for the key set fetch")
+	}
+
+	close(release)
+	if err := <-rotated; err != nil {
+		t.Fatalf("rotated key: %v", err)
+	}
+}
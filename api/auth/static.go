@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+// Static accepts a fixed set of dev tokens. It is meant for local development
+// and tests, never for a deployed environment.
+type Static struct {
+	tokens map[string]string
+}
+
+// NewStatic maps each token to the user id it authenticates as.
+func NewStatic(tokens map[string]string) *Static {
+	return &Static{tokens: tokens}
+}
+
+// ParseStatic builds a Static provider from comma separated token:userID pairs.
+func ParseStatic(spec string) (*Static, error) {
+	tokens := map[string]string{}
+	for _, pair := range strings.Split(spec, ",") {
+		token, userID, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || token == "" || userID == "" {
+			return nil, fmt.Errorf("invalid dev token %q, expected token:userID", pair)
+		}
+		tokens[token] = userID
+	}
+	return NewStatic(tokens), nil
+}
+
+func (s *Static) Authenticate(_ context.Context, token string) (Identity, error) {
+	for candidate, userID := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			return Identity{UserID: userID}, nil
+		}
+	}
+	return Identity{}, ErrInvalidCredentials
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestParseStatic(t *testing.T) {
+	s, err := ParseStatic("dev:dev-user, t2:u2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, err := s.Authenticate(context.Background(), "t2"); err != nil || identity.UserID != "u2" {
+		t.Errorf("t2: %+v %v", identity, err)
+	}
+	if _, err := s.Authenticate(context.Background(), "t3"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("t3: got %v, want invalid credentials", err)
+	}
+	if _, err := ParseStatic("dev"); err == nil {
+		t.Error("accepted a token without a user id")
+	}
+}
@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+type abilityEntry struct {
+	name      string
+	kind      ability.Kind
+	rules     string
+	modifiers []schematype.StatModifier
+}
+
+// abilities are the core game's abilities and the impairments left by severe
+// injuries, with the stat modifiers they carry.
+var abilities = []abilityEntry{
+	{
+		name:  "Bitter Frenzy",
+		kind:  ability.KindAbility,
+		rules: "You may use fighting arts and weapon specializations while frenzied. While frenzied, gain +1 strength.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatStrength, Amount: 1, Condition: "while frenzied"},
+		},
+	},
+	{name: "Ageless", kind: ability.KindAbility, rules: "You may hunt if you are retired. When you gain hunt XP, you may decide not to gain it."},
+	{name: "Analyze", kind: ability.KindAbility, rules: "At the start of the survivors' turn, if the monster's AI deck is not empty, you may look at its top card."},
+	{name: "Explore", kind: ability.KindAbility, rules: "When you roll on an investigate table, add +2 to your roll result."},
+	{name: "Tinker", kind: ability.KindAbility, rules: "When you are a returning survivor, gain +1 endeavor to use this settlement phase."},
+	{
+		name:  "Dismembered Arm",
+		kind:  ability.KindImpairment,
+		rules: "You lose an arm. You can't activate two-handed weapons, paired weapons or use block, and suffer -1 strength.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatStrength, Amount: -1},
+		},
+	},
+	{
+		name:  "Dismembered Leg",
+		kind:  ability.KindImpairment,
+		rules: "You lose a leg. You suffer -2 movement and cannot dash.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatMovement, Amount: -2},
+		},
+	},
+	{
+		name:  "Blind",
+		kind:  ability.KindImpairment,
+		rules: "You lose an eye. Suffer -1 accuracy. If you lose both eyes, you retire.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatAccuracy, Amount: -1},
+		},
+	},
+	{
+		name:  "Broken Arm",
+		kind:  ability.KindImpairment,
+		rules: "An ear-shattering crunch. Suffer -1 accuracy and -1 strength.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatAccuracy, Amount: -1},
+			{Stat: schematype.StatStrength, Amount: -1},
+		},
+	},
+	{
+		name:  "Broken Leg",
+		kind:  ability.KindImpairment,
+		rules: "An ear-shattering crunch. Suffer -1 movement.",
+		modifiers: []schematype.StatModifier{
+			{Stat: schematype.StatMovement, Amount: -1},
+		},
+	},
+}
@@ -0,0 +1,41 @@
+package catalog
+
+type fightingArtEntry struct {
+	name   string
+	rules  string
+	secret bool
+}
+
+type disorderEntry struct {
+	name  string
+	rules string
+}
+
+// fightingArts are the core game's fighting arts and secret fighting arts.
+var fightingArts = []fightingArtEntry{
+	{name: "Clutch Fighter", rules: "While you have 3 or more bleeding tokens, gain +1 strength and +1 accuracy."},
+	{name: "Crossarm Block", rules: "Whenever you are hit, after hit locations are rolled, you may change 1 result to the arms hit location."},
+	{name: "Leader", rules: "Once per showdown, when you encourage a survivor, they gain +1 speed until the end of the round."},
+	{name: "Strategist", rules: "During the showdown setup, after placing terrain, you may add a Giant Stone Face or a Toppled Pillar terrain card to the showdown board."},
+	{name: "Timeless Eye", rules: "Your attack roll is a perfect hit on a result of 9 or 10. You cannot use this while you have a blind severe head injury."},
+	{name: "Tough", rules: "When rolling on a severe injury table, unless you roll a 1, you are not knocked down."},
+	{name: "Tumble", rules: "When something would collide with you, roll 1d10. On a 6 or higher, you successfully tumble out of harm's way."},
+	{name: "Unconscious Fighter", rules: "It's hard to keep you down. While you are knocked down, gain +1 luck."},
+	{name: "King's Step", rules: "Whenever you attack, you may discard any number of battle pressure hit locations drawn and draw an equal number of new hit locations.", secret: true},
+	{name: "Red Fist", rules: "At the start of each showdown, each survivor gains +1 strength token. Survivors may spend strength tokens in place of survival.", secret: true},
+	{name: "Zero Presence", rules: "Gain +1 strength when attacking a monster from its blind spot. Whenever you attack a monster, you are always considered to be in its blind spot.", secret: true},
+}
+
+// disorders are the core game's disorders.
+var disorders = []disorderEntry{
+	{name: "Aichmophobia", rules: "You cannot activate or depart with axes, swords, spears, daggers, scythes or katars."},
+	{name: "Anxiety", rules: "At the start of each showdown, gain the priority target token unless you have stinky gear in your gear grid."},
+	{name: "Binge Eating Disorder", rules: "You cannot depart unless you have consumable gear in your gear grid. You must consume if a choice to consume arises."},
+	{name: "Coprolalia", rules: "All your gear is noisy. You are always a threat unless you are knocked down, even if an effect says otherwise."},
+	{name: "Fear of the Dark", rules: "You retire. If you gain this disorder during a hunt or showdown, you put on a brave face until you return to the settlement."},
+	{name: "Hemophobia", rules: "During the showdown, whenever a survivor on the board gains a bleeding token, you are knocked down."},
+	{name: "Hoarder", rules: "Whenever you are a returning survivor and arrive at the settlement, archive 1 resource gained from the last showdown and gain +1 courage."},
+	{name: "Quixotic", rules: "If you are insane when you depart, gain +1 survival and +1 strength token."},
+	{name: "Squeamish", rules: "You cannot depart with any stinky gear in your gear grid. If a status or effect would cause you to become stinky, lose all your survival."},
+	{name: "Vestiphobia", rules: "You cannot wear armor at the body location. If you are wearing armor at the body location when you gain this disorder, archive it."},
+}
@@ -0,0 +1,164 @@
+// Package catalog holds the game's reference data, such as settlement
+// locations, their recipes, the monsters, the cards survivors collect and
+// their abilities and impairments, and seeds it into the database at startup.
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Seed writes the catalog, adding missing entries and bringing existing ones
+// in line with the data in this package. Entries are matched by name.
+func Seed(ctx context.Context, client *ent.Client) error {
+	// The catalog belongs to nobody and is read-only through the API.
+	ctx = rule.Bypass(ctx)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := seedLocations(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	if err := buildLanternHoards(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	if err := seedMonsters(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	if err := seedCards(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	if err := seedAbilities(ctx, tx.Client()); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func seedLocations(ctx context.Context, c *ent.Client) error {
+	for _, l := range locations {
+		stored, err := c.Location.Query().Where(location.Name(l.name)).Only(ctx)
+		if ent.IsNotFound(err) {
+			stored, err = c.Location.Create().SetName(l.name).Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding location %s: %w", l.name, err)
+		}
+		for _, r := range l.recipes {
+			existing, err := c.Recipe.Query().Where(recipe.Name(r.name)).Only(ctx)
+			switch {
+			case ent.IsNotFound(err):
+				err = c.Recipe.Create().
+					SetName(r.name).
+					SetKeywords(r.keywords).
+					SetCosts(r.costs).
+					SetLocationID(stored.ID).
+					Exec(ctx)
+			case err == nil:
+				err = c.Recipe.UpdateOne(existing).
+					SetKeywords(r.keywords).
+					SetCosts(r.costs).
+					SetLocationID(stored.ID).
+					Exec(ctx)
+			}
+			if err != nil {
+				return fmt.Errorf("seeding recipe %s: %w", r.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// buildLanternHoards builds the Lantern Hoard of the settlements founded
+// before new settlements were built around one.
+func buildLanternHoards(ctx context.Context, c *ent.Client) error {
+	hoard, err := c.Location.Query().Where(location.Name(LanternHoard)).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("finding the %s: %w", LanternHoard, err)
+	}
+	err = c.Settlement.Update().
+		Where(settlement.Not(settlement.HasLocationsWith(location.ID(hoard)))).
+		AddLocationIDs(hoard).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("building the %s of existing settlements: %w", LanternHoard, err)
+	}
+	return nil
+}
+
+func seedMonsters(ctx context.Context, c *ent.Client) error {
+	for _, m := range monsters {
+		stored, err := c.Monster.Query().Where(monster.Name(m.name)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = c.Monster.Create().SetName(m.name).SetKind(m.kind).SetLevels(m.levels).Exec(ctx)
+		case err == nil:
+			err = c.Monster.UpdateOne(stored).SetKind(m.kind).SetLevels(m.levels).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding monster %s: %w", m.name, err)
+		}
+	}
+	return nil
+}
+
+func seedCards(ctx context.Context, c *ent.Client) error {
+	for _, a := range fightingArts {
+		stored, err := c.FightingArt.Query().Where(fightingart.Name(a.name)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = c.FightingArt.Create().SetName(a.name).SetRules(a.rules).SetSecret(a.secret).Exec(ctx)
+		case err == nil:
+			err = c.FightingArt.UpdateOne(stored).SetRules(a.rules).SetSecret(a.secret).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding fighting art %s: %w", a.name, err)
+		}
+	}
+	for _, d := range disorders {
+		stored, err := c.Disorder.Query().Where(disorder.Name(d.name)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = c.Disorder.Create().SetName(d.name).SetRules(d.rules).Exec(ctx)
+		case err == nil:
+			err = c.Disorder.UpdateOne(stored).SetRules(d.rules).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding disorder %s: %w", d.name, err)
+		}
+	}
+	return nil
+}
+
+func seedAbilities(ctx context.Context, c *ent.Client) error {
+	for _, a := range abilities {
+		stored, err := c.Ability.Query().Where(ability.Name(a.name)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			err = c.Ability.Create().SetName(a.name).SetKind(a.kind).SetRules(a.rules).SetModifiers(a.modifiers).Exec(ctx)
+		case err == nil:
+			err = c.Ability.UpdateOne(stored).SetKind(a.kind).SetRules(a.rules).SetModifiers(a.modifiers).Exec(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("seeding ability %s: %w", a.name, err)
+		}
+	}
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package catalog
+
+import "github.com/failuretoload/datamonster/ent/schema/schematype"
+
+type locationEntry struct {
+	name    string
+	recipes []recipeEntry
+}
+
+type recipeEntry struct {
+	name     string
+	keywords []string
+	costs    []schematype.ResourceCost
+}
+
+// keyword costs quantity resources of any name carrying kw.
+func keyword(kw string, quantity int) schematype.ResourceCost {
+	return schematype.ResourceCost{Keyword: kw, Quantity: quantity}
+}
+
+// named costs quantity resources called name.
+func named(name string, quantity int) schematype.ResourceCost {
+	return schematype.ResourceCost{Name: name, Quantity: quantity}
+}
+
+// LanternHoard is the location every settlement is founded around.
+const LanternHoard = "Lantern Hoard"
+
+// locations are the core game's settlement locations. The Lantern Hoard is
+// built when the settlement is founded and crafts nothing itself.
+var locations = []locationEntry{
+	{name: LanternHoard},
+	{
+		name: "Bone Smith",
+		recipes: []recipeEntry{
+			{"Bone Axe", []string{"weapon", "melee", "axe", "bone"}, []schematype.ResourceCost{keyword("bone", 1), keyword("organ", 1)}},
+			{"Bone Blade", []string{"weapon", "melee", "sword", "bone"}, []schematype.ResourceCost{keyword("bone", 1)}},
+			{"Bone Dagger", []string{"weapon", "melee", "dagger", "bone"}, []schematype.ResourceCost{keyword("bone", 1)}},
+			{"Bone Darts", []string{"weapon", "ranged", "thrown", "bone"}, []schematype.ResourceCost{keyword("bone", 1)}},
+			{"Bone Pickaxe", []string{"item", "tool", "bone"}, []schematype.ResourceCost{keyword("bone", 1), keyword("hide", 1)}},
+			{"Bone Sickle", []string{"item", "tool", "bone"}, []schematype.ResourceCost{keyword("bone", 1), keyword("hide", 1)}},
+			{"Skull Helm", []string{"armor", "bone"}, []schematype.ResourceCost{named("Skull", 1)}},
+		},
+	},
+	{
+		name: "Skinnery",
+		recipes: []recipeEntry{
+			{"Bandages", []string{"item", "soluble"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Boots", []string{"armor", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Drum", []string{"item", "rawhide", "noisy", "instrument"}, []schematype.ResourceCost{keyword("hide", 1), keyword("bone", 1)}},
+			{"Rawhide Gloves", []string{"armor", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Headband", []string{"armor", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Pants", []string{"armor", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Vest", []string{"armor", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+			{"Rawhide Whip", []string{"weapon", "melee", "whip", "rawhide"}, []schematype.ResourceCost{keyword("hide", 1)}},
+		},
+	},
+	{
+		name: "Organ Grinder",
+		recipes: []recipeEntry{
+			{"Fecal Salve", []string{"item", "balm", "stinky"}, []schematype.ResourceCost{keyword("organ", 1)}},
+			{"Lucky Charm", []string{"item", "jewelry", "other"}, []schematype.ResourceCost{keyword("organ", 1)}},
+			{"Monster Grease", []string{"item", "consumable", "stinky"}, []schematype.ResourceCost{keyword("organ", 1)}},
+			{"Monster Tooth Necklace", []string{"item", "jewelry", "bone"}, []schematype.ResourceCost{keyword("scrap", 1), keyword("bone", 2)}},
+		},
+	},
+}
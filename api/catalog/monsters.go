@@ -0,0 +1,20 @@
+package catalog
+
+import "github.com/failuretoload/datamonster/ent/monster"
+
+type monsterEntry struct {
+	name   string
+	kind   monster.Kind
+	levels int
+}
+
+// monsters are the core game's quarries and nemeses.
+var monsters = []monsterEntry{
+	{"White Lion", monster.KindQuarry, 3},
+	{"Screaming Antelope", monster.KindQuarry, 3},
+	{"Phoenix", monster.KindQuarry, 3},
+	{"Butcher", monster.KindNemesis, 3},
+	{"King's Man", monster.KindNemesis, 3},
+	{"The Hand", monster.KindNemesis, 3},
+	{"Watcher", monster.KindNemesis, 1},
+}
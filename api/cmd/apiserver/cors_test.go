@@ -0,0 +1,48 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOriginMatcher(t *testing.T) {
+	allowed := originMatcher([]string{"https://datamonster.app", "https://*.example.com", "http://localhost:*"})
+	for origin, want := range map[string]bool{
+		"https://datamonster.app":       true,
+		"HTTPS://DATAMONSTER.APP":       true,
+		"https://app.example.com":       true,
+		"http://localhost:5173":         true,
+		"https://example.com":           false,
+		"https://app.example.com.evil":  false,
+		"http://app.example.com":        false,
+		"https://datamonster.app.evil":  false,
+		"http://localhost.evil.com:443": false,
+	} {
+		if got := allowed(origin); got != want {
+			t.Errorf("%s: allowed %v, want %v", origin, got, want)
+		}
+	}
+}
+
+// TestCorsHandler checks the headers the API answers cross-origin requests
+// with now that the proxy in front of it no longer adds its own.
+func TestCorsHandler(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://datamonster.app")
+	h := CorsHandler()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for origin, want := range map[string]string{
+		"https://datamonster.app": "https://datamonster.app",
+		"https://evil.example":    "",
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Origin", origin)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != want {
+			t.Errorf("%s: allowed origin %q, want %q", origin, got, want)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Errorf("%s: credentials allowed by default", origin)
+		}
+	}
+}
@@ -4,14 +4,24 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
 	"time"
 
 	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
-	"github.com/clerk/clerk-sdk-go/v2"
-	clerkhttp "github.com/clerk/clerk-sdk-go/v2/http"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/auth"
+	"github.com/failuretoload/datamonster/catalog"
 	"github.com/failuretoload/datamonster/graph"
+	"github.com/failuretoload/datamonster/ratelimit"
+	"github.com/failuretoload/datamonster/trash"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
@@ -21,6 +31,7 @@ import (
 	"github.com/failuretoload/datamonster/config"
 	"github.com/failuretoload/datamonster/ent"
 	"github.com/failuretoload/datamonster/ent/migrate"
+	_ "github.com/failuretoload/datamonster/ent/runtime"
 	_ "github.com/lib/pq"
 )
 
@@ -42,8 +53,27 @@ func main() {
 		log.Println("db url", config.PGConn())
 		log.Fatal("opening ent client", schemaErr)
 	}
+	if err := catalog.Seed(context.Background(), client); err != nil {
+		log.Fatal("seeding catalog ", err)
+	}
+
+	sessions, err := auth.FromConfig()
+	if err != nil {
+		log.Fatal("configuring authentication ", err)
+	}
+	authenticator := auth.NewAccessTokens(client, sessions)
+
+	var trusted *graph.TrustedDocuments
+	if path := config.TrustedDocuments(); path != "" {
+		trusted, err = graph.LoadTrustedDocuments(path)
+		if err != nil {
+			log.Fatal("loading trusted documents ", err)
+		}
+	}
 
-	app := NewServer(client)
+	go trash.Run(context.Background(), client, config.TrashRetention())
+
+	app := NewServer(client, authenticator, trusted)
 
 	app.Run()
 }
@@ -52,10 +82,14 @@ type Server struct {
 	Mux *chi.Mux
 }
 
-func NewServer(client *ent.Client) Server {
+func NewServer(client *ent.Client, authenticator auth.Authenticator, trusted *graph.TrustedDocuments) Server {
+	proxies, err := ratelimit.ParseProxies(config.TrustedProxies())
+	if err != nil {
+		log.Fatal("configuring trusted proxies ", err)
+	}
 	router := chi.NewRouter()
 	router.Use(middleware.RequestID)
-	router.Use(middleware.RealIP)
+	router.Use(ratelimit.RealIP(proxies))
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.URLFormat)
@@ -90,30 +124,77 @@ func NewServer(client *ent.Client) Server {
 		_, _ = w.Write([]byte("ready"))
 	})
 
-	srv := handler.NewDefaultServer(graph.NewSchema(client))
+	srv := newGraphQLServer(graph.NewSchema(client), trusted)
 	srv.Use(entgql.Transactioner{TxOpener: client})
-	router.Mount("/graphql", graphqlRouter(mode, srv))
+	srv.AroundRootFields(graph.Memoize)
+	srv.SetErrorPresenter(graph.ErrorPresenter)
+	srv.AroundResponses(apperr.AroundResponses)
+	srv.AroundOperations(auth.AllowAnonymous(graph.PublicFields...))
+	srv.AroundOperations(auth.EnforceScopes)
+	limiter := ratelimit.New(
+		ratelimit.NewMemory(),
+		ratelimit.Limit{Requests: config.QueryRateLimit(), Per: time.Minute},
+		ratelimit.Limit{Requests: config.MutationRateLimit(), Per: time.Minute},
+		ratelimit.Limit{Requests: config.AuthFailureRateLimit(), Per: time.Minute},
+	)
+	srv.AroundOperations(limiter.AroundOperations)
+	srv.Use(&graph.Limits{
+		MaxDepth:      config.MaxQueryDepth(),
+		MaxComplexity: config.MaxQueryComplexity(),
+	})
+	router.Mount("/graphql", graphqlRouter(mode, srv, authenticator, limiter, proxies))
 
 	return Server{
 		Mux: router,
 	}
 }
 
-func graphqlRouter(mode string, srv *handler.Server) http.Handler {
+// newGraphQLServer mirrors handler.NewDefaultServer. Given a trusted documents
+// manifest, persisted queries are looked up in it instead of being cached as
+// clients send them, and documents outside it are rejected.
+func newGraphQLServer(es graphql.ExecutableSchema, trusted *graph.TrustedDocuments) *handler.Server {
+	srv := handler.New(es)
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(1000))
+
+	srv.Use(extension.Introspection{})
+	if trusted == nil {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New(100),
+		})
+		return srv
+	}
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: trusted,
+	})
+	srv.Use(trusted)
+	return srv
+}
+
+func graphqlRouter(mode string, srv *handler.Server, authenticator auth.Authenticator, limiter *ratelimit.Limiter, proxies []netip.Prefix) http.Handler {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(ratelimit.RealIP(proxies))
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.URLFormat)
 	r.Use(middleware.Timeout(10 * time.Second))
 	r.Use(CorsHandler())
+	r.Use(limiter.Authentication)
+	r.Use(apperr.Status)
 	if mode != "schema" {
 		r.Use(SecureOptions())
 		r.Use(CacheControl)
-		r.Use(clerkhttp.WithHeaderAuthorization())
-		r.Use(UserIDExtractor)
 	}
+	r.Use(auth.Middleware(authenticator))
+	r.Use(limiter.Middleware)
 	r.Handle("/", srv)
 	return r
 }
@@ -123,7 +204,6 @@ func (s Server) Handle(route string, handler http.Handler) {
 }
 
 func (s Server) Run() {
-	clerk.SetKey(config.Key())
 	ready = true
 	log.Default().Println("Starting server on 0.0.0.0:8080")
 	err := http.ListenAndServe("0.0.0.0:8080", s.Mux)
@@ -158,42 +238,50 @@ func CacheControl(next http.Handler) http.Handler {
 	})
 }
 
+// CorsHandler applies the CORS policy from config. Requests from origins that
+// aren't allowed are logged and get no CORS headers, so browsers block them.
 func CorsHandler() func(http.Handler) http.Handler {
+	origins := config.CORSAllowedOrigins()
+	credentials := config.CORSAllowCredentials()
+	if len(origins) == 0 {
+		log.Println("CORS_ALLOWED_ORIGINS is empty, cross-origin requests will be rejected")
+	}
+	if credentials && slices.Contains(origins, "*") {
+		log.Fatal("CORS_ALLOW_CREDENTIALS can't be combined with a * origin")
+	}
+	allowed := originMatcher(origins)
 	c := cors.New(cors.Options{
-		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"HEAD", "GET", "POST", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		AllowCredentials: true,
-		MaxAge:           3599, // Maximum value not ignored by any of major browsers
+		AllowOriginFunc: func(r *http.Request, origin string) bool {
+			if allowed(origin) {
+				return true
+			}
+			log.Printf("[%s] rejected CORS request from origin %q", middleware.GetReqID(r.Context()), origin)
+			return false
+		},
+		AllowedMethods:   config.CORSAllowedMethods(),
+		AllowedHeaders:   config.CORSAllowedHeaders(),
+		AllowCredentials: credentials,
+		MaxAge:           config.CORSMaxAge(),
 	})
 	return c.Handler
 }
 
-func UserIDExtractor(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		claims, ok := clerk.SessionClaimsFromContext(req.Context())
-		if !ok {
-			Unauthorized(rw, nil)
-			return
-		}
-		userID := claims.RegisteredClaims.Subject
-		if userID == "" {
-			reason := "user id not found"
-			Unauthorized(rw, &reason)
-			return
+// originMatcher reports whether an origin matches one of the patterns. A
+// pattern matches exactly, or around a single * wildcard.
+func originMatcher(patterns []string) func(origin string) bool {
+	return func(origin string) bool {
+		origin = strings.ToLower(origin)
+		for _, pattern := range patterns {
+			pattern = strings.ToLower(pattern)
+			prefix, suffix, wildcard := strings.Cut(pattern, "*")
+			if !wildcard && origin == pattern {
+				return true
+			}
+			if wildcard && len(origin) >= len(prefix)+len(suffix) &&
+				strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+				return true
+			}
 		}
-		ctx := context.WithValue(req.Context(), config.UserIDKey, userID)
-		next.ServeHTTP(rw, req.WithContext(ctx))
-	})
-}
-
-func Unauthorized(w http.ResponseWriter, message *string) {
-	content := "unauthorized"
-	if message != nil {
-		content = content + ": " + *message
+		return false
 	}
-	w.WriteHeader(http.StatusOK)
-	w.Header().Set("Content-Type", "application/text")
-	_, _ = w.Write([]byte(content))
-	log.Println(content)
 }
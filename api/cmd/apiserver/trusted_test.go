@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/failuretoload/datamonster/auth"
+	"github.com/failuretoload/datamonster/ent/enttest"
+	"github.com/failuretoload/datamonster/ent/migrate"
+	"github.com/failuretoload/datamonster/graph"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/vektah/gqlparser/v2"
+)
+
+// manifest is the trusted documents manifest the Dockerfile ships.
+const manifest = "../../trusted-documents.json"
+
+// TestTrustedDocumentsManifest checks that every operation the web client was
+// built with still runs against the schema.
+func TestTrustedDocumentsManifest(t *testing.T) {
+	if _, err := graph.LoadTrustedDocuments(manifest); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	documents := map[string]string{}
+	if err := json.Unmarshal(data, &documents); err != nil {
+		t.Fatal(err)
+	}
+	if len(documents) == 0 {
+		t.Fatal("the manifest is empty")
+	}
+	schema := graph.NewSchema(nil).Schema()
+	for hash, document := range documents {
+		if _, errs := gqlparser.LoadQuery(schema, document); len(errs) > 0 {
+			t.Errorf("%s %q: %v", hash, document, errs)
+		}
+	}
+}
+
+func TestTrustedDocuments(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:trusted?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	trusted, err := graph.LoadTrustedDocuments(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := newGraphQLServer(graph.NewSchema(client), trusted)
+	h := auth.Middleware(auth.NewStatic(map[string]string{"alice": "alice"}))(srv)
+
+	const settlements = "query GetSettlements { settlements { __typename id name } }"
+	hash := func(h string) string {
+		return `{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"` + h + `"}}}`
+	}
+	for name, tc := range map[string]struct {
+		body string
+		code string
+	}{
+		"trusted hash":     {body: hash("c3a07a1fe93ee6d7ffcb4a8c5516777b78e9f28789304aaa499ceb1c527353c2")},
+		"trusted document": {body: `{"query":"` + settlements + `"}`},
+		"unknown hash":     {body: hash("0000000000000000000000000000000000000000000000000000000000000000"), code: "PERSISTED_QUERY_NOT_FOUND"},
+		"ad-hoc document":  {body: `{"query":"{ settlements { id owner } }"}`, code: "OPERATION_NOT_TRUSTED"},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer alice")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		var res struct {
+			Errors []struct {
+				Extensions struct{ Code string }
+			}
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("%s: decoding %q: %v", name, rec.Body.String(), err)
+		}
+		code := ""
+		if len(res.Errors) > 0 {
+			code = res.Errors[0].Extensions.Code
+		}
+		if code != tc.code {
+			t.Errorf("%s: got %q, want %q: %s", name, code, tc.code, rec.Body.String())
+		}
+	}
+}
+
+// TestProductionRouter checks that the production router still serves the
+// operations the web client doesn't send: scripts run with an access token,
+// spectators following a share link, and the fields that get a user a token.
+func TestProductionRouter(t *testing.T) {
+	t.Setenv("MODE", "prod")
+	client := enttest.Open(t, dialect.SQLite, "file:production?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	trusted, err := graph.LoadTrustedDocuments(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := NewServer(client, auth.NewAccessTokens(client, auth.NewStatic(map[string]string{"alice": "alice"})), trusted).Mux
+
+	post := func(token, query string, vars map[string]any) (data map[string]any, code string) {
+		t.Helper()
+		body, _ := json.Marshal(map[string]any{"query": query, "variables": vars})
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		var res struct {
+			Data   map[string]any
+			Errors []struct {
+				Extensions struct{ Code string }
+			}
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("decoding %q: %v", rec.Body.String(), err)
+		}
+		if len(res.Errors) > 0 {
+			return res.Data, res.Errors[0].Extensions.Code
+		}
+		return res.Data, ""
+	}
+
+	created, code := post("alice", `mutation($expires: Time!) {
+		createAccessToken(name: "script", scope: read_write, expiresAt: $expires) { token }
+	}`, map[string]any{"expires": time.Now().Add(time.Hour)})
+	if code != "" {
+		t.Fatalf("creating an access token: %s", code)
+	}
+	pat := created["createAccessToken"].(map[string]any)["token"].(string)
+
+	settlement, code := post(pat, `mutation { createSettlement(input: {owner: "alice", name: "Lantern Hollow"}) { id } }`, nil)
+	if code != "" {
+		t.Fatalf("ad-hoc mutation with an access token: %s", code)
+	}
+	id := settlement["createSettlement"].(map[string]any)["id"]
+	shared, code := post(pat, `mutation($s: ID!) { shareSettlement(settlementID: $s) }`, map[string]any{"s": id})
+	if code != "" {
+		t.Fatalf("sharing with an access token: %s", code)
+	}
+
+	spectated, code := post("", `query($token: String!) { publicSettlement(token: $token) { name } }`, map[string]any{"token": shared["shareSettlement"]})
+	if code != "" {
+		t.Fatalf("anonymous public settlement: %s", code)
+	}
+	if name := spectated["publicSettlement"].(map[string]any)["name"]; name != "Lantern Hollow" {
+		t.Fatalf("public settlement %v", name)
+	}
+
+	// Sessions are still held to the manifest for everything else.
+	if _, code := post("alice", `{ settlements { id owner } }`, nil); code != "OPERATION_NOT_TRUSTED" {
+		t.Fatalf("ad-hoc session query: got %q, want OPERATION_NOT_TRUSTED", code)
+	}
+	if _, code := post("", `{ publicSettlement(token: "x") { name } settlements { id } }`, nil); code != "OPERATION_NOT_TRUSTED" {
+		t.Fatalf("public query with a private field: got %q, want OPERATION_NOT_TRUSTED", code)
+	}
+}
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"context"
 	"log"
 
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type CTXUserID string
@@ -12,6 +16,12 @@ const (
 	UserIDKey CTXUserID = "userId"
 )
 
+// UserID returns the authenticated user id stored on the context, if any.
+func UserID(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(UserIDKey).(string)
+	return userID, ok && userID != ""
+}
+
 func Key() string {
 	return getEnvVar("KEY")
 }
@@ -24,6 +34,175 @@ func Mode() string {
 	return getEnvVar("MODE")
 }
 
+// AuthProvider selects how requests are authenticated: clerk, jwks or static.
+// Schema mode falls back to static dev tokens so the playground works offline.
+func AuthProvider() string {
+	fallback := "clerk"
+	if os.Getenv("MODE") == "schema" {
+		fallback = "static"
+	}
+	return getOptionalEnvVar("AUTH_PROVIDER", fallback)
+}
+
+// JWKSURL is the remote key set the jwks provider verifies tokens against.
+func JWKSURL() string {
+	return getOptionalEnvVar("JWKS_URL", "")
+}
+
+// JWKSFile is a local key set used by the jwks provider instead of JWKS_URL.
+func JWKSFile() string {
+	return getOptionalEnvVar("JWKS_FILE", "")
+}
+
+func JWTIssuer() string {
+	return getOptionalEnvVar("JWT_ISSUER", "")
+}
+
+func JWTAudience() string {
+	return getOptionalEnvVar("JWT_AUDIENCE", "")
+}
+
+// DevTokens lists the static provider's tokens as comma separated token:userID pairs.
+func DevTokens() string {
+	return getOptionalEnvVar("DEV_TOKENS", "dev:dev-user")
+}
+
+// Production reports whether the API runs in a deployed mode rather than
+// locally in dev or schema mode.
+func Production() bool {
+	mode := os.Getenv("MODE")
+	return mode != "dev" && mode != "schema"
+}
+
+// TrustedDocuments is the path of the manifest of operations the API accepts.
+// Production requires one; locally any operation is accepted unless it's set.
+func TrustedDocuments() string {
+	path := getOptionalEnvVar("TRUSTED_DOCUMENTS", "")
+	if path == "" && Production() {
+		log.Fatal("TRUSTED_DOCUMENTS must be set in production")
+	}
+	return path
+}
+
+// MaxQueryDepth is the deepest field nesting a GraphQL operation may use.
+func MaxQueryDepth() int {
+	return getOptionalIntEnvVar("MAX_QUERY_DEPTH", 10)
+}
+
+// MaxQueryComplexity is the highest cost a GraphQL operation may have. List
+// fields cost their assumed size times the cost of their selection.
+func MaxQueryComplexity() int {
+	return getOptionalIntEnvVar("MAX_QUERY_COMPLEXITY", 5000)
+}
+
+// QueryRateLimit is how many queries a caller may run per minute.
+func QueryRateLimit() int {
+	return getOptionalIntEnvVar("RATE_LIMIT_QUERIES", 300)
+}
+
+// MutationRateLimit is how many mutations a caller may run per minute.
+func MutationRateLimit() int {
+	return getOptionalIntEnvVar("RATE_LIMIT_MUTATIONS", 60)
+}
+
+// AuthFailureRateLimit is how many requests with missing or invalid
+// credentials a client IP may send per minute.
+func AuthFailureRateLimit() int {
+	return getOptionalIntEnvVar("RATE_LIMIT_AUTH_FAILURES", 30)
+}
+
+// TrustedProxies are the IPs and CIDR ranges of the proxies whose
+// X-Forwarded-For and X-Real-IP headers are believed.
+func TrustedProxies() []string {
+	return getListEnvVar("TRUSTED_PROXIES", "127.0.0.1,::1")
+}
+
+// CORSAllowedOrigins lists the origins browsers may call the API from. Entries
+// may contain one * wildcard, such as https://*.example.com. Local modes allow
+// localhost on any port; deployed modes allow nothing until configured.
+func CORSAllowedOrigins() []string {
+	fallback := ""
+	switch os.Getenv("MODE") {
+	case "schema", "dev":
+		fallback = "http://localhost:*,http://127.0.0.1:*"
+	}
+	return getListEnvVar("CORS_ALLOWED_ORIGINS", fallback)
+}
+
+func CORSAllowedMethods() []string {
+	return getListEnvVar("CORS_ALLOWED_METHODS", "HEAD,GET,POST,OPTIONS")
+}
+
+func CORSAllowedHeaders() []string {
+	return getListEnvVar("CORS_ALLOWED_HEADERS", "Origin,Accept,Authorization,Content-Type,X-CSRF-Token")
+}
+
+// CORSMaxAge is how many seconds browsers may cache a preflight response.
+func CORSMaxAge() int {
+	return getOptionalIntEnvVar("CORS_MAX_AGE", 3599)
+}
+
+// CORSAllowCredentials lets browsers send cookies with cross-origin requests.
+// The API authenticates with bearer tokens, so it's off unless needed.
+func CORSAllowCredentials() bool {
+	return getOptionalEnvVar("CORS_ALLOW_CREDENTIALS", "false") == "true"
+}
+
+// TrashRetention is how long deleted settlements and survivors can be
+// restored before they are purged.
+func TrashRetention() time.Duration {
+	value := getOptionalEnvVar("TRASH_RETENTION", "720h")
+	retention, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("TRASH_RETENTION must be a duration such as 720h, got %q", value)
+	}
+	return retention
+}
+
+// AccessTokenMaxLifetime is the furthest in the future a personal access
+// token may expire.
+func AccessTokenMaxLifetime() time.Duration {
+	return getOptionalDurationEnvVar("ACCESS_TOKEN_MAX_LIFETIME", "2160h")
+}
+
+func getListEnvVar(key string, fallback string) []string {
+	var values []string
+	for _, value := range strings.Split(getOptionalEnvVar(key, fallback), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getOptionalIntEnvVar(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("%s must be an integer, got %q", key, value)
+	}
+	return n
+}
+
+func getOptionalDurationEnvVar(key string, fallback string) time.Duration {
+	value := getOptionalEnvVar(key, fallback)
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("%s must be a duration such as %s, got %q", key, fallback, value)
+	}
+	return d
+}
+
+func getOptionalEnvVar(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
 func getEnvVar(key string) string {
 	if os.Getenv(key) == "" {
 		log.Fatalf("%s doesn't exist or is not set", key)
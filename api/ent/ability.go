@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Ability is the model entity for the Ability schema.
+type Ability struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind ability.Kind `json:"kind,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules string `json:"rules,omitempty"`
+	// Modifiers holds the value of the "modifiers" field.
+	Modifiers []schematype.StatModifier `json:"modifiers,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AbilityQuery when eager-loading is set.
+	Edges        AbilityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AbilityEdges holds the relations/edges for other nodes in the graph.
+type AbilityEdges struct {
+	// Survivors holds the value of the survivors edge.
+	Survivors []*Survivor `json:"survivors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedSurvivors map[string][]*Survivor
+}
+
+// SurvivorsOrErr returns the Survivors value or an error if the edge
+// was not loaded in eager-loading.
+func (e AbilityEdges) SurvivorsOrErr() ([]*Survivor, error) {
+	if e.loadedTypes[0] {
+		return e.Survivors, nil
+	}
+	return nil, &NotLoadedError{edge: "survivors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ability) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ability.FieldModifiers:
+			values[i] = new([]byte)
+		case ability.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ability.FieldName, ability.FieldKind, ability.FieldRules:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ability fields.
+func (a *Ability) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ability.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case ability.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case ability.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				a.Kind = ability.Kind(value.String)
+			}
+		case ability.FieldRules:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value.Valid {
+				a.Rules = value.String
+			}
+		case ability.FieldModifiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field modifiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Modifiers); err != nil {
+					return fmt.Errorf("unmarshal field modifiers: %w", err)
+				}
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Ability.
+// This includes values selected through modifiers, order, etc.
+func (a *Ability) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QuerySurvivors queries the "survivors" edge of the Ability entity.
+func (a *Ability) QuerySurvivors() *SurvivorQuery {
+	return NewAbilityClient(a.config).QuerySurvivors(a)
+}
+
+// Update returns a builder for updating this Ability.
+// Note that you need to call Ability.Unwrap() before calling this method if this Ability
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Ability) Update() *AbilityUpdateOne {
+	return NewAbilityClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Ability entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Ability) Unwrap() *Ability {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ability is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Ability) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ability(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", a.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(a.Rules)
+	builder.WriteString(", ")
+	builder.WriteString("modifiers=")
+	builder.WriteString(fmt.Sprintf("%v", a.Modifiers))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedSurvivors returns the Survivors named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (a *Ability) NamedSurvivors(name string) ([]*Survivor, error) {
+	if a.Edges.namedSurvivors == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := a.Edges.namedSurvivors[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (a *Ability) appendNamedSurvivors(name string, edges ...*Survivor) {
+	if a.Edges.namedSurvivors == nil {
+		a.Edges.namedSurvivors = make(map[string][]*Survivor)
+	}
+	if len(edges) == 0 {
+		a.Edges.namedSurvivors[name] = []*Survivor{}
+	} else {
+		a.Edges.namedSurvivors[name] = append(a.Edges.namedSurvivors[name], edges...)
+	}
+}
+
+// Abilities is a parsable slice of Ability.
+type Abilities []*Ability
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ability
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ability type in the database.
+	Label = "ability"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldRules holds the string denoting the rules field in the database.
+	FieldRules = "rules"
+	// FieldModifiers holds the string denoting the modifiers field in the database.
+	FieldModifiers = "modifiers"
+	// EdgeSurvivors holds the string denoting the survivors edge name in mutations.
+	EdgeSurvivors = "survivors"
+	// Table holds the table name of the ability in the database.
+	Table = "abilities"
+	// SurvivorsTable is the table that holds the survivors relation/edge. The primary key declared below.
+	SurvivorsTable = "survivor_abilities"
+	// SurvivorsInverseTable is the table name for the Survivor entity.
+	// It exists in this package in order to avoid circular dependency with the "survivor" package.
+	SurvivorsInverseTable = "survivors"
+)
+
+// Columns holds all SQL columns for ability fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldKind,
+	FieldRules,
+	FieldModifiers,
+}
+
+var (
+	// SurvivorsPrimaryKey and SurvivorsColumn2 are the table columns denoting the
+	// primary key for the survivors relation (M2M).
+	SurvivorsPrimaryKey = []string{"survivor_id", "ability_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindAbility    Kind = "ability"
+	KindImpairment Kind = "impairment"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindAbility, KindImpairment:
+		return nil
+	default:
+		return fmt.Errorf("ability: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the Ability queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByRules orders the results by the rules field.
+func ByRules(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRules, opts...).ToFunc()
+}
+
+// BySurvivorsCount orders the results by survivors count.
+func BySurvivorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSurvivorsStep(), opts...)
+	}
+}
+
+// BySurvivors orders the results by survivors terms.
+func BySurvivors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSurvivorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSurvivorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SurvivorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SurvivorsTable, SurvivorsPrimaryKey...),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Kind) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Kind) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Kind(str)
+	if err := KindValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Kind", str)
+	}
+	return nil
+}
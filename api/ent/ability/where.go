@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ability
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Ability {
+	return predicate.Ability(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Ability {
+	return predicate.Ability(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Ability {
+	return predicate.Ability(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldName, v))
+}
+
+// Rules applies equality check predicate on the "rules" field. It's identical to RulesEQ.
+func Rules(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldRules, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Ability {
+	return predicate.Ability(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Ability {
+	return predicate.Ability(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Ability {
+	return predicate.Ability(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Ability {
+	return predicate.Ability(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Ability {
+	return predicate.Ability(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// RulesEQ applies the EQ predicate on the "rules" field.
+func RulesEQ(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEQ(FieldRules, v))
+}
+
+// RulesNEQ applies the NEQ predicate on the "rules" field.
+func RulesNEQ(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldNEQ(FieldRules, v))
+}
+
+// RulesIn applies the In predicate on the "rules" field.
+func RulesIn(vs ...string) predicate.Ability {
+	return predicate.Ability(sql.FieldIn(FieldRules, vs...))
+}
+
+// RulesNotIn applies the NotIn predicate on the "rules" field.
+func RulesNotIn(vs ...string) predicate.Ability {
+	return predicate.Ability(sql.FieldNotIn(FieldRules, vs...))
+}
+
+// RulesGT applies the GT predicate on the "rules" field.
+func RulesGT(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldGT(FieldRules, v))
+}
+
+// RulesGTE applies the GTE predicate on the "rules" field.
+func RulesGTE(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldGTE(FieldRules, v))
+}
+
+// RulesLT applies the LT predicate on the "rules" field.
+func RulesLT(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldLT(FieldRules, v))
+}
+
+// RulesLTE applies the LTE predicate on the "rules" field.
+func RulesLTE(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldLTE(FieldRules, v))
+}
+
+// RulesContains applies the Contains predicate on the "rules" field.
+func RulesContains(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldContains(FieldRules, v))
+}
+
+// RulesHasPrefix applies the HasPrefix predicate on the "rules" field.
+func RulesHasPrefix(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldHasPrefix(FieldRules, v))
+}
+
+// RulesHasSuffix applies the HasSuffix predicate on the "rules" field.
+func RulesHasSuffix(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldHasSuffix(FieldRules, v))
+}
+
+// RulesEqualFold applies the EqualFold predicate on the "rules" field.
+func RulesEqualFold(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldEqualFold(FieldRules, v))
+}
+
+// RulesContainsFold applies the ContainsFold predicate on the "rules" field.
+func RulesContainsFold(v string) predicate.Ability {
+	return predicate.Ability(sql.FieldContainsFold(FieldRules, v))
+}
+
+// ModifiersIsNil applies the IsNil predicate on the "modifiers" field.
+func ModifiersIsNil() predicate.Ability {
+	return predicate.Ability(sql.FieldIsNull(FieldModifiers))
+}
+
+// ModifiersNotNil applies the NotNil predicate on the "modifiers" field.
+func ModifiersNotNil() predicate.Ability {
+	return predicate.Ability(sql.FieldNotNull(FieldModifiers))
+}
+
+// HasSurvivors applies the HasEdge predicate on the "survivors" edge.
+func HasSurvivors() predicate.Ability {
+	return predicate.Ability(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SurvivorsTable, SurvivorsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSurvivorsWith applies the HasEdge predicate on the "survivors" edge with a given conditions (other predicates).
+func HasSurvivorsWith(preds ...predicate.Survivor) predicate.Ability {
+	return predicate.Ability(func(s *sql.Selector) {
+		step := newSurvivorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Ability) predicate.Ability {
+	return predicate.Ability(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Ability) predicate.Ability {
+	return predicate.Ability(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Ability) predicate.Ability {
+	return predicate.Ability(sql.NotPredicates(p))
+}
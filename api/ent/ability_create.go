@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// AbilityCreate is the builder for creating a Ability entity.
+type AbilityCreate struct {
+	config
+	mutation *AbilityMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ac *AbilityCreate) SetName(s string) *AbilityCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetKind sets the "kind" field.
+func (ac *AbilityCreate) SetKind(a ability.Kind) *AbilityCreate {
+	ac.mutation.SetKind(a)
+	return ac
+}
+
+// SetRules sets the "rules" field.
+func (ac *AbilityCreate) SetRules(s string) *AbilityCreate {
+	ac.mutation.SetRules(s)
+	return ac
+}
+
+// SetModifiers sets the "modifiers" field.
+func (ac *AbilityCreate) SetModifiers(sm []schematype.StatModifier) *AbilityCreate {
+	ac.mutation.SetModifiers(sm)
+	return ac
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (ac *AbilityCreate) AddSurvivorIDs(ids ...int) *AbilityCreate {
+	ac.mutation.AddSurvivorIDs(ids...)
+	return ac
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (ac *AbilityCreate) AddSurvivors(s ...*Survivor) *AbilityCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the AbilityMutation object of the builder.
+func (ac *AbilityCreate) Mutation() *AbilityMutation {
+	return ac.mutation
+}
+
+// Save creates the Ability in the database.
+func (ac *AbilityCreate) Save(ctx context.Context) (*Ability, error) {
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AbilityCreate) SaveX(ctx context.Context) *Ability {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AbilityCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AbilityCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AbilityCreate) check() error {
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Ability.name"`)}
+	}
+	if v, ok := ac.mutation.Name(); ok {
+		if err := ability.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ability.name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Ability.kind"`)}
+	}
+	if v, ok := ac.mutation.Kind(); ok {
+		if err := ability.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ability.kind": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "Ability.rules"`)}
+	}
+	return nil
+}
+
+func (ac *AbilityCreate) sqlSave(ctx context.Context) (*Ability, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AbilityCreate) createSpec() (*Ability, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ability{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(ability.Table, sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt))
+	)
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.SetField(ability.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.SetField(ability.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ac.mutation.Rules(); ok {
+		_spec.SetField(ability.FieldRules, field.TypeString, value)
+		_node.Rules = value
+	}
+	if value, ok := ac.mutation.Modifiers(); ok {
+		_spec.SetField(ability.FieldModifiers, field.TypeJSON, value)
+		_node.Modifiers = value
+	}
+	if nodes := ac.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AbilityCreateBulk is the builder for creating many Ability entities in bulk.
+type AbilityCreateBulk struct {
+	config
+	err      error
+	builders []*AbilityCreate
+}
+
+// Save creates the Ability entities in the database.
+func (acb *AbilityCreateBulk) Save(ctx context.Context) ([]*Ability, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Ability, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AbilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AbilityCreateBulk) SaveX(ctx context.Context) []*Ability {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AbilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AbilityCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
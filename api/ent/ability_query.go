@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// AbilityQuery is the builder for querying Ability entities.
+type AbilityQuery struct {
+	config
+	ctx                *QueryContext
+	order              []ability.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Ability
+	withSurvivors      *SurvivorQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*Ability) error
+	withNamedSurvivors map[string]*SurvivorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AbilityQuery builder.
+func (aq *AbilityQuery) Where(ps ...predicate.Ability) *AbilityQuery {
+	aq.predicates = append(aq.predicates, ps...)
+	return aq
+}
+
+// Limit the number of records to be returned by this query.
+func (aq *AbilityQuery) Limit(limit int) *AbilityQuery {
+	aq.ctx.Limit = &limit
+	return aq
+}
+
+// Offset to start from.
+func (aq *AbilityQuery) Offset(offset int) *AbilityQuery {
+	aq.ctx.Offset = &offset
+	return aq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aq *AbilityQuery) Unique(unique bool) *AbilityQuery {
+	aq.ctx.Unique = &unique
+	return aq
+}
+
+// Order specifies how the records should be ordered.
+func (aq *AbilityQuery) Order(o ...ability.OrderOption) *AbilityQuery {
+	aq.order = append(aq.order, o...)
+	return aq
+}
+
+// QuerySurvivors chains the current query on the "survivors" edge.
+func (aq *AbilityQuery) QuerySurvivors() *SurvivorQuery {
+	query := (&SurvivorClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ability.Table, ability.FieldID, selector),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ability.SurvivorsTable, ability.SurvivorsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Ability entity from the query.
+// Returns a *NotFoundError when no Ability was found.
+func (aq *AbilityQuery) First(ctx context.Context) (*Ability, error) {
+	nodes, err := aq.Limit(1).All(setContextOp(ctx, aq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ability.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aq *AbilityQuery) FirstX(ctx context.Context) *Ability {
+	node, err := aq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Ability ID from the query.
+// Returns a *NotFoundError when no Ability ID was found.
+func (aq *AbilityQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aq.Limit(1).IDs(setContextOp(ctx, aq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ability.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aq *AbilityQuery) FirstIDX(ctx context.Context) int {
+	id, err := aq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Ability entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Ability entity is found.
+// Returns a *NotFoundError when no Ability entities are found.
+func (aq *AbilityQuery) Only(ctx context.Context) (*Ability, error) {
+	nodes, err := aq.Limit(2).All(setContextOp(ctx, aq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ability.Label}
+	default:
+		return nil, &NotSingularError{ability.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aq *AbilityQuery) OnlyX(ctx context.Context) *Ability {
+	node, err := aq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Ability ID in the query.
+// Returns a *NotSingularError when more than one Ability ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aq *AbilityQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aq.Limit(2).IDs(setContextOp(ctx, aq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ability.Label}
+	default:
+		err = &NotSingularError{ability.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aq *AbilityQuery) OnlyIDX(ctx context.Context) int {
+	id, err := aq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Abilities.
+func (aq *AbilityQuery) All(ctx context.Context) ([]*Ability, error) {
+	ctx = setContextOp(ctx, aq.ctx, ent.OpQueryAll)
+	if err := aq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Ability, *AbilityQuery]()
+	return withInterceptors[[]*Ability](ctx, aq, qr, aq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aq *AbilityQuery) AllX(ctx context.Context) []*Ability {
+	nodes, err := aq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Ability IDs.
+func (aq *AbilityQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if aq.ctx.Unique == nil && aq.path != nil {
+		aq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aq.ctx, ent.OpQueryIDs)
+	if err = aq.Select(ability.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aq *AbilityQuery) IDsX(ctx context.Context) []int {
+	ids, err := aq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aq *AbilityQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aq.ctx, ent.OpQueryCount)
+	if err := aq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aq, querierCount[*AbilityQuery](), aq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aq *AbilityQuery) CountX(ctx context.Context) int {
+	count, err := aq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aq *AbilityQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aq.ctx, ent.OpQueryExist)
+	switch _, err := aq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aq *AbilityQuery) ExistX(ctx context.Context) bool {
+	exist, err := aq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AbilityQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aq *AbilityQuery) Clone() *AbilityQuery {
+	if aq == nil {
+		return nil
+	}
+	return &AbilityQuery{
+		config:        aq.config,
+		ctx:           aq.ctx.Clone(),
+		order:         append([]ability.OrderOption{}, aq.order...),
+		inters:        append([]Interceptor{}, aq.inters...),
+		predicates:    append([]predicate.Ability{}, aq.predicates...),
+		withSurvivors: aq.withSurvivors.Clone(),
+		// clone intermediate query.
+		sql:  aq.sql.Clone(),
+		path: aq.path,
+	}
+}
+
+// WithSurvivors tells the query-builder to eager-load the nodes that are connected to
+// the "survivors" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AbilityQuery) WithSurvivors(opts ...func(*SurvivorQuery)) *AbilityQuery {
+	query := (&SurvivorClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSurvivors = query
+	return aq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Ability.Query().
+//		GroupBy(ability.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aq *AbilityQuery) GroupBy(field string, fields ...string) *AbilityGroupBy {
+	aq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AbilityGroupBy{build: aq}
+	grbuild.flds = &aq.ctx.Fields
+	grbuild.label = ability.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Ability.Query().
+//		Select(ability.FieldName).
+//		Scan(ctx, &v)
+func (aq *AbilityQuery) Select(fields ...string) *AbilitySelect {
+	aq.ctx.Fields = append(aq.ctx.Fields, fields...)
+	sbuild := &AbilitySelect{AbilityQuery: aq}
+	sbuild.label = ability.Label
+	sbuild.flds, sbuild.scan = &aq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AbilitySelect configured with the given aggregations.
+func (aq *AbilityQuery) Aggregate(fns ...AggregateFunc) *AbilitySelect {
+	return aq.Select().Aggregate(fns...)
+}
+
+func (aq *AbilityQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aq.ctx.Fields {
+		if !ability.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aq.path != nil {
+		prev, err := aq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aq.sql = prev
+	}
+	if ability.Policy == nil {
+		return errors.New("ent: uninitialized ability.Policy (forgotten import ent/runtime?)")
+	}
+	if err := ability.Policy.EvalQuery(ctx, aq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (aq *AbilityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ability, error) {
+	var (
+		nodes       = []*Ability{}
+		_spec       = aq.querySpec()
+		loadedTypes = [1]bool{
+			aq.withSurvivors != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Ability).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Ability{config: aq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aq.withSurvivors; query != nil {
+		if err := aq.loadSurvivors(ctx, query, nodes,
+			func(n *Ability) { n.Edges.Survivors = []*Survivor{} },
+			func(n *Ability, e *Survivor) { n.Edges.Survivors = append(n.Edges.Survivors, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range aq.withNamedSurvivors {
+		if err := aq.loadSurvivors(ctx, query, nodes,
+			func(n *Ability) { n.appendNamedSurvivors(name) },
+			func(n *Ability, e *Survivor) { n.appendNamedSurvivors(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range aq.loadTotal {
+		if err := aq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aq *AbilityQuery) loadSurvivors(ctx context.Context, query *SurvivorQuery, nodes []*Ability, init func(*Ability), assign func(*Ability, *Survivor)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Ability)
+	nids := make(map[int]map[*Ability]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(ability.SurvivorsTable)
+		s.Join(joinT).On(s.C(survivor.FieldID), joinT.C(ability.SurvivorsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(ability.SurvivorsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(ability.SurvivorsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Ability]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Survivor](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "survivors" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (aq *AbilityQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aq.querySpec()
+	if len(aq.modifiers) > 0 {
+		_spec.Modifiers = aq.modifiers
+	}
+	_spec.Node.Columns = aq.ctx.Fields
+	if len(aq.ctx.Fields) > 0 {
+		_spec.Unique = aq.ctx.Unique != nil && *aq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aq.driver, _spec)
+}
+
+func (aq *AbilityQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ability.Table, ability.Columns, sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt))
+	_spec.From = aq.sql
+	if unique := aq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ability.FieldID)
+		for i := range fields {
+			if fields[i] != ability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aq *AbilityQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aq.driver.Dialect())
+	t1 := builder.Table(ability.Table)
+	columns := aq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ability.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aq.sql != nil {
+		selector = aq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aq.ctx.Unique != nil && *aq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range aq.predicates {
+		p(selector)
+	}
+	for _, p := range aq.order {
+		p(selector)
+	}
+	if offset := aq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedSurvivors tells the query-builder to eager-load the nodes that are connected to the "survivors"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aq *AbilityQuery) WithNamedSurvivors(name string, opts ...func(*SurvivorQuery)) *AbilityQuery {
+	query := (&SurvivorClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aq.withNamedSurvivors == nil {
+		aq.withNamedSurvivors = make(map[string]*SurvivorQuery)
+	}
+	aq.withNamedSurvivors[name] = query
+	return aq
+}
+
+// AbilityGroupBy is the group-by builder for Ability entities.
+type AbilityGroupBy struct {
+	selector
+	build *AbilityQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (agb *AbilityGroupBy) Aggregate(fns ...AggregateFunc) *AbilityGroupBy {
+	agb.fns = append(agb.fns, fns...)
+	return agb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (agb *AbilityGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, agb.build.ctx, ent.OpQueryGroupBy)
+	if err := agb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AbilityQuery, *AbilityGroupBy](ctx, agb.build, agb, agb.build.inters, v)
+}
+
+func (agb *AbilityGroupBy) sqlScan(ctx context.Context, root *AbilityQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(agb.fns))
+	for _, fn := range agb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*agb.flds)+len(agb.fns))
+		for _, f := range *agb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*agb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := agb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AbilitySelect is the builder for selecting fields of Ability entities.
+type AbilitySelect struct {
+	*AbilityQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (as *AbilitySelect) Aggregate(fns ...AggregateFunc) *AbilitySelect {
+	as.fns = append(as.fns, fns...)
+	return as
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (as *AbilitySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, as.ctx, ent.OpQuerySelect)
+	if err := as.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AbilityQuery, *AbilitySelect](ctx, as.AbilityQuery, as, as.inters, v)
+}
+
+func (as *AbilitySelect) sqlScan(ctx context.Context, root *AbilityQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(as.fns))
+	for _, fn := range as.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*as.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := as.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
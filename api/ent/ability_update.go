@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// AbilityUpdate is the builder for updating Ability entities.
+type AbilityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AbilityMutation
+}
+
+// Where appends a list predicates to the AbilityUpdate builder.
+func (au *AbilityUpdate) Where(ps ...predicate.Ability) *AbilityUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AbilityUpdate) SetName(s string) *AbilityUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AbilityUpdate) SetNillableName(s *string) *AbilityUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// SetKind sets the "kind" field.
+func (au *AbilityUpdate) SetKind(a ability.Kind) *AbilityUpdate {
+	au.mutation.SetKind(a)
+	return au
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (au *AbilityUpdate) SetNillableKind(a *ability.Kind) *AbilityUpdate {
+	if a != nil {
+		au.SetKind(*a)
+	}
+	return au
+}
+
+// SetRules sets the "rules" field.
+func (au *AbilityUpdate) SetRules(s string) *AbilityUpdate {
+	au.mutation.SetRules(s)
+	return au
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (au *AbilityUpdate) SetNillableRules(s *string) *AbilityUpdate {
+	if s != nil {
+		au.SetRules(*s)
+	}
+	return au
+}
+
+// SetModifiers sets the "modifiers" field.
+func (au *AbilityUpdate) SetModifiers(sm []schematype.StatModifier) *AbilityUpdate {
+	au.mutation.SetModifiers(sm)
+	return au
+}
+
+// AppendModifiers appends sm to the "modifiers" field.
+func (au *AbilityUpdate) AppendModifiers(sm []schematype.StatModifier) *AbilityUpdate {
+	au.mutation.AppendModifiers(sm)
+	return au
+}
+
+// ClearModifiers clears the value of the "modifiers" field.
+func (au *AbilityUpdate) ClearModifiers() *AbilityUpdate {
+	au.mutation.ClearModifiers()
+	return au
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (au *AbilityUpdate) AddSurvivorIDs(ids ...int) *AbilityUpdate {
+	au.mutation.AddSurvivorIDs(ids...)
+	return au
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (au *AbilityUpdate) AddSurvivors(s ...*Survivor) *AbilityUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the AbilityMutation object of the builder.
+func (au *AbilityUpdate) Mutation() *AbilityMutation {
+	return au.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (au *AbilityUpdate) ClearSurvivors() *AbilityUpdate {
+	au.mutation.ClearSurvivors()
+	return au
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (au *AbilityUpdate) RemoveSurvivorIDs(ids ...int) *AbilityUpdate {
+	au.mutation.RemoveSurvivorIDs(ids...)
+	return au
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (au *AbilityUpdate) RemoveSurvivors(s ...*Survivor) *AbilityUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveSurvivorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AbilityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AbilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AbilityUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AbilityUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AbilityUpdate) check() error {
+	if v, ok := au.mutation.Name(); ok {
+		if err := ability.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ability.name": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Kind(); ok {
+		if err := ability.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ability.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (au *AbilityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ability.Table, ability.Columns, sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.SetField(ability.FieldName, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Kind(); ok {
+		_spec.SetField(ability.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.Rules(); ok {
+		_spec.SetField(ability.FieldRules, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Modifiers(); ok {
+		_spec.SetField(ability.FieldModifiers, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedModifiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ability.FieldModifiers, value)
+		})
+	}
+	if au.mutation.ModifiersCleared() {
+		_spec.ClearField(ability.FieldModifiers, field.TypeJSON)
+	}
+	if au.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !au.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AbilityUpdateOne is the builder for updating a single Ability entity.
+type AbilityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AbilityMutation
+}
+
+// SetName sets the "name" field.
+func (auo *AbilityUpdateOne) SetName(s string) *AbilityUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AbilityUpdateOne) SetNillableName(s *string) *AbilityUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetKind sets the "kind" field.
+func (auo *AbilityUpdateOne) SetKind(a ability.Kind) *AbilityUpdateOne {
+	auo.mutation.SetKind(a)
+	return auo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (auo *AbilityUpdateOne) SetNillableKind(a *ability.Kind) *AbilityUpdateOne {
+	if a != nil {
+		auo.SetKind(*a)
+	}
+	return auo
+}
+
+// SetRules sets the "rules" field.
+func (auo *AbilityUpdateOne) SetRules(s string) *AbilityUpdateOne {
+	auo.mutation.SetRules(s)
+	return auo
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (auo *AbilityUpdateOne) SetNillableRules(s *string) *AbilityUpdateOne {
+	if s != nil {
+		auo.SetRules(*s)
+	}
+	return auo
+}
+
+// SetModifiers sets the "modifiers" field.
+func (auo *AbilityUpdateOne) SetModifiers(sm []schematype.StatModifier) *AbilityUpdateOne {
+	auo.mutation.SetModifiers(sm)
+	return auo
+}
+
+// AppendModifiers appends sm to the "modifiers" field.
+func (auo *AbilityUpdateOne) AppendModifiers(sm []schematype.StatModifier) *AbilityUpdateOne {
+	auo.mutation.AppendModifiers(sm)
+	return auo
+}
+
+// ClearModifiers clears the value of the "modifiers" field.
+func (auo *AbilityUpdateOne) ClearModifiers() *AbilityUpdateOne {
+	auo.mutation.ClearModifiers()
+	return auo
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (auo *AbilityUpdateOne) AddSurvivorIDs(ids ...int) *AbilityUpdateOne {
+	auo.mutation.AddSurvivorIDs(ids...)
+	return auo
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (auo *AbilityUpdateOne) AddSurvivors(s ...*Survivor) *AbilityUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the AbilityMutation object of the builder.
+func (auo *AbilityUpdateOne) Mutation() *AbilityMutation {
+	return auo.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (auo *AbilityUpdateOne) ClearSurvivors() *AbilityUpdateOne {
+	auo.mutation.ClearSurvivors()
+	return auo
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (auo *AbilityUpdateOne) RemoveSurvivorIDs(ids ...int) *AbilityUpdateOne {
+	auo.mutation.RemoveSurvivorIDs(ids...)
+	return auo
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (auo *AbilityUpdateOne) RemoveSurvivors(s ...*Survivor) *AbilityUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveSurvivorIDs(ids...)
+}
+
+// Where appends a list predicates to the AbilityUpdate builder.
+func (auo *AbilityUpdateOne) Where(ps ...predicate.Ability) *AbilityUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AbilityUpdateOne) Select(field string, fields ...string) *AbilityUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Ability entity.
+func (auo *AbilityUpdateOne) Save(ctx context.Context) (*Ability, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AbilityUpdateOne) SaveX(ctx context.Context) *Ability {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AbilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AbilityUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AbilityUpdateOne) check() error {
+	if v, ok := auo.mutation.Name(); ok {
+		if err := ability.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ability.name": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Kind(); ok {
+		if err := ability.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ability.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AbilityUpdateOne) sqlSave(ctx context.Context) (_node *Ability, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ability.Table, ability.Columns, sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ability.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ability.FieldID)
+		for _, f := range fields {
+			if !ability.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.SetField(ability.FieldName, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Kind(); ok {
+		_spec.SetField(ability.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.Rules(); ok {
+		_spec.SetField(ability.FieldRules, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Modifiers(); ok {
+		_spec.SetField(ability.FieldModifiers, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedModifiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ability.FieldModifiers, value)
+		})
+	}
+	if auo.mutation.ModifiersCleared() {
+		_spec.ClearField(ability.FieldModifiers, field.TypeJSON)
+	}
+	if auo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !auo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   ability.SurvivorsTable,
+			Columns: ability.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Ability{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
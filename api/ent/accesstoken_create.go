@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+)
+
+// AccessTokenCreate is the builder for creating a AccessToken entity.
+type AccessTokenCreate struct {
+	config
+	mutation *AccessTokenMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (atc *AccessTokenCreate) SetUserID(s string) *AccessTokenCreate {
+	atc.mutation.SetUserID(s)
+	return atc
+}
+
+// SetName sets the "name" field.
+func (atc *AccessTokenCreate) SetName(s string) *AccessTokenCreate {
+	atc.mutation.SetName(s)
+	return atc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atc *AccessTokenCreate) SetTokenHash(s string) *AccessTokenCreate {
+	atc.mutation.SetTokenHash(s)
+	return atc
+}
+
+// SetScope sets the "scope" field.
+func (atc *AccessTokenCreate) SetScope(a accesstoken.Scope) *AccessTokenCreate {
+	atc.mutation.SetScope(a)
+	return atc
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableScope(a *accesstoken.Scope) *AccessTokenCreate {
+	if a != nil {
+		atc.SetScope(*a)
+	}
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *AccessTokenCreate) SetExpiresAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atc *AccessTokenCreate) SetLastUsedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetLastUsedAt(t)
+	return atc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableLastUsedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetLastUsedAt(*t)
+	}
+	return atc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atc *AccessTokenCreate) SetRevokedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetRevokedAt(t)
+	return atc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableRevokedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetRevokedAt(*t)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AccessTokenCreate) SetCreatedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableCreatedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atc *AccessTokenCreate) Mutation() *AccessTokenMutation {
+	return atc.mutation
+}
+
+// Save creates the AccessToken in the database.
+func (atc *AccessTokenCreate) Save(ctx context.Context) (*AccessToken, error) {
+	if err := atc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AccessTokenCreate) SaveX(ctx context.Context) *AccessToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AccessTokenCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AccessTokenCreate) defaults() error {
+	if _, ok := atc.mutation.Scope(); !ok {
+		v := accesstoken.DefaultScope
+		atc.mutation.SetScope(v)
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		if accesstoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesstoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesstoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AccessTokenCreate) check() error {
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccessToken.user_id"`)}
+	}
+	if v, ok := atc.mutation.UserID(); ok {
+		if err := accesstoken.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "AccessToken.user_id": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccessToken.name"`)}
+	}
+	if v, ok := atc.mutation.Name(); ok {
+		if err := accesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessToken.name": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AccessToken.token_hash"`)}
+	}
+	if _, ok := atc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "AccessToken.scope"`)}
+	}
+	if v, ok := atc.mutation.Scope(); ok {
+		if err := accesstoken.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "AccessToken.scope": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AccessToken.expires_at"`)}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessToken.created_at"`)}
+	}
+	return nil
+}
+
+func (atc *AccessTokenCreate) sqlSave(ctx context.Context) (*AccessToken, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AccessTokenCreate) createSpec() (*AccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessToken{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(accesstoken.Table, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.UserID(); ok {
+		_spec.SetField(accesstoken.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := atc.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := atc.mutation.TokenHash(); ok {
+		_spec.SetField(accesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := atc.mutation.Scope(); ok {
+		_spec.SetField(accesstoken.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := atc.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := atc.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AccessTokenCreateBulk is the builder for creating many AccessToken entities in bulk.
+type AccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AccessTokenCreate
+}
+
+// Save creates the AccessToken entities in the database.
+func (atcb *AccessTokenCreateBulk) Save(ctx context.Context) ([]*AccessToken, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AccessToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) SaveX(ctx context.Context) []*AccessToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
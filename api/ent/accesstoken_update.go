@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// AccessTokenUpdate is the builder for updating AccessToken entities.
+type AccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atu *AccessTokenUpdate) Where(ps ...predicate.AccessToken) *AccessTokenUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atu *AccessTokenUpdate) SetLastUsedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetLastUsedAt(t)
+	return atu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetLastUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atu *AccessTokenUpdate) ClearLastUsedAt() *AccessTokenUpdate {
+	atu.mutation.ClearLastUsedAt()
+	return atu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atu *AccessTokenUpdate) SetRevokedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetRevokedAt(t)
+	return atu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableRevokedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetRevokedAt(*t)
+	}
+	return atu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (atu *AccessTokenUpdate) ClearRevokedAt() *AccessTokenUpdate {
+	atu.mutation.ClearRevokedAt()
+	return atu
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atu *AccessTokenUpdate) Mutation() *AccessTokenMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atu *AccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if atu.mutation.RevokedAtCleared() {
+		_spec.ClearField(accesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AccessTokenUpdateOne is the builder for updating a single AccessToken entity.
+type AccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) SetLastUsedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetLastUsedAt(t)
+	return atuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetLastUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) ClearLastUsedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearLastUsedAt()
+	return atuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atuo *AccessTokenUpdateOne) SetRevokedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetRevokedAt(t)
+	return atuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetRevokedAt(*t)
+	}
+	return atuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (atuo *AccessTokenUpdateOne) ClearRevokedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearRevokedAt()
+	return atuo
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atuo *AccessTokenUpdateOne) Mutation() *AccessTokenMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atuo *AccessTokenUpdateOne) Where(ps ...predicate.AccessToken) *AccessTokenUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AccessTokenUpdateOne) Select(field string, fields ...string) *AccessTokenUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AccessToken entity.
+func (atuo *AccessTokenUpdateOne) Save(ctx context.Context) (*AccessToken, error) {
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) SaveX(ctx context.Context) *AccessToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atuo *AccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *AccessToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesstoken.FieldID)
+		for _, f := range fields {
+			if !accesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(accesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &AccessToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
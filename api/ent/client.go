@@ -15,8 +15,24 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 // Client is the client that holds all ent builders.
@@ -24,10 +40,42 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Ability is the client for interacting with the Ability builders.
+	Ability *AbilityClient
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Disorder is the client for interacting with the Disorder builders.
+	Disorder *DisorderClient
+	// FightingArt is the client for interacting with the FightingArt builders.
+	FightingArt *FightingArtClient
+	// Innovation is the client for interacting with the Innovation builders.
+	Innovation *InnovationClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Monster is the client for interacting with the Monster builders.
+	Monster *MonsterClient
+	// MonsterProgress is the client for interacting with the MonsterProgress builders.
+	MonsterProgress *MonsterProgressClient
+	// Principle is the client for interacting with the Principle builders.
+	Principle *PrincipleClient
+	// Recipe is the client for interacting with the Recipe builders.
+	Recipe *RecipeClient
 	// Settlement is the client for interacting with the Settlement builders.
 	Settlement *SettlementClient
+	// StorageItem is the client for interacting with the StorageItem builders.
+	StorageItem *StorageItemClient
 	// Survivor is the client for interacting with the Survivor builders.
 	Survivor *SurvivorClient
+	// TimelineEvent is the client for interacting with the TimelineEvent builders.
+	TimelineEvent *TimelineEventClient
 	// additional fields for node api
 	tables tables
 }
@@ -41,8 +89,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Ability = NewAbilityClient(c.config)
+	c.AccessToken = NewAccessTokenClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.Disorder = NewDisorderClient(c.config)
+	c.FightingArt = NewFightingArtClient(c.config)
+	c.Innovation = NewInnovationClient(c.config)
+	c.Invite = NewInviteClient(c.config)
+	c.Location = NewLocationClient(c.config)
+	c.Membership = NewMembershipClient(c.config)
+	c.Milestone = NewMilestoneClient(c.config)
+	c.Monster = NewMonsterClient(c.config)
+	c.MonsterProgress = NewMonsterProgressClient(c.config)
+	c.Principle = NewPrincipleClient(c.config)
+	c.Recipe = NewRecipeClient(c.config)
 	c.Settlement = NewSettlementClient(c.config)
+	c.StorageItem = NewStorageItemClient(c.config)
 	c.Survivor = NewSurvivorClient(c.config)
+	c.TimelineEvent = NewTimelineEventClient(c.config)
 }
 
 type (
@@ -133,10 +197,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Settlement: NewSettlementClient(cfg),
-		Survivor:   NewSurvivorClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Ability:         NewAbilityClient(cfg),
+		AccessToken:     NewAccessTokenClient(cfg),
+		AuditLog:        NewAuditLogClient(cfg),
+		Disorder:        NewDisorderClient(cfg),
+		FightingArt:     NewFightingArtClient(cfg),
+		Innovation:      NewInnovationClient(cfg),
+		Invite:          NewInviteClient(cfg),
+		Location:        NewLocationClient(cfg),
+		Membership:      NewMembershipClient(cfg),
+		Milestone:       NewMilestoneClient(cfg),
+		Monster:         NewMonsterClient(cfg),
+		MonsterProgress: NewMonsterProgressClient(cfg),
+		Principle:       NewPrincipleClient(cfg),
+		Recipe:          NewRecipeClient(cfg),
+		Settlement:      NewSettlementClient(cfg),
+		StorageItem:     NewStorageItemClient(cfg),
+		Survivor:        NewSurvivorClient(cfg),
+		TimelineEvent:   NewTimelineEventClient(cfg),
 	}, nil
 }
 
@@ -154,17 +234,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Settlement: NewSettlementClient(cfg),
-		Survivor:   NewSurvivorClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		Ability:         NewAbilityClient(cfg),
+		AccessToken:     NewAccessTokenClient(cfg),
+		AuditLog:        NewAuditLogClient(cfg),
+		Disorder:        NewDisorderClient(cfg),
+		FightingArt:     NewFightingArtClient(cfg),
+		Innovation:      NewInnovationClient(cfg),
+		Invite:          NewInviteClient(cfg),
+		Location:        NewLocationClient(cfg),
+		Membership:      NewMembershipClient(cfg),
+		Milestone:       NewMilestoneClient(cfg),
+		Monster:         NewMonsterClient(cfg),
+		MonsterProgress: NewMonsterProgressClient(cfg),
+		Principle:       NewPrincipleClient(cfg),
+		Recipe:          NewRecipeClient(cfg),
+		Settlement:      NewSettlementClient(cfg),
+		StorageItem:     NewStorageItemClient(cfg),
+		Survivor:        NewSurvivorClient(cfg),
+		TimelineEvent:   NewTimelineEventClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Settlement.
+//		Ability.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -186,130 +282,2601 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Settlement.Use(hooks...)
-	c.Survivor.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Ability, c.AccessToken, c.AuditLog, c.Disorder, c.FightingArt, c.Innovation,
+		c.Invite, c.Location, c.Membership, c.Milestone, c.Monster, c.MonsterProgress,
+		c.Principle, c.Recipe, c.Settlement, c.StorageItem, c.Survivor,
+		c.TimelineEvent,
+	} {
+		n.Use(hooks...)
+	}
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Ability, c.AccessToken, c.AuditLog, c.Disorder, c.FightingArt, c.Innovation,
+		c.Invite, c.Location, c.Membership, c.Milestone, c.Monster, c.MonsterProgress,
+		c.Principle, c.Recipe, c.Settlement, c.StorageItem, c.Survivor,
+		c.TimelineEvent,
+	} {
+		n.Intercept(interceptors...)
+	}
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *AbilityMutation:
+		return c.Ability.mutate(ctx, m)
+	case *AccessTokenMutation:
+		return c.AccessToken.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *DisorderMutation:
+		return c.Disorder.mutate(ctx, m)
+	case *FightingArtMutation:
+		return c.FightingArt.mutate(ctx, m)
+	case *InnovationMutation:
+		return c.Innovation.mutate(ctx, m)
+	case *InviteMutation:
+		return c.Invite.mutate(ctx, m)
+	case *LocationMutation:
+		return c.Location.mutate(ctx, m)
+	case *MembershipMutation:
+		return c.Membership.mutate(ctx, m)
+	case *MilestoneMutation:
+		return c.Milestone.mutate(ctx, m)
+	case *MonsterMutation:
+		return c.Monster.mutate(ctx, m)
+	case *MonsterProgressMutation:
+		return c.MonsterProgress.mutate(ctx, m)
+	case *PrincipleMutation:
+		return c.Principle.mutate(ctx, m)
+	case *RecipeMutation:
+		return c.Recipe.mutate(ctx, m)
+	case *SettlementMutation:
+		return c.Settlement.mutate(ctx, m)
+	case *StorageItemMutation:
+		return c.StorageItem.mutate(ctx, m)
+	case *SurvivorMutation:
+		return c.Survivor.mutate(ctx, m)
+	case *TimelineEventMutation:
+		return c.TimelineEvent.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// AbilityClient is a client for the Ability schema.
+type AbilityClient struct {
+	config
+}
+
+// NewAbilityClient returns a client for the Ability from the given config.
+func NewAbilityClient(c config) *AbilityClient {
+	return &AbilityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ability.Hooks(f(g(h())))`.
+func (c *AbilityClient) Use(hooks ...Hook) {
+	c.hooks.Ability = append(c.hooks.Ability, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ability.Intercept(f(g(h())))`.
+func (c *AbilityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ability = append(c.inters.Ability, interceptors...)
+}
+
+// Create returns a builder for creating a Ability entity.
+func (c *AbilityClient) Create() *AbilityCreate {
+	mutation := newAbilityMutation(c.config, OpCreate)
+	return &AbilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ability entities.
+func (c *AbilityClient) CreateBulk(builders ...*AbilityCreate) *AbilityCreateBulk {
+	return &AbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AbilityClient) MapCreateBulk(slice any, setFunc func(*AbilityCreate, int)) *AbilityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AbilityCreateBulk{err: fmt.Errorf("calling to AbilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AbilityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ability.
+func (c *AbilityClient) Update() *AbilityUpdate {
+	mutation := newAbilityMutation(c.config, OpUpdate)
+	return &AbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AbilityClient) UpdateOne(a *Ability) *AbilityUpdateOne {
+	mutation := newAbilityMutation(c.config, OpUpdateOne, withAbility(a))
+	return &AbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AbilityClient) UpdateOneID(id int) *AbilityUpdateOne {
+	mutation := newAbilityMutation(c.config, OpUpdateOne, withAbilityID(id))
+	return &AbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ability.
+func (c *AbilityClient) Delete() *AbilityDelete {
+	mutation := newAbilityMutation(c.config, OpDelete)
+	return &AbilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AbilityClient) DeleteOne(a *Ability) *AbilityDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AbilityClient) DeleteOneID(id int) *AbilityDeleteOne {
+	builder := c.Delete().Where(ability.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AbilityDeleteOne{builder}
+}
+
+// Query returns a query builder for Ability.
+func (c *AbilityClient) Query() *AbilityQuery {
+	return &AbilityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAbility},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ability entity by its id.
+func (c *AbilityClient) Get(ctx context.Context, id int) (*Ability, error) {
+	return c.Query().Where(ability.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AbilityClient) GetX(ctx context.Context, id int) *Ability {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySurvivors queries the survivors edge of a Ability.
+func (c *AbilityClient) QuerySurvivors(a *Ability) *SurvivorQuery {
+	query := (&SurvivorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ability.Table, ability.FieldID, id),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ability.SurvivorsTable, ability.SurvivorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AbilityClient) Hooks() []Hook {
+	hooks := c.hooks.Ability
+	return append(hooks[:len(hooks):len(hooks)], ability.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AbilityClient) Interceptors() []Interceptor {
+	return c.inters.Ability
+}
+
+func (c *AbilityClient) mutate(ctx context.Context, m *AbilityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AbilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AbilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ability mutation op: %q", m.Op())
+	}
+}
+
+// AccessTokenClient is a client for the AccessToken schema.
+type AccessTokenClient struct {
+	config
+}
+
+// NewAccessTokenClient returns a client for the AccessToken from the given config.
+func NewAccessTokenClient(c config) *AccessTokenClient {
+	return &AccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesstoken.Hooks(f(g(h())))`.
+func (c *AccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccessToken = append(c.hooks.AccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesstoken.Intercept(f(g(h())))`.
+func (c *AccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessToken = append(c.inters.AccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a AccessToken entity.
+func (c *AccessTokenClient) Create() *AccessTokenCreate {
+	mutation := newAccessTokenMutation(c.config, OpCreate)
+	return &AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessToken entities.
+func (c *AccessTokenClient) CreateBulk(builders ...*AccessTokenCreate) *AccessTokenCreateBulk {
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessTokenClient) MapCreateBulk(slice any, setFunc func(*AccessTokenCreate, int)) *AccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessTokenCreateBulk{err: fmt.Errorf("calling to AccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessToken.
+func (c *AccessTokenClient) Update() *AccessTokenUpdate {
+	mutation := newAccessTokenMutation(c.config, OpUpdate)
+	return &AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessTokenClient) UpdateOne(at *AccessToken) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessToken(at))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessTokenClient) UpdateOneID(id int) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessTokenID(id))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessToken.
+func (c *AccessTokenClient) Delete() *AccessTokenDelete {
+	mutation := newAccessTokenMutation(c.config, OpDelete)
+	return &AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessTokenClient) DeleteOne(at *AccessToken) *AccessTokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessTokenClient) DeleteOneID(id int) *AccessTokenDeleteOne {
+	builder := c.Delete().Where(accesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessToken.
+func (c *AccessTokenClient) Query() *AccessTokenQuery {
+	return &AccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessToken entity by its id.
+func (c *AccessTokenClient) Get(ctx context.Context, id int) (*AccessToken, error) {
+	return c.Query().Where(accesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessTokenClient) GetX(ctx context.Context, id int) *AccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessTokenClient) Hooks() []Hook {
+	hooks := c.hooks.AccessToken
+	return append(hooks[:len(hooks):len(hooks)], accesstoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccessToken
+}
+
+func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessToken mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a AuditLog.
+func (c *AuditLogClient) QuerySettlement(al *AuditLog) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := al.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditlog.Table, auditlog.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditlog.SettlementTable, auditlog.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(al.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
+// DisorderClient is a client for the Disorder schema.
+type DisorderClient struct {
+	config
+}
+
+// NewDisorderClient returns a client for the Disorder from the given config.
+func NewDisorderClient(c config) *DisorderClient {
+	return &DisorderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `disorder.Hooks(f(g(h())))`.
+func (c *DisorderClient) Use(hooks ...Hook) {
+	c.hooks.Disorder = append(c.hooks.Disorder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `disorder.Intercept(f(g(h())))`.
+func (c *DisorderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Disorder = append(c.inters.Disorder, interceptors...)
+}
+
+// Create returns a builder for creating a Disorder entity.
+func (c *DisorderClient) Create() *DisorderCreate {
+	mutation := newDisorderMutation(c.config, OpCreate)
+	return &DisorderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Disorder entities.
+func (c *DisorderClient) CreateBulk(builders ...*DisorderCreate) *DisorderCreateBulk {
+	return &DisorderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DisorderClient) MapCreateBulk(slice any, setFunc func(*DisorderCreate, int)) *DisorderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DisorderCreateBulk{err: fmt.Errorf("calling to DisorderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DisorderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DisorderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Disorder.
+func (c *DisorderClient) Update() *DisorderUpdate {
+	mutation := newDisorderMutation(c.config, OpUpdate)
+	return &DisorderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DisorderClient) UpdateOne(d *Disorder) *DisorderUpdateOne {
+	mutation := newDisorderMutation(c.config, OpUpdateOne, withDisorder(d))
+	return &DisorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DisorderClient) UpdateOneID(id int) *DisorderUpdateOne {
+	mutation := newDisorderMutation(c.config, OpUpdateOne, withDisorderID(id))
+	return &DisorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Disorder.
+func (c *DisorderClient) Delete() *DisorderDelete {
+	mutation := newDisorderMutation(c.config, OpDelete)
+	return &DisorderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DisorderClient) DeleteOne(d *Disorder) *DisorderDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DisorderClient) DeleteOneID(id int) *DisorderDeleteOne {
+	builder := c.Delete().Where(disorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DisorderDeleteOne{builder}
+}
+
+// Query returns a query builder for Disorder.
+func (c *DisorderClient) Query() *DisorderQuery {
+	return &DisorderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDisorder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Disorder entity by its id.
+func (c *DisorderClient) Get(ctx context.Context, id int) (*Disorder, error) {
+	return c.Query().Where(disorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DisorderClient) GetX(ctx context.Context, id int) *Disorder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySurvivors queries the survivors edge of a Disorder.
+func (c *DisorderClient) QuerySurvivors(d *Disorder) *SurvivorQuery {
+	query := (&SurvivorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(disorder.Table, disorder.FieldID, id),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, disorder.SurvivorsTable, disorder.SurvivorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DisorderClient) Hooks() []Hook {
+	hooks := c.hooks.Disorder
+	return append(hooks[:len(hooks):len(hooks)], disorder.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DisorderClient) Interceptors() []Interceptor {
+	return c.inters.Disorder
+}
+
+func (c *DisorderClient) mutate(ctx context.Context, m *DisorderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DisorderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DisorderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DisorderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DisorderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Disorder mutation op: %q", m.Op())
+	}
+}
+
+// FightingArtClient is a client for the FightingArt schema.
+type FightingArtClient struct {
+	config
+}
+
+// NewFightingArtClient returns a client for the FightingArt from the given config.
+func NewFightingArtClient(c config) *FightingArtClient {
+	return &FightingArtClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fightingart.Hooks(f(g(h())))`.
+func (c *FightingArtClient) Use(hooks ...Hook) {
+	c.hooks.FightingArt = append(c.hooks.FightingArt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fightingart.Intercept(f(g(h())))`.
+func (c *FightingArtClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FightingArt = append(c.inters.FightingArt, interceptors...)
+}
+
+// Create returns a builder for creating a FightingArt entity.
+func (c *FightingArtClient) Create() *FightingArtCreate {
+	mutation := newFightingArtMutation(c.config, OpCreate)
+	return &FightingArtCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FightingArt entities.
+func (c *FightingArtClient) CreateBulk(builders ...*FightingArtCreate) *FightingArtCreateBulk {
+	return &FightingArtCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FightingArtClient) MapCreateBulk(slice any, setFunc func(*FightingArtCreate, int)) *FightingArtCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FightingArtCreateBulk{err: fmt.Errorf("calling to FightingArtClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FightingArtCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FightingArtCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FightingArt.
+func (c *FightingArtClient) Update() *FightingArtUpdate {
+	mutation := newFightingArtMutation(c.config, OpUpdate)
+	return &FightingArtUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FightingArtClient) UpdateOne(fa *FightingArt) *FightingArtUpdateOne {
+	mutation := newFightingArtMutation(c.config, OpUpdateOne, withFightingArt(fa))
+	return &FightingArtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FightingArtClient) UpdateOneID(id int) *FightingArtUpdateOne {
+	mutation := newFightingArtMutation(c.config, OpUpdateOne, withFightingArtID(id))
+	return &FightingArtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FightingArt.
+func (c *FightingArtClient) Delete() *FightingArtDelete {
+	mutation := newFightingArtMutation(c.config, OpDelete)
+	return &FightingArtDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FightingArtClient) DeleteOne(fa *FightingArt) *FightingArtDeleteOne {
+	return c.DeleteOneID(fa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FightingArtClient) DeleteOneID(id int) *FightingArtDeleteOne {
+	builder := c.Delete().Where(fightingart.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FightingArtDeleteOne{builder}
+}
+
+// Query returns a query builder for FightingArt.
+func (c *FightingArtClient) Query() *FightingArtQuery {
+	return &FightingArtQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFightingArt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FightingArt entity by its id.
+func (c *FightingArtClient) Get(ctx context.Context, id int) (*FightingArt, error) {
+	return c.Query().Where(fightingart.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FightingArtClient) GetX(ctx context.Context, id int) *FightingArt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySurvivors queries the survivors edge of a FightingArt.
+func (c *FightingArtClient) QuerySurvivors(fa *FightingArt) *SurvivorQuery {
+	query := (&SurvivorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fightingart.Table, fightingart.FieldID, id),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, fightingart.SurvivorsTable, fightingart.SurvivorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(fa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecretSurvivors queries the secret_survivors edge of a FightingArt.
+func (c *FightingArtClient) QuerySecretSurvivors(fa *FightingArt) *SurvivorQuery {
+	query := (&SurvivorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fightingart.Table, fightingart.FieldID, id),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, fightingart.SecretSurvivorsTable, fightingart.SecretSurvivorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(fa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FightingArtClient) Hooks() []Hook {
+	hooks := c.hooks.FightingArt
+	return append(hooks[:len(hooks):len(hooks)], fightingart.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FightingArtClient) Interceptors() []Interceptor {
+	return c.inters.FightingArt
+}
+
+func (c *FightingArtClient) mutate(ctx context.Context, m *FightingArtMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FightingArtCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FightingArtUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FightingArtUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FightingArtDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FightingArt mutation op: %q", m.Op())
+	}
+}
+
+// InnovationClient is a client for the Innovation schema.
+type InnovationClient struct {
+	config
+}
+
+// NewInnovationClient returns a client for the Innovation from the given config.
+func NewInnovationClient(c config) *InnovationClient {
+	return &InnovationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `innovation.Hooks(f(g(h())))`.
+func (c *InnovationClient) Use(hooks ...Hook) {
+	c.hooks.Innovation = append(c.hooks.Innovation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `innovation.Intercept(f(g(h())))`.
+func (c *InnovationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Innovation = append(c.inters.Innovation, interceptors...)
+}
+
+// Create returns a builder for creating a Innovation entity.
+func (c *InnovationClient) Create() *InnovationCreate {
+	mutation := newInnovationMutation(c.config, OpCreate)
+	return &InnovationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Innovation entities.
+func (c *InnovationClient) CreateBulk(builders ...*InnovationCreate) *InnovationCreateBulk {
+	return &InnovationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InnovationClient) MapCreateBulk(slice any, setFunc func(*InnovationCreate, int)) *InnovationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InnovationCreateBulk{err: fmt.Errorf("calling to InnovationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InnovationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InnovationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Innovation.
+func (c *InnovationClient) Update() *InnovationUpdate {
+	mutation := newInnovationMutation(c.config, OpUpdate)
+	return &InnovationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InnovationClient) UpdateOne(i *Innovation) *InnovationUpdateOne {
+	mutation := newInnovationMutation(c.config, OpUpdateOne, withInnovation(i))
+	return &InnovationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InnovationClient) UpdateOneID(id int) *InnovationUpdateOne {
+	mutation := newInnovationMutation(c.config, OpUpdateOne, withInnovationID(id))
+	return &InnovationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Innovation.
+func (c *InnovationClient) Delete() *InnovationDelete {
+	mutation := newInnovationMutation(c.config, OpDelete)
+	return &InnovationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InnovationClient) DeleteOne(i *Innovation) *InnovationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InnovationClient) DeleteOneID(id int) *InnovationDeleteOne {
+	builder := c.Delete().Where(innovation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InnovationDeleteOne{builder}
+}
+
+// Query returns a query builder for Innovation.
+func (c *InnovationClient) Query() *InnovationQuery {
+	return &InnovationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInnovation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Innovation entity by its id.
+func (c *InnovationClient) Get(ctx context.Context, id int) (*Innovation, error) {
+	return c.Query().Where(innovation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InnovationClient) GetX(ctx context.Context, id int) *Innovation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a Innovation.
+func (c *InnovationClient) QuerySettlement(i *Innovation) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(innovation.Table, innovation.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, innovation.SettlementTable, innovation.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InnovationClient) Hooks() []Hook {
+	hooks := c.hooks.Innovation
+	return append(hooks[:len(hooks):len(hooks)], innovation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InnovationClient) Interceptors() []Interceptor {
+	return c.inters.Innovation
+}
+
+func (c *InnovationClient) mutate(ctx context.Context, m *InnovationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InnovationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InnovationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InnovationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InnovationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Innovation mutation op: %q", m.Op())
+	}
+}
+
+// InviteClient is a client for the Invite schema.
+type InviteClient struct {
+	config
+}
+
+// NewInviteClient returns a client for the Invite from the given config.
+func NewInviteClient(c config) *InviteClient {
+	return &InviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invite.Hooks(f(g(h())))`.
+func (c *InviteClient) Use(hooks ...Hook) {
+	c.hooks.Invite = append(c.hooks.Invite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invite.Intercept(f(g(h())))`.
+func (c *InviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invite = append(c.inters.Invite, interceptors...)
+}
+
+// Create returns a builder for creating a Invite entity.
+func (c *InviteClient) Create() *InviteCreate {
+	mutation := newInviteMutation(c.config, OpCreate)
+	return &InviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invite entities.
+func (c *InviteClient) CreateBulk(builders ...*InviteCreate) *InviteCreateBulk {
+	return &InviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InviteClient) MapCreateBulk(slice any, setFunc func(*InviteCreate, int)) *InviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InviteCreateBulk{err: fmt.Errorf("calling to InviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invite.
+func (c *InviteClient) Update() *InviteUpdate {
+	mutation := newInviteMutation(c.config, OpUpdate)
+	return &InviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InviteClient) UpdateOne(i *Invite) *InviteUpdateOne {
+	mutation := newInviteMutation(c.config, OpUpdateOne, withInvite(i))
+	return &InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InviteClient) UpdateOneID(id int) *InviteUpdateOne {
+	mutation := newInviteMutation(c.config, OpUpdateOne, withInviteID(id))
+	return &InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invite.
+func (c *InviteClient) Delete() *InviteDelete {
+	mutation := newInviteMutation(c.config, OpDelete)
+	return &InviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InviteClient) DeleteOne(i *Invite) *InviteDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InviteClient) DeleteOneID(id int) *InviteDeleteOne {
+	builder := c.Delete().Where(invite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InviteDeleteOne{builder}
+}
+
+// Query returns a query builder for Invite.
+func (c *InviteClient) Query() *InviteQuery {
+	return &InviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invite entity by its id.
+func (c *InviteClient) Get(ctx context.Context, id int) (*Invite, error) {
+	return c.Query().Where(invite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InviteClient) GetX(ctx context.Context, id int) *Invite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a Invite.
+func (c *InviteClient) QuerySettlement(i *Invite) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invite.Table, invite.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invite.SettlementTable, invite.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InviteClient) Hooks() []Hook {
+	hooks := c.hooks.Invite
+	return append(hooks[:len(hooks):len(hooks)], invite.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InviteClient) Interceptors() []Interceptor {
+	return c.inters.Invite
+}
+
+func (c *InviteClient) mutate(ctx context.Context, m *InviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invite mutation op: %q", m.Op())
+	}
+}
+
+// LocationClient is a client for the Location schema.
+type LocationClient struct {
+	config
+}
+
+// NewLocationClient returns a client for the Location from the given config.
+func NewLocationClient(c config) *LocationClient {
+	return &LocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
+func (c *LocationClient) Use(hooks ...Hook) {
+	c.hooks.Location = append(c.hooks.Location, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
+func (c *LocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Location = append(c.inters.Location, interceptors...)
+}
+
+// Create returns a builder for creating a Location entity.
+func (c *LocationClient) Create() *LocationCreate {
+	mutation := newLocationMutation(c.config, OpCreate)
+	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Location entities.
+func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Location.
+func (c *LocationClient) Update() *LocationUpdate {
+	mutation := newLocationMutation(c.config, OpUpdate)
+	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocationClient) UpdateOne(l *Location) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(l))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocationClient) UpdateOneID(id int) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Location.
+func (c *LocationClient) Delete() *LocationDelete {
+	mutation := newLocationMutation(c.config, OpDelete)
+	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationClient) DeleteOne(l *Location) *LocationDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationClient) DeleteOneID(id int) *LocationDeleteOne {
+	builder := c.Delete().Where(location.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationDeleteOne{builder}
+}
+
+// Query returns a query builder for Location.
+func (c *LocationClient) Query() *LocationQuery {
+	return &LocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Location entity by its id.
+func (c *LocationClient) Get(ctx context.Context, id int) (*Location, error) {
+	return c.Query().Where(location.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationClient) GetX(ctx context.Context, id int) *Location {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRecipes queries the recipes edge of a Location.
+func (c *LocationClient) QueryRecipes(l *Location) *RecipeQuery {
+	query := (&RecipeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(recipe.Table, recipe.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.RecipesTable, location.RecipesColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySettlements queries the settlements edge of a Location.
+func (c *LocationClient) QuerySettlements(l *Location) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, location.SettlementsTable, location.SettlementsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocationClient) Hooks() []Hook {
+	hooks := c.hooks.Location
+	return append(hooks[:len(hooks):len(hooks)], location.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationClient) Interceptors() []Interceptor {
+	return c.inters.Location
+}
+
+func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+	}
+}
+
+// MembershipClient is a client for the Membership schema.
+type MembershipClient struct {
+	config
+}
+
+// NewMembershipClient returns a client for the Membership from the given config.
+func NewMembershipClient(c config) *MembershipClient {
+	return &MembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
+func (c *MembershipClient) Use(hooks ...Hook) {
+	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
+func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Membership = append(c.inters.Membership, interceptors...)
+}
+
+// Create returns a builder for creating a Membership entity.
+func (c *MembershipClient) Create() *MembershipCreate {
+	mutation := newMembershipMutation(c.config, OpCreate)
+	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Membership entities.
+func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Membership.
+func (c *MembershipClient) Update() *MembershipUpdate {
+	mutation := newMembershipMutation(c.config, OpUpdate)
+	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MembershipClient) UpdateOne(m *Membership) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(m))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MembershipClient) UpdateOneID(id int) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Membership.
+func (c *MembershipClient) Delete() *MembershipDelete {
+	mutation := newMembershipMutation(c.config, OpDelete)
+	return &MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MembershipClient) DeleteOne(m *Membership) *MembershipDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MembershipClient) DeleteOneID(id int) *MembershipDeleteOne {
+	builder := c.Delete().Where(membership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for Membership.
+func (c *MembershipClient) Query() *MembershipQuery {
+	return &MembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Membership entity by its id.
+func (c *MembershipClient) Get(ctx context.Context, id int) (*Membership, error) {
+	return c.Query().Where(membership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MembershipClient) GetX(ctx context.Context, id int) *Membership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a Membership.
+func (c *MembershipClient) QuerySettlement(m *Membership) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(membership.Table, membership.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, membership.SettlementTable, membership.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MembershipClient) Hooks() []Hook {
+	hooks := c.hooks.Membership
+	return append(hooks[:len(hooks):len(hooks)], membership.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MembershipClient) Interceptors() []Interceptor {
+	return c.inters.Membership
+}
+
+func (c *MembershipClient) mutate(ctx context.Context, m *MembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Membership mutation op: %q", m.Op())
+	}
+}
+
+// MilestoneClient is a client for the Milestone schema.
+type MilestoneClient struct {
+	config
+}
+
+// NewMilestoneClient returns a client for the Milestone from the given config.
+func NewMilestoneClient(c config) *MilestoneClient {
+	return &MilestoneClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `milestone.Hooks(f(g(h())))`.
+func (c *MilestoneClient) Use(hooks ...Hook) {
+	c.hooks.Milestone = append(c.hooks.Milestone, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `milestone.Intercept(f(g(h())))`.
+func (c *MilestoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Milestone = append(c.inters.Milestone, interceptors...)
+}
+
+// Create returns a builder for creating a Milestone entity.
+func (c *MilestoneClient) Create() *MilestoneCreate {
+	mutation := newMilestoneMutation(c.config, OpCreate)
+	return &MilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Milestone entities.
+func (c *MilestoneClient) CreateBulk(builders ...*MilestoneCreate) *MilestoneCreateBulk {
+	return &MilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MilestoneClient) MapCreateBulk(slice any, setFunc func(*MilestoneCreate, int)) *MilestoneCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MilestoneCreateBulk{err: fmt.Errorf("calling to MilestoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MilestoneCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Milestone.
+func (c *MilestoneClient) Update() *MilestoneUpdate {
+	mutation := newMilestoneMutation(c.config, OpUpdate)
+	return &MilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MilestoneClient) UpdateOne(m *Milestone) *MilestoneUpdateOne {
+	mutation := newMilestoneMutation(c.config, OpUpdateOne, withMilestone(m))
+	return &MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MilestoneClient) UpdateOneID(id int) *MilestoneUpdateOne {
+	mutation := newMilestoneMutation(c.config, OpUpdateOne, withMilestoneID(id))
+	return &MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Milestone.
+func (c *MilestoneClient) Delete() *MilestoneDelete {
+	mutation := newMilestoneMutation(c.config, OpDelete)
+	return &MilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MilestoneClient) DeleteOne(m *Milestone) *MilestoneDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MilestoneClient) DeleteOneID(id int) *MilestoneDeleteOne {
+	builder := c.Delete().Where(milestone.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MilestoneDeleteOne{builder}
+}
+
+// Query returns a query builder for Milestone.
+func (c *MilestoneClient) Query() *MilestoneQuery {
+	return &MilestoneQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMilestone},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Milestone entity by its id.
+func (c *MilestoneClient) Get(ctx context.Context, id int) (*Milestone, error) {
+	return c.Query().Where(milestone.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MilestoneClient) GetX(ctx context.Context, id int) *Milestone {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a Milestone.
+func (c *MilestoneClient) QuerySettlement(m *Milestone) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(milestone.Table, milestone.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, milestone.SettlementTable, milestone.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MilestoneClient) Hooks() []Hook {
+	hooks := c.hooks.Milestone
+	return append(hooks[:len(hooks):len(hooks)], milestone.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MilestoneClient) Interceptors() []Interceptor {
+	return c.inters.Milestone
+}
+
+func (c *MilestoneClient) mutate(ctx context.Context, m *MilestoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Milestone mutation op: %q", m.Op())
+	}
+}
+
+// MonsterClient is a client for the Monster schema.
+type MonsterClient struct {
+	config
+}
+
+// NewMonsterClient returns a client for the Monster from the given config.
+func NewMonsterClient(c config) *MonsterClient {
+	return &MonsterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `monster.Hooks(f(g(h())))`.
+func (c *MonsterClient) Use(hooks ...Hook) {
+	c.hooks.Monster = append(c.hooks.Monster, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `monster.Intercept(f(g(h())))`.
+func (c *MonsterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Monster = append(c.inters.Monster, interceptors...)
+}
+
+// Create returns a builder for creating a Monster entity.
+func (c *MonsterClient) Create() *MonsterCreate {
+	mutation := newMonsterMutation(c.config, OpCreate)
+	return &MonsterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Monster entities.
+func (c *MonsterClient) CreateBulk(builders ...*MonsterCreate) *MonsterCreateBulk {
+	return &MonsterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MonsterClient) MapCreateBulk(slice any, setFunc func(*MonsterCreate, int)) *MonsterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MonsterCreateBulk{err: fmt.Errorf("calling to MonsterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MonsterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MonsterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Monster.
+func (c *MonsterClient) Update() *MonsterUpdate {
+	mutation := newMonsterMutation(c.config, OpUpdate)
+	return &MonsterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MonsterClient) UpdateOne(m *Monster) *MonsterUpdateOne {
+	mutation := newMonsterMutation(c.config, OpUpdateOne, withMonster(m))
+	return &MonsterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MonsterClient) UpdateOneID(id int) *MonsterUpdateOne {
+	mutation := newMonsterMutation(c.config, OpUpdateOne, withMonsterID(id))
+	return &MonsterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Monster.
+func (c *MonsterClient) Delete() *MonsterDelete {
+	mutation := newMonsterMutation(c.config, OpDelete)
+	return &MonsterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MonsterClient) DeleteOne(m *Monster) *MonsterDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MonsterClient) DeleteOneID(id int) *MonsterDeleteOne {
+	builder := c.Delete().Where(monster.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MonsterDeleteOne{builder}
+}
+
+// Query returns a query builder for Monster.
+func (c *MonsterClient) Query() *MonsterQuery {
+	return &MonsterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMonster},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Monster entity by its id.
+func (c *MonsterClient) Get(ctx context.Context, id int) (*Monster, error) {
+	return c.Query().Where(monster.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MonsterClient) GetX(ctx context.Context, id int) *Monster {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MonsterClient) Hooks() []Hook {
+	hooks := c.hooks.Monster
+	return append(hooks[:len(hooks):len(hooks)], monster.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonsterClient) Interceptors() []Interceptor {
+	return c.inters.Monster
+}
+
+func (c *MonsterClient) mutate(ctx context.Context, m *MonsterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonsterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonsterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonsterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonsterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Monster mutation op: %q", m.Op())
+	}
+}
+
+// MonsterProgressClient is a client for the MonsterProgress schema.
+type MonsterProgressClient struct {
+	config
+}
+
+// NewMonsterProgressClient returns a client for the MonsterProgress from the given config.
+func NewMonsterProgressClient(c config) *MonsterProgressClient {
+	return &MonsterProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `monsterprogress.Hooks(f(g(h())))`.
+func (c *MonsterProgressClient) Use(hooks ...Hook) {
+	c.hooks.MonsterProgress = append(c.hooks.MonsterProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `monsterprogress.Intercept(f(g(h())))`.
+func (c *MonsterProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonsterProgress = append(c.inters.MonsterProgress, interceptors...)
+}
+
+// Create returns a builder for creating a MonsterProgress entity.
+func (c *MonsterProgressClient) Create() *MonsterProgressCreate {
+	mutation := newMonsterProgressMutation(c.config, OpCreate)
+	return &MonsterProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MonsterProgress entities.
+func (c *MonsterProgressClient) CreateBulk(builders ...*MonsterProgressCreate) *MonsterProgressCreateBulk {
+	return &MonsterProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MonsterProgressClient) MapCreateBulk(slice any, setFunc func(*MonsterProgressCreate, int)) *MonsterProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MonsterProgressCreateBulk{err: fmt.Errorf("calling to MonsterProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MonsterProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MonsterProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MonsterProgress.
+func (c *MonsterProgressClient) Update() *MonsterProgressUpdate {
+	mutation := newMonsterProgressMutation(c.config, OpUpdate)
+	return &MonsterProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MonsterProgressClient) UpdateOne(mp *MonsterProgress) *MonsterProgressUpdateOne {
+	mutation := newMonsterProgressMutation(c.config, OpUpdateOne, withMonsterProgress(mp))
+	return &MonsterProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MonsterProgressClient) UpdateOneID(id int) *MonsterProgressUpdateOne {
+	mutation := newMonsterProgressMutation(c.config, OpUpdateOne, withMonsterProgressID(id))
+	return &MonsterProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MonsterProgress.
+func (c *MonsterProgressClient) Delete() *MonsterProgressDelete {
+	mutation := newMonsterProgressMutation(c.config, OpDelete)
+	return &MonsterProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MonsterProgressClient) DeleteOne(mp *MonsterProgress) *MonsterProgressDeleteOne {
+	return c.DeleteOneID(mp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MonsterProgressClient) DeleteOneID(id int) *MonsterProgressDeleteOne {
+	builder := c.Delete().Where(monsterprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MonsterProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for MonsterProgress.
+func (c *MonsterProgressClient) Query() *MonsterProgressQuery {
+	return &MonsterProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMonsterProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MonsterProgress entity by its id.
+func (c *MonsterProgressClient) Get(ctx context.Context, id int) (*MonsterProgress, error) {
+	return c.Query().Where(monsterprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MonsterProgressClient) GetX(ctx context.Context, id int) *MonsterProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a MonsterProgress.
+func (c *MonsterProgressClient) QuerySettlement(mp *MonsterProgress) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monsterprogress.Table, monsterprogress.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monsterprogress.SettlementTable, monsterprogress.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMonster queries the monster edge of a MonsterProgress.
+func (c *MonsterProgressClient) QueryMonster(mp *MonsterProgress) *MonsterQuery {
+	query := (&MonsterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monsterprogress.Table, monsterprogress.FieldID, id),
+			sqlgraph.To(monster.Table, monster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monsterprogress.MonsterTable, monsterprogress.MonsterColumn),
+		)
+		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MonsterProgressClient) Hooks() []Hook {
+	hooks := c.hooks.MonsterProgress
+	return append(hooks[:len(hooks):len(hooks)], monsterprogress.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonsterProgressClient) Interceptors() []Interceptor {
+	return c.inters.MonsterProgress
+}
+
+func (c *MonsterProgressClient) mutate(ctx context.Context, m *MonsterProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonsterProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonsterProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonsterProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonsterProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MonsterProgress mutation op: %q", m.Op())
+	}
+}
+
+// PrincipleClient is a client for the Principle schema.
+type PrincipleClient struct {
+	config
+}
+
+// NewPrincipleClient returns a client for the Principle from the given config.
+func NewPrincipleClient(c config) *PrincipleClient {
+	return &PrincipleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `principle.Hooks(f(g(h())))`.
+func (c *PrincipleClient) Use(hooks ...Hook) {
+	c.hooks.Principle = append(c.hooks.Principle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `principle.Intercept(f(g(h())))`.
+func (c *PrincipleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Principle = append(c.inters.Principle, interceptors...)
+}
+
+// Create returns a builder for creating a Principle entity.
+func (c *PrincipleClient) Create() *PrincipleCreate {
+	mutation := newPrincipleMutation(c.config, OpCreate)
+	return &PrincipleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Principle entities.
+func (c *PrincipleClient) CreateBulk(builders ...*PrincipleCreate) *PrincipleCreateBulk {
+	return &PrincipleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrincipleClient) MapCreateBulk(slice any, setFunc func(*PrincipleCreate, int)) *PrincipleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrincipleCreateBulk{err: fmt.Errorf("calling to PrincipleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrincipleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrincipleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Principle.
+func (c *PrincipleClient) Update() *PrincipleUpdate {
+	mutation := newPrincipleMutation(c.config, OpUpdate)
+	return &PrincipleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrincipleClient) UpdateOne(pr *Principle) *PrincipleUpdateOne {
+	mutation := newPrincipleMutation(c.config, OpUpdateOne, withPrinciple(pr))
+	return &PrincipleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrincipleClient) UpdateOneID(id int) *PrincipleUpdateOne {
+	mutation := newPrincipleMutation(c.config, OpUpdateOne, withPrincipleID(id))
+	return &PrincipleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Principle.
+func (c *PrincipleClient) Delete() *PrincipleDelete {
+	mutation := newPrincipleMutation(c.config, OpDelete)
+	return &PrincipleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrincipleClient) DeleteOne(pr *Principle) *PrincipleDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrincipleClient) DeleteOneID(id int) *PrincipleDeleteOne {
+	builder := c.Delete().Where(principle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrincipleDeleteOne{builder}
+}
+
+// Query returns a query builder for Principle.
+func (c *PrincipleClient) Query() *PrincipleQuery {
+	return &PrincipleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrinciple},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Principle entity by its id.
+func (c *PrincipleClient) Get(ctx context.Context, id int) (*Principle, error) {
+	return c.Query().Where(principle.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrincipleClient) GetX(ctx context.Context, id int) *Principle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a Principle.
+func (c *PrincipleClient) QuerySettlement(pr *Principle) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(principle.Table, principle.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, principle.SettlementTable, principle.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PrincipleClient) Hooks() []Hook {
+	hooks := c.hooks.Principle
+	return append(hooks[:len(hooks):len(hooks)], principle.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrincipleClient) Interceptors() []Interceptor {
+	return c.inters.Principle
+}
+
+func (c *PrincipleClient) mutate(ctx context.Context, m *PrincipleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrincipleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrincipleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrincipleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrincipleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Principle mutation op: %q", m.Op())
+	}
+}
+
+// RecipeClient is a client for the Recipe schema.
+type RecipeClient struct {
+	config
+}
+
+// NewRecipeClient returns a client for the Recipe from the given config.
+func NewRecipeClient(c config) *RecipeClient {
+	return &RecipeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recipe.Hooks(f(g(h())))`.
+func (c *RecipeClient) Use(hooks ...Hook) {
+	c.hooks.Recipe = append(c.hooks.Recipe, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recipe.Intercept(f(g(h())))`.
+func (c *RecipeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Recipe = append(c.inters.Recipe, interceptors...)
+}
+
+// Create returns a builder for creating a Recipe entity.
+func (c *RecipeClient) Create() *RecipeCreate {
+	mutation := newRecipeMutation(c.config, OpCreate)
+	return &RecipeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Recipe entities.
+func (c *RecipeClient) CreateBulk(builders ...*RecipeCreate) *RecipeCreateBulk {
+	return &RecipeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecipeClient) MapCreateBulk(slice any, setFunc func(*RecipeCreate, int)) *RecipeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecipeCreateBulk{err: fmt.Errorf("calling to RecipeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecipeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecipeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Recipe.
+func (c *RecipeClient) Update() *RecipeUpdate {
+	mutation := newRecipeMutation(c.config, OpUpdate)
+	return &RecipeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecipeClient) UpdateOne(r *Recipe) *RecipeUpdateOne {
+	mutation := newRecipeMutation(c.config, OpUpdateOne, withRecipe(r))
+	return &RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecipeClient) UpdateOneID(id int) *RecipeUpdateOne {
+	mutation := newRecipeMutation(c.config, OpUpdateOne, withRecipeID(id))
+	return &RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Recipe.
+func (c *RecipeClient) Delete() *RecipeDelete {
+	mutation := newRecipeMutation(c.config, OpDelete)
+	return &RecipeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecipeClient) DeleteOne(r *Recipe) *RecipeDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecipeClient) DeleteOneID(id int) *RecipeDeleteOne {
+	builder := c.Delete().Where(recipe.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecipeDeleteOne{builder}
+}
+
+// Query returns a query builder for Recipe.
+func (c *RecipeClient) Query() *RecipeQuery {
+	return &RecipeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecipe},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Recipe entity by its id.
+func (c *RecipeClient) Get(ctx context.Context, id int) (*Recipe, error) {
+	return c.Query().Where(recipe.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecipeClient) GetX(ctx context.Context, id int) *Recipe {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a Recipe.
+func (c *RecipeClient) QueryLocation(r *Recipe) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recipe.Table, recipe.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recipe.LocationTable, recipe.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecipeClient) Hooks() []Hook {
+	hooks := c.hooks.Recipe
+	return append(hooks[:len(hooks):len(hooks)], recipe.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecipeClient) Interceptors() []Interceptor {
+	return c.inters.Recipe
+}
+
+func (c *RecipeClient) mutate(ctx context.Context, m *RecipeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecipeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecipeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecipeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecipeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Recipe mutation op: %q", m.Op())
+	}
+}
+
+// SettlementClient is a client for the Settlement schema.
+type SettlementClient struct {
+	config
+}
+
+// NewSettlementClient returns a client for the Settlement from the given config.
+func NewSettlementClient(c config) *SettlementClient {
+	return &SettlementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlement.Hooks(f(g(h())))`.
+func (c *SettlementClient) Use(hooks ...Hook) {
+	c.hooks.Settlement = append(c.hooks.Settlement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlement.Intercept(f(g(h())))`.
+func (c *SettlementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Settlement = append(c.inters.Settlement, interceptors...)
+}
+
+// Create returns a builder for creating a Settlement entity.
+func (c *SettlementClient) Create() *SettlementCreate {
+	mutation := newSettlementMutation(c.config, OpCreate)
+	return &SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Settlement entities.
+func (c *SettlementClient) CreateBulk(builders ...*SettlementCreate) *SettlementCreateBulk {
+	return &SettlementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementClient) MapCreateBulk(slice any, setFunc func(*SettlementCreate, int)) *SettlementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementCreateBulk{err: fmt.Errorf("calling to SettlementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Settlement.
+func (c *SettlementClient) Update() *SettlementUpdate {
+	mutation := newSettlementMutation(c.config, OpUpdate)
+	return &SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementClient) UpdateOne(s *Settlement) *SettlementUpdateOne {
+	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlement(s))
+	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementClient) UpdateOneID(id int) *SettlementUpdateOne {
+	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlementID(id))
+	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Settlement.
+func (c *SettlementClient) Delete() *SettlementDelete {
+	mutation := newSettlementMutation(c.config, OpDelete)
+	return &SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementClient) DeleteOne(s *Settlement) *SettlementDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementClient) DeleteOneID(id int) *SettlementDeleteOne {
+	builder := c.Delete().Where(settlement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementDeleteOne{builder}
+}
+
+// Query returns a query builder for Settlement.
+func (c *SettlementClient) Query() *SettlementQuery {
+	return &SettlementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Settlement entity by its id.
+func (c *SettlementClient) Get(ctx context.Context, id int) (*Settlement, error) {
+	return c.Query().Where(settlement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementClient) GetX(ctx context.Context, id int) *Settlement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPopulation queries the population edge of a Settlement.
+func (c *SettlementClient) QueryPopulation(s *Settlement) *SurvivorQuery {
+	query := (&SurvivorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.PopulationTable, settlement.PopulationColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Settlement.
+func (c *SettlementClient) QueryMembers(s *Settlement) *MembershipQuery {
+	query := (&MembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(membership.Table, membership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MembersTable, settlement.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a Settlement.
+func (c *SettlementClient) QueryInvites(s *Settlement) *InviteQuery {
+	query := (&InviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.InvitesTable, settlement.InvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuditLog queries the audit_log edge of a Settlement.
+func (c *SettlementClient) QueryAuditLog(s *Settlement) *AuditLogQuery {
+	query := (&AuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.AuditLogTable, settlement.AuditLogColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimeline queries the timeline edge of a Settlement.
+func (c *SettlementClient) QueryTimeline(s *Settlement) *TimelineEventQuery {
+	query := (&TimelineEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.TimelineTable, settlement.TimelineColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStorage queries the storage edge of a Settlement.
+func (c *SettlementClient) QueryStorage(s *Settlement) *StorageItemQuery {
+	query := (&StorageItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(storageitem.Table, storageitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.StorageTable, settlement.StorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInnovations queries the innovations edge of a Settlement.
+func (c *SettlementClient) QueryInnovations(s *Settlement) *InnovationQuery {
+	query := (&InnovationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(innovation.Table, innovation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.InnovationsTable, settlement.InnovationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrinciples queries the principles edge of a Settlement.
+func (c *SettlementClient) QueryPrinciples(s *Settlement) *PrincipleQuery {
+	query := (&PrincipleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(principle.Table, principle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.PrinciplesTable, settlement.PrinciplesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Intercept adds the query interceptors to all the entity clients.
-// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
-func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Settlement.Intercept(interceptors...)
-	c.Survivor.Intercept(interceptors...)
+// QueryMilestones queries the milestones edge of a Settlement.
+func (c *SettlementClient) QueryMilestones(s *Settlement) *MilestoneQuery {
+	query := (&MilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MilestonesTable, settlement.MilestonesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Mutate implements the ent.Mutator interface.
-func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
-	switch m := m.(type) {
-	case *SettlementMutation:
-		return c.Settlement.mutate(ctx, m)
-	case *SurvivorMutation:
-		return c.Survivor.mutate(ctx, m)
+// QueryLocations queries the locations edge of a Settlement.
+func (c *SettlementClient) QueryLocations(s *Settlement) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, settlement.LocationsTable, settlement.LocationsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMonsters queries the monsters edge of a Settlement.
+func (c *SettlementClient) QueryMonsters(s *Settlement) *MonsterProgressQuery {
+	query := (&MonsterProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, id),
+			sqlgraph.To(monsterprogress.Table, monsterprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MonstersTable, settlement.MonstersColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementClient) Hooks() []Hook {
+	hooks := c.hooks.Settlement
+	return append(hooks[:len(hooks):len(hooks)], settlement.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementClient) Interceptors() []Interceptor {
+	inters := c.inters.Settlement
+	return append(inters[:len(inters):len(inters)], settlement.Interceptors[:]...)
+}
+
+func (c *SettlementClient) mutate(ctx context.Context, m *SettlementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+		return nil, fmt.Errorf("ent: unknown Settlement mutation op: %q", m.Op())
 	}
 }
 
-// SettlementClient is a client for the Settlement schema.
-type SettlementClient struct {
+// StorageItemClient is a client for the StorageItem schema.
+type StorageItemClient struct {
 	config
 }
 
-// NewSettlementClient returns a client for the Settlement from the given config.
-func NewSettlementClient(c config) *SettlementClient {
-	return &SettlementClient{config: c}
+// NewStorageItemClient returns a client for the StorageItem from the given config.
+func NewStorageItemClient(c config) *StorageItemClient {
+	return &StorageItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `settlement.Hooks(f(g(h())))`.
-func (c *SettlementClient) Use(hooks ...Hook) {
-	c.hooks.Settlement = append(c.hooks.Settlement, hooks...)
+// A call to `Use(f, g, h)` equals to `storageitem.Hooks(f(g(h())))`.
+func (c *StorageItemClient) Use(hooks ...Hook) {
+	c.hooks.StorageItem = append(c.hooks.StorageItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `settlement.Intercept(f(g(h())))`.
-func (c *SettlementClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Settlement = append(c.inters.Settlement, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `storageitem.Intercept(f(g(h())))`.
+func (c *StorageItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageItem = append(c.inters.StorageItem, interceptors...)
 }
 
-// Create returns a builder for creating a Settlement entity.
-func (c *SettlementClient) Create() *SettlementCreate {
-	mutation := newSettlementMutation(c.config, OpCreate)
-	return &SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a StorageItem entity.
+func (c *StorageItemClient) Create() *StorageItemCreate {
+	mutation := newStorageItemMutation(c.config, OpCreate)
+	return &StorageItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Settlement entities.
-func (c *SettlementClient) CreateBulk(builders ...*SettlementCreate) *SettlementCreateBulk {
-	return &SettlementCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of StorageItem entities.
+func (c *StorageItemClient) CreateBulk(builders ...*StorageItemCreate) *StorageItemCreateBulk {
+	return &StorageItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SettlementClient) MapCreateBulk(slice any, setFunc func(*SettlementCreate, int)) *SettlementCreateBulk {
+func (c *StorageItemClient) MapCreateBulk(slice any, setFunc func(*StorageItemCreate, int)) *StorageItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SettlementCreateBulk{err: fmt.Errorf("calling to SettlementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &StorageItemCreateBulk{err: fmt.Errorf("calling to StorageItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SettlementCreate, rv.Len())
+	builders := make([]*StorageItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SettlementCreateBulk{config: c.config, builders: builders}
+	return &StorageItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Settlement.
-func (c *SettlementClient) Update() *SettlementUpdate {
-	mutation := newSettlementMutation(c.config, OpUpdate)
-	return &SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for StorageItem.
+func (c *StorageItemClient) Update() *StorageItemUpdate {
+	mutation := newStorageItemMutation(c.config, OpUpdate)
+	return &StorageItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SettlementClient) UpdateOne(s *Settlement) *SettlementUpdateOne {
-	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlement(s))
-	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StorageItemClient) UpdateOne(si *StorageItem) *StorageItemUpdateOne {
+	mutation := newStorageItemMutation(c.config, OpUpdateOne, withStorageItem(si))
+	return &StorageItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SettlementClient) UpdateOneID(id int) *SettlementUpdateOne {
-	mutation := newSettlementMutation(c.config, OpUpdateOne, withSettlementID(id))
-	return &SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *StorageItemClient) UpdateOneID(id int) *StorageItemUpdateOne {
+	mutation := newStorageItemMutation(c.config, OpUpdateOne, withStorageItemID(id))
+	return &StorageItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Settlement.
-func (c *SettlementClient) Delete() *SettlementDelete {
-	mutation := newSettlementMutation(c.config, OpDelete)
-	return &SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for StorageItem.
+func (c *StorageItemClient) Delete() *StorageItemDelete {
+	mutation := newStorageItemMutation(c.config, OpDelete)
+	return &StorageItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SettlementClient) DeleteOne(s *Settlement) *SettlementDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *StorageItemClient) DeleteOne(si *StorageItem) *StorageItemDeleteOne {
+	return c.DeleteOneID(si.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SettlementClient) DeleteOneID(id int) *SettlementDeleteOne {
-	builder := c.Delete().Where(settlement.ID(id))
+func (c *StorageItemClient) DeleteOneID(id int) *StorageItemDeleteOne {
+	builder := c.Delete().Where(storageitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SettlementDeleteOne{builder}
+	return &StorageItemDeleteOne{builder}
 }
 
-// Query returns a query builder for Settlement.
-func (c *SettlementClient) Query() *SettlementQuery {
-	return &SettlementQuery{
+// Query returns a query builder for StorageItem.
+func (c *StorageItemClient) Query() *StorageItemQuery {
+	return &StorageItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSettlement},
+		ctx:    &QueryContext{Type: TypeStorageItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Settlement entity by its id.
-func (c *SettlementClient) Get(ctx context.Context, id int) (*Settlement, error) {
-	return c.Query().Where(settlement.ID(id)).Only(ctx)
+// Get returns a StorageItem entity by its id.
+func (c *StorageItemClient) Get(ctx context.Context, id int) (*StorageItem, error) {
+	return c.Query().Where(storageitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SettlementClient) GetX(ctx context.Context, id int) *Settlement {
+func (c *StorageItemClient) GetX(ctx context.Context, id int) *StorageItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -317,44 +2884,45 @@ func (c *SettlementClient) GetX(ctx context.Context, id int) *Settlement {
 	return obj
 }
 
-// QueryPopulation queries the population edge of a Settlement.
-func (c *SettlementClient) QueryPopulation(s *Settlement) *SurvivorQuery {
-	query := (&SurvivorClient{config: c.config}).Query()
+// QuerySettlement queries the settlement edge of a StorageItem.
+func (c *StorageItemClient) QuerySettlement(si *StorageItem) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := si.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(settlement.Table, settlement.FieldID, id),
-			sqlgraph.To(survivor.Table, survivor.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, settlement.PopulationTable, settlement.PopulationColumn),
+			sqlgraph.From(storageitem.Table, storageitem.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, storageitem.SettlementTable, storageitem.SettlementColumn),
 		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SettlementClient) Hooks() []Hook {
-	return c.hooks.Settlement
+func (c *StorageItemClient) Hooks() []Hook {
+	hooks := c.hooks.StorageItem
+	return append(hooks[:len(hooks):len(hooks)], storageitem.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SettlementClient) Interceptors() []Interceptor {
-	return c.inters.Settlement
+func (c *StorageItemClient) Interceptors() []Interceptor {
+	return c.inters.StorageItem
 }
 
-func (c *SettlementClient) mutate(ctx context.Context, m *SettlementMutation) (Value, error) {
+func (c *StorageItemClient) mutate(ctx context.Context, m *StorageItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SettlementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StorageItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SettlementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StorageItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SettlementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StorageItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SettlementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StorageItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Settlement mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StorageItem mutation op: %q", m.Op())
 	}
 }
 
@@ -482,14 +3050,80 @@ func (c *SurvivorClient) QuerySettlement(s *Survivor) *SettlementQuery {
 	return query
 }
 
+// QueryFightingArts queries the fighting_arts edge of a Survivor.
+func (c *SurvivorClient) QueryFightingArts(s *Survivor) *FightingArtQuery {
+	query := (&FightingArtClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, id),
+			sqlgraph.To(fightingart.Table, fightingart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.FightingArtsTable, survivor.FightingArtsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecretFightingArts queries the secret_fighting_arts edge of a Survivor.
+func (c *SurvivorClient) QuerySecretFightingArts(s *Survivor) *FightingArtQuery {
+	query := (&FightingArtClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, id),
+			sqlgraph.To(fightingart.Table, fightingart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.SecretFightingArtsTable, survivor.SecretFightingArtsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDisorders queries the disorders edge of a Survivor.
+func (c *SurvivorClient) QueryDisorders(s *Survivor) *DisorderQuery {
+	query := (&DisorderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, id),
+			sqlgraph.To(disorder.Table, disorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.DisordersTable, survivor.DisordersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAbilities queries the abilities edge of a Survivor.
+func (c *SurvivorClient) QueryAbilities(s *Survivor) *AbilityQuery {
+	query := (&AbilityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, id),
+			sqlgraph.To(ability.Table, ability.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.AbilitiesTable, survivor.AbilitiesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SurvivorClient) Hooks() []Hook {
-	return c.hooks.Survivor
+	hooks := c.hooks.Survivor
+	return append(hooks[:len(hooks):len(hooks)], survivor.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *SurvivorClient) Interceptors() []Interceptor {
-	return c.inters.Survivor
+	inters := c.inters.Survivor
+	return append(inters[:len(inters):len(inters)], survivor.Interceptors[:]...)
 }
 
 func (c *SurvivorClient) mutate(ctx context.Context, m *SurvivorMutation) (Value, error) {
@@ -507,12 +3141,166 @@ func (c *SurvivorClient) mutate(ctx context.Context, m *SurvivorMutation) (Value
 	}
 }
 
+// TimelineEventClient is a client for the TimelineEvent schema.
+type TimelineEventClient struct {
+	config
+}
+
+// NewTimelineEventClient returns a client for the TimelineEvent from the given config.
+func NewTimelineEventClient(c config) *TimelineEventClient {
+	return &TimelineEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `timelineevent.Hooks(f(g(h())))`.
+func (c *TimelineEventClient) Use(hooks ...Hook) {
+	c.hooks.TimelineEvent = append(c.hooks.TimelineEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `timelineevent.Intercept(f(g(h())))`.
+func (c *TimelineEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TimelineEvent = append(c.inters.TimelineEvent, interceptors...)
+}
+
+// Create returns a builder for creating a TimelineEvent entity.
+func (c *TimelineEventClient) Create() *TimelineEventCreate {
+	mutation := newTimelineEventMutation(c.config, OpCreate)
+	return &TimelineEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TimelineEvent entities.
+func (c *TimelineEventClient) CreateBulk(builders ...*TimelineEventCreate) *TimelineEventCreateBulk {
+	return &TimelineEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TimelineEventClient) MapCreateBulk(slice any, setFunc func(*TimelineEventCreate, int)) *TimelineEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TimelineEventCreateBulk{err: fmt.Errorf("calling to TimelineEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TimelineEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TimelineEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TimelineEvent.
+func (c *TimelineEventClient) Update() *TimelineEventUpdate {
+	mutation := newTimelineEventMutation(c.config, OpUpdate)
+	return &TimelineEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TimelineEventClient) UpdateOne(te *TimelineEvent) *TimelineEventUpdateOne {
+	mutation := newTimelineEventMutation(c.config, OpUpdateOne, withTimelineEvent(te))
+	return &TimelineEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TimelineEventClient) UpdateOneID(id int) *TimelineEventUpdateOne {
+	mutation := newTimelineEventMutation(c.config, OpUpdateOne, withTimelineEventID(id))
+	return &TimelineEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TimelineEvent.
+func (c *TimelineEventClient) Delete() *TimelineEventDelete {
+	mutation := newTimelineEventMutation(c.config, OpDelete)
+	return &TimelineEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TimelineEventClient) DeleteOne(te *TimelineEvent) *TimelineEventDeleteOne {
+	return c.DeleteOneID(te.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TimelineEventClient) DeleteOneID(id int) *TimelineEventDeleteOne {
+	builder := c.Delete().Where(timelineevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TimelineEventDeleteOne{builder}
+}
+
+// Query returns a query builder for TimelineEvent.
+func (c *TimelineEventClient) Query() *TimelineEventQuery {
+	return &TimelineEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTimelineEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TimelineEvent entity by its id.
+func (c *TimelineEventClient) Get(ctx context.Context, id int) (*TimelineEvent, error) {
+	return c.Query().Where(timelineevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TimelineEventClient) GetX(ctx context.Context, id int) *TimelineEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySettlement queries the settlement edge of a TimelineEvent.
+func (c *TimelineEventClient) QuerySettlement(te *TimelineEvent) *SettlementQuery {
+	query := (&SettlementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := te.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(timelineevent.Table, timelineevent.FieldID, id),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, timelineevent.SettlementTable, timelineevent.SettlementColumn),
+		)
+		fromV = sqlgraph.Neighbors(te.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TimelineEventClient) Hooks() []Hook {
+	hooks := c.hooks.TimelineEvent
+	return append(hooks[:len(hooks):len(hooks)], timelineevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TimelineEventClient) Interceptors() []Interceptor {
+	return c.inters.TimelineEvent
+}
+
+func (c *TimelineEventClient) mutate(ctx context.Context, m *TimelineEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TimelineEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TimelineEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TimelineEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TimelineEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TimelineEvent mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Settlement, Survivor []ent.Hook
+		Ability, AccessToken, AuditLog, Disorder, FightingArt, Innovation, Invite,
+		Location, Membership, Milestone, Monster, MonsterProgress, Principle, Recipe,
+		Settlement, StorageItem, Survivor, TimelineEvent []ent.Hook
 	}
 	inters struct {
-		Settlement, Survivor []ent.Interceptor
+		Ability, AccessToken, AuditLog, Disorder, FightingArt, Innovation, Invite,
+		Location, Membership, Milestone, Monster, MonsterProgress, Principle, Recipe,
+		Settlement, StorageItem, Survivor, TimelineEvent []ent.Interceptor
 	}
 )
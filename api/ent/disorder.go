@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/disorder"
+)
+
+// Disorder is the model entity for the Disorder schema.
+type Disorder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules string `json:"rules,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DisorderQuery when eager-loading is set.
+	Edges        DisorderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DisorderEdges holds the relations/edges for other nodes in the graph.
+type DisorderEdges struct {
+	// Survivors holds the value of the survivors edge.
+	Survivors []*Survivor `json:"survivors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedSurvivors map[string][]*Survivor
+}
+
+// SurvivorsOrErr returns the Survivors value or an error if the edge
+// was not loaded in eager-loading.
+func (e DisorderEdges) SurvivorsOrErr() ([]*Survivor, error) {
+	if e.loadedTypes[0] {
+		return e.Survivors, nil
+	}
+	return nil, &NotLoadedError{edge: "survivors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Disorder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case disorder.FieldID:
+			values[i] = new(sql.NullInt64)
+		case disorder.FieldName, disorder.FieldRules:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Disorder fields.
+func (d *Disorder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case disorder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case disorder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case disorder.FieldRules:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value.Valid {
+				d.Rules = value.String
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Disorder.
+// This includes values selected through modifiers, order, etc.
+func (d *Disorder) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QuerySurvivors queries the "survivors" edge of the Disorder entity.
+func (d *Disorder) QuerySurvivors() *SurvivorQuery {
+	return NewDisorderClient(d.config).QuerySurvivors(d)
+}
+
+// Update returns a builder for updating this Disorder.
+// Note that you need to call Disorder.Unwrap() before calling this method if this Disorder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Disorder) Update() *DisorderUpdateOne {
+	return NewDisorderClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Disorder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Disorder) Unwrap() *Disorder {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Disorder is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Disorder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Disorder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(d.Rules)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedSurvivors returns the Survivors named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (d *Disorder) NamedSurvivors(name string) ([]*Survivor, error) {
+	if d.Edges.namedSurvivors == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := d.Edges.namedSurvivors[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (d *Disorder) appendNamedSurvivors(name string, edges ...*Survivor) {
+	if d.Edges.namedSurvivors == nil {
+		d.Edges.namedSurvivors = make(map[string][]*Survivor)
+	}
+	if len(edges) == 0 {
+		d.Edges.namedSurvivors[name] = []*Survivor{}
+	} else {
+		d.Edges.namedSurvivors[name] = append(d.Edges.namedSurvivors[name], edges...)
+	}
+}
+
+// Disorders is a parsable slice of Disorder.
+type Disorders []*Disorder
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package disorder
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldName, v))
+}
+
+// Rules applies equality check predicate on the "rules" field. It's identical to RulesEQ.
+func Rules(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldRules, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldContainsFold(FieldName, v))
+}
+
+// RulesEQ applies the EQ predicate on the "rules" field.
+func RulesEQ(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEQ(FieldRules, v))
+}
+
+// RulesNEQ applies the NEQ predicate on the "rules" field.
+func RulesNEQ(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNEQ(FieldRules, v))
+}
+
+// RulesIn applies the In predicate on the "rules" field.
+func RulesIn(vs ...string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldIn(FieldRules, vs...))
+}
+
+// RulesNotIn applies the NotIn predicate on the "rules" field.
+func RulesNotIn(vs ...string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldNotIn(FieldRules, vs...))
+}
+
+// RulesGT applies the GT predicate on the "rules" field.
+func RulesGT(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGT(FieldRules, v))
+}
+
+// RulesGTE applies the GTE predicate on the "rules" field.
+func RulesGTE(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldGTE(FieldRules, v))
+}
+
+// RulesLT applies the LT predicate on the "rules" field.
+func RulesLT(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLT(FieldRules, v))
+}
+
+// RulesLTE applies the LTE predicate on the "rules" field.
+func RulesLTE(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldLTE(FieldRules, v))
+}
+
+// RulesContains applies the Contains predicate on the "rules" field.
+func RulesContains(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldContains(FieldRules, v))
+}
+
+// RulesHasPrefix applies the HasPrefix predicate on the "rules" field.
+func RulesHasPrefix(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldHasPrefix(FieldRules, v))
+}
+
+// RulesHasSuffix applies the HasSuffix predicate on the "rules" field.
+func RulesHasSuffix(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldHasSuffix(FieldRules, v))
+}
+
+// RulesEqualFold applies the EqualFold predicate on the "rules" field.
+func RulesEqualFold(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldEqualFold(FieldRules, v))
+}
+
+// RulesContainsFold applies the ContainsFold predicate on the "rules" field.
+func RulesContainsFold(v string) predicate.Disorder {
+	return predicate.Disorder(sql.FieldContainsFold(FieldRules, v))
+}
+
+// HasSurvivors applies the HasEdge predicate on the "survivors" edge.
+func HasSurvivors() predicate.Disorder {
+	return predicate.Disorder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SurvivorsTable, SurvivorsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSurvivorsWith applies the HasEdge predicate on the "survivors" edge with a given conditions (other predicates).
+func HasSurvivorsWith(preds ...predicate.Survivor) predicate.Disorder {
+	return predicate.Disorder(func(s *sql.Selector) {
+		step := newSurvivorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Disorder) predicate.Disorder {
+	return predicate.Disorder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Disorder) predicate.Disorder {
+	return predicate.Disorder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Disorder) predicate.Disorder {
+	return predicate.Disorder(sql.NotPredicates(p))
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// DisorderCreate is the builder for creating a Disorder entity.
+type DisorderCreate struct {
+	config
+	mutation *DisorderMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (dc *DisorderCreate) SetName(s string) *DisorderCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetRules sets the "rules" field.
+func (dc *DisorderCreate) SetRules(s string) *DisorderCreate {
+	dc.mutation.SetRules(s)
+	return dc
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (dc *DisorderCreate) AddSurvivorIDs(ids ...int) *DisorderCreate {
+	dc.mutation.AddSurvivorIDs(ids...)
+	return dc
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (dc *DisorderCreate) AddSurvivors(s ...*Survivor) *DisorderCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return dc.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the DisorderMutation object of the builder.
+func (dc *DisorderCreate) Mutation() *DisorderMutation {
+	return dc.mutation
+}
+
+// Save creates the Disorder in the database.
+func (dc *DisorderCreate) Save(ctx context.Context) (*Disorder, error) {
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DisorderCreate) SaveX(ctx context.Context) *Disorder {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DisorderCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DisorderCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DisorderCreate) check() error {
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Disorder.name"`)}
+	}
+	if v, ok := dc.mutation.Name(); ok {
+		if err := disorder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Disorder.name": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "Disorder.rules"`)}
+	}
+	return nil
+}
+
+func (dc *DisorderCreate) sqlSave(ctx context.Context) (*Disorder, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DisorderCreate) createSpec() (*Disorder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Disorder{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(disorder.Table, sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt))
+	)
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(disorder.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.Rules(); ok {
+		_spec.SetField(disorder.FieldRules, field.TypeString, value)
+		_node.Rules = value
+	}
+	if nodes := dc.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DisorderCreateBulk is the builder for creating many Disorder entities in bulk.
+type DisorderCreateBulk struct {
+	config
+	err      error
+	builders []*DisorderCreate
+}
+
+// Save creates the Disorder entities in the database.
+func (dcb *DisorderCreateBulk) Save(ctx context.Context) ([]*Disorder, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Disorder, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DisorderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DisorderCreateBulk) SaveX(ctx context.Context) []*Disorder {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DisorderCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DisorderCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
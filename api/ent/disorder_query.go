@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// DisorderQuery is the builder for querying Disorder entities.
+type DisorderQuery struct {
+	config
+	ctx                *QueryContext
+	order              []disorder.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Disorder
+	withSurvivors      *SurvivorQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*Disorder) error
+	withNamedSurvivors map[string]*SurvivorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DisorderQuery builder.
+func (dq *DisorderQuery) Where(ps ...predicate.Disorder) *DisorderQuery {
+	dq.predicates = append(dq.predicates, ps...)
+	return dq
+}
+
+// Limit the number of records to be returned by this query.
+func (dq *DisorderQuery) Limit(limit int) *DisorderQuery {
+	dq.ctx.Limit = &limit
+	return dq
+}
+
+// Offset to start from.
+func (dq *DisorderQuery) Offset(offset int) *DisorderQuery {
+	dq.ctx.Offset = &offset
+	return dq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dq *DisorderQuery) Unique(unique bool) *DisorderQuery {
+	dq.ctx.Unique = &unique
+	return dq
+}
+
+// Order specifies how the records should be ordered.
+func (dq *DisorderQuery) Order(o ...disorder.OrderOption) *DisorderQuery {
+	dq.order = append(dq.order, o...)
+	return dq
+}
+
+// QuerySurvivors chains the current query on the "survivors" edge.
+func (dq *DisorderQuery) QuerySurvivors() *SurvivorQuery {
+	query := (&SurvivorClient{config: dq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(disorder.Table, disorder.FieldID, selector),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, disorder.SurvivorsTable, disorder.SurvivorsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(dq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Disorder entity from the query.
+// Returns a *NotFoundError when no Disorder was found.
+func (dq *DisorderQuery) First(ctx context.Context) (*Disorder, error) {
+	nodes, err := dq.Limit(1).All(setContextOp(ctx, dq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{disorder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dq *DisorderQuery) FirstX(ctx context.Context) *Disorder {
+	node, err := dq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Disorder ID from the query.
+// Returns a *NotFoundError when no Disorder ID was found.
+func (dq *DisorderQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dq.Limit(1).IDs(setContextOp(ctx, dq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{disorder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dq *DisorderQuery) FirstIDX(ctx context.Context) int {
+	id, err := dq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Disorder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Disorder entity is found.
+// Returns a *NotFoundError when no Disorder entities are found.
+func (dq *DisorderQuery) Only(ctx context.Context) (*Disorder, error) {
+	nodes, err := dq.Limit(2).All(setContextOp(ctx, dq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{disorder.Label}
+	default:
+		return nil, &NotSingularError{disorder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dq *DisorderQuery) OnlyX(ctx context.Context) *Disorder {
+	node, err := dq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Disorder ID in the query.
+// Returns a *NotSingularError when more than one Disorder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dq *DisorderQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dq.Limit(2).IDs(setContextOp(ctx, dq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{disorder.Label}
+	default:
+		err = &NotSingularError{disorder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dq *DisorderQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Disorders.
+func (dq *DisorderQuery) All(ctx context.Context) ([]*Disorder, error) {
+	ctx = setContextOp(ctx, dq.ctx, ent.OpQueryAll)
+	if err := dq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Disorder, *DisorderQuery]()
+	return withInterceptors[[]*Disorder](ctx, dq, qr, dq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dq *DisorderQuery) AllX(ctx context.Context) []*Disorder {
+	nodes, err := dq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Disorder IDs.
+func (dq *DisorderQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dq.ctx.Unique == nil && dq.path != nil {
+		dq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dq.ctx, ent.OpQueryIDs)
+	if err = dq.Select(disorder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dq *DisorderQuery) IDsX(ctx context.Context) []int {
+	ids, err := dq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dq *DisorderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dq.ctx, ent.OpQueryCount)
+	if err := dq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dq, querierCount[*DisorderQuery](), dq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dq *DisorderQuery) CountX(ctx context.Context) int {
+	count, err := dq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dq *DisorderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dq.ctx, ent.OpQueryExist)
+	switch _, err := dq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dq *DisorderQuery) ExistX(ctx context.Context) bool {
+	exist, err := dq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DisorderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dq *DisorderQuery) Clone() *DisorderQuery {
+	if dq == nil {
+		return nil
+	}
+	return &DisorderQuery{
+		config:        dq.config,
+		ctx:           dq.ctx.Clone(),
+		order:         append([]disorder.OrderOption{}, dq.order...),
+		inters:        append([]Interceptor{}, dq.inters...),
+		predicates:    append([]predicate.Disorder{}, dq.predicates...),
+		withSurvivors: dq.withSurvivors.Clone(),
+		// clone intermediate query.
+		sql:  dq.sql.Clone(),
+		path: dq.path,
+	}
+}
+
+// WithSurvivors tells the query-builder to eager-load the nodes that are connected to
+// the "survivors" edge. The optional arguments are used to configure the query builder of the edge.
+func (dq *DisorderQuery) WithSurvivors(opts ...func(*SurvivorQuery)) *DisorderQuery {
+	query := (&SurvivorClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dq.withSurvivors = query
+	return dq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Disorder.Query().
+//		GroupBy(disorder.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dq *DisorderQuery) GroupBy(field string, fields ...string) *DisorderGroupBy {
+	dq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DisorderGroupBy{build: dq}
+	grbuild.flds = &dq.ctx.Fields
+	grbuild.label = disorder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Disorder.Query().
+//		Select(disorder.FieldName).
+//		Scan(ctx, &v)
+func (dq *DisorderQuery) Select(fields ...string) *DisorderSelect {
+	dq.ctx.Fields = append(dq.ctx.Fields, fields...)
+	sbuild := &DisorderSelect{DisorderQuery: dq}
+	sbuild.label = disorder.Label
+	sbuild.flds, sbuild.scan = &dq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DisorderSelect configured with the given aggregations.
+func (dq *DisorderQuery) Aggregate(fns ...AggregateFunc) *DisorderSelect {
+	return dq.Select().Aggregate(fns...)
+}
+
+func (dq *DisorderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dq.ctx.Fields {
+		if !disorder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dq.path != nil {
+		prev, err := dq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dq.sql = prev
+	}
+	if disorder.Policy == nil {
+		return errors.New("ent: uninitialized disorder.Policy (forgotten import ent/runtime?)")
+	}
+	if err := disorder.Policy.EvalQuery(ctx, dq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (dq *DisorderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Disorder, error) {
+	var (
+		nodes       = []*Disorder{}
+		_spec       = dq.querySpec()
+		loadedTypes = [1]bool{
+			dq.withSurvivors != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Disorder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Disorder{config: dq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dq.withSurvivors; query != nil {
+		if err := dq.loadSurvivors(ctx, query, nodes,
+			func(n *Disorder) { n.Edges.Survivors = []*Survivor{} },
+			func(n *Disorder, e *Survivor) { n.Edges.Survivors = append(n.Edges.Survivors, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range dq.withNamedSurvivors {
+		if err := dq.loadSurvivors(ctx, query, nodes,
+			func(n *Disorder) { n.appendNamedSurvivors(name) },
+			func(n *Disorder, e *Survivor) { n.appendNamedSurvivors(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range dq.loadTotal {
+		if err := dq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dq *DisorderQuery) loadSurvivors(ctx context.Context, query *SurvivorQuery, nodes []*Disorder, init func(*Disorder), assign func(*Disorder, *Survivor)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Disorder)
+	nids := make(map[int]map[*Disorder]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(disorder.SurvivorsTable)
+		s.Join(joinT).On(s.C(survivor.FieldID), joinT.C(disorder.SurvivorsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(disorder.SurvivorsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(disorder.SurvivorsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Disorder]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Survivor](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "survivors" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (dq *DisorderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dq.querySpec()
+	if len(dq.modifiers) > 0 {
+		_spec.Modifiers = dq.modifiers
+	}
+	_spec.Node.Columns = dq.ctx.Fields
+	if len(dq.ctx.Fields) > 0 {
+		_spec.Unique = dq.ctx.Unique != nil && *dq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+}
+
+func (dq *DisorderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(disorder.Table, disorder.Columns, sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt))
+	_spec.From = dq.sql
+	if unique := dq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, disorder.FieldID)
+		for i := range fields {
+			if fields[i] != disorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dq *DisorderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dq.driver.Dialect())
+	t1 := builder.Table(disorder.Table)
+	columns := dq.ctx.Fields
+	if len(columns) == 0 {
+		columns = disorder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dq.sql != nil {
+		selector = dq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dq.ctx.Unique != nil && *dq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dq.predicates {
+		p(selector)
+	}
+	for _, p := range dq.order {
+		p(selector)
+	}
+	if offset := dq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedSurvivors tells the query-builder to eager-load the nodes that are connected to the "survivors"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (dq *DisorderQuery) WithNamedSurvivors(name string, opts ...func(*SurvivorQuery)) *DisorderQuery {
+	query := (&SurvivorClient{config: dq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if dq.withNamedSurvivors == nil {
+		dq.withNamedSurvivors = make(map[string]*SurvivorQuery)
+	}
+	dq.withNamedSurvivors[name] = query
+	return dq
+}
+
+// DisorderGroupBy is the group-by builder for Disorder entities.
+type DisorderGroupBy struct {
+	selector
+	build *DisorderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dgb *DisorderGroupBy) Aggregate(fns ...AggregateFunc) *DisorderGroupBy {
+	dgb.fns = append(dgb.fns, fns...)
+	return dgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dgb *DisorderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DisorderQuery, *DisorderGroupBy](ctx, dgb.build, dgb, dgb.build.inters, v)
+}
+
+func (dgb *DisorderGroupBy) sqlScan(ctx context.Context, root *DisorderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dgb.fns))
+	for _, fn := range dgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dgb.flds)+len(dgb.fns))
+		for _, f := range *dgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DisorderSelect is the builder for selecting fields of Disorder entities.
+type DisorderSelect struct {
+	*DisorderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ds *DisorderSelect) Aggregate(fns ...AggregateFunc) *DisorderSelect {
+	ds.fns = append(ds.fns, fns...)
+	return ds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ds *DisorderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ds.ctx, ent.OpQuerySelect)
+	if err := ds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DisorderQuery, *DisorderSelect](ctx, ds.DisorderQuery, ds, ds.inters, v)
+}
+
+func (ds *DisorderSelect) sqlScan(ctx context.Context, root *DisorderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ds.fns))
+	for _, fn := range ds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// DisorderUpdate is the builder for updating Disorder entities.
+type DisorderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DisorderMutation
+}
+
+// Where appends a list predicates to the DisorderUpdate builder.
+func (du *DisorderUpdate) Where(ps ...predicate.Disorder) *DisorderUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DisorderUpdate) SetName(s string) *DisorderUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (du *DisorderUpdate) SetNillableName(s *string) *DisorderUpdate {
+	if s != nil {
+		du.SetName(*s)
+	}
+	return du
+}
+
+// SetRules sets the "rules" field.
+func (du *DisorderUpdate) SetRules(s string) *DisorderUpdate {
+	du.mutation.SetRules(s)
+	return du
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (du *DisorderUpdate) SetNillableRules(s *string) *DisorderUpdate {
+	if s != nil {
+		du.SetRules(*s)
+	}
+	return du
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (du *DisorderUpdate) AddSurvivorIDs(ids ...int) *DisorderUpdate {
+	du.mutation.AddSurvivorIDs(ids...)
+	return du
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (du *DisorderUpdate) AddSurvivors(s ...*Survivor) *DisorderUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the DisorderMutation object of the builder.
+func (du *DisorderUpdate) Mutation() *DisorderMutation {
+	return du.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (du *DisorderUpdate) ClearSurvivors() *DisorderUpdate {
+	du.mutation.ClearSurvivors()
+	return du
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (du *DisorderUpdate) RemoveSurvivorIDs(ids ...int) *DisorderUpdate {
+	du.mutation.RemoveSurvivorIDs(ids...)
+	return du
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (du *DisorderUpdate) RemoveSurvivors(s ...*Survivor) *DisorderUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return du.RemoveSurvivorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DisorderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DisorderUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DisorderUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DisorderUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DisorderUpdate) check() error {
+	if v, ok := du.mutation.Name(); ok {
+		if err := disorder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Disorder.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (du *DisorderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(disorder.Table, disorder.Columns, sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(disorder.FieldName, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Rules(); ok {
+		_spec.SetField(disorder.FieldRules, field.TypeString, value)
+	}
+	if du.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !du.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{disorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DisorderUpdateOne is the builder for updating a single Disorder entity.
+type DisorderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DisorderMutation
+}
+
+// SetName sets the "name" field.
+func (duo *DisorderUpdateOne) SetName(s string) *DisorderUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (duo *DisorderUpdateOne) SetNillableName(s *string) *DisorderUpdateOne {
+	if s != nil {
+		duo.SetName(*s)
+	}
+	return duo
+}
+
+// SetRules sets the "rules" field.
+func (duo *DisorderUpdateOne) SetRules(s string) *DisorderUpdateOne {
+	duo.mutation.SetRules(s)
+	return duo
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (duo *DisorderUpdateOne) SetNillableRules(s *string) *DisorderUpdateOne {
+	if s != nil {
+		duo.SetRules(*s)
+	}
+	return duo
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (duo *DisorderUpdateOne) AddSurvivorIDs(ids ...int) *DisorderUpdateOne {
+	duo.mutation.AddSurvivorIDs(ids...)
+	return duo
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (duo *DisorderUpdateOne) AddSurvivors(s ...*Survivor) *DisorderUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.AddSurvivorIDs(ids...)
+}
+
+// Mutation returns the DisorderMutation object of the builder.
+func (duo *DisorderUpdateOne) Mutation() *DisorderMutation {
+	return duo.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (duo *DisorderUpdateOne) ClearSurvivors() *DisorderUpdateOne {
+	duo.mutation.ClearSurvivors()
+	return duo
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (duo *DisorderUpdateOne) RemoveSurvivorIDs(ids ...int) *DisorderUpdateOne {
+	duo.mutation.RemoveSurvivorIDs(ids...)
+	return duo
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (duo *DisorderUpdateOne) RemoveSurvivors(s ...*Survivor) *DisorderUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return duo.RemoveSurvivorIDs(ids...)
+}
+
+// Where appends a list predicates to the DisorderUpdate builder.
+func (duo *DisorderUpdateOne) Where(ps ...predicate.Disorder) *DisorderUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DisorderUpdateOne) Select(field string, fields ...string) *DisorderUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Disorder entity.
+func (duo *DisorderUpdateOne) Save(ctx context.Context) (*Disorder, error) {
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DisorderUpdateOne) SaveX(ctx context.Context) *Disorder {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DisorderUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DisorderUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DisorderUpdateOne) check() error {
+	if v, ok := duo.mutation.Name(); ok {
+		if err := disorder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Disorder.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (duo *DisorderUpdateOne) sqlSave(ctx context.Context) (_node *Disorder, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(disorder.Table, disorder.Columns, sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Disorder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, disorder.FieldID)
+		for _, f := range fields {
+			if !disorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != disorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(disorder.FieldName, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Rules(); ok {
+		_spec.SetField(disorder.FieldRules, field.TypeString, value)
+	}
+	if duo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !duo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   disorder.SurvivorsTable,
+			Columns: disorder.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Disorder{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{disorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +90,24 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			settlement.Table: settlement.ValidColumn,
-			survivor.Table:   survivor.ValidColumn,
+			ability.Table:         ability.ValidColumn,
+			accesstoken.Table:     accesstoken.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			disorder.Table:        disorder.ValidColumn,
+			fightingart.Table:     fightingart.ValidColumn,
+			innovation.Table:      innovation.ValidColumn,
+			invite.Table:          invite.ValidColumn,
+			location.Table:        location.ValidColumn,
+			membership.Table:      membership.ValidColumn,
+			milestone.Table:       milestone.ValidColumn,
+			monster.Table:         monster.ValidColumn,
+			monsterprogress.Table: monsterprogress.ValidColumn,
+			principle.Table:       principle.ValidColumn,
+			recipe.Table:          recipe.ValidColumn,
+			settlement.Table:      settlement.ValidColumn,
+			storageitem.Table:     storageitem.ValidColumn,
+			survivor.Table:        survivor.ValidColumn,
+			timelineevent.Table:   timelineevent.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -23,7 +23,10 @@ func main() {
 	opts := []entc.Option{
 		entc.Extensions(ex),
 	}
-	if err := entc.Generate("./ent/schema", &gen.Config{}, opts...); err != nil {
+	cfg := &gen.Config{
+		Features: []gen.Feature{gen.FeaturePrivacy, gen.FeatureIntercept},
+	}
+	if err := entc.Generate("./ent/schema", cfg, opts...); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
 }
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+)
+
+// FightingArt is the model entity for the FightingArt schema.
+type FightingArt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules string `json:"rules,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret bool `json:"secret,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FightingArtQuery when eager-loading is set.
+	Edges        FightingArtEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FightingArtEdges holds the relations/edges for other nodes in the graph.
+type FightingArtEdges struct {
+	// Survivors holds the value of the survivors edge.
+	Survivors []*Survivor `json:"survivors,omitempty"`
+	// SecretSurvivors holds the value of the secret_survivors edge.
+	SecretSurvivors []*Survivor `json:"secret_survivors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+
+	namedSurvivors       map[string][]*Survivor
+	namedSecretSurvivors map[string][]*Survivor
+}
+
+// SurvivorsOrErr returns the Survivors value or an error if the edge
+// was not loaded in eager-loading.
+func (e FightingArtEdges) SurvivorsOrErr() ([]*Survivor, error) {
+	if e.loadedTypes[0] {
+		return e.Survivors, nil
+	}
+	return nil, &NotLoadedError{edge: "survivors"}
+}
+
+// SecretSurvivorsOrErr returns the SecretSurvivors value or an error if the edge
+// was not loaded in eager-loading.
+func (e FightingArtEdges) SecretSurvivorsOrErr() ([]*Survivor, error) {
+	if e.loadedTypes[1] {
+		return e.SecretSurvivors, nil
+	}
+	return nil, &NotLoadedError{edge: "secret_survivors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FightingArt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fightingart.FieldSecret:
+			values[i] = new(sql.NullBool)
+		case fightingart.FieldID:
+			values[i] = new(sql.NullInt64)
+		case fightingart.FieldName, fightingart.FieldRules:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FightingArt fields.
+func (fa *FightingArt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fightingart.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fa.ID = int(value.Int64)
+		case fightingart.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				fa.Name = value.String
+			}
+		case fightingart.FieldRules:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value.Valid {
+				fa.Rules = value.String
+			}
+		case fightingart.FieldSecret:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				fa.Secret = value.Bool
+			}
+		default:
+			fa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FightingArt.
+// This includes values selected through modifiers, order, etc.
+func (fa *FightingArt) Value(name string) (ent.Value, error) {
+	return fa.selectValues.Get(name)
+}
+
+// QuerySurvivors queries the "survivors" edge of the FightingArt entity.
+func (fa *FightingArt) QuerySurvivors() *SurvivorQuery {
+	return NewFightingArtClient(fa.config).QuerySurvivors(fa)
+}
+
+// QuerySecretSurvivors queries the "secret_survivors" edge of the FightingArt entity.
+func (fa *FightingArt) QuerySecretSurvivors() *SurvivorQuery {
+	return NewFightingArtClient(fa.config).QuerySecretSurvivors(fa)
+}
+
+// Update returns a builder for updating this FightingArt.
+// Note that you need to call FightingArt.Unwrap() before calling this method if this FightingArt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fa *FightingArt) Update() *FightingArtUpdateOne {
+	return NewFightingArtClient(fa.config).UpdateOne(fa)
+}
+
+// Unwrap unwraps the FightingArt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fa *FightingArt) Unwrap() *FightingArt {
+	_tx, ok := fa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FightingArt is not a transactional entity")
+	}
+	fa.config.driver = _tx.drv
+	return fa
+}
+
+// String implements the fmt.Stringer.
+func (fa *FightingArt) String() string {
+	var builder strings.Builder
+	builder.WriteString("FightingArt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fa.ID))
+	builder.WriteString("name=")
+	builder.WriteString(fa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fa.Rules)
+	builder.WriteString(", ")
+	builder.WriteString("secret=")
+	builder.WriteString(fmt.Sprintf("%v", fa.Secret))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedSurvivors returns the Survivors named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (fa *FightingArt) NamedSurvivors(name string) ([]*Survivor, error) {
+	if fa.Edges.namedSurvivors == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := fa.Edges.namedSurvivors[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (fa *FightingArt) appendNamedSurvivors(name string, edges ...*Survivor) {
+	if fa.Edges.namedSurvivors == nil {
+		fa.Edges.namedSurvivors = make(map[string][]*Survivor)
+	}
+	if len(edges) == 0 {
+		fa.Edges.namedSurvivors[name] = []*Survivor{}
+	} else {
+		fa.Edges.namedSurvivors[name] = append(fa.Edges.namedSurvivors[name], edges...)
+	}
+}
+
+// NamedSecretSurvivors returns the SecretSurvivors named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (fa *FightingArt) NamedSecretSurvivors(name string) ([]*Survivor, error) {
+	if fa.Edges.namedSecretSurvivors == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := fa.Edges.namedSecretSurvivors[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (fa *FightingArt) appendNamedSecretSurvivors(name string, edges ...*Survivor) {
+	if fa.Edges.namedSecretSurvivors == nil {
+		fa.Edges.namedSecretSurvivors = make(map[string][]*Survivor)
+	}
+	if len(edges) == 0 {
+		fa.Edges.namedSecretSurvivors[name] = []*Survivor{}
+	} else {
+		fa.Edges.namedSecretSurvivors[name] = append(fa.Edges.namedSecretSurvivors[name], edges...)
+	}
+}
+
+// FightingArts is a parsable slice of FightingArt.
+type FightingArts []*FightingArt
@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fightingart
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the fightingart type in the database.
+	Label = "fighting_art"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRules holds the string denoting the rules field in the database.
+	FieldRules = "rules"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// EdgeSurvivors holds the string denoting the survivors edge name in mutations.
+	EdgeSurvivors = "survivors"
+	// EdgeSecretSurvivors holds the string denoting the secret_survivors edge name in mutations.
+	EdgeSecretSurvivors = "secret_survivors"
+	// Table holds the table name of the fightingart in the database.
+	Table = "fighting_arts"
+	// SurvivorsTable is the table that holds the survivors relation/edge. The primary key declared below.
+	SurvivorsTable = "survivor_fighting_arts"
+	// SurvivorsInverseTable is the table name for the Survivor entity.
+	// It exists in this package in order to avoid circular dependency with the "survivor" package.
+	SurvivorsInverseTable = "survivors"
+	// SecretSurvivorsTable is the table that holds the secret_survivors relation/edge. The primary key declared below.
+	SecretSurvivorsTable = "survivor_secret_fighting_arts"
+	// SecretSurvivorsInverseTable is the table name for the Survivor entity.
+	// It exists in this package in order to avoid circular dependency with the "survivor" package.
+	SecretSurvivorsInverseTable = "survivors"
+)
+
+// Columns holds all SQL columns for fightingart fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldRules,
+	FieldSecret,
+}
+
+var (
+	// SurvivorsPrimaryKey and SurvivorsColumn2 are the table columns denoting the
+	// primary key for the survivors relation (M2M).
+	SurvivorsPrimaryKey = []string{"survivor_id", "fighting_art_id"}
+	// SecretSurvivorsPrimaryKey and SecretSurvivorsColumn2 are the table columns denoting the
+	// primary key for the secret_survivors relation (M2M).
+	SecretSurvivorsPrimaryKey = []string{"survivor_id", "fighting_art_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultSecret holds the default value on creation for the "secret" field.
+	DefaultSecret bool
+)
+
+// OrderOption defines the ordering options for the FightingArt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRules orders the results by the rules field.
+func ByRules(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRules, opts...).ToFunc()
+}
+
+// BySecret orders the results by the secret field.
+func BySecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecret, opts...).ToFunc()
+}
+
+// BySurvivorsCount orders the results by survivors count.
+func BySurvivorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSurvivorsStep(), opts...)
+	}
+}
+
+// BySurvivors orders the results by survivors terms.
+func BySurvivors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSurvivorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySecretSurvivorsCount orders the results by secret_survivors count.
+func BySecretSurvivorsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecretSurvivorsStep(), opts...)
+	}
+}
+
+// BySecretSurvivors orders the results by secret_survivors terms.
+func BySecretSurvivors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecretSurvivorsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSurvivorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SurvivorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SurvivorsTable, SurvivorsPrimaryKey...),
+	)
+}
+func newSecretSurvivorsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecretSurvivorsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SecretSurvivorsTable, SecretSurvivorsPrimaryKey...),
+	)
+}
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// FightingArtCreate is the builder for creating a FightingArt entity.
+type FightingArtCreate struct {
+	config
+	mutation *FightingArtMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (fac *FightingArtCreate) SetName(s string) *FightingArtCreate {
+	fac.mutation.SetName(s)
+	return fac
+}
+
+// SetRules sets the "rules" field.
+func (fac *FightingArtCreate) SetRules(s string) *FightingArtCreate {
+	fac.mutation.SetRules(s)
+	return fac
+}
+
+// SetSecret sets the "secret" field.
+func (fac *FightingArtCreate) SetSecret(b bool) *FightingArtCreate {
+	fac.mutation.SetSecret(b)
+	return fac
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (fac *FightingArtCreate) SetNillableSecret(b *bool) *FightingArtCreate {
+	if b != nil {
+		fac.SetSecret(*b)
+	}
+	return fac
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (fac *FightingArtCreate) AddSurvivorIDs(ids ...int) *FightingArtCreate {
+	fac.mutation.AddSurvivorIDs(ids...)
+	return fac
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (fac *FightingArtCreate) AddSurvivors(s ...*Survivor) *FightingArtCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fac.AddSurvivorIDs(ids...)
+}
+
+// AddSecretSurvivorIDs adds the "secret_survivors" edge to the Survivor entity by IDs.
+func (fac *FightingArtCreate) AddSecretSurvivorIDs(ids ...int) *FightingArtCreate {
+	fac.mutation.AddSecretSurvivorIDs(ids...)
+	return fac
+}
+
+// AddSecretSurvivors adds the "secret_survivors" edges to the Survivor entity.
+func (fac *FightingArtCreate) AddSecretSurvivors(s ...*Survivor) *FightingArtCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fac.AddSecretSurvivorIDs(ids...)
+}
+
+// Mutation returns the FightingArtMutation object of the builder.
+func (fac *FightingArtCreate) Mutation() *FightingArtMutation {
+	return fac.mutation
+}
+
+// Save creates the FightingArt in the database.
+func (fac *FightingArtCreate) Save(ctx context.Context) (*FightingArt, error) {
+	if err := fac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, fac.sqlSave, fac.mutation, fac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fac *FightingArtCreate) SaveX(ctx context.Context) *FightingArt {
+	v, err := fac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fac *FightingArtCreate) Exec(ctx context.Context) error {
+	_, err := fac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fac *FightingArtCreate) ExecX(ctx context.Context) {
+	if err := fac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fac *FightingArtCreate) defaults() error {
+	if _, ok := fac.mutation.Secret(); !ok {
+		v := fightingart.DefaultSecret
+		fac.mutation.SetSecret(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fac *FightingArtCreate) check() error {
+	if _, ok := fac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FightingArt.name"`)}
+	}
+	if v, ok := fac.mutation.Name(); ok {
+		if err := fightingart.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FightingArt.name": %w`, err)}
+		}
+	}
+	if _, ok := fac.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "FightingArt.rules"`)}
+	}
+	if _, ok := fac.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "FightingArt.secret"`)}
+	}
+	return nil
+}
+
+func (fac *FightingArtCreate) sqlSave(ctx context.Context) (*FightingArt, error) {
+	if err := fac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fac.mutation.id = &_node.ID
+	fac.mutation.done = true
+	return _node, nil
+}
+
+func (fac *FightingArtCreate) createSpec() (*FightingArt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FightingArt{config: fac.config}
+		_spec = sqlgraph.NewCreateSpec(fightingart.Table, sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt))
+	)
+	if value, ok := fac.mutation.Name(); ok {
+		_spec.SetField(fightingart.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := fac.mutation.Rules(); ok {
+		_spec.SetField(fightingart.FieldRules, field.TypeString, value)
+		_node.Rules = value
+	}
+	if value, ok := fac.mutation.Secret(); ok {
+		_spec.SetField(fightingart.FieldSecret, field.TypeBool, value)
+		_node.Secret = value
+	}
+	if nodes := fac.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fac.mutation.SecretSurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FightingArtCreateBulk is the builder for creating many FightingArt entities in bulk.
+type FightingArtCreateBulk struct {
+	config
+	err      error
+	builders []*FightingArtCreate
+}
+
+// Save creates the FightingArt entities in the database.
+func (facb *FightingArtCreateBulk) Save(ctx context.Context) ([]*FightingArt, error) {
+	if facb.err != nil {
+		return nil, facb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(facb.builders))
+	nodes := make([]*FightingArt, len(facb.builders))
+	mutators := make([]Mutator, len(facb.builders))
+	for i := range facb.builders {
+		func(i int, root context.Context) {
+			builder := facb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FightingArtMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, facb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, facb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, facb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (facb *FightingArtCreateBulk) SaveX(ctx context.Context) []*FightingArt {
+	v, err := facb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (facb *FightingArtCreateBulk) Exec(ctx context.Context) error {
+	_, err := facb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (facb *FightingArtCreateBulk) ExecX(ctx context.Context) {
+	if err := facb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// FightingArtDelete is the builder for deleting a FightingArt entity.
+type FightingArtDelete struct {
+	config
+	hooks    []Hook
+	mutation *FightingArtMutation
+}
+
+// Where appends a list predicates to the FightingArtDelete builder.
+func (fad *FightingArtDelete) Where(ps ...predicate.FightingArt) *FightingArtDelete {
+	fad.mutation.Where(ps...)
+	return fad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fad *FightingArtDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, fad.sqlExec, fad.mutation, fad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fad *FightingArtDelete) ExecX(ctx context.Context) int {
+	n, err := fad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fad *FightingArtDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fightingart.Table, sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt))
+	if ps := fad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, fad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	fad.mutation.done = true
+	return affected, err
+}
+
+// FightingArtDeleteOne is the builder for deleting a single FightingArt entity.
+type FightingArtDeleteOne struct {
+	fad *FightingArtDelete
+}
+
+// Where appends a list predicates to the FightingArtDelete builder.
+func (fado *FightingArtDeleteOne) Where(ps ...predicate.FightingArt) *FightingArtDeleteOne {
+	fado.fad.mutation.Where(ps...)
+	return fado
+}
+
+// Exec executes the deletion query.
+func (fado *FightingArtDeleteOne) Exec(ctx context.Context) error {
+	n, err := fado.fad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fightingart.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fado *FightingArtDeleteOne) ExecX(ctx context.Context) {
+	if err := fado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// FightingArtQuery is the builder for querying FightingArt entities.
+type FightingArtQuery struct {
+	config
+	ctx                      *QueryContext
+	order                    []fightingart.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.FightingArt
+	withSurvivors            *SurvivorQuery
+	withSecretSurvivors      *SurvivorQuery
+	modifiers                []func(*sql.Selector)
+	loadTotal                []func(context.Context, []*FightingArt) error
+	withNamedSurvivors       map[string]*SurvivorQuery
+	withNamedSecretSurvivors map[string]*SurvivorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FightingArtQuery builder.
+func (faq *FightingArtQuery) Where(ps ...predicate.FightingArt) *FightingArtQuery {
+	faq.predicates = append(faq.predicates, ps...)
+	return faq
+}
+
+// Limit the number of records to be returned by this query.
+func (faq *FightingArtQuery) Limit(limit int) *FightingArtQuery {
+	faq.ctx.Limit = &limit
+	return faq
+}
+
+// Offset to start from.
+func (faq *FightingArtQuery) Offset(offset int) *FightingArtQuery {
+	faq.ctx.Offset = &offset
+	return faq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (faq *FightingArtQuery) Unique(unique bool) *FightingArtQuery {
+	faq.ctx.Unique = &unique
+	return faq
+}
+
+// Order specifies how the records should be ordered.
+func (faq *FightingArtQuery) Order(o ...fightingart.OrderOption) *FightingArtQuery {
+	faq.order = append(faq.order, o...)
+	return faq
+}
+
+// QuerySurvivors chains the current query on the "survivors" edge.
+func (faq *FightingArtQuery) QuerySurvivors() *SurvivorQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fightingart.Table, fightingart.FieldID, selector),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, fightingart.SurvivorsTable, fightingart.SurvivorsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySecretSurvivors chains the current query on the "secret_survivors" edge.
+func (faq *FightingArtQuery) QuerySecretSurvivors() *SurvivorQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := faq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := faq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fightingart.Table, fightingart.FieldID, selector),
+			sqlgraph.To(survivor.Table, survivor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, fightingart.SecretSurvivorsTable, fightingart.SecretSurvivorsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(faq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FightingArt entity from the query.
+// Returns a *NotFoundError when no FightingArt was found.
+func (faq *FightingArtQuery) First(ctx context.Context) (*FightingArt, error) {
+	nodes, err := faq.Limit(1).All(setContextOp(ctx, faq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fightingart.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (faq *FightingArtQuery) FirstX(ctx context.Context) *FightingArt {
+	node, err := faq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FightingArt ID from the query.
+// Returns a *NotFoundError when no FightingArt ID was found.
+func (faq *FightingArtQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = faq.Limit(1).IDs(setContextOp(ctx, faq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fightingart.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (faq *FightingArtQuery) FirstIDX(ctx context.Context) int {
+	id, err := faq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FightingArt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FightingArt entity is found.
+// Returns a *NotFoundError when no FightingArt entities are found.
+func (faq *FightingArtQuery) Only(ctx context.Context) (*FightingArt, error) {
+	nodes, err := faq.Limit(2).All(setContextOp(ctx, faq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fightingart.Label}
+	default:
+		return nil, &NotSingularError{fightingart.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (faq *FightingArtQuery) OnlyX(ctx context.Context) *FightingArt {
+	node, err := faq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FightingArt ID in the query.
+// Returns a *NotSingularError when more than one FightingArt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (faq *FightingArtQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = faq.Limit(2).IDs(setContextOp(ctx, faq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fightingart.Label}
+	default:
+		err = &NotSingularError{fightingart.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (faq *FightingArtQuery) OnlyIDX(ctx context.Context) int {
+	id, err := faq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FightingArts.
+func (faq *FightingArtQuery) All(ctx context.Context) ([]*FightingArt, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryAll)
+	if err := faq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FightingArt, *FightingArtQuery]()
+	return withInterceptors[[]*FightingArt](ctx, faq, qr, faq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (faq *FightingArtQuery) AllX(ctx context.Context) []*FightingArt {
+	nodes, err := faq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FightingArt IDs.
+func (faq *FightingArtQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if faq.ctx.Unique == nil && faq.path != nil {
+		faq.Unique(true)
+	}
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryIDs)
+	if err = faq.Select(fightingart.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (faq *FightingArtQuery) IDsX(ctx context.Context) []int {
+	ids, err := faq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (faq *FightingArtQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryCount)
+	if err := faq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, faq, querierCount[*FightingArtQuery](), faq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (faq *FightingArtQuery) CountX(ctx context.Context) int {
+	count, err := faq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (faq *FightingArtQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, faq.ctx, ent.OpQueryExist)
+	switch _, err := faq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (faq *FightingArtQuery) ExistX(ctx context.Context) bool {
+	exist, err := faq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FightingArtQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (faq *FightingArtQuery) Clone() *FightingArtQuery {
+	if faq == nil {
+		return nil
+	}
+	return &FightingArtQuery{
+		config:              faq.config,
+		ctx:                 faq.ctx.Clone(),
+		order:               append([]fightingart.OrderOption{}, faq.order...),
+		inters:              append([]Interceptor{}, faq.inters...),
+		predicates:          append([]predicate.FightingArt{}, faq.predicates...),
+		withSurvivors:       faq.withSurvivors.Clone(),
+		withSecretSurvivors: faq.withSecretSurvivors.Clone(),
+		// clone intermediate query.
+		sql:  faq.sql.Clone(),
+		path: faq.path,
+	}
+}
+
+// WithSurvivors tells the query-builder to eager-load the nodes that are connected to
+// the "survivors" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FightingArtQuery) WithSurvivors(opts ...func(*SurvivorQuery)) *FightingArtQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withSurvivors = query
+	return faq
+}
+
+// WithSecretSurvivors tells the query-builder to eager-load the nodes that are connected to
+// the "secret_survivors" edge. The optional arguments are used to configure the query builder of the edge.
+func (faq *FightingArtQuery) WithSecretSurvivors(opts ...func(*SurvivorQuery)) *FightingArtQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	faq.withSecretSurvivors = query
+	return faq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FightingArt.Query().
+//		GroupBy(fightingart.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (faq *FightingArtQuery) GroupBy(field string, fields ...string) *FightingArtGroupBy {
+	faq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FightingArtGroupBy{build: faq}
+	grbuild.flds = &faq.ctx.Fields
+	grbuild.label = fightingart.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.FightingArt.Query().
+//		Select(fightingart.FieldName).
+//		Scan(ctx, &v)
+func (faq *FightingArtQuery) Select(fields ...string) *FightingArtSelect {
+	faq.ctx.Fields = append(faq.ctx.Fields, fields...)
+	sbuild := &FightingArtSelect{FightingArtQuery: faq}
+	sbuild.label = fightingart.Label
+	sbuild.flds, sbuild.scan = &faq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FightingArtSelect configured with the given aggregations.
+func (faq *FightingArtQuery) Aggregate(fns ...AggregateFunc) *FightingArtSelect {
+	return faq.Select().Aggregate(fns...)
+}
+
+func (faq *FightingArtQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range faq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, faq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range faq.ctx.Fields {
+		if !fightingart.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if faq.path != nil {
+		prev, err := faq.path(ctx)
+		if err != nil {
+			return err
+		}
+		faq.sql = prev
+	}
+	if fightingart.Policy == nil {
+		return errors.New("ent: uninitialized fightingart.Policy (forgotten import ent/runtime?)")
+	}
+	if err := fightingart.Policy.EvalQuery(ctx, faq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (faq *FightingArtQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FightingArt, error) {
+	var (
+		nodes       = []*FightingArt{}
+		_spec       = faq.querySpec()
+		loadedTypes = [2]bool{
+			faq.withSurvivors != nil,
+			faq.withSecretSurvivors != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FightingArt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FightingArt{config: faq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(faq.modifiers) > 0 {
+		_spec.Modifiers = faq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, faq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := faq.withSurvivors; query != nil {
+		if err := faq.loadSurvivors(ctx, query, nodes,
+			func(n *FightingArt) { n.Edges.Survivors = []*Survivor{} },
+			func(n *FightingArt, e *Survivor) { n.Edges.Survivors = append(n.Edges.Survivors, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := faq.withSecretSurvivors; query != nil {
+		if err := faq.loadSecretSurvivors(ctx, query, nodes,
+			func(n *FightingArt) { n.Edges.SecretSurvivors = []*Survivor{} },
+			func(n *FightingArt, e *Survivor) { n.Edges.SecretSurvivors = append(n.Edges.SecretSurvivors, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range faq.withNamedSurvivors {
+		if err := faq.loadSurvivors(ctx, query, nodes,
+			func(n *FightingArt) { n.appendNamedSurvivors(name) },
+			func(n *FightingArt, e *Survivor) { n.appendNamedSurvivors(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range faq.withNamedSecretSurvivors {
+		if err := faq.loadSecretSurvivors(ctx, query, nodes,
+			func(n *FightingArt) { n.appendNamedSecretSurvivors(name) },
+			func(n *FightingArt, e *Survivor) { n.appendNamedSecretSurvivors(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range faq.loadTotal {
+		if err := faq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (faq *FightingArtQuery) loadSurvivors(ctx context.Context, query *SurvivorQuery, nodes []*FightingArt, init func(*FightingArt), assign func(*FightingArt, *Survivor)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*FightingArt)
+	nids := make(map[int]map[*FightingArt]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(fightingart.SurvivorsTable)
+		s.Join(joinT).On(s.C(survivor.FieldID), joinT.C(fightingart.SurvivorsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(fightingart.SurvivorsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(fightingart.SurvivorsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*FightingArt]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Survivor](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "survivors" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (faq *FightingArtQuery) loadSecretSurvivors(ctx context.Context, query *SurvivorQuery, nodes []*FightingArt, init func(*FightingArt), assign func(*FightingArt, *Survivor)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*FightingArt)
+	nids := make(map[int]map[*FightingArt]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(fightingart.SecretSurvivorsTable)
+		s.Join(joinT).On(s.C(survivor.FieldID), joinT.C(fightingart.SecretSurvivorsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(fightingart.SecretSurvivorsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(fightingart.SecretSurvivorsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*FightingArt]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Survivor](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "secret_survivors" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (faq *FightingArtQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := faq.querySpec()
+	if len(faq.modifiers) > 0 {
+		_spec.Modifiers = faq.modifiers
+	}
+	_spec.Node.Columns = faq.ctx.Fields
+	if len(faq.ctx.Fields) > 0 {
+		_spec.Unique = faq.ctx.Unique != nil && *faq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, faq.driver, _spec)
+}
+
+func (faq *FightingArtQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(fightingart.Table, fightingart.Columns, sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt))
+	_spec.From = faq.sql
+	if unique := faq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if faq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := faq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fightingart.FieldID)
+		for i := range fields {
+			if fields[i] != fightingart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := faq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := faq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (faq *FightingArtQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(faq.driver.Dialect())
+	t1 := builder.Table(fightingart.Table)
+	columns := faq.ctx.Fields
+	if len(columns) == 0 {
+		columns = fightingart.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if faq.sql != nil {
+		selector = faq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if faq.ctx.Unique != nil && *faq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range faq.predicates {
+		p(selector)
+	}
+	for _, p := range faq.order {
+		p(selector)
+	}
+	if offset := faq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := faq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedSurvivors tells the query-builder to eager-load the nodes that are connected to the "survivors"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (faq *FightingArtQuery) WithNamedSurvivors(name string, opts ...func(*SurvivorQuery)) *FightingArtQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if faq.withNamedSurvivors == nil {
+		faq.withNamedSurvivors = make(map[string]*SurvivorQuery)
+	}
+	faq.withNamedSurvivors[name] = query
+	return faq
+}
+
+// WithNamedSecretSurvivors tells the query-builder to eager-load the nodes that are connected to the "secret_survivors"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (faq *FightingArtQuery) WithNamedSecretSurvivors(name string, opts ...func(*SurvivorQuery)) *FightingArtQuery {
+	query := (&SurvivorClient{config: faq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if faq.withNamedSecretSurvivors == nil {
+		faq.withNamedSecretSurvivors = make(map[string]*SurvivorQuery)
+	}
+	faq.withNamedSecretSurvivors[name] = query
+	return faq
+}
+
+// FightingArtGroupBy is the group-by builder for FightingArt entities.
+type FightingArtGroupBy struct {
+	selector
+	build *FightingArtQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fagb *FightingArtGroupBy) Aggregate(fns ...AggregateFunc) *FightingArtGroupBy {
+	fagb.fns = append(fagb.fns, fns...)
+	return fagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fagb *FightingArtGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fagb.build.ctx, ent.OpQueryGroupBy)
+	if err := fagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FightingArtQuery, *FightingArtGroupBy](ctx, fagb.build, fagb, fagb.build.inters, v)
+}
+
+func (fagb *FightingArtGroupBy) sqlScan(ctx context.Context, root *FightingArtQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(fagb.fns))
+	for _, fn := range fagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*fagb.flds)+len(fagb.fns))
+		for _, f := range *fagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*fagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FightingArtSelect is the builder for selecting fields of FightingArt entities.
+type FightingArtSelect struct {
+	*FightingArtQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (fas *FightingArtSelect) Aggregate(fns ...AggregateFunc) *FightingArtSelect {
+	fas.fns = append(fas.fns, fns...)
+	return fas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (fas *FightingArtSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, fas.ctx, ent.OpQuerySelect)
+	if err := fas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FightingArtQuery, *FightingArtSelect](ctx, fas.FightingArtQuery, fas, fas.inters, v)
+}
+
+func (fas *FightingArtSelect) sqlScan(ctx context.Context, root *FightingArtQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(fas.fns))
+	for _, fn := range fas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*fas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := fas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
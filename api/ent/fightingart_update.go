@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// FightingArtUpdate is the builder for updating FightingArt entities.
+type FightingArtUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FightingArtMutation
+}
+
+// Where appends a list predicates to the FightingArtUpdate builder.
+func (fau *FightingArtUpdate) Where(ps ...predicate.FightingArt) *FightingArtUpdate {
+	fau.mutation.Where(ps...)
+	return fau
+}
+
+// SetName sets the "name" field.
+func (fau *FightingArtUpdate) SetName(s string) *FightingArtUpdate {
+	fau.mutation.SetName(s)
+	return fau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fau *FightingArtUpdate) SetNillableName(s *string) *FightingArtUpdate {
+	if s != nil {
+		fau.SetName(*s)
+	}
+	return fau
+}
+
+// SetRules sets the "rules" field.
+func (fau *FightingArtUpdate) SetRules(s string) *FightingArtUpdate {
+	fau.mutation.SetRules(s)
+	return fau
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (fau *FightingArtUpdate) SetNillableRules(s *string) *FightingArtUpdate {
+	if s != nil {
+		fau.SetRules(*s)
+	}
+	return fau
+}
+
+// SetSecret sets the "secret" field.
+func (fau *FightingArtUpdate) SetSecret(b bool) *FightingArtUpdate {
+	fau.mutation.SetSecret(b)
+	return fau
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (fau *FightingArtUpdate) SetNillableSecret(b *bool) *FightingArtUpdate {
+	if b != nil {
+		fau.SetSecret(*b)
+	}
+	return fau
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (fau *FightingArtUpdate) AddSurvivorIDs(ids ...int) *FightingArtUpdate {
+	fau.mutation.AddSurvivorIDs(ids...)
+	return fau
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (fau *FightingArtUpdate) AddSurvivors(s ...*Survivor) *FightingArtUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fau.AddSurvivorIDs(ids...)
+}
+
+// AddSecretSurvivorIDs adds the "secret_survivors" edge to the Survivor entity by IDs.
+func (fau *FightingArtUpdate) AddSecretSurvivorIDs(ids ...int) *FightingArtUpdate {
+	fau.mutation.AddSecretSurvivorIDs(ids...)
+	return fau
+}
+
+// AddSecretSurvivors adds the "secret_survivors" edges to the Survivor entity.
+func (fau *FightingArtUpdate) AddSecretSurvivors(s ...*Survivor) *FightingArtUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fau.AddSecretSurvivorIDs(ids...)
+}
+
+// Mutation returns the FightingArtMutation object of the builder.
+func (fau *FightingArtUpdate) Mutation() *FightingArtMutation {
+	return fau.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (fau *FightingArtUpdate) ClearSurvivors() *FightingArtUpdate {
+	fau.mutation.ClearSurvivors()
+	return fau
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (fau *FightingArtUpdate) RemoveSurvivorIDs(ids ...int) *FightingArtUpdate {
+	fau.mutation.RemoveSurvivorIDs(ids...)
+	return fau
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (fau *FightingArtUpdate) RemoveSurvivors(s ...*Survivor) *FightingArtUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fau.RemoveSurvivorIDs(ids...)
+}
+
+// ClearSecretSurvivors clears all "secret_survivors" edges to the Survivor entity.
+func (fau *FightingArtUpdate) ClearSecretSurvivors() *FightingArtUpdate {
+	fau.mutation.ClearSecretSurvivors()
+	return fau
+}
+
+// RemoveSecretSurvivorIDs removes the "secret_survivors" edge to Survivor entities by IDs.
+func (fau *FightingArtUpdate) RemoveSecretSurvivorIDs(ids ...int) *FightingArtUpdate {
+	fau.mutation.RemoveSecretSurvivorIDs(ids...)
+	return fau
+}
+
+// RemoveSecretSurvivors removes "secret_survivors" edges to Survivor entities.
+func (fau *FightingArtUpdate) RemoveSecretSurvivors(s ...*Survivor) *FightingArtUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fau.RemoveSecretSurvivorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fau *FightingArtUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fau.sqlSave, fau.mutation, fau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fau *FightingArtUpdate) SaveX(ctx context.Context) int {
+	affected, err := fau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fau *FightingArtUpdate) Exec(ctx context.Context) error {
+	_, err := fau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fau *FightingArtUpdate) ExecX(ctx context.Context) {
+	if err := fau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fau *FightingArtUpdate) check() error {
+	if v, ok := fau.mutation.Name(); ok {
+		if err := fightingart.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FightingArt.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fau *FightingArtUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fightingart.Table, fightingart.Columns, sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt))
+	if ps := fau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fau.mutation.Name(); ok {
+		_spec.SetField(fightingart.FieldName, field.TypeString, value)
+	}
+	if value, ok := fau.mutation.Rules(); ok {
+		_spec.SetField(fightingart.FieldRules, field.TypeString, value)
+	}
+	if value, ok := fau.mutation.Secret(); ok {
+		_spec.SetField(fightingart.FieldSecret, field.TypeBool, value)
+	}
+	if fau.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !fau.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fau.mutation.SecretSurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.RemovedSecretSurvivorsIDs(); len(nodes) > 0 && !fau.mutation.SecretSurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fau.mutation.SecretSurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fightingart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fau.mutation.done = true
+	return n, nil
+}
+
+// FightingArtUpdateOne is the builder for updating a single FightingArt entity.
+type FightingArtUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FightingArtMutation
+}
+
+// SetName sets the "name" field.
+func (fauo *FightingArtUpdateOne) SetName(s string) *FightingArtUpdateOne {
+	fauo.mutation.SetName(s)
+	return fauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fauo *FightingArtUpdateOne) SetNillableName(s *string) *FightingArtUpdateOne {
+	if s != nil {
+		fauo.SetName(*s)
+	}
+	return fauo
+}
+
+// SetRules sets the "rules" field.
+func (fauo *FightingArtUpdateOne) SetRules(s string) *FightingArtUpdateOne {
+	fauo.mutation.SetRules(s)
+	return fauo
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (fauo *FightingArtUpdateOne) SetNillableRules(s *string) *FightingArtUpdateOne {
+	if s != nil {
+		fauo.SetRules(*s)
+	}
+	return fauo
+}
+
+// SetSecret sets the "secret" field.
+func (fauo *FightingArtUpdateOne) SetSecret(b bool) *FightingArtUpdateOne {
+	fauo.mutation.SetSecret(b)
+	return fauo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (fauo *FightingArtUpdateOne) SetNillableSecret(b *bool) *FightingArtUpdateOne {
+	if b != nil {
+		fauo.SetSecret(*b)
+	}
+	return fauo
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by IDs.
+func (fauo *FightingArtUpdateOne) AddSurvivorIDs(ids ...int) *FightingArtUpdateOne {
+	fauo.mutation.AddSurvivorIDs(ids...)
+	return fauo
+}
+
+// AddSurvivors adds the "survivors" edges to the Survivor entity.
+func (fauo *FightingArtUpdateOne) AddSurvivors(s ...*Survivor) *FightingArtUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fauo.AddSurvivorIDs(ids...)
+}
+
+// AddSecretSurvivorIDs adds the "secret_survivors" edge to the Survivor entity by IDs.
+func (fauo *FightingArtUpdateOne) AddSecretSurvivorIDs(ids ...int) *FightingArtUpdateOne {
+	fauo.mutation.AddSecretSurvivorIDs(ids...)
+	return fauo
+}
+
+// AddSecretSurvivors adds the "secret_survivors" edges to the Survivor entity.
+func (fauo *FightingArtUpdateOne) AddSecretSurvivors(s ...*Survivor) *FightingArtUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fauo.AddSecretSurvivorIDs(ids...)
+}
+
+// Mutation returns the FightingArtMutation object of the builder.
+func (fauo *FightingArtUpdateOne) Mutation() *FightingArtMutation {
+	return fauo.mutation
+}
+
+// ClearSurvivors clears all "survivors" edges to the Survivor entity.
+func (fauo *FightingArtUpdateOne) ClearSurvivors() *FightingArtUpdateOne {
+	fauo.mutation.ClearSurvivors()
+	return fauo
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to Survivor entities by IDs.
+func (fauo *FightingArtUpdateOne) RemoveSurvivorIDs(ids ...int) *FightingArtUpdateOne {
+	fauo.mutation.RemoveSurvivorIDs(ids...)
+	return fauo
+}
+
+// RemoveSurvivors removes "survivors" edges to Survivor entities.
+func (fauo *FightingArtUpdateOne) RemoveSurvivors(s ...*Survivor) *FightingArtUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fauo.RemoveSurvivorIDs(ids...)
+}
+
+// ClearSecretSurvivors clears all "secret_survivors" edges to the Survivor entity.
+func (fauo *FightingArtUpdateOne) ClearSecretSurvivors() *FightingArtUpdateOne {
+	fauo.mutation.ClearSecretSurvivors()
+	return fauo
+}
+
+// RemoveSecretSurvivorIDs removes the "secret_survivors" edge to Survivor entities by IDs.
+func (fauo *FightingArtUpdateOne) RemoveSecretSurvivorIDs(ids ...int) *FightingArtUpdateOne {
+	fauo.mutation.RemoveSecretSurvivorIDs(ids...)
+	return fauo
+}
+
+// RemoveSecretSurvivors removes "secret_survivors" edges to Survivor entities.
+func (fauo *FightingArtUpdateOne) RemoveSecretSurvivors(s ...*Survivor) *FightingArtUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fauo.RemoveSecretSurvivorIDs(ids...)
+}
+
+// Where appends a list predicates to the FightingArtUpdate builder.
+func (fauo *FightingArtUpdateOne) Where(ps ...predicate.FightingArt) *FightingArtUpdateOne {
+	fauo.mutation.Where(ps...)
+	return fauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fauo *FightingArtUpdateOne) Select(field string, fields ...string) *FightingArtUpdateOne {
+	fauo.fields = append([]string{field}, fields...)
+	return fauo
+}
+
+// Save executes the query and returns the updated FightingArt entity.
+func (fauo *FightingArtUpdateOne) Save(ctx context.Context) (*FightingArt, error) {
+	return withHooks(ctx, fauo.sqlSave, fauo.mutation, fauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fauo *FightingArtUpdateOne) SaveX(ctx context.Context) *FightingArt {
+	node, err := fauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fauo *FightingArtUpdateOne) Exec(ctx context.Context) error {
+	_, err := fauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fauo *FightingArtUpdateOne) ExecX(ctx context.Context) {
+	if err := fauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fauo *FightingArtUpdateOne) check() error {
+	if v, ok := fauo.mutation.Name(); ok {
+		if err := fightingart.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FightingArt.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (fauo *FightingArtUpdateOne) sqlSave(ctx context.Context) (_node *FightingArt, err error) {
+	if err := fauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fightingart.Table, fightingart.Columns, sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt))
+	id, ok := fauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FightingArt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fightingart.FieldID)
+		for _, f := range fields {
+			if !fightingart.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fightingart.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fauo.mutation.Name(); ok {
+		_spec.SetField(fightingart.FieldName, field.TypeString, value)
+	}
+	if value, ok := fauo.mutation.Rules(); ok {
+		_spec.SetField(fightingart.FieldRules, field.TypeString, value)
+	}
+	if value, ok := fauo.mutation.Secret(); ok {
+		_spec.SetField(fightingart.FieldSecret, field.TypeBool, value)
+	}
+	if fauo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.RemovedSurvivorsIDs(); len(nodes) > 0 && !fauo.mutation.SurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.SurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SurvivorsTable,
+			Columns: fightingart.SurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fauo.mutation.SecretSurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.RemovedSecretSurvivorsIDs(); len(nodes) > 0 && !fauo.mutation.SecretSurvivorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fauo.mutation.SecretSurvivorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   fightingart.SecretSurvivorsTable,
+			Columns: fightingart.SecretSurvivorsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FightingArt{config: fauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fightingart.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fauo.mutation.done = true
+	return _node, nil
+}
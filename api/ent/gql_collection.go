@@ -7,10 +7,1523 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (a *AbilityQuery) CollectFields(ctx context.Context, satisfies ...string) (*AbilityQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return a, nil
+	}
+	if err := a.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *AbilityQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(ability.Columns))
+		selectedFields = []string{ability.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[ability.FieldName]; !ok {
+				selectedFields = append(selectedFields, ability.FieldName)
+				fieldSeen[ability.FieldName] = struct{}{}
+			}
+		case "kind":
+			if _, ok := fieldSeen[ability.FieldKind]; !ok {
+				selectedFields = append(selectedFields, ability.FieldKind)
+				fieldSeen[ability.FieldKind] = struct{}{}
+			}
+		case "rules":
+			if _, ok := fieldSeen[ability.FieldRules]; !ok {
+				selectedFields = append(selectedFields, ability.FieldRules)
+				fieldSeen[ability.FieldRules] = struct{}{}
+			}
+		case "modifiers":
+			if _, ok := fieldSeen[ability.FieldModifiers]; !ok {
+				selectedFields = append(selectedFields, ability.FieldModifiers)
+				fieldSeen[ability.FieldModifiers] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		a.Select(selectedFields...)
+	}
+	return nil
+}
+
+type abilityPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AbilityPaginateOption
+}
+
+func newAbilityPaginateArgs(rv map[string]any) *abilityPaginateArgs {
+	args := &abilityPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AbilityOrder{Field: &AbilityOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAbilityOrder(order))
+			}
+		case *AbilityOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAbilityOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AbilityWhereInput); ok {
+		args.opts = append(args.opts, WithAbilityFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (at *AccessTokenQuery) CollectFields(ctx context.Context, satisfies ...string) (*AccessTokenQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return at, nil
+	}
+	if err := at.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return at, nil
+}
+
+func (at *AccessTokenQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(accesstoken.Columns))
+		selectedFields = []string{accesstoken.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "userID":
+			if _, ok := fieldSeen[accesstoken.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldUserID)
+				fieldSeen[accesstoken.FieldUserID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[accesstoken.FieldName]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldName)
+				fieldSeen[accesstoken.FieldName] = struct{}{}
+			}
+		case "scope":
+			if _, ok := fieldSeen[accesstoken.FieldScope]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldScope)
+				fieldSeen[accesstoken.FieldScope] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[accesstoken.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldExpiresAt)
+				fieldSeen[accesstoken.FieldExpiresAt] = struct{}{}
+			}
+		case "lastUsedAt":
+			if _, ok := fieldSeen[accesstoken.FieldLastUsedAt]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldLastUsedAt)
+				fieldSeen[accesstoken.FieldLastUsedAt] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[accesstoken.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldRevokedAt)
+				fieldSeen[accesstoken.FieldRevokedAt] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[accesstoken.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, accesstoken.FieldCreatedAt)
+				fieldSeen[accesstoken.FieldCreatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		at.Select(selectedFields...)
+	}
+	return nil
+}
+
+type accesstokenPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AccessTokenPaginateOption
+}
+
+func newAccessTokenPaginateArgs(rv map[string]any) *accesstokenPaginateArgs {
+	args := &accesstokenPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*AccessTokenWhereInput); ok {
+		args.opts = append(args.opts, WithAccessTokenFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (al *AuditLogQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditLogQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return al, nil
+	}
+	if err := al.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return al, nil
+}
+
+func (al *AuditLogQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditlog.Columns))
+		selectedFields = []string{auditlog.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: al.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			al.withSettlement = query
+			if _, ok := fieldSeen[auditlog.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldSettlementID)
+				fieldSeen[auditlog.FieldSettlementID] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[auditlog.FieldActor]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldActor)
+				fieldSeen[auditlog.FieldActor] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[auditlog.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldOperation)
+				fieldSeen[auditlog.FieldOperation] = struct{}{}
+			}
+		case "entityType":
+			if _, ok := fieldSeen[auditlog.FieldEntityType]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityType)
+				fieldSeen[auditlog.FieldEntityType] = struct{}{}
+			}
+		case "entityID":
+			if _, ok := fieldSeen[auditlog.FieldEntityID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityID)
+				fieldSeen[auditlog.FieldEntityID] = struct{}{}
+			}
+		case "changes":
+			if _, ok := fieldSeen[auditlog.FieldChanges]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldChanges)
+				fieldSeen[auditlog.FieldChanges] = struct{}{}
+			}
+		case "requestID":
+			if _, ok := fieldSeen[auditlog.FieldRequestID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldRequestID)
+				fieldSeen[auditlog.FieldRequestID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[auditlog.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldCreatedAt)
+				fieldSeen[auditlog.FieldCreatedAt] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[auditlog.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldSettlementID)
+				fieldSeen[auditlog.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		al.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditlogPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditLogPaginateOption
+}
+
+func newAuditLogPaginateArgs(rv map[string]any) *auditlogPaginateArgs {
+	args := &auditlogPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AuditLogOrder{Field: &AuditLogOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAuditLogOrder(order))
+			}
+		case *AuditLogOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAuditLogOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AuditLogWhereInput); ok {
+		args.opts = append(args.opts, WithAuditLogFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (d *DisorderQuery) CollectFields(ctx context.Context, satisfies ...string) (*DisorderQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return d, nil
+	}
+	if err := d.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DisorderQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(disorder.Columns))
+		selectedFields = []string{disorder.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[disorder.FieldName]; !ok {
+				selectedFields = append(selectedFields, disorder.FieldName)
+				fieldSeen[disorder.FieldName] = struct{}{}
+			}
+		case "rules":
+			if _, ok := fieldSeen[disorder.FieldRules]; !ok {
+				selectedFields = append(selectedFields, disorder.FieldRules)
+				fieldSeen[disorder.FieldRules] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		d.Select(selectedFields...)
+	}
+	return nil
+}
+
+type disorderPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DisorderPaginateOption
+}
+
+func newDisorderPaginateArgs(rv map[string]any) *disorderPaginateArgs {
+	args := &disorderPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &DisorderOrder{Field: &DisorderOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithDisorderOrder(order))
+			}
+		case *DisorderOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithDisorderOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*DisorderWhereInput); ok {
+		args.opts = append(args.opts, WithDisorderFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (fa *FightingArtQuery) CollectFields(ctx context.Context, satisfies ...string) (*FightingArtQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return fa, nil
+	}
+	if err := fa.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return fa, nil
+}
+
+func (fa *FightingArtQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(fightingart.Columns))
+		selectedFields = []string{fightingart.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[fightingart.FieldName]; !ok {
+				selectedFields = append(selectedFields, fightingart.FieldName)
+				fieldSeen[fightingart.FieldName] = struct{}{}
+			}
+		case "rules":
+			if _, ok := fieldSeen[fightingart.FieldRules]; !ok {
+				selectedFields = append(selectedFields, fightingart.FieldRules)
+				fieldSeen[fightingart.FieldRules] = struct{}{}
+			}
+		case "secret":
+			if _, ok := fieldSeen[fightingart.FieldSecret]; !ok {
+				selectedFields = append(selectedFields, fightingart.FieldSecret)
+				fieldSeen[fightingart.FieldSecret] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		fa.Select(selectedFields...)
+	}
+	return nil
+}
+
+type fightingartPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []FightingArtPaginateOption
+}
+
+func newFightingArtPaginateArgs(rv map[string]any) *fightingartPaginateArgs {
+	args := &fightingartPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &FightingArtOrder{Field: &FightingArtOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithFightingArtOrder(order))
+			}
+		case *FightingArtOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithFightingArtOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*FightingArtWhereInput); ok {
+		args.opts = append(args.opts, WithFightingArtFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (i *InnovationQuery) CollectFields(ctx context.Context, satisfies ...string) (*InnovationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return i, nil
+	}
+	if err := i.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *InnovationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(innovation.Columns))
+		selectedFields = []string{innovation.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: i.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			i.withSettlement = query
+			if _, ok := fieldSeen[innovation.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldSettlementID)
+				fieldSeen[innovation.FieldSettlementID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[innovation.FieldName]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldName)
+				fieldSeen[innovation.FieldName] = struct{}{}
+			}
+		case "consequences":
+			if _, ok := fieldSeen[innovation.FieldConsequences]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldConsequences)
+				fieldSeen[innovation.FieldConsequences] = struct{}{}
+			}
+		case "survivalLimitBonus":
+			if _, ok := fieldSeen[innovation.FieldSurvivalLimitBonus]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldSurvivalLimitBonus)
+				fieldSeen[innovation.FieldSurvivalLimitBonus] = struct{}{}
+			}
+		case "departingSurvivalBonus":
+			if _, ok := fieldSeen[innovation.FieldDepartingSurvivalBonus]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldDepartingSurvivalBonus)
+				fieldSeen[innovation.FieldDepartingSurvivalBonus] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[innovation.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, innovation.FieldSettlementID)
+				fieldSeen[innovation.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		i.Select(selectedFields...)
+	}
+	return nil
+}
+
+type innovationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []InnovationPaginateOption
+}
+
+func newInnovationPaginateArgs(rv map[string]any) *innovationPaginateArgs {
+	args := &innovationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &InnovationOrder{Field: &InnovationOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithInnovationOrder(order))
+			}
+		case *InnovationOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithInnovationOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*InnovationWhereInput); ok {
+		args.opts = append(args.opts, WithInnovationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (i *InviteQuery) CollectFields(ctx context.Context, satisfies ...string) (*InviteQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return i, nil
+	}
+	if err := i.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *InviteQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(invite.Columns))
+		selectedFields = []string{invite.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: i.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			i.withSettlement = query
+			if _, ok := fieldSeen[invite.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, invite.FieldSettlementID)
+				fieldSeen[invite.FieldSettlementID] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[invite.FieldRole]; !ok {
+				selectedFields = append(selectedFields, invite.FieldRole)
+				fieldSeen[invite.FieldRole] = struct{}{}
+			}
+		case "maxUses":
+			if _, ok := fieldSeen[invite.FieldMaxUses]; !ok {
+				selectedFields = append(selectedFields, invite.FieldMaxUses)
+				fieldSeen[invite.FieldMaxUses] = struct{}{}
+			}
+		case "uses":
+			if _, ok := fieldSeen[invite.FieldUses]; !ok {
+				selectedFields = append(selectedFields, invite.FieldUses)
+				fieldSeen[invite.FieldUses] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[invite.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldExpiresAt)
+				fieldSeen[invite.FieldExpiresAt] = struct{}{}
+			}
+		case "revokedAt":
+			if _, ok := fieldSeen[invite.FieldRevokedAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldRevokedAt)
+				fieldSeen[invite.FieldRevokedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[invite.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, invite.FieldCreatedBy)
+				fieldSeen[invite.FieldCreatedBy] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[invite.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, invite.FieldCreatedAt)
+				fieldSeen[invite.FieldCreatedAt] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[invite.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, invite.FieldSettlementID)
+				fieldSeen[invite.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		i.Select(selectedFields...)
+	}
+	return nil
+}
+
+type invitePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []InvitePaginateOption
+}
+
+func newInvitePaginateArgs(rv map[string]any) *invitePaginateArgs {
+	args := &invitePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*InviteWhereInput); ok {
+		args.opts = append(args.opts, WithInviteFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (l *LocationQuery) CollectFields(ctx context.Context, satisfies ...string) (*LocationQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return l, nil
+	}
+	if err := l.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *LocationQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(location.Columns))
+		selectedFields = []string{location.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "recipes":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RecipeClient{config: l.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, recipeImplementors)...); err != nil {
+				return err
+			}
+			l.WithNamedRecipes(alias, func(wq *RecipeQuery) {
+				*wq = *query
+			})
+		case "name":
+			if _, ok := fieldSeen[location.FieldName]; !ok {
+				selectedFields = append(selectedFields, location.FieldName)
+				fieldSeen[location.FieldName] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		l.Select(selectedFields...)
+	}
+	return nil
+}
+
+type locationPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []LocationPaginateOption
+}
+
+func newLocationPaginateArgs(rv map[string]any) *locationPaginateArgs {
+	args := &locationPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &LocationOrder{Field: &LocationOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithLocationOrder(order))
+			}
+		case *LocationOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithLocationOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*LocationWhereInput); ok {
+		args.opts = append(args.opts, WithLocationFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (m *MembershipQuery) CollectFields(ctx context.Context, satisfies ...string) (*MembershipQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return m, nil
+	}
+	if err := m.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *MembershipQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(membership.Columns))
+		selectedFields = []string{membership.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: m.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			m.withSettlement = query
+			if _, ok := fieldSeen[membership.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, membership.FieldSettlementID)
+				fieldSeen[membership.FieldSettlementID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[membership.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, membership.FieldUserID)
+				fieldSeen[membership.FieldUserID] = struct{}{}
+			}
+		case "role":
+			if _, ok := fieldSeen[membership.FieldRole]; !ok {
+				selectedFields = append(selectedFields, membership.FieldRole)
+				fieldSeen[membership.FieldRole] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[membership.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, membership.FieldSettlementID)
+				fieldSeen[membership.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		m.Select(selectedFields...)
+	}
+	return nil
+}
+
+type membershipPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []MembershipPaginateOption
+}
+
+func newMembershipPaginateArgs(rv map[string]any) *membershipPaginateArgs {
+	args := &membershipPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*MembershipWhereInput); ok {
+		args.opts = append(args.opts, WithMembershipFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (m *MilestoneQuery) CollectFields(ctx context.Context, satisfies ...string) (*MilestoneQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return m, nil
+	}
+	if err := m.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *MilestoneQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(milestone.Columns))
+		selectedFields = []string{milestone.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: m.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			m.withSettlement = query
+			if _, ok := fieldSeen[milestone.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, milestone.FieldSettlementID)
+				fieldSeen[milestone.FieldSettlementID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[milestone.FieldName]; !ok {
+				selectedFields = append(selectedFields, milestone.FieldName)
+				fieldSeen[milestone.FieldName] = struct{}{}
+			}
+		case "year":
+			if _, ok := fieldSeen[milestone.FieldYear]; !ok {
+				selectedFields = append(selectedFields, milestone.FieldYear)
+				fieldSeen[milestone.FieldYear] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[milestone.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, milestone.FieldCreatedAt)
+				fieldSeen[milestone.FieldCreatedAt] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[milestone.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, milestone.FieldSettlementID)
+				fieldSeen[milestone.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		m.Select(selectedFields...)
+	}
+	return nil
+}
+
+type milestonePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []MilestonePaginateOption
+}
+
+func newMilestonePaginateArgs(rv map[string]any) *milestonePaginateArgs {
+	args := &milestonePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*MilestoneWhereInput); ok {
+		args.opts = append(args.opts, WithMilestoneFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (m *MonsterQuery) CollectFields(ctx context.Context, satisfies ...string) (*MonsterQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return m, nil
+	}
+	if err := m.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *MonsterQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(monster.Columns))
+		selectedFields = []string{monster.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "name":
+			if _, ok := fieldSeen[monster.FieldName]; !ok {
+				selectedFields = append(selectedFields, monster.FieldName)
+				fieldSeen[monster.FieldName] = struct{}{}
+			}
+		case "kind":
+			if _, ok := fieldSeen[monster.FieldKind]; !ok {
+				selectedFields = append(selectedFields, monster.FieldKind)
+				fieldSeen[monster.FieldKind] = struct{}{}
+			}
+		case "levels":
+			if _, ok := fieldSeen[monster.FieldLevels]; !ok {
+				selectedFields = append(selectedFields, monster.FieldLevels)
+				fieldSeen[monster.FieldLevels] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		m.Select(selectedFields...)
+	}
+	return nil
+}
+
+type monsterPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []MonsterPaginateOption
+}
+
+func newMonsterPaginateArgs(rv map[string]any) *monsterPaginateArgs {
+	args := &monsterPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &MonsterOrder{Field: &MonsterOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithMonsterOrder(order))
+			}
+		case *MonsterOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithMonsterOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*MonsterWhereInput); ok {
+		args.opts = append(args.opts, WithMonsterFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (mp *MonsterProgressQuery) CollectFields(ctx context.Context, satisfies ...string) (*MonsterProgressQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return mp, nil
+	}
+	if err := mp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
+func (mp *MonsterProgressQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(monsterprogress.Columns))
+		selectedFields = []string{monsterprogress.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: mp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			mp.withSettlement = query
+			if _, ok := fieldSeen[monsterprogress.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldSettlementID)
+				fieldSeen[monsterprogress.FieldSettlementID] = struct{}{}
+			}
+
+		case "monster":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MonsterClient{config: mp.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, monsterImplementors)...); err != nil {
+				return err
+			}
+			mp.withMonster = query
+			if _, ok := fieldSeen[monsterprogress.FieldMonsterID]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldMonsterID)
+				fieldSeen[monsterprogress.FieldMonsterID] = struct{}{}
+			}
+		case "unlockedYear":
+			if _, ok := fieldSeen[monsterprogress.FieldUnlockedYear]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldUnlockedYear)
+				fieldSeen[monsterprogress.FieldUnlockedYear] = struct{}{}
+			}
+		case "defeats":
+			if _, ok := fieldSeen[monsterprogress.FieldDefeats]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldDefeats)
+				fieldSeen[monsterprogress.FieldDefeats] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[monsterprogress.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldSettlementID)
+				fieldSeen[monsterprogress.FieldSettlementID] = struct{}{}
+			}
+		case "monsterID":
+			if _, ok := fieldSeen[monsterprogress.FieldMonsterID]; !ok {
+				selectedFields = append(selectedFields, monsterprogress.FieldMonsterID)
+				fieldSeen[monsterprogress.FieldMonsterID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		mp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type monsterprogressPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []MonsterProgressPaginateOption
+}
+
+func newMonsterProgressPaginateArgs(rv map[string]any) *monsterprogressPaginateArgs {
+	args := &monsterprogressPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*MonsterProgressWhereInput); ok {
+		args.opts = append(args.opts, WithMonsterProgressFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (pr *PrincipleQuery) CollectFields(ctx context.Context, satisfies ...string) (*PrincipleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pr, nil
+	}
+	if err := pr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (pr *PrincipleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(principle.Columns))
+		selectedFields = []string{principle.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: pr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			pr.withSettlement = query
+			if _, ok := fieldSeen[principle.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, principle.FieldSettlementID)
+				fieldSeen[principle.FieldSettlementID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[principle.FieldName]; !ok {
+				selectedFields = append(selectedFields, principle.FieldName)
+				fieldSeen[principle.FieldName] = struct{}{}
+			}
+		case "choice":
+			if _, ok := fieldSeen[principle.FieldChoice]; !ok {
+				selectedFields = append(selectedFields, principle.FieldChoice)
+				fieldSeen[principle.FieldChoice] = struct{}{}
+			}
+		case "survivalLimitBonus":
+			if _, ok := fieldSeen[principle.FieldSurvivalLimitBonus]; !ok {
+				selectedFields = append(selectedFields, principle.FieldSurvivalLimitBonus)
+				fieldSeen[principle.FieldSurvivalLimitBonus] = struct{}{}
+			}
+		case "departingSurvivalBonus":
+			if _, ok := fieldSeen[principle.FieldDepartingSurvivalBonus]; !ok {
+				selectedFields = append(selectedFields, principle.FieldDepartingSurvivalBonus)
+				fieldSeen[principle.FieldDepartingSurvivalBonus] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[principle.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, principle.FieldSettlementID)
+				fieldSeen[principle.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		pr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type principlePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []PrinciplePaginateOption
+}
+
+func newPrinciplePaginateArgs(rv map[string]any) *principlePaginateArgs {
+	args := &principlePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*PrincipleWhereInput); ok {
+		args.opts = append(args.opts, WithPrincipleFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (r *RecipeQuery) CollectFields(ctx context.Context, satisfies ...string) (*RecipeQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return r, nil
+	}
+	if err := r.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RecipeQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(recipe.Columns))
+		selectedFields = []string{recipe.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "location":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&LocationClient{config: r.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, locationImplementors)...); err != nil {
+				return err
+			}
+			r.withLocation = query
+			if _, ok := fieldSeen[recipe.FieldLocationID]; !ok {
+				selectedFields = append(selectedFields, recipe.FieldLocationID)
+				fieldSeen[recipe.FieldLocationID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[recipe.FieldName]; !ok {
+				selectedFields = append(selectedFields, recipe.FieldName)
+				fieldSeen[recipe.FieldName] = struct{}{}
+			}
+		case "keywords":
+			if _, ok := fieldSeen[recipe.FieldKeywords]; !ok {
+				selectedFields = append(selectedFields, recipe.FieldKeywords)
+				fieldSeen[recipe.FieldKeywords] = struct{}{}
+			}
+		case "costs":
+			if _, ok := fieldSeen[recipe.FieldCosts]; !ok {
+				selectedFields = append(selectedFields, recipe.FieldCosts)
+				fieldSeen[recipe.FieldCosts] = struct{}{}
+			}
+		case "locationID":
+			if _, ok := fieldSeen[recipe.FieldLocationID]; !ok {
+				selectedFields = append(selectedFields, recipe.FieldLocationID)
+				fieldSeen[recipe.FieldLocationID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		r.Select(selectedFields...)
+	}
+	return nil
+}
+
+type recipePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RecipePaginateOption
+}
+
+func newRecipePaginateArgs(rv map[string]any) *recipePaginateArgs {
+	args := &recipePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RecipeOrder{Field: &RecipeOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRecipeOrder(order))
+			}
+		case *RecipeOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRecipeOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RecipeWhereInput); ok {
+		args.opts = append(args.opts, WithRecipeFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (s *SettlementQuery) CollectFields(ctx context.Context, satisfies ...string) (*SettlementQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -23,28 +1536,137 @@ func (s *SettlementQuery) CollectFields(ctx context.Context, satisfies ...string
 	return s, nil
 }
 
-func (s *SettlementQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
-	path = append([]string(nil), path...)
-	var (
-		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(settlement.Columns))
-		selectedFields = []string{settlement.FieldID}
-	)
-	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
-		switch field.Name {
+func (s *SettlementQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(settlement.Columns))
+		selectedFields = []string{settlement.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "population":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SurvivorClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, survivorImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedPopulation(alias, func(wq *SurvivorQuery) {
+				*wq = *query
+			})
+
+		case "members":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MembershipClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, membershipImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedMembers(alias, func(wq *MembershipQuery) {
+				*wq = *query
+			})
+
+		case "timeline":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TimelineEventClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, timelineeventImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedTimeline(alias, func(wq *TimelineEventQuery) {
+				*wq = *query
+			})
+
+		case "storage":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&StorageItemClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, storageitemImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedStorage(alias, func(wq *StorageItemQuery) {
+				*wq = *query
+			})
+
+		case "innovations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&InnovationClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, innovationImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedInnovations(alias, func(wq *InnovationQuery) {
+				*wq = *query
+			})
 
-		case "population":
+		case "principles":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&SurvivorClient{config: s.config}).Query()
+				query = (&PrincipleClient{config: s.config}).Query()
 			)
-			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, survivorImplementors)...); err != nil {
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, principleImplementors)...); err != nil {
 				return err
 			}
-			s.WithNamedPopulation(alias, func(wq *SurvivorQuery) {
+			s.WithNamedPrinciples(alias, func(wq *PrincipleQuery) {
+				*wq = *query
+			})
+
+		case "milestones":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MilestoneClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, milestoneImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedMilestones(alias, func(wq *MilestoneQuery) {
 				*wq = *query
 			})
+
+		case "locations":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&LocationClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, locationImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedLocations(alias, func(wq *LocationQuery) {
+				*wq = *query
+			})
+
+		case "monsters":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&MonsterProgressClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, monsterprogressImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedMonsters(alias, func(wq *MonsterProgressQuery) {
+				*wq = *query
+			})
+		case "deletedAt":
+			if _, ok := fieldSeen[settlement.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, settlement.FieldDeletedAt)
+				fieldSeen[settlement.FieldDeletedAt] = struct{}{}
+			}
 		case "owner":
 			if _, ok := fieldSeen[settlement.FieldOwner]; !ok {
 				selectedFields = append(selectedFields, settlement.FieldOwner)
@@ -138,6 +1760,135 @@ func newSettlementPaginateArgs(rv map[string]any) *settlementPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (si *StorageItemQuery) CollectFields(ctx context.Context, satisfies ...string) (*StorageItemQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return si, nil
+	}
+	if err := si.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return si, nil
+}
+
+func (si *StorageItemQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(storageitem.Columns))
+		selectedFields = []string{storageitem.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: si.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			si.withSettlement = query
+			if _, ok := fieldSeen[storageitem.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldSettlementID)
+				fieldSeen[storageitem.FieldSettlementID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[storageitem.FieldName]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldName)
+				fieldSeen[storageitem.FieldName] = struct{}{}
+			}
+		case "category":
+			if _, ok := fieldSeen[storageitem.FieldCategory]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldCategory)
+				fieldSeen[storageitem.FieldCategory] = struct{}{}
+			}
+		case "sourceMonster":
+			if _, ok := fieldSeen[storageitem.FieldSourceMonster]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldSourceMonster)
+				fieldSeen[storageitem.FieldSourceMonster] = struct{}{}
+			}
+		case "keywords":
+			if _, ok := fieldSeen[storageitem.FieldKeywords]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldKeywords)
+				fieldSeen[storageitem.FieldKeywords] = struct{}{}
+			}
+		case "quantity":
+			if _, ok := fieldSeen[storageitem.FieldQuantity]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldQuantity)
+				fieldSeen[storageitem.FieldQuantity] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[storageitem.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, storageitem.FieldSettlementID)
+				fieldSeen[storageitem.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		si.Select(selectedFields...)
+	}
+	return nil
+}
+
+type storageitemPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []StorageItemPaginateOption
+}
+
+func newStorageItemPaginateArgs(rv map[string]any) *storageitemPaginateArgs {
+	args := &storageitemPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &StorageItemOrder{Field: &StorageItemOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithStorageItemOrder(order))
+			}
+		case *StorageItemOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithStorageItemOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*StorageItemWhereInput); ok {
+		args.opts = append(args.opts, WithStorageItemFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (s *SurvivorQuery) CollectFields(ctx context.Context, satisfies ...string) (*SurvivorQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -174,6 +1925,63 @@ func (s *SurvivorQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, survivor.FieldSettlementID)
 				fieldSeen[survivor.FieldSettlementID] = struct{}{}
 			}
+
+		case "fightingArts":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FightingArtClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, fightingartImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedFightingArts(alias, func(wq *FightingArtQuery) {
+				*wq = *query
+			})
+
+		case "secretFightingArts":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&FightingArtClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, fightingartImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedSecretFightingArts(alias, func(wq *FightingArtQuery) {
+				*wq = *query
+			})
+
+		case "disorders":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DisorderClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, disorderImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedDisorders(alias, func(wq *DisorderQuery) {
+				*wq = *query
+			})
+
+		case "abilities":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AbilityClient{config: s.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, abilityImplementors)...); err != nil {
+				return err
+			}
+			s.WithNamedAbilities(alias, func(wq *AbilityQuery) {
+				*wq = *query
+			})
+		case "deletedAt":
+			if _, ok := fieldSeen[survivor.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, survivor.FieldDeletedAt)
+				fieldSeen[survivor.FieldDeletedAt] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[survivor.FieldName]; !ok {
 				selectedFields = append(selectedFields, survivor.FieldName)
@@ -269,6 +2077,11 @@ func (s *SurvivorQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, survivor.FieldStatusChangeYear)
 				fieldSeen[survivor.FieldStatusChangeYear] = struct{}{}
 			}
+		case "cannotUseFightingArts":
+			if _, ok := fieldSeen[survivor.FieldCannotUseFightingArts]; !ok {
+				selectedFields = append(selectedFields, survivor.FieldCannotUseFightingArts)
+				fieldSeen[survivor.FieldCannotUseFightingArts] = struct{}{}
+			}
 		case "settlementID":
 			if _, ok := fieldSeen[survivor.FieldSettlementID]; !ok {
 				selectedFields = append(selectedFields, survivor.FieldSettlementID)
@@ -337,6 +2150,130 @@ func newSurvivorPaginateArgs(rv map[string]any) *survivorPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (te *TimelineEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*TimelineEventQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return te, nil
+	}
+	if err := te.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return te, nil
+}
+
+func (te *TimelineEventQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(timelineevent.Columns))
+		selectedFields = []string{timelineevent.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "settlement":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SettlementClient{config: te.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, settlementImplementors)...); err != nil {
+				return err
+			}
+			te.withSettlement = query
+			if _, ok := fieldSeen[timelineevent.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldSettlementID)
+				fieldSeen[timelineevent.FieldSettlementID] = struct{}{}
+			}
+		case "year":
+			if _, ok := fieldSeen[timelineevent.FieldYear]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldYear)
+				fieldSeen[timelineevent.FieldYear] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[timelineevent.FieldType]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldType)
+				fieldSeen[timelineevent.FieldType] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[timelineevent.FieldName]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldName)
+				fieldSeen[timelineevent.FieldName] = struct{}{}
+			}
+		case "completed":
+			if _, ok := fieldSeen[timelineevent.FieldCompleted]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldCompleted)
+				fieldSeen[timelineevent.FieldCompleted] = struct{}{}
+			}
+		case "settlementID":
+			if _, ok := fieldSeen[timelineevent.FieldSettlementID]; !ok {
+				selectedFields = append(selectedFields, timelineevent.FieldSettlementID)
+				fieldSeen[timelineevent.FieldSettlementID] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		te.Select(selectedFields...)
+	}
+	return nil
+}
+
+type timelineeventPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TimelineEventPaginateOption
+}
+
+func newTimelineEventPaginateArgs(rv map[string]any) *timelineeventPaginateArgs {
+	args := &timelineeventPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &TimelineEventOrder{Field: &TimelineEventOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithTimelineEventOrder(order))
+			}
+		case *TimelineEventOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithTimelineEventOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*TimelineEventWhereInput); ok {
+		args.opts = append(args.opts, WithTimelineEventFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
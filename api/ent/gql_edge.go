@@ -8,6 +8,90 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
+func (al *AuditLog) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := al.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = al.QuerySettlement().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (i *Innovation) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := i.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (i *Invite) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := i.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = i.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (l *Location) Recipes(ctx context.Context) (result []*Recipe, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = l.NamedRecipes(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = l.Edges.RecipesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = l.QueryRecipes().All(ctx)
+	}
+	return result, err
+}
+
+func (m *Membership) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := m.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (m *Milestone) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := m.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (mp *MonsterProgress) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := mp.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = mp.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (mp *MonsterProgress) Monster(ctx context.Context) (*Monster, error) {
+	result, err := mp.Edges.MonsterOrErr()
+	if IsNotLoaded(err) {
+		result, err = mp.QueryMonster().Only(ctx)
+	}
+	return result, err
+}
+
+func (pr *Principle) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := pr.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = pr.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
+func (r *Recipe) Location(ctx context.Context) (*Location, error) {
+	result, err := r.Edges.LocationOrErr()
+	if IsNotLoaded(err) {
+		result, err = r.QueryLocation().Only(ctx)
+	}
+	return result, err
+}
+
 func (s *Settlement) Population(ctx context.Context) (result []*Survivor, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = s.NamedPopulation(graphql.GetFieldContext(ctx).Field.Alias)
@@ -20,6 +104,110 @@ func (s *Settlement) Population(ctx context.Context) (result []*Survivor, err er
 	return result, err
 }
 
+func (s *Settlement) Members(ctx context.Context) (result []*Membership, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedMembers(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.MembersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryMembers().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Timeline(ctx context.Context) (result []*TimelineEvent, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedTimeline(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.TimelineOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryTimeline().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Storage(ctx context.Context) (result []*StorageItem, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedStorage(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.StorageOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryStorage().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Innovations(ctx context.Context) (result []*Innovation, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedInnovations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.InnovationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryInnovations().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Principles(ctx context.Context) (result []*Principle, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedPrinciples(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.PrinciplesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryPrinciples().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Milestones(ctx context.Context) (result []*Milestone, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedMilestones(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.MilestonesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryMilestones().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Locations(ctx context.Context) (result []*Location, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedLocations(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.LocationsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryLocations().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Settlement) Monsters(ctx context.Context) (result []*MonsterProgress, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedMonsters(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.MonstersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryMonsters().All(ctx)
+	}
+	return result, err
+}
+
+func (si *StorageItem) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := si.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = si.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
+
 func (s *Survivor) Settlement(ctx context.Context) (*Settlement, error) {
 	result, err := s.Edges.SettlementOrErr()
 	if IsNotLoaded(err) {
@@ -27,3 +215,59 @@ func (s *Survivor) Settlement(ctx context.Context) (*Settlement, error) {
 	}
 	return result, MaskNotFound(err)
 }
+
+func (s *Survivor) FightingArts(ctx context.Context) (result []*FightingArt, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedFightingArts(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.FightingArtsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryFightingArts().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Survivor) SecretFightingArts(ctx context.Context) (result []*FightingArt, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedSecretFightingArts(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.SecretFightingArtsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QuerySecretFightingArts().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Survivor) Disorders(ctx context.Context) (result []*Disorder, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedDisorders(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.DisordersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryDisorders().All(ctx)
+	}
+	return result, err
+}
+
+func (s *Survivor) Abilities(ctx context.Context) (result []*Ability, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = s.NamedAbilities(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = s.Edges.AbilitiesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = s.QueryAbilities().All(ctx)
+	}
+	return result, err
+}
+
+func (te *TimelineEvent) Settlement(ctx context.Context) (*Settlement, error) {
+	result, err := te.Edges.SettlementOrErr()
+	if IsNotLoaded(err) {
+		result, err = te.QuerySettlement().Only(ctx)
+	}
+	return result, err
+}
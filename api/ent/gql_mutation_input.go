@@ -3,9 +3,69 @@
 package ent
 
 import (
+	"github.com/failuretoload/datamonster/ent/principle"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
+// CreateInnovationInput represents a mutation input for creating innovations.
+type CreateInnovationInput struct {
+	Name                   string
+	Consequences           []string
+	SurvivalLimitBonus     *int
+	DepartingSurvivalBonus *int
+	SettlementID           int
+}
+
+// Mutate applies the CreateInnovationInput on the InnovationMutation builder.
+func (i *CreateInnovationInput) Mutate(m *InnovationMutation) {
+	m.SetName(i.Name)
+	if v := i.Consequences; v != nil {
+		m.SetConsequences(v)
+	}
+	if v := i.SurvivalLimitBonus; v != nil {
+		m.SetSurvivalLimitBonus(*v)
+	}
+	if v := i.DepartingSurvivalBonus; v != nil {
+		m.SetDepartingSurvivalBonus(*v)
+	}
+	m.SetSettlementID(i.SettlementID)
+}
+
+// SetInput applies the change-set in the CreateInnovationInput on the InnovationCreate builder.
+func (c *InnovationCreate) SetInput(i CreateInnovationInput) *InnovationCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// CreatePrincipleInput represents a mutation input for creating principles.
+type CreatePrincipleInput struct {
+	Name                   principle.Name
+	Choice                 string
+	SurvivalLimitBonus     *int
+	DepartingSurvivalBonus *int
+	SettlementID           int
+}
+
+// Mutate applies the CreatePrincipleInput on the PrincipleMutation builder.
+func (i *CreatePrincipleInput) Mutate(m *PrincipleMutation) {
+	m.SetName(i.Name)
+	m.SetChoice(i.Choice)
+	if v := i.SurvivalLimitBonus; v != nil {
+		m.SetSurvivalLimitBonus(*v)
+	}
+	if v := i.DepartingSurvivalBonus; v != nil {
+		m.SetDepartingSurvivalBonus(*v)
+	}
+	m.SetSettlementID(i.SettlementID)
+}
+
+// SetInput applies the change-set in the CreatePrincipleInput on the PrincipleCreate builder.
+func (c *PrincipleCreate) SetInput(i CreatePrincipleInput) *PrincipleCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateSettlementInput represents a mutation input for creating settlements.
 type CreateSettlementInput struct {
 	Owner               string
@@ -102,26 +162,27 @@ func (c *SettlementUpdateOne) SetInput(i UpdateSettlementInput) *SettlementUpdat
 
 // CreateSurvivorInput represents a mutation input for creating survivors.
 type CreateSurvivorInput struct {
-	Name             string
-	Born             *int
-	Gender           *survivor.Gender
-	Huntxp           *int
-	Survival         *int
-	Movement         *int
-	Accuracy         *int
-	Strength         *int
-	Evasion          *int
-	Luck             *int
-	Speed            *int
-	Systemicpressure *int
-	Torment          *int
-	Insanity         *int
-	Lumi             *int
-	Courage          *int
-	Understanding    *int
-	Status           *survivor.Status
-	StatusChangeYear *int
-	SettlementID     *int
+	Name                  string
+	Born                  *int
+	Gender                *survivor.Gender
+	Huntxp                *int
+	Survival              *int
+	Movement              *int
+	Accuracy              *int
+	Strength              *int
+	Evasion               *int
+	Luck                  *int
+	Speed                 *int
+	Systemicpressure      *int
+	Torment               *int
+	Insanity              *int
+	Lumi                  *int
+	Courage               *int
+	Understanding         *int
+	Status                *survivor.Status
+	StatusChangeYear      *int
+	CannotUseFightingArts *bool
+	SettlementID          *int
 }
 
 // Mutate applies the CreateSurvivorInput on the SurvivorMutation builder.
@@ -181,6 +242,9 @@ func (i *CreateSurvivorInput) Mutate(m *SurvivorMutation) {
 	if v := i.StatusChangeYear; v != nil {
 		m.SetStatusChangeYear(*v)
 	}
+	if v := i.CannotUseFightingArts; v != nil {
+		m.SetCannotUseFightingArts(*v)
+	}
 	if v := i.SettlementID; v != nil {
 		m.SetSettlementID(*v)
 	}
@@ -194,27 +258,28 @@ func (c *SurvivorCreate) SetInput(i CreateSurvivorInput) *SurvivorCreate {
 
 // UpdateSurvivorInput represents a mutation input for updating survivors.
 type UpdateSurvivorInput struct {
-	Name             *string
-	Born             *int
-	Gender           *survivor.Gender
-	Huntxp           *int
-	Survival         *int
-	Movement         *int
-	Accuracy         *int
-	Strength         *int
-	Evasion          *int
-	Luck             *int
-	Speed            *int
-	Systemicpressure *int
-	Torment          *int
-	Insanity         *int
-	Lumi             *int
-	Courage          *int
-	Understanding    *int
-	Status           *survivor.Status
-	StatusChangeYear *int
-	ClearSettlement  bool
-	SettlementID     *int
+	Name                  *string
+	Born                  *int
+	Gender                *survivor.Gender
+	Huntxp                *int
+	Survival              *int
+	Movement              *int
+	Accuracy              *int
+	Strength              *int
+	Evasion               *int
+	Luck                  *int
+	Speed                 *int
+	Systemicpressure      *int
+	Torment               *int
+	Insanity              *int
+	Lumi                  *int
+	Courage               *int
+	Understanding         *int
+	Status                *survivor.Status
+	StatusChangeYear      *int
+	CannotUseFightingArts *bool
+	ClearSettlement       bool
+	SettlementID          *int
 }
 
 // Mutate applies the UpdateSurvivorInput on the SurvivorMutation builder.
@@ -276,6 +341,9 @@ func (i *UpdateSurvivorInput) Mutate(m *SurvivorMutation) {
 	if v := i.StatusChangeYear; v != nil {
 		m.SetStatusChangeYear(*v)
 	}
+	if v := i.CannotUseFightingArts; v != nil {
+		m.SetCannotUseFightingArts(*v)
+	}
 	if i.ClearSettlement {
 		m.ClearSettlement()
 	}
@@ -295,3 +363,29 @@ func (c *SurvivorUpdateOne) SetInput(i UpdateSurvivorInput) *SurvivorUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// CreateTimelineEventInput represents a mutation input for creating timelineevents.
+type CreateTimelineEventInput struct {
+	Year         int
+	Type         timelineevent.Type
+	Name         string
+	Completed    *bool
+	SettlementID int
+}
+
+// Mutate applies the CreateTimelineEventInput on the TimelineEventMutation builder.
+func (i *CreateTimelineEventInput) Mutate(m *TimelineEventMutation) {
+	m.SetYear(i.Year)
+	m.SetType(i.Type)
+	m.SetName(i.Name)
+	if v := i.Completed; v != nil {
+		m.SetCompleted(*v)
+	}
+	m.SetSettlementID(i.SettlementID)
+}
+
+// SetInput applies the change-set in the CreateTimelineEventInput on the TimelineEventCreate builder.
+func (c *TimelineEventCreate) SetInput(i CreateTimelineEventInput) *TimelineEventCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
@@ -13,8 +13,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/schema"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 	"github.com/hashicorp/go-multierror"
 	"golang.org/x/sync/semaphore"
 )
@@ -24,16 +40,96 @@ type Noder interface {
 	IsNode()
 }
 
+var abilityImplementors = []string{"Ability", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Ability) IsNode() {}
+
+var accesstokenImplementors = []string{"AccessToken", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*AccessToken) IsNode() {}
+
+var auditlogImplementors = []string{"AuditLog", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*AuditLog) IsNode() {}
+
+var disorderImplementors = []string{"Disorder", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Disorder) IsNode() {}
+
+var fightingartImplementors = []string{"FightingArt", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*FightingArt) IsNode() {}
+
+var innovationImplementors = []string{"Innovation", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Innovation) IsNode() {}
+
+var inviteImplementors = []string{"Invite", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Invite) IsNode() {}
+
+var locationImplementors = []string{"Location", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Location) IsNode() {}
+
+var membershipImplementors = []string{"Membership", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Membership) IsNode() {}
+
+var milestoneImplementors = []string{"Milestone", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Milestone) IsNode() {}
+
+var monsterImplementors = []string{"Monster", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Monster) IsNode() {}
+
+var monsterprogressImplementors = []string{"MonsterProgress", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*MonsterProgress) IsNode() {}
+
+var principleImplementors = []string{"Principle", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Principle) IsNode() {}
+
+var recipeImplementors = []string{"Recipe", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Recipe) IsNode() {}
+
 var settlementImplementors = []string{"Settlement", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Settlement) IsNode() {}
 
+var storageitemImplementors = []string{"StorageItem", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*StorageItem) IsNode() {}
+
 var survivorImplementors = []string{"Survivor", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
 func (*Survivor) IsNode() {}
 
+var timelineeventImplementors = []string{"TimelineEvent", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*TimelineEvent) IsNode() {}
+
 var errNodeInvalidID = &NotFoundError{"node"}
 
 // NodeOption allows configuring the Noder execution using functional options.
@@ -92,6 +188,132 @@ func (c *Client) Noder(ctx context.Context, id int, opts ...NodeOption) (_ Noder
 
 func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error) {
 	switch table {
+	case ability.Table:
+		query := c.Ability.Query().
+			Where(ability.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, abilityImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case accesstoken.Table:
+		query := c.AccessToken.Query().
+			Where(accesstoken.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, accesstokenImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case auditlog.Table:
+		query := c.AuditLog.Query().
+			Where(auditlog.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, auditlogImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case disorder.Table:
+		query := c.Disorder.Query().
+			Where(disorder.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, disorderImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case fightingart.Table:
+		query := c.FightingArt.Query().
+			Where(fightingart.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, fightingartImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case innovation.Table:
+		query := c.Innovation.Query().
+			Where(innovation.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, innovationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case invite.Table:
+		query := c.Invite.Query().
+			Where(invite.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, inviteImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case location.Table:
+		query := c.Location.Query().
+			Where(location.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, locationImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case membership.Table:
+		query := c.Membership.Query().
+			Where(membership.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, membershipImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case milestone.Table:
+		query := c.Milestone.Query().
+			Where(milestone.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, milestoneImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case monster.Table:
+		query := c.Monster.Query().
+			Where(monster.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, monsterImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case monsterprogress.Table:
+		query := c.MonsterProgress.Query().
+			Where(monsterprogress.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, monsterprogressImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case principle.Table:
+		query := c.Principle.Query().
+			Where(principle.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, principleImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case recipe.Table:
+		query := c.Recipe.Query().
+			Where(recipe.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, recipeImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case settlement.Table:
 		query := c.Settlement.Query().
 			Where(settlement.ID(id))
@@ -101,6 +323,15 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			}
 		}
 		return query.Only(ctx)
+	case storageitem.Table:
+		query := c.StorageItem.Query().
+			Where(storageitem.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, storageitemImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case survivor.Table:
 		query := c.Survivor.Query().
 			Where(survivor.ID(id))
@@ -110,6 +341,15 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			}
 		}
 		return query.Only(ctx)
+	case timelineevent.Table:
+		query := c.TimelineEvent.Query().
+			Where(timelineevent.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, timelineeventImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	default:
 		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
 	}
@@ -183,6 +423,230 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 		idmap[id] = append(idmap[id], &noders[i])
 	}
 	switch table {
+	case ability.Table:
+		query := c.Ability.Query().
+			Where(ability.IDIn(ids...))
+		query, err := query.CollectFields(ctx, abilityImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case accesstoken.Table:
+		query := c.AccessToken.Query().
+			Where(accesstoken.IDIn(ids...))
+		query, err := query.CollectFields(ctx, accesstokenImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case auditlog.Table:
+		query := c.AuditLog.Query().
+			Where(auditlog.IDIn(ids...))
+		query, err := query.CollectFields(ctx, auditlogImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case disorder.Table:
+		query := c.Disorder.Query().
+			Where(disorder.IDIn(ids...))
+		query, err := query.CollectFields(ctx, disorderImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case fightingart.Table:
+		query := c.FightingArt.Query().
+			Where(fightingart.IDIn(ids...))
+		query, err := query.CollectFields(ctx, fightingartImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case innovation.Table:
+		query := c.Innovation.Query().
+			Where(innovation.IDIn(ids...))
+		query, err := query.CollectFields(ctx, innovationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case invite.Table:
+		query := c.Invite.Query().
+			Where(invite.IDIn(ids...))
+		query, err := query.CollectFields(ctx, inviteImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case location.Table:
+		query := c.Location.Query().
+			Where(location.IDIn(ids...))
+		query, err := query.CollectFields(ctx, locationImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case membership.Table:
+		query := c.Membership.Query().
+			Where(membership.IDIn(ids...))
+		query, err := query.CollectFields(ctx, membershipImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case milestone.Table:
+		query := c.Milestone.Query().
+			Where(milestone.IDIn(ids...))
+		query, err := query.CollectFields(ctx, milestoneImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case monster.Table:
+		query := c.Monster.Query().
+			Where(monster.IDIn(ids...))
+		query, err := query.CollectFields(ctx, monsterImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case monsterprogress.Table:
+		query := c.MonsterProgress.Query().
+			Where(monsterprogress.IDIn(ids...))
+		query, err := query.CollectFields(ctx, monsterprogressImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case principle.Table:
+		query := c.Principle.Query().
+			Where(principle.IDIn(ids...))
+		query, err := query.CollectFields(ctx, principleImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case recipe.Table:
+		query := c.Recipe.Query().
+			Where(recipe.IDIn(ids...))
+		query, err := query.CollectFields(ctx, recipeImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case settlement.Table:
 		query := c.Settlement.Query().
 			Where(settlement.IDIn(ids...))
@@ -199,6 +663,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case storageitem.Table:
+		query := c.StorageItem.Query().
+			Where(storageitem.IDIn(ids...))
+		query, err := query.CollectFields(ctx, storageitemImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case survivor.Table:
 		query := c.Survivor.Query().
 			Where(survivor.IDIn(ids...))
@@ -215,6 +695,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case timelineevent.Table:
+		query := c.TimelineEvent.Query().
+			Where(timelineevent.IDIn(ids...))
+		query, err := query.CollectFields(ctx, timelineeventImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	default:
 		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
 	}
@@ -14,8 +14,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -99,6 +115,3886 @@ func paginateLimit(first, last *int) int {
 	return limit
 }
 
+// AbilityEdge is the edge representation of Ability.
+type AbilityEdge struct {
+	Node   *Ability `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// AbilityConnection is the connection containing edges to Ability.
+type AbilityConnection struct {
+	Edges      []*AbilityEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *AbilityConnection) build(nodes []*Ability, pager *abilityPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Ability
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Ability {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Ability {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AbilityEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AbilityEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AbilityPaginateOption enables pagination customization.
+type AbilityPaginateOption func(*abilityPager) error
+
+// WithAbilityOrder configures pagination ordering.
+func WithAbilityOrder(order *AbilityOrder) AbilityPaginateOption {
+	if order == nil {
+		order = DefaultAbilityOrder
+	}
+	o := *order
+	return func(pager *abilityPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAbilityOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAbilityFilter configures pagination filter.
+func WithAbilityFilter(filter func(*AbilityQuery) (*AbilityQuery, error)) AbilityPaginateOption {
+	return func(pager *abilityPager) error {
+		if filter == nil {
+			return errors.New("AbilityQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type abilityPager struct {
+	reverse bool
+	order   *AbilityOrder
+	filter  func(*AbilityQuery) (*AbilityQuery, error)
+}
+
+func newAbilityPager(opts []AbilityPaginateOption, reverse bool) (*abilityPager, error) {
+	pager := &abilityPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAbilityOrder
+	}
+	return pager, nil
+}
+
+func (p *abilityPager) applyFilter(query *AbilityQuery) (*AbilityQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *abilityPager) toCursor(a *Ability) Cursor {
+	return p.order.Field.toCursor(a)
+}
+
+func (p *abilityPager) applyCursors(query *AbilityQuery, after, before *Cursor) (*AbilityQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAbilityOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *abilityPager) applyOrder(query *AbilityQuery) *AbilityQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAbilityOrder.Field {
+		query = query.Order(DefaultAbilityOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *abilityPager) orderExpr(query *AbilityQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAbilityOrder.Field {
+			b.Comma().Ident(DefaultAbilityOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Ability.
+func (a *AbilityQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AbilityPaginateOption,
+) (*AbilityConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAbilityPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if a, err = pager.applyFilter(a); err != nil {
+		return nil, err
+	}
+	conn := &AbilityConnection{Edges: []*AbilityEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := a.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if a, err = pager.applyCursors(a, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		a.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := a.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	a = pager.applyOrder(a)
+	nodes, err := a.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// AbilityOrderFieldName orders Ability by name.
+	AbilityOrderFieldName = &AbilityOrderField{
+		Value: func(a *Ability) (ent.Value, error) {
+			return a.Name, nil
+		},
+		column: ability.FieldName,
+		toTerm: ability.ByName,
+		toCursor: func(a *Ability) Cursor {
+			return Cursor{
+				ID:    a.ID,
+				Value: a.Name,
+			}
+		},
+	}
+	// AbilityOrderFieldKind orders Ability by kind.
+	AbilityOrderFieldKind = &AbilityOrderField{
+		Value: func(a *Ability) (ent.Value, error) {
+			return a.Kind, nil
+		},
+		column: ability.FieldKind,
+		toTerm: ability.ByKind,
+		toCursor: func(a *Ability) Cursor {
+			return Cursor{
+				ID:    a.ID,
+				Value: a.Kind,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f AbilityOrderField) String() string {
+	var str string
+	switch f.column {
+	case AbilityOrderFieldName.column:
+		str = "NAME"
+	case AbilityOrderFieldKind.column:
+		str = "KIND"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f AbilityOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *AbilityOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("AbilityOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *AbilityOrderFieldName
+	case "KIND":
+		*f = *AbilityOrderFieldKind
+	default:
+		return fmt.Errorf("%s is not a valid AbilityOrderField", str)
+	}
+	return nil
+}
+
+// AbilityOrderField defines the ordering field of Ability.
+type AbilityOrderField struct {
+	// Value extracts the ordering value from the given Ability.
+	Value    func(*Ability) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) ability.OrderOption
+	toCursor func(*Ability) Cursor
+}
+
+// AbilityOrder defines the ordering of Ability.
+type AbilityOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *AbilityOrderField `json:"field"`
+}
+
+// DefaultAbilityOrder is the default ordering of Ability.
+var DefaultAbilityOrder = &AbilityOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AbilityOrderField{
+		Value: func(a *Ability) (ent.Value, error) {
+			return a.ID, nil
+		},
+		column: ability.FieldID,
+		toTerm: ability.ByID,
+		toCursor: func(a *Ability) Cursor {
+			return Cursor{ID: a.ID}
+		},
+	},
+}
+
+// ToEdge converts Ability into AbilityEdge.
+func (a *Ability) ToEdge(order *AbilityOrder) *AbilityEdge {
+	if order == nil {
+		order = DefaultAbilityOrder
+	}
+	return &AbilityEdge{
+		Node:   a,
+		Cursor: order.Field.toCursor(a),
+	}
+}
+
+// AccessTokenEdge is the edge representation of AccessToken.
+type AccessTokenEdge struct {
+	Node   *AccessToken `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// AccessTokenConnection is the connection containing edges to AccessToken.
+type AccessTokenConnection struct {
+	Edges      []*AccessTokenEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *AccessTokenConnection) build(nodes []*AccessToken, pager *accesstokenPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AccessToken
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AccessToken {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AccessToken {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AccessTokenEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AccessTokenEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AccessTokenPaginateOption enables pagination customization.
+type AccessTokenPaginateOption func(*accesstokenPager) error
+
+// WithAccessTokenOrder configures pagination ordering.
+func WithAccessTokenOrder(order *AccessTokenOrder) AccessTokenPaginateOption {
+	if order == nil {
+		order = DefaultAccessTokenOrder
+	}
+	o := *order
+	return func(pager *accesstokenPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAccessTokenOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAccessTokenFilter configures pagination filter.
+func WithAccessTokenFilter(filter func(*AccessTokenQuery) (*AccessTokenQuery, error)) AccessTokenPaginateOption {
+	return func(pager *accesstokenPager) error {
+		if filter == nil {
+			return errors.New("AccessTokenQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type accesstokenPager struct {
+	reverse bool
+	order   *AccessTokenOrder
+	filter  func(*AccessTokenQuery) (*AccessTokenQuery, error)
+}
+
+func newAccessTokenPager(opts []AccessTokenPaginateOption, reverse bool) (*accesstokenPager, error) {
+	pager := &accesstokenPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAccessTokenOrder
+	}
+	return pager, nil
+}
+
+func (p *accesstokenPager) applyFilter(query *AccessTokenQuery) (*AccessTokenQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *accesstokenPager) toCursor(at *AccessToken) Cursor {
+	return p.order.Field.toCursor(at)
+}
+
+func (p *accesstokenPager) applyCursors(query *AccessTokenQuery, after, before *Cursor) (*AccessTokenQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAccessTokenOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *accesstokenPager) applyOrder(query *AccessTokenQuery) *AccessTokenQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAccessTokenOrder.Field {
+		query = query.Order(DefaultAccessTokenOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *accesstokenPager) orderExpr(query *AccessTokenQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAccessTokenOrder.Field {
+			b.Comma().Ident(DefaultAccessTokenOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AccessToken.
+func (at *AccessTokenQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AccessTokenPaginateOption,
+) (*AccessTokenConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAccessTokenPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if at, err = pager.applyFilter(at); err != nil {
+		return nil, err
+	}
+	conn := &AccessTokenConnection{Edges: []*AccessTokenEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := at.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if at, err = pager.applyCursors(at, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		at.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := at.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	at = pager.applyOrder(at)
+	nodes, err := at.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// AccessTokenOrderField defines the ordering field of AccessToken.
+type AccessTokenOrderField struct {
+	// Value extracts the ordering value from the given AccessToken.
+	Value    func(*AccessToken) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) accesstoken.OrderOption
+	toCursor func(*AccessToken) Cursor
+}
+
+// AccessTokenOrder defines the ordering of AccessToken.
+type AccessTokenOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *AccessTokenOrderField `json:"field"`
+}
+
+// DefaultAccessTokenOrder is the default ordering of AccessToken.
+var DefaultAccessTokenOrder = &AccessTokenOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AccessTokenOrderField{
+		Value: func(at *AccessToken) (ent.Value, error) {
+			return at.ID, nil
+		},
+		column: accesstoken.FieldID,
+		toTerm: accesstoken.ByID,
+		toCursor: func(at *AccessToken) Cursor {
+			return Cursor{ID: at.ID}
+		},
+	},
+}
+
+// ToEdge converts AccessToken into AccessTokenEdge.
+func (at *AccessToken) ToEdge(order *AccessTokenOrder) *AccessTokenEdge {
+	if order == nil {
+		order = DefaultAccessTokenOrder
+	}
+	return &AccessTokenEdge{
+		Node:   at,
+		Cursor: order.Field.toCursor(at),
+	}
+}
+
+// AuditLogEdge is the edge representation of AuditLog.
+type AuditLogEdge struct {
+	Node   *AuditLog `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// AuditLogConnection is the connection containing edges to AuditLog.
+type AuditLogConnection struct {
+	Edges      []*AuditLogEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *AuditLogConnection) build(nodes []*AuditLog, pager *auditlogPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AuditLog
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AuditLog {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AuditLog {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AuditLogEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AuditLogEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AuditLogPaginateOption enables pagination customization.
+type AuditLogPaginateOption func(*auditlogPager) error
+
+// WithAuditLogOrder configures pagination ordering.
+func WithAuditLogOrder(order *AuditLogOrder) AuditLogPaginateOption {
+	if order == nil {
+		order = DefaultAuditLogOrder
+	}
+	o := *order
+	return func(pager *auditlogPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAuditLogOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAuditLogFilter configures pagination filter.
+func WithAuditLogFilter(filter func(*AuditLogQuery) (*AuditLogQuery, error)) AuditLogPaginateOption {
+	return func(pager *auditlogPager) error {
+		if filter == nil {
+			return errors.New("AuditLogQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type auditlogPager struct {
+	reverse bool
+	order   *AuditLogOrder
+	filter  func(*AuditLogQuery) (*AuditLogQuery, error)
+}
+
+func newAuditLogPager(opts []AuditLogPaginateOption, reverse bool) (*auditlogPager, error) {
+	pager := &auditlogPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAuditLogOrder
+	}
+	return pager, nil
+}
+
+func (p *auditlogPager) applyFilter(query *AuditLogQuery) (*AuditLogQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *auditlogPager) toCursor(al *AuditLog) Cursor {
+	return p.order.Field.toCursor(al)
+}
+
+func (p *auditlogPager) applyCursors(query *AuditLogQuery, after, before *Cursor) (*AuditLogQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAuditLogOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *auditlogPager) applyOrder(query *AuditLogQuery) *AuditLogQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAuditLogOrder.Field {
+		query = query.Order(DefaultAuditLogOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *auditlogPager) orderExpr(query *AuditLogQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAuditLogOrder.Field {
+			b.Comma().Ident(DefaultAuditLogOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AuditLog.
+func (al *AuditLogQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AuditLogPaginateOption,
+) (*AuditLogConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAuditLogPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if al, err = pager.applyFilter(al); err != nil {
+		return nil, err
+	}
+	conn := &AuditLogConnection{Edges: []*AuditLogEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := al.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if al, err = pager.applyCursors(al, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		al.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := al.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	al = pager.applyOrder(al)
+	nodes, err := al.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// AuditLogOrderFieldCreatedAt orders AuditLog by created_at.
+	AuditLogOrderFieldCreatedAt = &AuditLogOrderField{
+		Value: func(al *AuditLog) (ent.Value, error) {
+			return al.CreatedAt, nil
+		},
+		column: auditlog.FieldCreatedAt,
+		toTerm: auditlog.ByCreatedAt,
+		toCursor: func(al *AuditLog) Cursor {
+			return Cursor{
+				ID:    al.ID,
+				Value: al.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f AuditLogOrderField) String() string {
+	var str string
+	switch f.column {
+	case AuditLogOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f AuditLogOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *AuditLogOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("AuditLogOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *AuditLogOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid AuditLogOrderField", str)
+	}
+	return nil
+}
+
+// AuditLogOrderField defines the ordering field of AuditLog.
+type AuditLogOrderField struct {
+	// Value extracts the ordering value from the given AuditLog.
+	Value    func(*AuditLog) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) auditlog.OrderOption
+	toCursor func(*AuditLog) Cursor
+}
+
+// AuditLogOrder defines the ordering of AuditLog.
+type AuditLogOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *AuditLogOrderField `json:"field"`
+}
+
+// DefaultAuditLogOrder is the default ordering of AuditLog.
+var DefaultAuditLogOrder = &AuditLogOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AuditLogOrderField{
+		Value: func(al *AuditLog) (ent.Value, error) {
+			return al.ID, nil
+		},
+		column: auditlog.FieldID,
+		toTerm: auditlog.ByID,
+		toCursor: func(al *AuditLog) Cursor {
+			return Cursor{ID: al.ID}
+		},
+	},
+}
+
+// ToEdge converts AuditLog into AuditLogEdge.
+func (al *AuditLog) ToEdge(order *AuditLogOrder) *AuditLogEdge {
+	if order == nil {
+		order = DefaultAuditLogOrder
+	}
+	return &AuditLogEdge{
+		Node:   al,
+		Cursor: order.Field.toCursor(al),
+	}
+}
+
+// DisorderEdge is the edge representation of Disorder.
+type DisorderEdge struct {
+	Node   *Disorder `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// DisorderConnection is the connection containing edges to Disorder.
+type DisorderConnection struct {
+	Edges      []*DisorderEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *DisorderConnection) build(nodes []*Disorder, pager *disorderPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Disorder
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Disorder {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Disorder {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*DisorderEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &DisorderEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// DisorderPaginateOption enables pagination customization.
+type DisorderPaginateOption func(*disorderPager) error
+
+// WithDisorderOrder configures pagination ordering.
+func WithDisorderOrder(order *DisorderOrder) DisorderPaginateOption {
+	if order == nil {
+		order = DefaultDisorderOrder
+	}
+	o := *order
+	return func(pager *disorderPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultDisorderOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithDisorderFilter configures pagination filter.
+func WithDisorderFilter(filter func(*DisorderQuery) (*DisorderQuery, error)) DisorderPaginateOption {
+	return func(pager *disorderPager) error {
+		if filter == nil {
+			return errors.New("DisorderQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type disorderPager struct {
+	reverse bool
+	order   *DisorderOrder
+	filter  func(*DisorderQuery) (*DisorderQuery, error)
+}
+
+func newDisorderPager(opts []DisorderPaginateOption, reverse bool) (*disorderPager, error) {
+	pager := &disorderPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultDisorderOrder
+	}
+	return pager, nil
+}
+
+func (p *disorderPager) applyFilter(query *DisorderQuery) (*DisorderQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *disorderPager) toCursor(d *Disorder) Cursor {
+	return p.order.Field.toCursor(d)
+}
+
+func (p *disorderPager) applyCursors(query *DisorderQuery, after, before *Cursor) (*DisorderQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDisorderOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *disorderPager) applyOrder(query *DisorderQuery) *DisorderQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultDisorderOrder.Field {
+		query = query.Order(DefaultDisorderOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *disorderPager) orderExpr(query *DisorderQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultDisorderOrder.Field {
+			b.Comma().Ident(DefaultDisorderOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Disorder.
+func (d *DisorderQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DisorderPaginateOption,
+) (*DisorderConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDisorderPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if d, err = pager.applyFilter(d); err != nil {
+		return nil, err
+	}
+	conn := &DisorderConnection{Edges: []*DisorderEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := d.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if d, err = pager.applyCursors(d, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		d.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := d.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	d = pager.applyOrder(d)
+	nodes, err := d.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DisorderOrderFieldName orders Disorder by name.
+	DisorderOrderFieldName = &DisorderOrderField{
+		Value: func(d *Disorder) (ent.Value, error) {
+			return d.Name, nil
+		},
+		column: disorder.FieldName,
+		toTerm: disorder.ByName,
+		toCursor: func(d *Disorder) Cursor {
+			return Cursor{
+				ID:    d.ID,
+				Value: d.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DisorderOrderField) String() string {
+	var str string
+	switch f.column {
+	case DisorderOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DisorderOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DisorderOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DisorderOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *DisorderOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid DisorderOrderField", str)
+	}
+	return nil
+}
+
+// DisorderOrderField defines the ordering field of Disorder.
+type DisorderOrderField struct {
+	// Value extracts the ordering value from the given Disorder.
+	Value    func(*Disorder) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) disorder.OrderOption
+	toCursor func(*Disorder) Cursor
+}
+
+// DisorderOrder defines the ordering of Disorder.
+type DisorderOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *DisorderOrderField `json:"field"`
+}
+
+// DefaultDisorderOrder is the default ordering of Disorder.
+var DefaultDisorderOrder = &DisorderOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DisorderOrderField{
+		Value: func(d *Disorder) (ent.Value, error) {
+			return d.ID, nil
+		},
+		column: disorder.FieldID,
+		toTerm: disorder.ByID,
+		toCursor: func(d *Disorder) Cursor {
+			return Cursor{ID: d.ID}
+		},
+	},
+}
+
+// ToEdge converts Disorder into DisorderEdge.
+func (d *Disorder) ToEdge(order *DisorderOrder) *DisorderEdge {
+	if order == nil {
+		order = DefaultDisorderOrder
+	}
+	return &DisorderEdge{
+		Node:   d,
+		Cursor: order.Field.toCursor(d),
+	}
+}
+
+// FightingArtEdge is the edge representation of FightingArt.
+type FightingArtEdge struct {
+	Node   *FightingArt `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// FightingArtConnection is the connection containing edges to FightingArt.
+type FightingArtConnection struct {
+	Edges      []*FightingArtEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *FightingArtConnection) build(nodes []*FightingArt, pager *fightingartPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *FightingArt
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *FightingArt {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *FightingArt {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*FightingArtEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &FightingArtEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// FightingArtPaginateOption enables pagination customization.
+type FightingArtPaginateOption func(*fightingartPager) error
+
+// WithFightingArtOrder configures pagination ordering.
+func WithFightingArtOrder(order *FightingArtOrder) FightingArtPaginateOption {
+	if order == nil {
+		order = DefaultFightingArtOrder
+	}
+	o := *order
+	return func(pager *fightingartPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultFightingArtOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithFightingArtFilter configures pagination filter.
+func WithFightingArtFilter(filter func(*FightingArtQuery) (*FightingArtQuery, error)) FightingArtPaginateOption {
+	return func(pager *fightingartPager) error {
+		if filter == nil {
+			return errors.New("FightingArtQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type fightingartPager struct {
+	reverse bool
+	order   *FightingArtOrder
+	filter  func(*FightingArtQuery) (*FightingArtQuery, error)
+}
+
+func newFightingArtPager(opts []FightingArtPaginateOption, reverse bool) (*fightingartPager, error) {
+	pager := &fightingartPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultFightingArtOrder
+	}
+	return pager, nil
+}
+
+func (p *fightingartPager) applyFilter(query *FightingArtQuery) (*FightingArtQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *fightingartPager) toCursor(fa *FightingArt) Cursor {
+	return p.order.Field.toCursor(fa)
+}
+
+func (p *fightingartPager) applyCursors(query *FightingArtQuery, after, before *Cursor) (*FightingArtQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultFightingArtOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *fightingartPager) applyOrder(query *FightingArtQuery) *FightingArtQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultFightingArtOrder.Field {
+		query = query.Order(DefaultFightingArtOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *fightingartPager) orderExpr(query *FightingArtQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultFightingArtOrder.Field {
+			b.Comma().Ident(DefaultFightingArtOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to FightingArt.
+func (fa *FightingArtQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...FightingArtPaginateOption,
+) (*FightingArtConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newFightingArtPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if fa, err = pager.applyFilter(fa); err != nil {
+		return nil, err
+	}
+	conn := &FightingArtConnection{Edges: []*FightingArtEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := fa.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if fa, err = pager.applyCursors(fa, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		fa.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := fa.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	fa = pager.applyOrder(fa)
+	nodes, err := fa.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// FightingArtOrderFieldName orders FightingArt by name.
+	FightingArtOrderFieldName = &FightingArtOrderField{
+		Value: func(fa *FightingArt) (ent.Value, error) {
+			return fa.Name, nil
+		},
+		column: fightingart.FieldName,
+		toTerm: fightingart.ByName,
+		toCursor: func(fa *FightingArt) Cursor {
+			return Cursor{
+				ID:    fa.ID,
+				Value: fa.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f FightingArtOrderField) String() string {
+	var str string
+	switch f.column {
+	case FightingArtOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f FightingArtOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *FightingArtOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("FightingArtOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *FightingArtOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid FightingArtOrderField", str)
+	}
+	return nil
+}
+
+// FightingArtOrderField defines the ordering field of FightingArt.
+type FightingArtOrderField struct {
+	// Value extracts the ordering value from the given FightingArt.
+	Value    func(*FightingArt) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) fightingart.OrderOption
+	toCursor func(*FightingArt) Cursor
+}
+
+// FightingArtOrder defines the ordering of FightingArt.
+type FightingArtOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *FightingArtOrderField `json:"field"`
+}
+
+// DefaultFightingArtOrder is the default ordering of FightingArt.
+var DefaultFightingArtOrder = &FightingArtOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &FightingArtOrderField{
+		Value: func(fa *FightingArt) (ent.Value, error) {
+			return fa.ID, nil
+		},
+		column: fightingart.FieldID,
+		toTerm: fightingart.ByID,
+		toCursor: func(fa *FightingArt) Cursor {
+			return Cursor{ID: fa.ID}
+		},
+	},
+}
+
+// ToEdge converts FightingArt into FightingArtEdge.
+func (fa *FightingArt) ToEdge(order *FightingArtOrder) *FightingArtEdge {
+	if order == nil {
+		order = DefaultFightingArtOrder
+	}
+	return &FightingArtEdge{
+		Node:   fa,
+		Cursor: order.Field.toCursor(fa),
+	}
+}
+
+// InnovationEdge is the edge representation of Innovation.
+type InnovationEdge struct {
+	Node   *Innovation `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// InnovationConnection is the connection containing edges to Innovation.
+type InnovationConnection struct {
+	Edges      []*InnovationEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *InnovationConnection) build(nodes []*Innovation, pager *innovationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Innovation
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Innovation {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Innovation {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*InnovationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &InnovationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// InnovationPaginateOption enables pagination customization.
+type InnovationPaginateOption func(*innovationPager) error
+
+// WithInnovationOrder configures pagination ordering.
+func WithInnovationOrder(order *InnovationOrder) InnovationPaginateOption {
+	if order == nil {
+		order = DefaultInnovationOrder
+	}
+	o := *order
+	return func(pager *innovationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultInnovationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithInnovationFilter configures pagination filter.
+func WithInnovationFilter(filter func(*InnovationQuery) (*InnovationQuery, error)) InnovationPaginateOption {
+	return func(pager *innovationPager) error {
+		if filter == nil {
+			return errors.New("InnovationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type innovationPager struct {
+	reverse bool
+	order   *InnovationOrder
+	filter  func(*InnovationQuery) (*InnovationQuery, error)
+}
+
+func newInnovationPager(opts []InnovationPaginateOption, reverse bool) (*innovationPager, error) {
+	pager := &innovationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultInnovationOrder
+	}
+	return pager, nil
+}
+
+func (p *innovationPager) applyFilter(query *InnovationQuery) (*InnovationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *innovationPager) toCursor(i *Innovation) Cursor {
+	return p.order.Field.toCursor(i)
+}
+
+func (p *innovationPager) applyCursors(query *InnovationQuery, after, before *Cursor) (*InnovationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultInnovationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *innovationPager) applyOrder(query *InnovationQuery) *InnovationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultInnovationOrder.Field {
+		query = query.Order(DefaultInnovationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *innovationPager) orderExpr(query *InnovationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultInnovationOrder.Field {
+			b.Comma().Ident(DefaultInnovationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Innovation.
+func (i *InnovationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...InnovationPaginateOption,
+) (*InnovationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newInnovationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if i, err = pager.applyFilter(i); err != nil {
+		return nil, err
+	}
+	conn := &InnovationConnection{Edges: []*InnovationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := i.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if i, err = pager.applyCursors(i, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		i.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := i.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	i = pager.applyOrder(i)
+	nodes, err := i.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// InnovationOrderFieldName orders Innovation by name.
+	InnovationOrderFieldName = &InnovationOrderField{
+		Value: func(i *Innovation) (ent.Value, error) {
+			return i.Name, nil
+		},
+		column: innovation.FieldName,
+		toTerm: innovation.ByName,
+		toCursor: func(i *Innovation) Cursor {
+			return Cursor{
+				ID:    i.ID,
+				Value: i.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f InnovationOrderField) String() string {
+	var str string
+	switch f.column {
+	case InnovationOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f InnovationOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *InnovationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("InnovationOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *InnovationOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid InnovationOrderField", str)
+	}
+	return nil
+}
+
+// InnovationOrderField defines the ordering field of Innovation.
+type InnovationOrderField struct {
+	// Value extracts the ordering value from the given Innovation.
+	Value    func(*Innovation) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) innovation.OrderOption
+	toCursor func(*Innovation) Cursor
+}
+
+// InnovationOrder defines the ordering of Innovation.
+type InnovationOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *InnovationOrderField `json:"field"`
+}
+
+// DefaultInnovationOrder is the default ordering of Innovation.
+var DefaultInnovationOrder = &InnovationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &InnovationOrderField{
+		Value: func(i *Innovation) (ent.Value, error) {
+			return i.ID, nil
+		},
+		column: innovation.FieldID,
+		toTerm: innovation.ByID,
+		toCursor: func(i *Innovation) Cursor {
+			return Cursor{ID: i.ID}
+		},
+	},
+}
+
+// ToEdge converts Innovation into InnovationEdge.
+func (i *Innovation) ToEdge(order *InnovationOrder) *InnovationEdge {
+	if order == nil {
+		order = DefaultInnovationOrder
+	}
+	return &InnovationEdge{
+		Node:   i,
+		Cursor: order.Field.toCursor(i),
+	}
+}
+
+// InviteEdge is the edge representation of Invite.
+type InviteEdge struct {
+	Node   *Invite `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// InviteConnection is the connection containing edges to Invite.
+type InviteConnection struct {
+	Edges      []*InviteEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *InviteConnection) build(nodes []*Invite, pager *invitePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Invite
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Invite {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Invite {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*InviteEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &InviteEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// InvitePaginateOption enables pagination customization.
+type InvitePaginateOption func(*invitePager) error
+
+// WithInviteOrder configures pagination ordering.
+func WithInviteOrder(order *InviteOrder) InvitePaginateOption {
+	if order == nil {
+		order = DefaultInviteOrder
+	}
+	o := *order
+	return func(pager *invitePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultInviteOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithInviteFilter configures pagination filter.
+func WithInviteFilter(filter func(*InviteQuery) (*InviteQuery, error)) InvitePaginateOption {
+	return func(pager *invitePager) error {
+		if filter == nil {
+			return errors.New("InviteQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type invitePager struct {
+	reverse bool
+	order   *InviteOrder
+	filter  func(*InviteQuery) (*InviteQuery, error)
+}
+
+func newInvitePager(opts []InvitePaginateOption, reverse bool) (*invitePager, error) {
+	pager := &invitePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultInviteOrder
+	}
+	return pager, nil
+}
+
+func (p *invitePager) applyFilter(query *InviteQuery) (*InviteQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *invitePager) toCursor(i *Invite) Cursor {
+	return p.order.Field.toCursor(i)
+}
+
+func (p *invitePager) applyCursors(query *InviteQuery, after, before *Cursor) (*InviteQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultInviteOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *invitePager) applyOrder(query *InviteQuery) *InviteQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultInviteOrder.Field {
+		query = query.Order(DefaultInviteOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *invitePager) orderExpr(query *InviteQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultInviteOrder.Field {
+			b.Comma().Ident(DefaultInviteOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Invite.
+func (i *InviteQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...InvitePaginateOption,
+) (*InviteConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newInvitePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if i, err = pager.applyFilter(i); err != nil {
+		return nil, err
+	}
+	conn := &InviteConnection{Edges: []*InviteEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := i.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if i, err = pager.applyCursors(i, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		i.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := i.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	i = pager.applyOrder(i)
+	nodes, err := i.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// InviteOrderField defines the ordering field of Invite.
+type InviteOrderField struct {
+	// Value extracts the ordering value from the given Invite.
+	Value    func(*Invite) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) invite.OrderOption
+	toCursor func(*Invite) Cursor
+}
+
+// InviteOrder defines the ordering of Invite.
+type InviteOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *InviteOrderField `json:"field"`
+}
+
+// DefaultInviteOrder is the default ordering of Invite.
+var DefaultInviteOrder = &InviteOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &InviteOrderField{
+		Value: func(i *Invite) (ent.Value, error) {
+			return i.ID, nil
+		},
+		column: invite.FieldID,
+		toTerm: invite.ByID,
+		toCursor: func(i *Invite) Cursor {
+			return Cursor{ID: i.ID}
+		},
+	},
+}
+
+// ToEdge converts Invite into InviteEdge.
+func (i *Invite) ToEdge(order *InviteOrder) *InviteEdge {
+	if order == nil {
+		order = DefaultInviteOrder
+	}
+	return &InviteEdge{
+		Node:   i,
+		Cursor: order.Field.toCursor(i),
+	}
+}
+
+// LocationEdge is the edge representation of Location.
+type LocationEdge struct {
+	Node   *Location `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// LocationConnection is the connection containing edges to Location.
+type LocationConnection struct {
+	Edges      []*LocationEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (c *LocationConnection) build(nodes []*Location, pager *locationPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Location
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Location {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Location {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*LocationEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &LocationEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// LocationPaginateOption enables pagination customization.
+type LocationPaginateOption func(*locationPager) error
+
+// WithLocationOrder configures pagination ordering.
+func WithLocationOrder(order *LocationOrder) LocationPaginateOption {
+	if order == nil {
+		order = DefaultLocationOrder
+	}
+	o := *order
+	return func(pager *locationPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultLocationOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithLocationFilter configures pagination filter.
+func WithLocationFilter(filter func(*LocationQuery) (*LocationQuery, error)) LocationPaginateOption {
+	return func(pager *locationPager) error {
+		if filter == nil {
+			return errors.New("LocationQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type locationPager struct {
+	reverse bool
+	order   *LocationOrder
+	filter  func(*LocationQuery) (*LocationQuery, error)
+}
+
+func newLocationPager(opts []LocationPaginateOption, reverse bool) (*locationPager, error) {
+	pager := &locationPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultLocationOrder
+	}
+	return pager, nil
+}
+
+func (p *locationPager) applyFilter(query *LocationQuery) (*LocationQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *locationPager) toCursor(l *Location) Cursor {
+	return p.order.Field.toCursor(l)
+}
+
+func (p *locationPager) applyCursors(query *LocationQuery, after, before *Cursor) (*LocationQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultLocationOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *locationPager) applyOrder(query *LocationQuery) *LocationQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultLocationOrder.Field {
+		query = query.Order(DefaultLocationOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *locationPager) orderExpr(query *LocationQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultLocationOrder.Field {
+			b.Comma().Ident(DefaultLocationOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Location.
+func (l *LocationQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...LocationPaginateOption,
+) (*LocationConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newLocationPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if l, err = pager.applyFilter(l); err != nil {
+		return nil, err
+	}
+	conn := &LocationConnection{Edges: []*LocationEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := l.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if l, err = pager.applyCursors(l, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		l.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := l.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	l = pager.applyOrder(l)
+	nodes, err := l.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// LocationOrderFieldName orders Location by name.
+	LocationOrderFieldName = &LocationOrderField{
+		Value: func(l *Location) (ent.Value, error) {
+			return l.Name, nil
+		},
+		column: location.FieldName,
+		toTerm: location.ByName,
+		toCursor: func(l *Location) Cursor {
+			return Cursor{
+				ID:    l.ID,
+				Value: l.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f LocationOrderField) String() string {
+	var str string
+	switch f.column {
+	case LocationOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f LocationOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *LocationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("LocationOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *LocationOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid LocationOrderField", str)
+	}
+	return nil
+}
+
+// LocationOrderField defines the ordering field of Location.
+type LocationOrderField struct {
+	// Value extracts the ordering value from the given Location.
+	Value    func(*Location) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) location.OrderOption
+	toCursor func(*Location) Cursor
+}
+
+// LocationOrder defines the ordering of Location.
+type LocationOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *LocationOrderField `json:"field"`
+}
+
+// DefaultLocationOrder is the default ordering of Location.
+var DefaultLocationOrder = &LocationOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &LocationOrderField{
+		Value: func(l *Location) (ent.Value, error) {
+			return l.ID, nil
+		},
+		column: location.FieldID,
+		toTerm: location.ByID,
+		toCursor: func(l *Location) Cursor {
+			return Cursor{ID: l.ID}
+		},
+	},
+}
+
+// ToEdge converts Location into LocationEdge.
+func (l *Location) ToEdge(order *LocationOrder) *LocationEdge {
+	if order == nil {
+		order = DefaultLocationOrder
+	}
+	return &LocationEdge{
+		Node:   l,
+		Cursor: order.Field.toCursor(l),
+	}
+}
+
+// MembershipEdge is the edge representation of Membership.
+type MembershipEdge struct {
+	Node   *Membership `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// MembershipConnection is the connection containing edges to Membership.
+type MembershipConnection struct {
+	Edges      []*MembershipEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *MembershipConnection) build(nodes []*Membership, pager *membershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Membership
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Membership {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Membership {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MembershipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MembershipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MembershipPaginateOption enables pagination customization.
+type MembershipPaginateOption func(*membershipPager) error
+
+// WithMembershipOrder configures pagination ordering.
+func WithMembershipOrder(order *MembershipOrder) MembershipPaginateOption {
+	if order == nil {
+		order = DefaultMembershipOrder
+	}
+	o := *order
+	return func(pager *membershipPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultMembershipOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithMembershipFilter configures pagination filter.
+func WithMembershipFilter(filter func(*MembershipQuery) (*MembershipQuery, error)) MembershipPaginateOption {
+	return func(pager *membershipPager) error {
+		if filter == nil {
+			return errors.New("MembershipQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type membershipPager struct {
+	reverse bool
+	order   *MembershipOrder
+	filter  func(*MembershipQuery) (*MembershipQuery, error)
+}
+
+func newMembershipPager(opts []MembershipPaginateOption, reverse bool) (*membershipPager, error) {
+	pager := &membershipPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultMembershipOrder
+	}
+	return pager, nil
+}
+
+func (p *membershipPager) applyFilter(query *MembershipQuery) (*MembershipQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *membershipPager) toCursor(m *Membership) Cursor {
+	return p.order.Field.toCursor(m)
+}
+
+func (p *membershipPager) applyCursors(query *MembershipQuery, after, before *Cursor) (*MembershipQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultMembershipOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *membershipPager) applyOrder(query *MembershipQuery) *MembershipQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultMembershipOrder.Field {
+		query = query.Order(DefaultMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *membershipPager) orderExpr(query *MembershipQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultMembershipOrder.Field {
+			b.Comma().Ident(DefaultMembershipOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Membership.
+func (m *MembershipQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MembershipPaginateOption,
+) (*MembershipConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMembershipPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if m, err = pager.applyFilter(m); err != nil {
+		return nil, err
+	}
+	conn := &MembershipConnection{Edges: []*MembershipEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if m, err = pager.applyCursors(m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	m = pager.applyOrder(m)
+	nodes, err := m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// MembershipOrderField defines the ordering field of Membership.
+type MembershipOrderField struct {
+	// Value extracts the ordering value from the given Membership.
+	Value    func(*Membership) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) membership.OrderOption
+	toCursor func(*Membership) Cursor
+}
+
+// MembershipOrder defines the ordering of Membership.
+type MembershipOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *MembershipOrderField `json:"field"`
+}
+
+// DefaultMembershipOrder is the default ordering of Membership.
+var DefaultMembershipOrder = &MembershipOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &MembershipOrderField{
+		Value: func(m *Membership) (ent.Value, error) {
+			return m.ID, nil
+		},
+		column: membership.FieldID,
+		toTerm: membership.ByID,
+		toCursor: func(m *Membership) Cursor {
+			return Cursor{ID: m.ID}
+		},
+	},
+}
+
+// ToEdge converts Membership into MembershipEdge.
+func (m *Membership) ToEdge(order *MembershipOrder) *MembershipEdge {
+	if order == nil {
+		order = DefaultMembershipOrder
+	}
+	return &MembershipEdge{
+		Node:   m,
+		Cursor: order.Field.toCursor(m),
+	}
+}
+
+// MilestoneEdge is the edge representation of Milestone.
+type MilestoneEdge struct {
+	Node   *Milestone `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// MilestoneConnection is the connection containing edges to Milestone.
+type MilestoneConnection struct {
+	Edges      []*MilestoneEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *MilestoneConnection) build(nodes []*Milestone, pager *milestonePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Milestone
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Milestone {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Milestone {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MilestoneEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MilestoneEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MilestonePaginateOption enables pagination customization.
+type MilestonePaginateOption func(*milestonePager) error
+
+// WithMilestoneOrder configures pagination ordering.
+func WithMilestoneOrder(order *MilestoneOrder) MilestonePaginateOption {
+	if order == nil {
+		order = DefaultMilestoneOrder
+	}
+	o := *order
+	return func(pager *milestonePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultMilestoneOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithMilestoneFilter configures pagination filter.
+func WithMilestoneFilter(filter func(*MilestoneQuery) (*MilestoneQuery, error)) MilestonePaginateOption {
+	return func(pager *milestonePager) error {
+		if filter == nil {
+			return errors.New("MilestoneQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type milestonePager struct {
+	reverse bool
+	order   *MilestoneOrder
+	filter  func(*MilestoneQuery) (*MilestoneQuery, error)
+}
+
+func newMilestonePager(opts []MilestonePaginateOption, reverse bool) (*milestonePager, error) {
+	pager := &milestonePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultMilestoneOrder
+	}
+	return pager, nil
+}
+
+func (p *milestonePager) applyFilter(query *MilestoneQuery) (*MilestoneQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *milestonePager) toCursor(m *Milestone) Cursor {
+	return p.order.Field.toCursor(m)
+}
+
+func (p *milestonePager) applyCursors(query *MilestoneQuery, after, before *Cursor) (*MilestoneQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultMilestoneOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *milestonePager) applyOrder(query *MilestoneQuery) *MilestoneQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultMilestoneOrder.Field {
+		query = query.Order(DefaultMilestoneOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *milestonePager) orderExpr(query *MilestoneQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultMilestoneOrder.Field {
+			b.Comma().Ident(DefaultMilestoneOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Milestone.
+func (m *MilestoneQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MilestonePaginateOption,
+) (*MilestoneConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMilestonePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if m, err = pager.applyFilter(m); err != nil {
+		return nil, err
+	}
+	conn := &MilestoneConnection{Edges: []*MilestoneEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if m, err = pager.applyCursors(m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	m = pager.applyOrder(m)
+	nodes, err := m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// MilestoneOrderField defines the ordering field of Milestone.
+type MilestoneOrderField struct {
+	// Value extracts the ordering value from the given Milestone.
+	Value    func(*Milestone) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) milestone.OrderOption
+	toCursor func(*Milestone) Cursor
+}
+
+// MilestoneOrder defines the ordering of Milestone.
+type MilestoneOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *MilestoneOrderField `json:"field"`
+}
+
+// DefaultMilestoneOrder is the default ordering of Milestone.
+var DefaultMilestoneOrder = &MilestoneOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &MilestoneOrderField{
+		Value: func(m *Milestone) (ent.Value, error) {
+			return m.ID, nil
+		},
+		column: milestone.FieldID,
+		toTerm: milestone.ByID,
+		toCursor: func(m *Milestone) Cursor {
+			return Cursor{ID: m.ID}
+		},
+	},
+}
+
+// ToEdge converts Milestone into MilestoneEdge.
+func (m *Milestone) ToEdge(order *MilestoneOrder) *MilestoneEdge {
+	if order == nil {
+		order = DefaultMilestoneOrder
+	}
+	return &MilestoneEdge{
+		Node:   m,
+		Cursor: order.Field.toCursor(m),
+	}
+}
+
+// MonsterEdge is the edge representation of Monster.
+type MonsterEdge struct {
+	Node   *Monster `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// MonsterConnection is the connection containing edges to Monster.
+type MonsterConnection struct {
+	Edges      []*MonsterEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *MonsterConnection) build(nodes []*Monster, pager *monsterPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Monster
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Monster {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Monster {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MonsterEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MonsterEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MonsterPaginateOption enables pagination customization.
+type MonsterPaginateOption func(*monsterPager) error
+
+// WithMonsterOrder configures pagination ordering.
+func WithMonsterOrder(order *MonsterOrder) MonsterPaginateOption {
+	if order == nil {
+		order = DefaultMonsterOrder
+	}
+	o := *order
+	return func(pager *monsterPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultMonsterOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithMonsterFilter configures pagination filter.
+func WithMonsterFilter(filter func(*MonsterQuery) (*MonsterQuery, error)) MonsterPaginateOption {
+	return func(pager *monsterPager) error {
+		if filter == nil {
+			return errors.New("MonsterQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type monsterPager struct {
+	reverse bool
+	order   *MonsterOrder
+	filter  func(*MonsterQuery) (*MonsterQuery, error)
+}
+
+func newMonsterPager(opts []MonsterPaginateOption, reverse bool) (*monsterPager, error) {
+	pager := &monsterPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultMonsterOrder
+	}
+	return pager, nil
+}
+
+func (p *monsterPager) applyFilter(query *MonsterQuery) (*MonsterQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *monsterPager) toCursor(m *Monster) Cursor {
+	return p.order.Field.toCursor(m)
+}
+
+func (p *monsterPager) applyCursors(query *MonsterQuery, after, before *Cursor) (*MonsterQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultMonsterOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *monsterPager) applyOrder(query *MonsterQuery) *MonsterQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultMonsterOrder.Field {
+		query = query.Order(DefaultMonsterOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *monsterPager) orderExpr(query *MonsterQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultMonsterOrder.Field {
+			b.Comma().Ident(DefaultMonsterOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Monster.
+func (m *MonsterQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MonsterPaginateOption,
+) (*MonsterConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMonsterPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if m, err = pager.applyFilter(m); err != nil {
+		return nil, err
+	}
+	conn := &MonsterConnection{Edges: []*MonsterEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if m, err = pager.applyCursors(m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	m = pager.applyOrder(m)
+	nodes, err := m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// MonsterOrderFieldName orders Monster by name.
+	MonsterOrderFieldName = &MonsterOrderField{
+		Value: func(m *Monster) (ent.Value, error) {
+			return m.Name, nil
+		},
+		column: monster.FieldName,
+		toTerm: monster.ByName,
+		toCursor: func(m *Monster) Cursor {
+			return Cursor{
+				ID:    m.ID,
+				Value: m.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f MonsterOrderField) String() string {
+	var str string
+	switch f.column {
+	case MonsterOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f MonsterOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *MonsterOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("MonsterOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *MonsterOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid MonsterOrderField", str)
+	}
+	return nil
+}
+
+// MonsterOrderField defines the ordering field of Monster.
+type MonsterOrderField struct {
+	// Value extracts the ordering value from the given Monster.
+	Value    func(*Monster) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) monster.OrderOption
+	toCursor func(*Monster) Cursor
+}
+
+// MonsterOrder defines the ordering of Monster.
+type MonsterOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *MonsterOrderField `json:"field"`
+}
+
+// DefaultMonsterOrder is the default ordering of Monster.
+var DefaultMonsterOrder = &MonsterOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &MonsterOrderField{
+		Value: func(m *Monster) (ent.Value, error) {
+			return m.ID, nil
+		},
+		column: monster.FieldID,
+		toTerm: monster.ByID,
+		toCursor: func(m *Monster) Cursor {
+			return Cursor{ID: m.ID}
+		},
+	},
+}
+
+// ToEdge converts Monster into MonsterEdge.
+func (m *Monster) ToEdge(order *MonsterOrder) *MonsterEdge {
+	if order == nil {
+		order = DefaultMonsterOrder
+	}
+	return &MonsterEdge{
+		Node:   m,
+		Cursor: order.Field.toCursor(m),
+	}
+}
+
+// MonsterProgressEdge is the edge representation of MonsterProgress.
+type MonsterProgressEdge struct {
+	Node   *MonsterProgress `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// MonsterProgressConnection is the connection containing edges to MonsterProgress.
+type MonsterProgressConnection struct {
+	Edges      []*MonsterProgressEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *MonsterProgressConnection) build(nodes []*MonsterProgress, pager *monsterprogressPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *MonsterProgress
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *MonsterProgress {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *MonsterProgress {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*MonsterProgressEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &MonsterProgressEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// MonsterProgressPaginateOption enables pagination customization.
+type MonsterProgressPaginateOption func(*monsterprogressPager) error
+
+// WithMonsterProgressOrder configures pagination ordering.
+func WithMonsterProgressOrder(order *MonsterProgressOrder) MonsterProgressPaginateOption {
+	if order == nil {
+		order = DefaultMonsterProgressOrder
+	}
+	o := *order
+	return func(pager *monsterprogressPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultMonsterProgressOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithMonsterProgressFilter configures pagination filter.
+func WithMonsterProgressFilter(filter func(*MonsterProgressQuery) (*MonsterProgressQuery, error)) MonsterProgressPaginateOption {
+	return func(pager *monsterprogressPager) error {
+		if filter == nil {
+			return errors.New("MonsterProgressQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type monsterprogressPager struct {
+	reverse bool
+	order   *MonsterProgressOrder
+	filter  func(*MonsterProgressQuery) (*MonsterProgressQuery, error)
+}
+
+func newMonsterProgressPager(opts []MonsterProgressPaginateOption, reverse bool) (*monsterprogressPager, error) {
+	pager := &monsterprogressPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultMonsterProgressOrder
+	}
+	return pager, nil
+}
+
+func (p *monsterprogressPager) applyFilter(query *MonsterProgressQuery) (*MonsterProgressQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *monsterprogressPager) toCursor(mp *MonsterProgress) Cursor {
+	return p.order.Field.toCursor(mp)
+}
+
+func (p *monsterprogressPager) applyCursors(query *MonsterProgressQuery, after, before *Cursor) (*MonsterProgressQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultMonsterProgressOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *monsterprogressPager) applyOrder(query *MonsterProgressQuery) *MonsterProgressQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultMonsterProgressOrder.Field {
+		query = query.Order(DefaultMonsterProgressOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *monsterprogressPager) orderExpr(query *MonsterProgressQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultMonsterProgressOrder.Field {
+			b.Comma().Ident(DefaultMonsterProgressOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to MonsterProgress.
+func (mp *MonsterProgressQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...MonsterProgressPaginateOption,
+) (*MonsterProgressConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newMonsterProgressPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if mp, err = pager.applyFilter(mp); err != nil {
+		return nil, err
+	}
+	conn := &MonsterProgressConnection{Edges: []*MonsterProgressEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := mp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if mp, err = pager.applyCursors(mp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		mp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := mp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	mp = pager.applyOrder(mp)
+	nodes, err := mp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// MonsterProgressOrderField defines the ordering field of MonsterProgress.
+type MonsterProgressOrderField struct {
+	// Value extracts the ordering value from the given MonsterProgress.
+	Value    func(*MonsterProgress) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) monsterprogress.OrderOption
+	toCursor func(*MonsterProgress) Cursor
+}
+
+// MonsterProgressOrder defines the ordering of MonsterProgress.
+type MonsterProgressOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *MonsterProgressOrderField `json:"field"`
+}
+
+// DefaultMonsterProgressOrder is the default ordering of MonsterProgress.
+var DefaultMonsterProgressOrder = &MonsterProgressOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &MonsterProgressOrderField{
+		Value: func(mp *MonsterProgress) (ent.Value, error) {
+			return mp.ID, nil
+		},
+		column: monsterprogress.FieldID,
+		toTerm: monsterprogress.ByID,
+		toCursor: func(mp *MonsterProgress) Cursor {
+			return Cursor{ID: mp.ID}
+		},
+	},
+}
+
+// ToEdge converts MonsterProgress into MonsterProgressEdge.
+func (mp *MonsterProgress) ToEdge(order *MonsterProgressOrder) *MonsterProgressEdge {
+	if order == nil {
+		order = DefaultMonsterProgressOrder
+	}
+	return &MonsterProgressEdge{
+		Node:   mp,
+		Cursor: order.Field.toCursor(mp),
+	}
+}
+
+// PrincipleEdge is the edge representation of Principle.
+type PrincipleEdge struct {
+	Node   *Principle `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// PrincipleConnection is the connection containing edges to Principle.
+type PrincipleConnection struct {
+	Edges      []*PrincipleEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
+}
+
+func (c *PrincipleConnection) build(nodes []*Principle, pager *principlePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Principle
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Principle {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Principle {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*PrincipleEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &PrincipleEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// PrinciplePaginateOption enables pagination customization.
+type PrinciplePaginateOption func(*principlePager) error
+
+// WithPrincipleOrder configures pagination ordering.
+func WithPrincipleOrder(order *PrincipleOrder) PrinciplePaginateOption {
+	if order == nil {
+		order = DefaultPrincipleOrder
+	}
+	o := *order
+	return func(pager *principlePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultPrincipleOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithPrincipleFilter configures pagination filter.
+func WithPrincipleFilter(filter func(*PrincipleQuery) (*PrincipleQuery, error)) PrinciplePaginateOption {
+	return func(pager *principlePager) error {
+		if filter == nil {
+			return errors.New("PrincipleQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type principlePager struct {
+	reverse bool
+	order   *PrincipleOrder
+	filter  func(*PrincipleQuery) (*PrincipleQuery, error)
+}
+
+func newPrinciplePager(opts []PrinciplePaginateOption, reverse bool) (*principlePager, error) {
+	pager := &principlePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultPrincipleOrder
+	}
+	return pager, nil
+}
+
+func (p *principlePager) applyFilter(query *PrincipleQuery) (*PrincipleQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *principlePager) toCursor(pr *Principle) Cursor {
+	return p.order.Field.toCursor(pr)
+}
+
+func (p *principlePager) applyCursors(query *PrincipleQuery, after, before *Cursor) (*PrincipleQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPrincipleOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *principlePager) applyOrder(query *PrincipleQuery) *PrincipleQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPrincipleOrder.Field {
+		query = query.Order(DefaultPrincipleOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *principlePager) orderExpr(query *PrincipleQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPrincipleOrder.Field {
+			b.Comma().Ident(DefaultPrincipleOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Principle.
+func (pr *PrincipleQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...PrinciplePaginateOption,
+) (*PrincipleConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newPrinciplePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if pr, err = pager.applyFilter(pr); err != nil {
+		return nil, err
+	}
+	conn := &PrincipleConnection{Edges: []*PrincipleEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := pr.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if pr, err = pager.applyCursors(pr, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		pr.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := pr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	pr = pager.applyOrder(pr)
+	nodes, err := pr.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// PrincipleOrderField defines the ordering field of Principle.
+type PrincipleOrderField struct {
+	// Value extracts the ordering value from the given Principle.
+	Value    func(*Principle) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) principle.OrderOption
+	toCursor func(*Principle) Cursor
+}
+
+// PrincipleOrder defines the ordering of Principle.
+type PrincipleOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *PrincipleOrderField `json:"field"`
+}
+
+// DefaultPrincipleOrder is the default ordering of Principle.
+var DefaultPrincipleOrder = &PrincipleOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &PrincipleOrderField{
+		Value: func(pr *Principle) (ent.Value, error) {
+			return pr.ID, nil
+		},
+		column: principle.FieldID,
+		toTerm: principle.ByID,
+		toCursor: func(pr *Principle) Cursor {
+			return Cursor{ID: pr.ID}
+		},
+	},
+}
+
+// ToEdge converts Principle into PrincipleEdge.
+func (pr *Principle) ToEdge(order *PrincipleOrder) *PrincipleEdge {
+	if order == nil {
+		order = DefaultPrincipleOrder
+	}
+	return &PrincipleEdge{
+		Node:   pr,
+		Cursor: order.Field.toCursor(pr),
+	}
+}
+
+// RecipeEdge is the edge representation of Recipe.
+type RecipeEdge struct {
+	Node   *Recipe `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// RecipeConnection is the connection containing edges to Recipe.
+type RecipeConnection struct {
+	Edges      []*RecipeEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *RecipeConnection) build(nodes []*Recipe, pager *recipePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Recipe
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Recipe {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Recipe {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RecipeEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RecipeEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RecipePaginateOption enables pagination customization.
+type RecipePaginateOption func(*recipePager) error
+
+// WithRecipeOrder configures pagination ordering.
+func WithRecipeOrder(order *RecipeOrder) RecipePaginateOption {
+	if order == nil {
+		order = DefaultRecipeOrder
+	}
+	o := *order
+	return func(pager *recipePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRecipeOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRecipeFilter configures pagination filter.
+func WithRecipeFilter(filter func(*RecipeQuery) (*RecipeQuery, error)) RecipePaginateOption {
+	return func(pager *recipePager) error {
+		if filter == nil {
+			return errors.New("RecipeQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type recipePager struct {
+	reverse bool
+	order   *RecipeOrder
+	filter  func(*RecipeQuery) (*RecipeQuery, error)
+}
+
+func newRecipePager(opts []RecipePaginateOption, reverse bool) (*recipePager, error) {
+	pager := &recipePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRecipeOrder
+	}
+	return pager, nil
+}
+
+func (p *recipePager) applyFilter(query *RecipeQuery) (*RecipeQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *recipePager) toCursor(r *Recipe) Cursor {
+	return p.order.Field.toCursor(r)
+}
+
+func (p *recipePager) applyCursors(query *RecipeQuery, after, before *Cursor) (*RecipeQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRecipeOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *recipePager) applyOrder(query *RecipeQuery) *RecipeQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRecipeOrder.Field {
+		query = query.Order(DefaultRecipeOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *recipePager) orderExpr(query *RecipeQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRecipeOrder.Field {
+			b.Comma().Ident(DefaultRecipeOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Recipe.
+func (r *RecipeQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RecipePaginateOption,
+) (*RecipeConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRecipePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if r, err = pager.applyFilter(r); err != nil {
+		return nil, err
+	}
+	conn := &RecipeConnection{Edges: []*RecipeEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := r.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if r, err = pager.applyCursors(r, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		r.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := r.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	r = pager.applyOrder(r)
+	nodes, err := r.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RecipeOrderFieldName orders Recipe by name.
+	RecipeOrderFieldName = &RecipeOrderField{
+		Value: func(r *Recipe) (ent.Value, error) {
+			return r.Name, nil
+		},
+		column: recipe.FieldName,
+		toTerm: recipe.ByName,
+		toCursor: func(r *Recipe) Cursor {
+			return Cursor{
+				ID:    r.ID,
+				Value: r.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f RecipeOrderField) String() string {
+	var str string
+	switch f.column {
+	case RecipeOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RecipeOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RecipeOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RecipeOrderField %T must be a string", v)
+	}
+	switch str {
+	case "NAME":
+		*f = *RecipeOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid RecipeOrderField", str)
+	}
+	return nil
+}
+
+// RecipeOrderField defines the ordering field of Recipe.
+type RecipeOrderField struct {
+	// Value extracts the ordering value from the given Recipe.
+	Value    func(*Recipe) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) recipe.OrderOption
+	toCursor func(*Recipe) Cursor
+}
+
+// RecipeOrder defines the ordering of Recipe.
+type RecipeOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *RecipeOrderField `json:"field"`
+}
+
+// DefaultRecipeOrder is the default ordering of Recipe.
+var DefaultRecipeOrder = &RecipeOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &RecipeOrderField{
+		Value: func(r *Recipe) (ent.Value, error) {
+			return r.ID, nil
+		},
+		column: recipe.FieldID,
+		toTerm: recipe.ByID,
+		toCursor: func(r *Recipe) Cursor {
+			return Cursor{ID: r.ID}
+		},
+	},
+}
+
+// ToEdge converts Recipe into RecipeEdge.
+func (r *Recipe) ToEdge(order *RecipeOrder) *RecipeEdge {
+	if order == nil {
+		order = DefaultRecipeOrder
+	}
+	return &RecipeEdge{
+		Node:   r,
+		Cursor: order.Field.toCursor(r),
+	}
+}
+
 // SettlementEdge is the edge representation of Settlement.
 type SettlementEdge struct {
 	Node   *Settlement `json:"node"`
@@ -415,73 +4311,405 @@ func (f SettlementOrderField) String() string {
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f SettlementOrderField) MarshalGQL(w io.Writer) {
+func (f SettlementOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *SettlementOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("SettlementOrderField %T must be a string", v)
+	}
+	switch str {
+	case "OWNER":
+		*f = *SettlementOrderFieldOwner
+	case "NAME":
+		*f = *SettlementOrderFieldName
+	case "SURVIVAL_LIMIT":
+		*f = *SettlementOrderFieldSurvivalLimit
+	case "DEPARTING_SURVIVAL":
+		*f = *SettlementOrderFieldDepartingSurvival
+	case "COLLECTIVE_COGNITION":
+		*f = *SettlementOrderFieldCollectiveCognition
+	case "CURRENT_YEAR":
+		*f = *SettlementOrderFieldCurrentYear
+	default:
+		return fmt.Errorf("%s is not a valid SettlementOrderField", str)
+	}
+	return nil
+}
+
+// SettlementOrderField defines the ordering field of Settlement.
+type SettlementOrderField struct {
+	// Value extracts the ordering value from the given Settlement.
+	Value    func(*Settlement) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) settlement.OrderOption
+	toCursor func(*Settlement) Cursor
+}
+
+// SettlementOrder defines the ordering of Settlement.
+type SettlementOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *SettlementOrderField `json:"field"`
+}
+
+// DefaultSettlementOrder is the default ordering of Settlement.
+var DefaultSettlementOrder = &SettlementOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SettlementOrderField{
+		Value: func(s *Settlement) (ent.Value, error) {
+			return s.ID, nil
+		},
+		column: settlement.FieldID,
+		toTerm: settlement.ByID,
+		toCursor: func(s *Settlement) Cursor {
+			return Cursor{ID: s.ID}
+		},
+	},
+}
+
+// ToEdge converts Settlement into SettlementEdge.
+func (s *Settlement) ToEdge(order *SettlementOrder) *SettlementEdge {
+	if order == nil {
+		order = DefaultSettlementOrder
+	}
+	return &SettlementEdge{
+		Node:   s,
+		Cursor: order.Field.toCursor(s),
+	}
+}
+
+// StorageItemEdge is the edge representation of StorageItem.
+type StorageItemEdge struct {
+	Node   *StorageItem `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// StorageItemConnection is the connection containing edges to StorageItem.
+type StorageItemConnection struct {
+	Edges      []*StorageItemEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *StorageItemConnection) build(nodes []*StorageItem, pager *storageitemPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *StorageItem
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *StorageItem {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *StorageItem {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*StorageItemEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &StorageItemEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// StorageItemPaginateOption enables pagination customization.
+type StorageItemPaginateOption func(*storageitemPager) error
+
+// WithStorageItemOrder configures pagination ordering.
+func WithStorageItemOrder(order *StorageItemOrder) StorageItemPaginateOption {
+	if order == nil {
+		order = DefaultStorageItemOrder
+	}
+	o := *order
+	return func(pager *storageitemPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultStorageItemOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithStorageItemFilter configures pagination filter.
+func WithStorageItemFilter(filter func(*StorageItemQuery) (*StorageItemQuery, error)) StorageItemPaginateOption {
+	return func(pager *storageitemPager) error {
+		if filter == nil {
+			return errors.New("StorageItemQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type storageitemPager struct {
+	reverse bool
+	order   *StorageItemOrder
+	filter  func(*StorageItemQuery) (*StorageItemQuery, error)
+}
+
+func newStorageItemPager(opts []StorageItemPaginateOption, reverse bool) (*storageitemPager, error) {
+	pager := &storageitemPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultStorageItemOrder
+	}
+	return pager, nil
+}
+
+func (p *storageitemPager) applyFilter(query *StorageItemQuery) (*StorageItemQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *storageitemPager) toCursor(si *StorageItem) Cursor {
+	return p.order.Field.toCursor(si)
+}
+
+func (p *storageitemPager) applyCursors(query *StorageItemQuery, after, before *Cursor) (*StorageItemQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStorageItemOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *storageitemPager) applyOrder(query *StorageItemQuery) *StorageItemQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultStorageItemOrder.Field {
+		query = query.Order(DefaultStorageItemOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *storageitemPager) orderExpr(query *StorageItemQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultStorageItemOrder.Field {
+			b.Comma().Ident(DefaultStorageItemOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to StorageItem.
+func (si *StorageItemQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...StorageItemPaginateOption,
+) (*StorageItemConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newStorageItemPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if si, err = pager.applyFilter(si); err != nil {
+		return nil, err
+	}
+	conn := &StorageItemConnection{Edges: []*StorageItemEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := si.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if si, err = pager.applyCursors(si, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		si.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := si.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	si = pager.applyOrder(si)
+	nodes, err := si.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// StorageItemOrderFieldName orders StorageItem by name.
+	StorageItemOrderFieldName = &StorageItemOrderField{
+		Value: func(si *StorageItem) (ent.Value, error) {
+			return si.Name, nil
+		},
+		column: storageitem.FieldName,
+		toTerm: storageitem.ByName,
+		toCursor: func(si *StorageItem) Cursor {
+			return Cursor{
+				ID:    si.ID,
+				Value: si.Name,
+			}
+		},
+	}
+	// StorageItemOrderFieldCategory orders StorageItem by category.
+	StorageItemOrderFieldCategory = &StorageItemOrderField{
+		Value: func(si *StorageItem) (ent.Value, error) {
+			return si.Category, nil
+		},
+		column: storageitem.FieldCategory,
+		toTerm: storageitem.ByCategory,
+		toCursor: func(si *StorageItem) Cursor {
+			return Cursor{
+				ID:    si.ID,
+				Value: si.Category,
+			}
+		},
+	}
+	// StorageItemOrderFieldQuantity orders StorageItem by quantity.
+	StorageItemOrderFieldQuantity = &StorageItemOrderField{
+		Value: func(si *StorageItem) (ent.Value, error) {
+			return si.Quantity, nil
+		},
+		column: storageitem.FieldQuantity,
+		toTerm: storageitem.ByQuantity,
+		toCursor: func(si *StorageItem) Cursor {
+			return Cursor{
+				ID:    si.ID,
+				Value: si.Quantity,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f StorageItemOrderField) String() string {
+	var str string
+	switch f.column {
+	case StorageItemOrderFieldName.column:
+		str = "NAME"
+	case StorageItemOrderFieldCategory.column:
+		str = "CATEGORY"
+	case StorageItemOrderFieldQuantity.column:
+		str = "QUANTITY"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f StorageItemOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *SettlementOrderField) UnmarshalGQL(v interface{}) error {
+func (f *StorageItemOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("SettlementOrderField %T must be a string", v)
+		return fmt.Errorf("StorageItemOrderField %T must be a string", v)
 	}
 	switch str {
-	case "OWNER":
-		*f = *SettlementOrderFieldOwner
 	case "NAME":
-		*f = *SettlementOrderFieldName
-	case "SURVIVAL_LIMIT":
-		*f = *SettlementOrderFieldSurvivalLimit
-	case "DEPARTING_SURVIVAL":
-		*f = *SettlementOrderFieldDepartingSurvival
-	case "COLLECTIVE_COGNITION":
-		*f = *SettlementOrderFieldCollectiveCognition
-	case "CURRENT_YEAR":
-		*f = *SettlementOrderFieldCurrentYear
+		*f = *StorageItemOrderFieldName
+	case "CATEGORY":
+		*f = *StorageItemOrderFieldCategory
+	case "QUANTITY":
+		*f = *StorageItemOrderFieldQuantity
 	default:
-		return fmt.Errorf("%s is not a valid SettlementOrderField", str)
+		return fmt.Errorf("%s is not a valid StorageItemOrderField", str)
 	}
 	return nil
 }
 
-// SettlementOrderField defines the ordering field of Settlement.
-type SettlementOrderField struct {
-	// Value extracts the ordering value from the given Settlement.
-	Value    func(*Settlement) (ent.Value, error)
+// StorageItemOrderField defines the ordering field of StorageItem.
+type StorageItemOrderField struct {
+	// Value extracts the ordering value from the given StorageItem.
+	Value    func(*StorageItem) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) settlement.OrderOption
-	toCursor func(*Settlement) Cursor
+	toTerm   func(...sql.OrderTermOption) storageitem.OrderOption
+	toCursor func(*StorageItem) Cursor
 }
 
-// SettlementOrder defines the ordering of Settlement.
-type SettlementOrder struct {
-	Direction OrderDirection        `json:"direction"`
-	Field     *SettlementOrderField `json:"field"`
+// StorageItemOrder defines the ordering of StorageItem.
+type StorageItemOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *StorageItemOrderField `json:"field"`
 }
 
-// DefaultSettlementOrder is the default ordering of Settlement.
-var DefaultSettlementOrder = &SettlementOrder{
+// DefaultStorageItemOrder is the default ordering of StorageItem.
+var DefaultStorageItemOrder = &StorageItemOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &SettlementOrderField{
-		Value: func(s *Settlement) (ent.Value, error) {
-			return s.ID, nil
+	Field: &StorageItemOrderField{
+		Value: func(si *StorageItem) (ent.Value, error) {
+			return si.ID, nil
 		},
-		column: settlement.FieldID,
-		toTerm: settlement.ByID,
-		toCursor: func(s *Settlement) Cursor {
-			return Cursor{ID: s.ID}
+		column: storageitem.FieldID,
+		toTerm: storageitem.ByID,
+		toCursor: func(si *StorageItem) Cursor {
+			return Cursor{ID: si.ID}
 		},
 	},
 }
 
-// ToEdge converts Settlement into SettlementEdge.
-func (s *Settlement) ToEdge(order *SettlementOrder) *SettlementEdge {
+// ToEdge converts StorageItem into StorageItemEdge.
+func (si *StorageItem) ToEdge(order *StorageItemOrder) *StorageItemEdge {
 	if order == nil {
-		order = DefaultSettlementOrder
+		order = DefaultStorageItemOrder
 	}
-	return &SettlementEdge{
-		Node:   s,
-		Cursor: order.Field.toCursor(s),
+	return &StorageItemEdge{
+		Node:   si,
+		Cursor: order.Field.toCursor(si),
 	}
 }
 
@@ -1122,3 +5350,335 @@ func (s *Survivor) ToEdge(order *SurvivorOrder) *SurvivorEdge {
 		Cursor: order.Field.toCursor(s),
 	}
 }
+
+// TimelineEventEdge is the edge representation of TimelineEvent.
+type TimelineEventEdge struct {
+	Node   *TimelineEvent `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// TimelineEventConnection is the connection containing edges to TimelineEvent.
+type TimelineEventConnection struct {
+	Edges      []*TimelineEventEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *TimelineEventConnection) build(nodes []*TimelineEvent, pager *timelineeventPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *TimelineEvent
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *TimelineEvent {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *TimelineEvent {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*TimelineEventEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &TimelineEventEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// TimelineEventPaginateOption enables pagination customization.
+type TimelineEventPaginateOption func(*timelineeventPager) error
+
+// WithTimelineEventOrder configures pagination ordering.
+func WithTimelineEventOrder(order *TimelineEventOrder) TimelineEventPaginateOption {
+	if order == nil {
+		order = DefaultTimelineEventOrder
+	}
+	o := *order
+	return func(pager *timelineeventPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultTimelineEventOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithTimelineEventFilter configures pagination filter.
+func WithTimelineEventFilter(filter func(*TimelineEventQuery) (*TimelineEventQuery, error)) TimelineEventPaginateOption {
+	return func(pager *timelineeventPager) error {
+		if filter == nil {
+			return errors.New("TimelineEventQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type timelineeventPager struct {
+	reverse bool
+	order   *TimelineEventOrder
+	filter  func(*TimelineEventQuery) (*TimelineEventQuery, error)
+}
+
+func newTimelineEventPager(opts []TimelineEventPaginateOption, reverse bool) (*timelineeventPager, error) {
+	pager := &timelineeventPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultTimelineEventOrder
+	}
+	return pager, nil
+}
+
+func (p *timelineeventPager) applyFilter(query *TimelineEventQuery) (*TimelineEventQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *timelineeventPager) toCursor(te *TimelineEvent) Cursor {
+	return p.order.Field.toCursor(te)
+}
+
+func (p *timelineeventPager) applyCursors(query *TimelineEventQuery, after, before *Cursor) (*TimelineEventQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTimelineEventOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *timelineeventPager) applyOrder(query *TimelineEventQuery) *TimelineEventQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultTimelineEventOrder.Field {
+		query = query.Order(DefaultTimelineEventOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *timelineeventPager) orderExpr(query *TimelineEventQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultTimelineEventOrder.Field {
+			b.Comma().Ident(DefaultTimelineEventOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to TimelineEvent.
+func (te *TimelineEventQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...TimelineEventPaginateOption,
+) (*TimelineEventConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTimelineEventPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if te, err = pager.applyFilter(te); err != nil {
+		return nil, err
+	}
+	conn := &TimelineEventConnection{Edges: []*TimelineEventEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := te.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if te, err = pager.applyCursors(te, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		te.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := te.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	te = pager.applyOrder(te)
+	nodes, err := te.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// TimelineEventOrderFieldYear orders TimelineEvent by year.
+	TimelineEventOrderFieldYear = &TimelineEventOrderField{
+		Value: func(te *TimelineEvent) (ent.Value, error) {
+			return te.Year, nil
+		},
+		column: timelineevent.FieldYear,
+		toTerm: timelineevent.ByYear,
+		toCursor: func(te *TimelineEvent) Cursor {
+			return Cursor{
+				ID:    te.ID,
+				Value: te.Year,
+			}
+		},
+	}
+	// TimelineEventOrderFieldType orders TimelineEvent by type.
+	TimelineEventOrderFieldType = &TimelineEventOrderField{
+		Value: func(te *TimelineEvent) (ent.Value, error) {
+			return te.Type, nil
+		},
+		column: timelineevent.FieldType,
+		toTerm: timelineevent.ByType,
+		toCursor: func(te *TimelineEvent) Cursor {
+			return Cursor{
+				ID:    te.ID,
+				Value: te.Type,
+			}
+		},
+	}
+	// TimelineEventOrderFieldName orders TimelineEvent by name.
+	TimelineEventOrderFieldName = &TimelineEventOrderField{
+		Value: func(te *TimelineEvent) (ent.Value, error) {
+			return te.Name, nil
+		},
+		column: timelineevent.FieldName,
+		toTerm: timelineevent.ByName,
+		toCursor: func(te *TimelineEvent) Cursor {
+			return Cursor{
+				ID:    te.ID,
+				Value: te.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f TimelineEventOrderField) String() string {
+	var str string
+	switch f.column {
+	case TimelineEventOrderFieldYear.column:
+		str = "YEAR"
+	case TimelineEventOrderFieldType.column:
+		str = "TYPE"
+	case TimelineEventOrderFieldName.column:
+		str = "NAME"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f TimelineEventOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *TimelineEventOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TimelineEventOrderField %T must be a string", v)
+	}
+	switch str {
+	case "YEAR":
+		*f = *TimelineEventOrderFieldYear
+	case "TYPE":
+		*f = *TimelineEventOrderFieldType
+	case "NAME":
+		*f = *TimelineEventOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid TimelineEventOrderField", str)
+	}
+	return nil
+}
+
+// TimelineEventOrderField defines the ordering field of TimelineEvent.
+type TimelineEventOrderField struct {
+	// Value extracts the ordering value from the given TimelineEvent.
+	Value    func(*TimelineEvent) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) timelineevent.OrderOption
+	toCursor func(*TimelineEvent) Cursor
+}
+
+// TimelineEventOrder defines the ordering of TimelineEvent.
+type TimelineEventOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *TimelineEventOrderField `json:"field"`
+}
+
+// DefaultTimelineEventOrder is the default ordering of TimelineEvent.
+var DefaultTimelineEventOrder = &TimelineEventOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &TimelineEventOrderField{
+		Value: func(te *TimelineEvent) (ent.Value, error) {
+			return te.ID, nil
+		},
+		column: timelineevent.FieldID,
+		toTerm: timelineevent.ByID,
+		toCursor: func(te *TimelineEvent) Cursor {
+			return Cursor{ID: te.ID}
+		},
+	},
+}
+
+// ToEdge converts TimelineEvent into TimelineEventEdge.
+func (te *TimelineEvent) ToEdge(order *TimelineEventOrder) *TimelineEventEdge {
+	if order == nil {
+		order = DefaultTimelineEventOrder
+	}
+	return &TimelineEventEdge{
+		Node:   te,
+		Cursor: order.Field.toCursor(te),
+	}
+}
@@ -5,12 +5,3959 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
 	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
+// AbilityWhereInput represents a where input for filtering Ability queries.
+type AbilityWhereInput struct {
+	Predicates []predicate.Ability  `json:"-"`
+	Not        *AbilityWhereInput   `json:"not,omitempty"`
+	Or         []*AbilityWhereInput `json:"or,omitempty"`
+	And        []*AbilityWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "kind" field predicates.
+	Kind      *ability.Kind  `json:"kind,omitempty"`
+	KindNEQ   *ability.Kind  `json:"kindNEQ,omitempty"`
+	KindIn    []ability.Kind `json:"kindIn,omitempty"`
+	KindNotIn []ability.Kind `json:"kindNotIn,omitempty"`
+
+	// "rules" field predicates.
+	Rules             *string  `json:"rules,omitempty"`
+	RulesNEQ          *string  `json:"rulesNEQ,omitempty"`
+	RulesIn           []string `json:"rulesIn,omitempty"`
+	RulesNotIn        []string `json:"rulesNotIn,omitempty"`
+	RulesGT           *string  `json:"rulesGT,omitempty"`
+	RulesGTE          *string  `json:"rulesGTE,omitempty"`
+	RulesLT           *string  `json:"rulesLT,omitempty"`
+	RulesLTE          *string  `json:"rulesLTE,omitempty"`
+	RulesContains     *string  `json:"rulesContains,omitempty"`
+	RulesHasPrefix    *string  `json:"rulesHasPrefix,omitempty"`
+	RulesHasSuffix    *string  `json:"rulesHasSuffix,omitempty"`
+	RulesEqualFold    *string  `json:"rulesEqualFold,omitempty"`
+	RulesContainsFold *string  `json:"rulesContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AbilityWhereInput) AddPredicates(predicates ...predicate.Ability) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AbilityWhereInput filter on the AbilityQuery builder.
+func (i *AbilityWhereInput) Filter(q *AbilityQuery) (*AbilityQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAbilityWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAbilityWhereInput is returned in case the AbilityWhereInput is empty.
+var ErrEmptyAbilityWhereInput = errors.New("ent: empty predicate AbilityWhereInput")
+
+// P returns a predicate for filtering abilities.
+// An error is returned if the input is empty or invalid.
+func (i *AbilityWhereInput) P() (predicate.Ability, error) {
+	var predicates []predicate.Ability
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, ability.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Ability, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, ability.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Ability, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, ability.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, ability.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, ability.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, ability.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, ability.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, ability.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, ability.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, ability.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, ability.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, ability.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, ability.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, ability.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, ability.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, ability.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, ability.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, ability.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, ability.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, ability.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, ability.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, ability.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, ability.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, ability.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Kind != nil {
+		predicates = append(predicates, ability.KindEQ(*i.Kind))
+	}
+	if i.KindNEQ != nil {
+		predicates = append(predicates, ability.KindNEQ(*i.KindNEQ))
+	}
+	if len(i.KindIn) > 0 {
+		predicates = append(predicates, ability.KindIn(i.KindIn...))
+	}
+	if len(i.KindNotIn) > 0 {
+		predicates = append(predicates, ability.KindNotIn(i.KindNotIn...))
+	}
+	if i.Rules != nil {
+		predicates = append(predicates, ability.RulesEQ(*i.Rules))
+	}
+	if i.RulesNEQ != nil {
+		predicates = append(predicates, ability.RulesNEQ(*i.RulesNEQ))
+	}
+	if len(i.RulesIn) > 0 {
+		predicates = append(predicates, ability.RulesIn(i.RulesIn...))
+	}
+	if len(i.RulesNotIn) > 0 {
+		predicates = append(predicates, ability.RulesNotIn(i.RulesNotIn...))
+	}
+	if i.RulesGT != nil {
+		predicates = append(predicates, ability.RulesGT(*i.RulesGT))
+	}
+	if i.RulesGTE != nil {
+		predicates = append(predicates, ability.RulesGTE(*i.RulesGTE))
+	}
+	if i.RulesLT != nil {
+		predicates = append(predicates, ability.RulesLT(*i.RulesLT))
+	}
+	if i.RulesLTE != nil {
+		predicates = append(predicates, ability.RulesLTE(*i.RulesLTE))
+	}
+	if i.RulesContains != nil {
+		predicates = append(predicates, ability.RulesContains(*i.RulesContains))
+	}
+	if i.RulesHasPrefix != nil {
+		predicates = append(predicates, ability.RulesHasPrefix(*i.RulesHasPrefix))
+	}
+	if i.RulesHasSuffix != nil {
+		predicates = append(predicates, ability.RulesHasSuffix(*i.RulesHasSuffix))
+	}
+	if i.RulesEqualFold != nil {
+		predicates = append(predicates, ability.RulesEqualFold(*i.RulesEqualFold))
+	}
+	if i.RulesContainsFold != nil {
+		predicates = append(predicates, ability.RulesContainsFold(*i.RulesContainsFold))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAbilityWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return ability.And(predicates...), nil
+	}
+}
+
+// AccessTokenWhereInput represents a where input for filtering AccessToken queries.
+type AccessTokenWhereInput struct {
+	Predicates []predicate.AccessToken  `json:"-"`
+	Not        *AccessTokenWhereInput   `json:"not,omitempty"`
+	Or         []*AccessTokenWhereInput `json:"or,omitempty"`
+	And        []*AccessTokenWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "scope" field predicates.
+	Scope      *accesstoken.Scope  `json:"scope,omitempty"`
+	ScopeNEQ   *accesstoken.Scope  `json:"scopeNEQ,omitempty"`
+	ScopeIn    []accesstoken.Scope `json:"scopeIn,omitempty"`
+	ScopeNotIn []accesstoken.Scope `json:"scopeNotIn,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "last_used_at" field predicates.
+	LastUsedAt       *time.Time  `json:"lastUsedAt,omitempty"`
+	LastUsedAtNEQ    *time.Time  `json:"lastUsedAtNEQ,omitempty"`
+	LastUsedAtIn     []time.Time `json:"lastUsedAtIn,omitempty"`
+	LastUsedAtNotIn  []time.Time `json:"lastUsedAtNotIn,omitempty"`
+	LastUsedAtGT     *time.Time  `json:"lastUsedAtGT,omitempty"`
+	LastUsedAtGTE    *time.Time  `json:"lastUsedAtGTE,omitempty"`
+	LastUsedAtLT     *time.Time  `json:"lastUsedAtLT,omitempty"`
+	LastUsedAtLTE    *time.Time  `json:"lastUsedAtLTE,omitempty"`
+	LastUsedAtIsNil  bool        `json:"lastUsedAtIsNil,omitempty"`
+	LastUsedAtNotNil bool        `json:"lastUsedAtNotNil,omitempty"`
+
+	// "revoked_at" field predicates.
+	RevokedAt       *time.Time  `json:"revokedAt,omitempty"`
+	RevokedAtNEQ    *time.Time  `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGT     *time.Time  `json:"revokedAtGT,omitempty"`
+	RevokedAtGTE    *time.Time  `json:"revokedAtGTE,omitempty"`
+	RevokedAtLT     *time.Time  `json:"revokedAtLT,omitempty"`
+	RevokedAtLTE    *time.Time  `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil bool        `json:"revokedAtNotNil,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AccessTokenWhereInput) AddPredicates(predicates ...predicate.AccessToken) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AccessTokenWhereInput filter on the AccessTokenQuery builder.
+func (i *AccessTokenWhereInput) Filter(q *AccessTokenQuery) (*AccessTokenQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAccessTokenWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAccessTokenWhereInput is returned in case the AccessTokenWhereInput is empty.
+var ErrEmptyAccessTokenWhereInput = errors.New("ent: empty predicate AccessTokenWhereInput")
+
+// P returns a predicate for filtering accesstokens.
+// An error is returned if the input is empty or invalid.
+func (i *AccessTokenWhereInput) P() (predicate.AccessToken, error) {
+	var predicates []predicate.AccessToken
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, accesstoken.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.AccessToken, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, accesstoken.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.AccessToken, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, accesstoken.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, accesstoken.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, accesstoken.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, accesstoken.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, accesstoken.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, accesstoken.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, accesstoken.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, accesstoken.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, accesstoken.IDLTE(*i.IDLTE))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, accesstoken.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, accesstoken.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, accesstoken.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, accesstoken.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, accesstoken.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, accesstoken.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, accesstoken.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, accesstoken.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, accesstoken.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, accesstoken.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, accesstoken.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, accesstoken.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, accesstoken.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, accesstoken.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, accesstoken.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, accesstoken.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, accesstoken.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, accesstoken.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, accesstoken.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, accesstoken.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, accesstoken.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, accesstoken.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, accesstoken.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, accesstoken.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, accesstoken.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, accesstoken.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Scope != nil {
+		predicates = append(predicates, accesstoken.ScopeEQ(*i.Scope))
+	}
+	if i.ScopeNEQ != nil {
+		predicates = append(predicates, accesstoken.ScopeNEQ(*i.ScopeNEQ))
+	}
+	if len(i.ScopeIn) > 0 {
+		predicates = append(predicates, accesstoken.ScopeIn(i.ScopeIn...))
+	}
+	if len(i.ScopeNotIn) > 0 {
+		predicates = append(predicates, accesstoken.ScopeNotIn(i.ScopeNotIn...))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, accesstoken.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, accesstoken.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, accesstoken.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.LastUsedAt != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtEQ(*i.LastUsedAt))
+	}
+	if i.LastUsedAtNEQ != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtNEQ(*i.LastUsedAtNEQ))
+	}
+	if len(i.LastUsedAtIn) > 0 {
+		predicates = append(predicates, accesstoken.LastUsedAtIn(i.LastUsedAtIn...))
+	}
+	if len(i.LastUsedAtNotIn) > 0 {
+		predicates = append(predicates, accesstoken.LastUsedAtNotIn(i.LastUsedAtNotIn...))
+	}
+	if i.LastUsedAtGT != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtGT(*i.LastUsedAtGT))
+	}
+	if i.LastUsedAtGTE != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtGTE(*i.LastUsedAtGTE))
+	}
+	if i.LastUsedAtLT != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtLT(*i.LastUsedAtLT))
+	}
+	if i.LastUsedAtLTE != nil {
+		predicates = append(predicates, accesstoken.LastUsedAtLTE(*i.LastUsedAtLTE))
+	}
+	if i.LastUsedAtIsNil {
+		predicates = append(predicates, accesstoken.LastUsedAtIsNil())
+	}
+	if i.LastUsedAtNotNil {
+		predicates = append(predicates, accesstoken.LastUsedAtNotNil())
+	}
+	if i.RevokedAt != nil {
+		predicates = append(predicates, accesstoken.RevokedAtEQ(*i.RevokedAt))
+	}
+	if i.RevokedAtNEQ != nil {
+		predicates = append(predicates, accesstoken.RevokedAtNEQ(*i.RevokedAtNEQ))
+	}
+	if len(i.RevokedAtIn) > 0 {
+		predicates = append(predicates, accesstoken.RevokedAtIn(i.RevokedAtIn...))
+	}
+	if len(i.RevokedAtNotIn) > 0 {
+		predicates = append(predicates, accesstoken.RevokedAtNotIn(i.RevokedAtNotIn...))
+	}
+	if i.RevokedAtGT != nil {
+		predicates = append(predicates, accesstoken.RevokedAtGT(*i.RevokedAtGT))
+	}
+	if i.RevokedAtGTE != nil {
+		predicates = append(predicates, accesstoken.RevokedAtGTE(*i.RevokedAtGTE))
+	}
+	if i.RevokedAtLT != nil {
+		predicates = append(predicates, accesstoken.RevokedAtLT(*i.RevokedAtLT))
+	}
+	if i.RevokedAtLTE != nil {
+		predicates = append(predicates, accesstoken.RevokedAtLTE(*i.RevokedAtLTE))
+	}
+	if i.RevokedAtIsNil {
+		predicates = append(predicates, accesstoken.RevokedAtIsNil())
+	}
+	if i.RevokedAtNotNil {
+		predicates = append(predicates, accesstoken.RevokedAtNotNil())
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, accesstoken.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, accesstoken.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, accesstoken.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, accesstoken.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, accesstoken.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, accesstoken.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, accesstoken.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, accesstoken.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAccessTokenWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return accesstoken.And(predicates...), nil
+	}
+}
+
+// AuditLogWhereInput represents a where input for filtering AuditLog queries.
+type AuditLogWhereInput struct {
+	Predicates []predicate.AuditLog  `json:"-"`
+	Not        *AuditLogWhereInput   `json:"not,omitempty"`
+	Or         []*AuditLogWhereInput `json:"or,omitempty"`
+	And        []*AuditLogWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "actor" field predicates.
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNEQ          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGT           *string  `json:"actorGT,omitempty"`
+	ActorGTE          *string  `json:"actorGTE,omitempty"`
+	ActorLT           *string  `json:"actorLT,omitempty"`
+	ActorLTE          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
+
+	// "operation" field predicates.
+	Operation      *auditlog.Operation  `json:"operation,omitempty"`
+	OperationNEQ   *auditlog.Operation  `json:"operationNEQ,omitempty"`
+	OperationIn    []auditlog.Operation `json:"operationIn,omitempty"`
+	OperationNotIn []auditlog.Operation `json:"operationNotIn,omitempty"`
+
+	// "entity_type" field predicates.
+	EntityType             *string  `json:"entityType,omitempty"`
+	EntityTypeNEQ          *string  `json:"entityTypeNEQ,omitempty"`
+	EntityTypeIn           []string `json:"entityTypeIn,omitempty"`
+	EntityTypeNotIn        []string `json:"entityTypeNotIn,omitempty"`
+	EntityTypeGT           *string  `json:"entityTypeGT,omitempty"`
+	EntityTypeGTE          *string  `json:"entityTypeGTE,omitempty"`
+	EntityTypeLT           *string  `json:"entityTypeLT,omitempty"`
+	EntityTypeLTE          *string  `json:"entityTypeLTE,omitempty"`
+	EntityTypeContains     *string  `json:"entityTypeContains,omitempty"`
+	EntityTypeHasPrefix    *string  `json:"entityTypeHasPrefix,omitempty"`
+	EntityTypeHasSuffix    *string  `json:"entityTypeHasSuffix,omitempty"`
+	EntityTypeEqualFold    *string  `json:"entityTypeEqualFold,omitempty"`
+	EntityTypeContainsFold *string  `json:"entityTypeContainsFold,omitempty"`
+
+	// "entity_id" field predicates.
+	EntityID      *int  `json:"entityID,omitempty"`
+	EntityIDNEQ   *int  `json:"entityIDNEQ,omitempty"`
+	EntityIDIn    []int `json:"entityIDIn,omitempty"`
+	EntityIDNotIn []int `json:"entityIDNotIn,omitempty"`
+	EntityIDGT    *int  `json:"entityIDGT,omitempty"`
+	EntityIDGTE   *int  `json:"entityIDGTE,omitempty"`
+	EntityIDLT    *int  `json:"entityIDLT,omitempty"`
+	EntityIDLTE   *int  `json:"entityIDLTE,omitempty"`
+
+	// "request_id" field predicates.
+	RequestID             *string  `json:"requestID,omitempty"`
+	RequestIDNEQ          *string  `json:"requestIDNEQ,omitempty"`
+	RequestIDIn           []string `json:"requestIDIn,omitempty"`
+	RequestIDNotIn        []string `json:"requestIDNotIn,omitempty"`
+	RequestIDGT           *string  `json:"requestIDGT,omitempty"`
+	RequestIDGTE          *string  `json:"requestIDGTE,omitempty"`
+	RequestIDLT           *string  `json:"requestIDLT,omitempty"`
+	RequestIDLTE          *string  `json:"requestIDLTE,omitempty"`
+	RequestIDContains     *string  `json:"requestIDContains,omitempty"`
+	RequestIDHasPrefix    *string  `json:"requestIDHasPrefix,omitempty"`
+	RequestIDHasSuffix    *string  `json:"requestIDHasSuffix,omitempty"`
+	RequestIDIsNil        bool     `json:"requestIDIsNil,omitempty"`
+	RequestIDNotNil       bool     `json:"requestIDNotNil,omitempty"`
+	RequestIDEqualFold    *string  `json:"requestIDEqualFold,omitempty"`
+	RequestIDContainsFold *string  `json:"requestIDContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID       *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ    *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn     []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn  []int `json:"settlementIDNotIn,omitempty"`
+	SettlementIDIsNil  bool  `json:"settlementIDIsNil,omitempty"`
+	SettlementIDNotNil bool  `json:"settlementIDNotNil,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AuditLogWhereInput) AddPredicates(predicates ...predicate.AuditLog) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AuditLogWhereInput filter on the AuditLogQuery builder.
+func (i *AuditLogWhereInput) Filter(q *AuditLogQuery) (*AuditLogQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAuditLogWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAuditLogWhereInput is returned in case the AuditLogWhereInput is empty.
+var ErrEmptyAuditLogWhereInput = errors.New("ent: empty predicate AuditLogWhereInput")
+
+// P returns a predicate for filtering auditlogs.
+// An error is returned if the input is empty or invalid.
+func (i *AuditLogWhereInput) P() (predicate.AuditLog, error) {
+	var predicates []predicate.AuditLog
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, auditlog.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.AuditLog, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, auditlog.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.AuditLog, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, auditlog.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, auditlog.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, auditlog.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, auditlog.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, auditlog.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, auditlog.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, auditlog.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, auditlog.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, auditlog.IDLTE(*i.IDLTE))
+	}
+	if i.Actor != nil {
+		predicates = append(predicates, auditlog.ActorEQ(*i.Actor))
+	}
+	if i.ActorNEQ != nil {
+		predicates = append(predicates, auditlog.ActorNEQ(*i.ActorNEQ))
+	}
+	if len(i.ActorIn) > 0 {
+		predicates = append(predicates, auditlog.ActorIn(i.ActorIn...))
+	}
+	if len(i.ActorNotIn) > 0 {
+		predicates = append(predicates, auditlog.ActorNotIn(i.ActorNotIn...))
+	}
+	if i.ActorGT != nil {
+		predicates = append(predicates, auditlog.ActorGT(*i.ActorGT))
+	}
+	if i.ActorGTE != nil {
+		predicates = append(predicates, auditlog.ActorGTE(*i.ActorGTE))
+	}
+	if i.ActorLT != nil {
+		predicates = append(predicates, auditlog.ActorLT(*i.ActorLT))
+	}
+	if i.ActorLTE != nil {
+		predicates = append(predicates, auditlog.ActorLTE(*i.ActorLTE))
+	}
+	if i.ActorContains != nil {
+		predicates = append(predicates, auditlog.ActorContains(*i.ActorContains))
+	}
+	if i.ActorHasPrefix != nil {
+		predicates = append(predicates, auditlog.ActorHasPrefix(*i.ActorHasPrefix))
+	}
+	if i.ActorHasSuffix != nil {
+		predicates = append(predicates, auditlog.ActorHasSuffix(*i.ActorHasSuffix))
+	}
+	if i.ActorEqualFold != nil {
+		predicates = append(predicates, auditlog.ActorEqualFold(*i.ActorEqualFold))
+	}
+	if i.ActorContainsFold != nil {
+		predicates = append(predicates, auditlog.ActorContainsFold(*i.ActorContainsFold))
+	}
+	if i.Operation != nil {
+		predicates = append(predicates, auditlog.OperationEQ(*i.Operation))
+	}
+	if i.OperationNEQ != nil {
+		predicates = append(predicates, auditlog.OperationNEQ(*i.OperationNEQ))
+	}
+	if len(i.OperationIn) > 0 {
+		predicates = append(predicates, auditlog.OperationIn(i.OperationIn...))
+	}
+	if len(i.OperationNotIn) > 0 {
+		predicates = append(predicates, auditlog.OperationNotIn(i.OperationNotIn...))
+	}
+	if i.EntityType != nil {
+		predicates = append(predicates, auditlog.EntityTypeEQ(*i.EntityType))
+	}
+	if i.EntityTypeNEQ != nil {
+		predicates = append(predicates, auditlog.EntityTypeNEQ(*i.EntityTypeNEQ))
+	}
+	if len(i.EntityTypeIn) > 0 {
+		predicates = append(predicates, auditlog.EntityTypeIn(i.EntityTypeIn...))
+	}
+	if len(i.EntityTypeNotIn) > 0 {
+		predicates = append(predicates, auditlog.EntityTypeNotIn(i.EntityTypeNotIn...))
+	}
+	if i.EntityTypeGT != nil {
+		predicates = append(predicates, auditlog.EntityTypeGT(*i.EntityTypeGT))
+	}
+	if i.EntityTypeGTE != nil {
+		predicates = append(predicates, auditlog.EntityTypeGTE(*i.EntityTypeGTE))
+	}
+	if i.EntityTypeLT != nil {
+		predicates = append(predicates, auditlog.EntityTypeLT(*i.EntityTypeLT))
+	}
+	if i.EntityTypeLTE != nil {
+		predicates = append(predicates, auditlog.EntityTypeLTE(*i.EntityTypeLTE))
+	}
+	if i.EntityTypeContains != nil {
+		predicates = append(predicates, auditlog.EntityTypeContains(*i.EntityTypeContains))
+	}
+	if i.EntityTypeHasPrefix != nil {
+		predicates = append(predicates, auditlog.EntityTypeHasPrefix(*i.EntityTypeHasPrefix))
+	}
+	if i.EntityTypeHasSuffix != nil {
+		predicates = append(predicates, auditlog.EntityTypeHasSuffix(*i.EntityTypeHasSuffix))
+	}
+	if i.EntityTypeEqualFold != nil {
+		predicates = append(predicates, auditlog.EntityTypeEqualFold(*i.EntityTypeEqualFold))
+	}
+	if i.EntityTypeContainsFold != nil {
+		predicates = append(predicates, auditlog.EntityTypeContainsFold(*i.EntityTypeContainsFold))
+	}
+	if i.EntityID != nil {
+		predicates = append(predicates, auditlog.EntityIDEQ(*i.EntityID))
+	}
+	if i.EntityIDNEQ != nil {
+		predicates = append(predicates, auditlog.EntityIDNEQ(*i.EntityIDNEQ))
+	}
+	if len(i.EntityIDIn) > 0 {
+		predicates = append(predicates, auditlog.EntityIDIn(i.EntityIDIn...))
+	}
+	if len(i.EntityIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.EntityIDNotIn(i.EntityIDNotIn...))
+	}
+	if i.EntityIDGT != nil {
+		predicates = append(predicates, auditlog.EntityIDGT(*i.EntityIDGT))
+	}
+	if i.EntityIDGTE != nil {
+		predicates = append(predicates, auditlog.EntityIDGTE(*i.EntityIDGTE))
+	}
+	if i.EntityIDLT != nil {
+		predicates = append(predicates, auditlog.EntityIDLT(*i.EntityIDLT))
+	}
+	if i.EntityIDLTE != nil {
+		predicates = append(predicates, auditlog.EntityIDLTE(*i.EntityIDLTE))
+	}
+	if i.RequestID != nil {
+		predicates = append(predicates, auditlog.RequestIDEQ(*i.RequestID))
+	}
+	if i.RequestIDNEQ != nil {
+		predicates = append(predicates, auditlog.RequestIDNEQ(*i.RequestIDNEQ))
+	}
+	if len(i.RequestIDIn) > 0 {
+		predicates = append(predicates, auditlog.RequestIDIn(i.RequestIDIn...))
+	}
+	if len(i.RequestIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.RequestIDNotIn(i.RequestIDNotIn...))
+	}
+	if i.RequestIDGT != nil {
+		predicates = append(predicates, auditlog.RequestIDGT(*i.RequestIDGT))
+	}
+	if i.RequestIDGTE != nil {
+		predicates = append(predicates, auditlog.RequestIDGTE(*i.RequestIDGTE))
+	}
+	if i.RequestIDLT != nil {
+		predicates = append(predicates, auditlog.RequestIDLT(*i.RequestIDLT))
+	}
+	if i.RequestIDLTE != nil {
+		predicates = append(predicates, auditlog.RequestIDLTE(*i.RequestIDLTE))
+	}
+	if i.RequestIDContains != nil {
+		predicates = append(predicates, auditlog.RequestIDContains(*i.RequestIDContains))
+	}
+	if i.RequestIDHasPrefix != nil {
+		predicates = append(predicates, auditlog.RequestIDHasPrefix(*i.RequestIDHasPrefix))
+	}
+	if i.RequestIDHasSuffix != nil {
+		predicates = append(predicates, auditlog.RequestIDHasSuffix(*i.RequestIDHasSuffix))
+	}
+	if i.RequestIDIsNil {
+		predicates = append(predicates, auditlog.RequestIDIsNil())
+	}
+	if i.RequestIDNotNil {
+		predicates = append(predicates, auditlog.RequestIDNotNil())
+	}
+	if i.RequestIDEqualFold != nil {
+		predicates = append(predicates, auditlog.RequestIDEqualFold(*i.RequestIDEqualFold))
+	}
+	if i.RequestIDContainsFold != nil {
+		predicates = append(predicates, auditlog.RequestIDContainsFold(*i.RequestIDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, auditlog.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, auditlog.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, auditlog.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, auditlog.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, auditlog.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, auditlog.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, auditlog.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, auditlog.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, auditlog.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, auditlog.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, auditlog.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+	if i.SettlementIDIsNil {
+		predicates = append(predicates, auditlog.SettlementIDIsNil())
+	}
+	if i.SettlementIDNotNil {
+		predicates = append(predicates, auditlog.SettlementIDNotNil())
+	}
+
+	if i.HasSettlement != nil {
+		p := auditlog.HasSettlement()
+		if !*i.HasSettlement {
+			p = auditlog.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, auditlog.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAuditLogWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return auditlog.And(predicates...), nil
+	}
+}
+
+// DisorderWhereInput represents a where input for filtering Disorder queries.
+type DisorderWhereInput struct {
+	Predicates []predicate.Disorder  `json:"-"`
+	Not        *DisorderWhereInput   `json:"not,omitempty"`
+	Or         []*DisorderWhereInput `json:"or,omitempty"`
+	And        []*DisorderWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "rules" field predicates.
+	Rules             *string  `json:"rules,omitempty"`
+	RulesNEQ          *string  `json:"rulesNEQ,omitempty"`
+	RulesIn           []string `json:"rulesIn,omitempty"`
+	RulesNotIn        []string `json:"rulesNotIn,omitempty"`
+	RulesGT           *string  `json:"rulesGT,omitempty"`
+	RulesGTE          *string  `json:"rulesGTE,omitempty"`
+	RulesLT           *string  `json:"rulesLT,omitempty"`
+	RulesLTE          *string  `json:"rulesLTE,omitempty"`
+	RulesContains     *string  `json:"rulesContains,omitempty"`
+	RulesHasPrefix    *string  `json:"rulesHasPrefix,omitempty"`
+	RulesHasSuffix    *string  `json:"rulesHasSuffix,omitempty"`
+	RulesEqualFold    *string  `json:"rulesEqualFold,omitempty"`
+	RulesContainsFold *string  `json:"rulesContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *DisorderWhereInput) AddPredicates(predicates ...predicate.Disorder) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the DisorderWhereInput filter on the DisorderQuery builder.
+func (i *DisorderWhereInput) Filter(q *DisorderQuery) (*DisorderQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyDisorderWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyDisorderWhereInput is returned in case the DisorderWhereInput is empty.
+var ErrEmptyDisorderWhereInput = errors.New("ent: empty predicate DisorderWhereInput")
+
+// P returns a predicate for filtering disorders.
+// An error is returned if the input is empty or invalid.
+func (i *DisorderWhereInput) P() (predicate.Disorder, error) {
+	var predicates []predicate.Disorder
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, disorder.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Disorder, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, disorder.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Disorder, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, disorder.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, disorder.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, disorder.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, disorder.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, disorder.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, disorder.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, disorder.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, disorder.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, disorder.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, disorder.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, disorder.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, disorder.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, disorder.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, disorder.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, disorder.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, disorder.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, disorder.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, disorder.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, disorder.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, disorder.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, disorder.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, disorder.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Rules != nil {
+		predicates = append(predicates, disorder.RulesEQ(*i.Rules))
+	}
+	if i.RulesNEQ != nil {
+		predicates = append(predicates, disorder.RulesNEQ(*i.RulesNEQ))
+	}
+	if len(i.RulesIn) > 0 {
+		predicates = append(predicates, disorder.RulesIn(i.RulesIn...))
+	}
+	if len(i.RulesNotIn) > 0 {
+		predicates = append(predicates, disorder.RulesNotIn(i.RulesNotIn...))
+	}
+	if i.RulesGT != nil {
+		predicates = append(predicates, disorder.RulesGT(*i.RulesGT))
+	}
+	if i.RulesGTE != nil {
+		predicates = append(predicates, disorder.RulesGTE(*i.RulesGTE))
+	}
+	if i.RulesLT != nil {
+		predicates = append(predicates, disorder.RulesLT(*i.RulesLT))
+	}
+	if i.RulesLTE != nil {
+		predicates = append(predicates, disorder.RulesLTE(*i.RulesLTE))
+	}
+	if i.RulesContains != nil {
+		predicates = append(predicates, disorder.RulesContains(*i.RulesContains))
+	}
+	if i.RulesHasPrefix != nil {
+		predicates = append(predicates, disorder.RulesHasPrefix(*i.RulesHasPrefix))
+	}
+	if i.RulesHasSuffix != nil {
+		predicates = append(predicates, disorder.RulesHasSuffix(*i.RulesHasSuffix))
+	}
+	if i.RulesEqualFold != nil {
+		predicates = append(predicates, disorder.RulesEqualFold(*i.RulesEqualFold))
+	}
+	if i.RulesContainsFold != nil {
+		predicates = append(predicates, disorder.RulesContainsFold(*i.RulesContainsFold))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyDisorderWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return disorder.And(predicates...), nil
+	}
+}
+
+// FightingArtWhereInput represents a where input for filtering FightingArt queries.
+type FightingArtWhereInput struct {
+	Predicates []predicate.FightingArt  `json:"-"`
+	Not        *FightingArtWhereInput   `json:"not,omitempty"`
+	Or         []*FightingArtWhereInput `json:"or,omitempty"`
+	And        []*FightingArtWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "rules" field predicates.
+	Rules             *string  `json:"rules,omitempty"`
+	RulesNEQ          *string  `json:"rulesNEQ,omitempty"`
+	RulesIn           []string `json:"rulesIn,omitempty"`
+	RulesNotIn        []string `json:"rulesNotIn,omitempty"`
+	RulesGT           *string  `json:"rulesGT,omitempty"`
+	RulesGTE          *string  `json:"rulesGTE,omitempty"`
+	RulesLT           *string  `json:"rulesLT,omitempty"`
+	RulesLTE          *string  `json:"rulesLTE,omitempty"`
+	RulesContains     *string  `json:"rulesContains,omitempty"`
+	RulesHasPrefix    *string  `json:"rulesHasPrefix,omitempty"`
+	RulesHasSuffix    *string  `json:"rulesHasSuffix,omitempty"`
+	RulesEqualFold    *string  `json:"rulesEqualFold,omitempty"`
+	RulesContainsFold *string  `json:"rulesContainsFold,omitempty"`
+
+	// "secret" field predicates.
+	Secret    *bool `json:"secret,omitempty"`
+	SecretNEQ *bool `json:"secretNEQ,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *FightingArtWhereInput) AddPredicates(predicates ...predicate.FightingArt) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the FightingArtWhereInput filter on the FightingArtQuery builder.
+func (i *FightingArtWhereInput) Filter(q *FightingArtQuery) (*FightingArtQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyFightingArtWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyFightingArtWhereInput is returned in case the FightingArtWhereInput is empty.
+var ErrEmptyFightingArtWhereInput = errors.New("ent: empty predicate FightingArtWhereInput")
+
+// P returns a predicate for filtering fightingarts.
+// An error is returned if the input is empty or invalid.
+func (i *FightingArtWhereInput) P() (predicate.FightingArt, error) {
+	var predicates []predicate.FightingArt
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, fightingart.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.FightingArt, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, fightingart.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.FightingArt, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, fightingart.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, fightingart.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, fightingart.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, fightingart.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, fightingart.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, fightingart.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, fightingart.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, fightingart.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, fightingart.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, fightingart.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, fightingart.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, fightingart.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, fightingart.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, fightingart.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, fightingart.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, fightingart.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, fightingart.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, fightingart.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, fightingart.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, fightingart.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, fightingart.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, fightingart.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Rules != nil {
+		predicates = append(predicates, fightingart.RulesEQ(*i.Rules))
+	}
+	if i.RulesNEQ != nil {
+		predicates = append(predicates, fightingart.RulesNEQ(*i.RulesNEQ))
+	}
+	if len(i.RulesIn) > 0 {
+		predicates = append(predicates, fightingart.RulesIn(i.RulesIn...))
+	}
+	if len(i.RulesNotIn) > 0 {
+		predicates = append(predicates, fightingart.RulesNotIn(i.RulesNotIn...))
+	}
+	if i.RulesGT != nil {
+		predicates = append(predicates, fightingart.RulesGT(*i.RulesGT))
+	}
+	if i.RulesGTE != nil {
+		predicates = append(predicates, fightingart.RulesGTE(*i.RulesGTE))
+	}
+	if i.RulesLT != nil {
+		predicates = append(predicates, fightingart.RulesLT(*i.RulesLT))
+	}
+	if i.RulesLTE != nil {
+		predicates = append(predicates, fightingart.RulesLTE(*i.RulesLTE))
+	}
+	if i.RulesContains != nil {
+		predicates = append(predicates, fightingart.RulesContains(*i.RulesContains))
+	}
+	if i.RulesHasPrefix != nil {
+		predicates = append(predicates, fightingart.RulesHasPrefix(*i.RulesHasPrefix))
+	}
+	if i.RulesHasSuffix != nil {
+		predicates = append(predicates, fightingart.RulesHasSuffix(*i.RulesHasSuffix))
+	}
+	if i.RulesEqualFold != nil {
+		predicates = append(predicates, fightingart.RulesEqualFold(*i.RulesEqualFold))
+	}
+	if i.RulesContainsFold != nil {
+		predicates = append(predicates, fightingart.RulesContainsFold(*i.RulesContainsFold))
+	}
+	if i.Secret != nil {
+		predicates = append(predicates, fightingart.SecretEQ(*i.Secret))
+	}
+	if i.SecretNEQ != nil {
+		predicates = append(predicates, fightingart.SecretNEQ(*i.SecretNEQ))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyFightingArtWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return fightingart.And(predicates...), nil
+	}
+}
+
+// InnovationWhereInput represents a where input for filtering Innovation queries.
+type InnovationWhereInput struct {
+	Predicates []predicate.Innovation  `json:"-"`
+	Not        *InnovationWhereInput   `json:"not,omitempty"`
+	Or         []*InnovationWhereInput `json:"or,omitempty"`
+	And        []*InnovationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "survival_limit_bonus" field predicates.
+	SurvivalLimitBonus      *int  `json:"survivalLimitBonus,omitempty"`
+	SurvivalLimitBonusNEQ   *int  `json:"survivalLimitBonusNEQ,omitempty"`
+	SurvivalLimitBonusIn    []int `json:"survivalLimitBonusIn,omitempty"`
+	SurvivalLimitBonusNotIn []int `json:"survivalLimitBonusNotIn,omitempty"`
+	SurvivalLimitBonusGT    *int  `json:"survivalLimitBonusGT,omitempty"`
+	SurvivalLimitBonusGTE   *int  `json:"survivalLimitBonusGTE,omitempty"`
+	SurvivalLimitBonusLT    *int  `json:"survivalLimitBonusLT,omitempty"`
+	SurvivalLimitBonusLTE   *int  `json:"survivalLimitBonusLTE,omitempty"`
+
+	// "departing_survival_bonus" field predicates.
+	DepartingSurvivalBonus      *int  `json:"departingSurvivalBonus,omitempty"`
+	DepartingSurvivalBonusNEQ   *int  `json:"departingSurvivalBonusNEQ,omitempty"`
+	DepartingSurvivalBonusIn    []int `json:"departingSurvivalBonusIn,omitempty"`
+	DepartingSurvivalBonusNotIn []int `json:"departingSurvivalBonusNotIn,omitempty"`
+	DepartingSurvivalBonusGT    *int  `json:"departingSurvivalBonusGT,omitempty"`
+	DepartingSurvivalBonusGTE   *int  `json:"departingSurvivalBonusGTE,omitempty"`
+	DepartingSurvivalBonusLT    *int  `json:"departingSurvivalBonusLT,omitempty"`
+	DepartingSurvivalBonusLTE   *int  `json:"departingSurvivalBonusLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *InnovationWhereInput) AddPredicates(predicates ...predicate.Innovation) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the InnovationWhereInput filter on the InnovationQuery builder.
+func (i *InnovationWhereInput) Filter(q *InnovationQuery) (*InnovationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyInnovationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyInnovationWhereInput is returned in case the InnovationWhereInput is empty.
+var ErrEmptyInnovationWhereInput = errors.New("ent: empty predicate InnovationWhereInput")
+
+// P returns a predicate for filtering innovations.
+// An error is returned if the input is empty or invalid.
+func (i *InnovationWhereInput) P() (predicate.Innovation, error) {
+	var predicates []predicate.Innovation
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, innovation.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Innovation, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, innovation.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Innovation, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, innovation.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, innovation.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, innovation.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, innovation.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, innovation.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, innovation.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, innovation.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, innovation.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, innovation.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, innovation.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, innovation.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, innovation.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, innovation.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, innovation.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, innovation.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, innovation.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, innovation.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, innovation.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, innovation.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, innovation.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, innovation.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, innovation.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.SurvivalLimitBonus != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusEQ(*i.SurvivalLimitBonus))
+	}
+	if i.SurvivalLimitBonusNEQ != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusNEQ(*i.SurvivalLimitBonusNEQ))
+	}
+	if len(i.SurvivalLimitBonusIn) > 0 {
+		predicates = append(predicates, innovation.SurvivalLimitBonusIn(i.SurvivalLimitBonusIn...))
+	}
+	if len(i.SurvivalLimitBonusNotIn) > 0 {
+		predicates = append(predicates, innovation.SurvivalLimitBonusNotIn(i.SurvivalLimitBonusNotIn...))
+	}
+	if i.SurvivalLimitBonusGT != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusGT(*i.SurvivalLimitBonusGT))
+	}
+	if i.SurvivalLimitBonusGTE != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusGTE(*i.SurvivalLimitBonusGTE))
+	}
+	if i.SurvivalLimitBonusLT != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusLT(*i.SurvivalLimitBonusLT))
+	}
+	if i.SurvivalLimitBonusLTE != nil {
+		predicates = append(predicates, innovation.SurvivalLimitBonusLTE(*i.SurvivalLimitBonusLTE))
+	}
+	if i.DepartingSurvivalBonus != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusEQ(*i.DepartingSurvivalBonus))
+	}
+	if i.DepartingSurvivalBonusNEQ != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusNEQ(*i.DepartingSurvivalBonusNEQ))
+	}
+	if len(i.DepartingSurvivalBonusIn) > 0 {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusIn(i.DepartingSurvivalBonusIn...))
+	}
+	if len(i.DepartingSurvivalBonusNotIn) > 0 {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusNotIn(i.DepartingSurvivalBonusNotIn...))
+	}
+	if i.DepartingSurvivalBonusGT != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusGT(*i.DepartingSurvivalBonusGT))
+	}
+	if i.DepartingSurvivalBonusGTE != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusGTE(*i.DepartingSurvivalBonusGTE))
+	}
+	if i.DepartingSurvivalBonusLT != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusLT(*i.DepartingSurvivalBonusLT))
+	}
+	if i.DepartingSurvivalBonusLTE != nil {
+		predicates = append(predicates, innovation.DepartingSurvivalBonusLTE(*i.DepartingSurvivalBonusLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, innovation.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, innovation.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, innovation.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, innovation.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := innovation.HasSettlement()
+		if !*i.HasSettlement {
+			p = innovation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, innovation.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyInnovationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return innovation.And(predicates...), nil
+	}
+}
+
+// InviteWhereInput represents a where input for filtering Invite queries.
+type InviteWhereInput struct {
+	Predicates []predicate.Invite  `json:"-"`
+	Not        *InviteWhereInput   `json:"not,omitempty"`
+	Or         []*InviteWhereInput `json:"or,omitempty"`
+	And        []*InviteWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "role" field predicates.
+	Role      *invite.Role  `json:"role,omitempty"`
+	RoleNEQ   *invite.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []invite.Role `json:"roleIn,omitempty"`
+	RoleNotIn []invite.Role `json:"roleNotIn,omitempty"`
+
+	// "max_uses" field predicates.
+	MaxUses      *int  `json:"maxUses,omitempty"`
+	MaxUsesNEQ   *int  `json:"maxUsesNEQ,omitempty"`
+	MaxUsesIn    []int `json:"maxUsesIn,omitempty"`
+	MaxUsesNotIn []int `json:"maxUsesNotIn,omitempty"`
+	MaxUsesGT    *int  `json:"maxUsesGT,omitempty"`
+	MaxUsesGTE   *int  `json:"maxUsesGTE,omitempty"`
+	MaxUsesLT    *int  `json:"maxUsesLT,omitempty"`
+	MaxUsesLTE   *int  `json:"maxUsesLTE,omitempty"`
+
+	// "uses" field predicates.
+	Uses      *int  `json:"uses,omitempty"`
+	UsesNEQ   *int  `json:"usesNEQ,omitempty"`
+	UsesIn    []int `json:"usesIn,omitempty"`
+	UsesNotIn []int `json:"usesNotIn,omitempty"`
+	UsesGT    *int  `json:"usesGT,omitempty"`
+	UsesGTE   *int  `json:"usesGTE,omitempty"`
+	UsesLT    *int  `json:"usesLT,omitempty"`
+	UsesLTE   *int  `json:"usesLTE,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt      *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ   *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn    []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT    *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE   *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT    *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE   *time.Time  `json:"expiresAtLTE,omitempty"`
+
+	// "revoked_at" field predicates.
+	RevokedAt       *time.Time  `json:"revokedAt,omitempty"`
+	RevokedAtNEQ    *time.Time  `json:"revokedAtNEQ,omitempty"`
+	RevokedAtIn     []time.Time `json:"revokedAtIn,omitempty"`
+	RevokedAtNotIn  []time.Time `json:"revokedAtNotIn,omitempty"`
+	RevokedAtGT     *time.Time  `json:"revokedAtGT,omitempty"`
+	RevokedAtGTE    *time.Time  `json:"revokedAtGTE,omitempty"`
+	RevokedAtLT     *time.Time  `json:"revokedAtLT,omitempty"`
+	RevokedAtLTE    *time.Time  `json:"revokedAtLTE,omitempty"`
+	RevokedAtIsNil  bool        `json:"revokedAtIsNil,omitempty"`
+	RevokedAtNotNil bool        `json:"revokedAtNotNil,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *InviteWhereInput) AddPredicates(predicates ...predicate.Invite) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the InviteWhereInput filter on the InviteQuery builder.
+func (i *InviteWhereInput) Filter(q *InviteQuery) (*InviteQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyInviteWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyInviteWhereInput is returned in case the InviteWhereInput is empty.
+var ErrEmptyInviteWhereInput = errors.New("ent: empty predicate InviteWhereInput")
+
+// P returns a predicate for filtering invites.
+// An error is returned if the input is empty or invalid.
+func (i *InviteWhereInput) P() (predicate.Invite, error) {
+	var predicates []predicate.Invite
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, invite.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Invite, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, invite.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Invite, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, invite.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, invite.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, invite.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, invite.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, invite.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, invite.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, invite.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, invite.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, invite.IDLTE(*i.IDLTE))
+	}
+	if i.Role != nil {
+		predicates = append(predicates, invite.RoleEQ(*i.Role))
+	}
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, invite.RoleNEQ(*i.RoleNEQ))
+	}
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, invite.RoleIn(i.RoleIn...))
+	}
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, invite.RoleNotIn(i.RoleNotIn...))
+	}
+	if i.MaxUses != nil {
+		predicates = append(predicates, invite.MaxUsesEQ(*i.MaxUses))
+	}
+	if i.MaxUsesNEQ != nil {
+		predicates = append(predicates, invite.MaxUsesNEQ(*i.MaxUsesNEQ))
+	}
+	if len(i.MaxUsesIn) > 0 {
+		predicates = append(predicates, invite.MaxUsesIn(i.MaxUsesIn...))
+	}
+	if len(i.MaxUsesNotIn) > 0 {
+		predicates = append(predicates, invite.MaxUsesNotIn(i.MaxUsesNotIn...))
+	}
+	if i.MaxUsesGT != nil {
+		predicates = append(predicates, invite.MaxUsesGT(*i.MaxUsesGT))
+	}
+	if i.MaxUsesGTE != nil {
+		predicates = append(predicates, invite.MaxUsesGTE(*i.MaxUsesGTE))
+	}
+	if i.MaxUsesLT != nil {
+		predicates = append(predicates, invite.MaxUsesLT(*i.MaxUsesLT))
+	}
+	if i.MaxUsesLTE != nil {
+		predicates = append(predicates, invite.MaxUsesLTE(*i.MaxUsesLTE))
+	}
+	if i.Uses != nil {
+		predicates = append(predicates, invite.UsesEQ(*i.Uses))
+	}
+	if i.UsesNEQ != nil {
+		predicates = append(predicates, invite.UsesNEQ(*i.UsesNEQ))
+	}
+	if len(i.UsesIn) > 0 {
+		predicates = append(predicates, invite.UsesIn(i.UsesIn...))
+	}
+	if len(i.UsesNotIn) > 0 {
+		predicates = append(predicates, invite.UsesNotIn(i.UsesNotIn...))
+	}
+	if i.UsesGT != nil {
+		predicates = append(predicates, invite.UsesGT(*i.UsesGT))
+	}
+	if i.UsesGTE != nil {
+		predicates = append(predicates, invite.UsesGTE(*i.UsesGTE))
+	}
+	if i.UsesLT != nil {
+		predicates = append(predicates, invite.UsesLT(*i.UsesLT))
+	}
+	if i.UsesLTE != nil {
+		predicates = append(predicates, invite.UsesLTE(*i.UsesLTE))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, invite.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, invite.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, invite.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, invite.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, invite.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, invite.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, invite.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, invite.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.RevokedAt != nil {
+		predicates = append(predicates, invite.RevokedAtEQ(*i.RevokedAt))
+	}
+	if i.RevokedAtNEQ != nil {
+		predicates = append(predicates, invite.RevokedAtNEQ(*i.RevokedAtNEQ))
+	}
+	if len(i.RevokedAtIn) > 0 {
+		predicates = append(predicates, invite.RevokedAtIn(i.RevokedAtIn...))
+	}
+	if len(i.RevokedAtNotIn) > 0 {
+		predicates = append(predicates, invite.RevokedAtNotIn(i.RevokedAtNotIn...))
+	}
+	if i.RevokedAtGT != nil {
+		predicates = append(predicates, invite.RevokedAtGT(*i.RevokedAtGT))
+	}
+	if i.RevokedAtGTE != nil {
+		predicates = append(predicates, invite.RevokedAtGTE(*i.RevokedAtGTE))
+	}
+	if i.RevokedAtLT != nil {
+		predicates = append(predicates, invite.RevokedAtLT(*i.RevokedAtLT))
+	}
+	if i.RevokedAtLTE != nil {
+		predicates = append(predicates, invite.RevokedAtLTE(*i.RevokedAtLTE))
+	}
+	if i.RevokedAtIsNil {
+		predicates = append(predicates, invite.RevokedAtIsNil())
+	}
+	if i.RevokedAtNotNil {
+		predicates = append(predicates, invite.RevokedAtNotNil())
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, invite.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, invite.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, invite.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, invite.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, invite.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, invite.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, invite.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, invite.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, invite.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, invite.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, invite.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, invite.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, invite.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, invite.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, invite.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, invite.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, invite.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, invite.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, invite.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, invite.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, invite.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, invite.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, invite.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, invite.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, invite.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := invite.HasSettlement()
+		if !*i.HasSettlement {
+			p = invite.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, invite.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyInviteWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return invite.And(predicates...), nil
+	}
+}
+
+// LocationWhereInput represents a where input for filtering Location queries.
+type LocationWhereInput struct {
+	Predicates []predicate.Location  `json:"-"`
+	Not        *LocationWhereInput   `json:"not,omitempty"`
+	Or         []*LocationWhereInput `json:"or,omitempty"`
+	And        []*LocationWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "recipes" edge predicates.
+	HasRecipes     *bool               `json:"hasRecipes,omitempty"`
+	HasRecipesWith []*RecipeWhereInput `json:"hasRecipesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *LocationWhereInput) AddPredicates(predicates ...predicate.Location) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the LocationWhereInput filter on the LocationQuery builder.
+func (i *LocationWhereInput) Filter(q *LocationQuery) (*LocationQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyLocationWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyLocationWhereInput is returned in case the LocationWhereInput is empty.
+var ErrEmptyLocationWhereInput = errors.New("ent: empty predicate LocationWhereInput")
+
+// P returns a predicate for filtering locations.
+// An error is returned if the input is empty or invalid.
+func (i *LocationWhereInput) P() (predicate.Location, error) {
+	var predicates []predicate.Location
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, location.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Location, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, location.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Location, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, location.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, location.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, location.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, location.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, location.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, location.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, location.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, location.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, location.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, location.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, location.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, location.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, location.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, location.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, location.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, location.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, location.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, location.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, location.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, location.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, location.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, location.NameContainsFold(*i.NameContainsFold))
+	}
+
+	if i.HasRecipes != nil {
+		p := location.HasRecipes()
+		if !*i.HasRecipes {
+			p = location.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRecipesWith) > 0 {
+		with := make([]predicate.Recipe, 0, len(i.HasRecipesWith))
+		for _, w := range i.HasRecipesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRecipesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, location.HasRecipesWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyLocationWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return location.And(predicates...), nil
+	}
+}
+
+// MembershipWhereInput represents a where input for filtering Membership queries.
+type MembershipWhereInput struct {
+	Predicates []predicate.Membership  `json:"-"`
+	Not        *MembershipWhereInput   `json:"not,omitempty"`
+	Or         []*MembershipWhereInput `json:"or,omitempty"`
+	And        []*MembershipWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+
+	// "role" field predicates.
+	Role      *membership.Role  `json:"role,omitempty"`
+	RoleNEQ   *membership.Role  `json:"roleNEQ,omitempty"`
+	RoleIn    []membership.Role `json:"roleIn,omitempty"`
+	RoleNotIn []membership.Role `json:"roleNotIn,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MembershipWhereInput) AddPredicates(predicates ...predicate.Membership) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MembershipWhereInput filter on the MembershipQuery builder.
+func (i *MembershipWhereInput) Filter(q *MembershipQuery) (*MembershipQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMembershipWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMembershipWhereInput is returned in case the MembershipWhereInput is empty.
+var ErrEmptyMembershipWhereInput = errors.New("ent: empty predicate MembershipWhereInput")
+
+// P returns a predicate for filtering memberships.
+// An error is returned if the input is empty or invalid.
+func (i *MembershipWhereInput) P() (predicate.Membership, error) {
+	var predicates []predicate.Membership
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, membership.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Membership, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, membership.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Membership, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, membership.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, membership.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, membership.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, membership.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, membership.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, membership.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, membership.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, membership.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, membership.IDLTE(*i.IDLTE))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, membership.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, membership.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, membership.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, membership.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, membership.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, membership.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, membership.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, membership.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, membership.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, membership.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, membership.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, membership.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, membership.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.Role != nil {
+		predicates = append(predicates, membership.RoleEQ(*i.Role))
+	}
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, membership.RoleNEQ(*i.RoleNEQ))
+	}
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, membership.RoleIn(i.RoleIn...))
+	}
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, membership.RoleNotIn(i.RoleNotIn...))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, membership.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, membership.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, membership.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, membership.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := membership.HasSettlement()
+		if !*i.HasSettlement {
+			p = membership.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, membership.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMembershipWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return membership.And(predicates...), nil
+	}
+}
+
+// MilestoneWhereInput represents a where input for filtering Milestone queries.
+type MilestoneWhereInput struct {
+	Predicates []predicate.Milestone  `json:"-"`
+	Not        *MilestoneWhereInput   `json:"not,omitempty"`
+	Or         []*MilestoneWhereInput `json:"or,omitempty"`
+	And        []*MilestoneWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name      *milestone.Name  `json:"name,omitempty"`
+	NameNEQ   *milestone.Name  `json:"nameNEQ,omitempty"`
+	NameIn    []milestone.Name `json:"nameIn,omitempty"`
+	NameNotIn []milestone.Name `json:"nameNotIn,omitempty"`
+
+	// "year" field predicates.
+	Year      *int  `json:"year,omitempty"`
+	YearNEQ   *int  `json:"yearNEQ,omitempty"`
+	YearIn    []int `json:"yearIn,omitempty"`
+	YearNotIn []int `json:"yearNotIn,omitempty"`
+	YearGT    *int  `json:"yearGT,omitempty"`
+	YearGTE   *int  `json:"yearGTE,omitempty"`
+	YearLT    *int  `json:"yearLT,omitempty"`
+	YearLTE   *int  `json:"yearLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MilestoneWhereInput) AddPredicates(predicates ...predicate.Milestone) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MilestoneWhereInput filter on the MilestoneQuery builder.
+func (i *MilestoneWhereInput) Filter(q *MilestoneQuery) (*MilestoneQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMilestoneWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMilestoneWhereInput is returned in case the MilestoneWhereInput is empty.
+var ErrEmptyMilestoneWhereInput = errors.New("ent: empty predicate MilestoneWhereInput")
+
+// P returns a predicate for filtering milestones.
+// An error is returned if the input is empty or invalid.
+func (i *MilestoneWhereInput) P() (predicate.Milestone, error) {
+	var predicates []predicate.Milestone
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, milestone.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Milestone, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, milestone.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Milestone, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, milestone.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, milestone.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, milestone.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, milestone.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, milestone.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, milestone.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, milestone.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, milestone.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, milestone.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, milestone.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, milestone.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, milestone.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, milestone.NameNotIn(i.NameNotIn...))
+	}
+	if i.Year != nil {
+		predicates = append(predicates, milestone.YearEQ(*i.Year))
+	}
+	if i.YearNEQ != nil {
+		predicates = append(predicates, milestone.YearNEQ(*i.YearNEQ))
+	}
+	if len(i.YearIn) > 0 {
+		predicates = append(predicates, milestone.YearIn(i.YearIn...))
+	}
+	if len(i.YearNotIn) > 0 {
+		predicates = append(predicates, milestone.YearNotIn(i.YearNotIn...))
+	}
+	if i.YearGT != nil {
+		predicates = append(predicates, milestone.YearGT(*i.YearGT))
+	}
+	if i.YearGTE != nil {
+		predicates = append(predicates, milestone.YearGTE(*i.YearGTE))
+	}
+	if i.YearLT != nil {
+		predicates = append(predicates, milestone.YearLT(*i.YearLT))
+	}
+	if i.YearLTE != nil {
+		predicates = append(predicates, milestone.YearLTE(*i.YearLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, milestone.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, milestone.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, milestone.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, milestone.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, milestone.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, milestone.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, milestone.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, milestone.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, milestone.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, milestone.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, milestone.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, milestone.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := milestone.HasSettlement()
+		if !*i.HasSettlement {
+			p = milestone.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, milestone.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMilestoneWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return milestone.And(predicates...), nil
+	}
+}
+
+// MonsterWhereInput represents a where input for filtering Monster queries.
+type MonsterWhereInput struct {
+	Predicates []predicate.Monster  `json:"-"`
+	Not        *MonsterWhereInput   `json:"not,omitempty"`
+	Or         []*MonsterWhereInput `json:"or,omitempty"`
+	And        []*MonsterWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "kind" field predicates.
+	Kind      *monster.Kind  `json:"kind,omitempty"`
+	KindNEQ   *monster.Kind  `json:"kindNEQ,omitempty"`
+	KindIn    []monster.Kind `json:"kindIn,omitempty"`
+	KindNotIn []monster.Kind `json:"kindNotIn,omitempty"`
+
+	// "levels" field predicates.
+	Levels      *int  `json:"levels,omitempty"`
+	LevelsNEQ   *int  `json:"levelsNEQ,omitempty"`
+	LevelsIn    []int `json:"levelsIn,omitempty"`
+	LevelsNotIn []int `json:"levelsNotIn,omitempty"`
+	LevelsGT    *int  `json:"levelsGT,omitempty"`
+	LevelsGTE   *int  `json:"levelsGTE,omitempty"`
+	LevelsLT    *int  `json:"levelsLT,omitempty"`
+	LevelsLTE   *int  `json:"levelsLTE,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MonsterWhereInput) AddPredicates(predicates ...predicate.Monster) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MonsterWhereInput filter on the MonsterQuery builder.
+func (i *MonsterWhereInput) Filter(q *MonsterQuery) (*MonsterQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMonsterWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMonsterWhereInput is returned in case the MonsterWhereInput is empty.
+var ErrEmptyMonsterWhereInput = errors.New("ent: empty predicate MonsterWhereInput")
+
+// P returns a predicate for filtering monsters.
+// An error is returned if the input is empty or invalid.
+func (i *MonsterWhereInput) P() (predicate.Monster, error) {
+	var predicates []predicate.Monster
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, monster.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Monster, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, monster.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Monster, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, monster.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, monster.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, monster.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, monster.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, monster.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, monster.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, monster.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, monster.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, monster.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, monster.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, monster.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, monster.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, monster.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, monster.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, monster.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, monster.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, monster.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, monster.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, monster.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, monster.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, monster.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, monster.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Kind != nil {
+		predicates = append(predicates, monster.KindEQ(*i.Kind))
+	}
+	if i.KindNEQ != nil {
+		predicates = append(predicates, monster.KindNEQ(*i.KindNEQ))
+	}
+	if len(i.KindIn) > 0 {
+		predicates = append(predicates, monster.KindIn(i.KindIn...))
+	}
+	if len(i.KindNotIn) > 0 {
+		predicates = append(predicates, monster.KindNotIn(i.KindNotIn...))
+	}
+	if i.Levels != nil {
+		predicates = append(predicates, monster.LevelsEQ(*i.Levels))
+	}
+	if i.LevelsNEQ != nil {
+		predicates = append(predicates, monster.LevelsNEQ(*i.LevelsNEQ))
+	}
+	if len(i.LevelsIn) > 0 {
+		predicates = append(predicates, monster.LevelsIn(i.LevelsIn...))
+	}
+	if len(i.LevelsNotIn) > 0 {
+		predicates = append(predicates, monster.LevelsNotIn(i.LevelsNotIn...))
+	}
+	if i.LevelsGT != nil {
+		predicates = append(predicates, monster.LevelsGT(*i.LevelsGT))
+	}
+	if i.LevelsGTE != nil {
+		predicates = append(predicates, monster.LevelsGTE(*i.LevelsGTE))
+	}
+	if i.LevelsLT != nil {
+		predicates = append(predicates, monster.LevelsLT(*i.LevelsLT))
+	}
+	if i.LevelsLTE != nil {
+		predicates = append(predicates, monster.LevelsLTE(*i.LevelsLTE))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMonsterWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return monster.And(predicates...), nil
+	}
+}
+
+// MonsterProgressWhereInput represents a where input for filtering MonsterProgress queries.
+type MonsterProgressWhereInput struct {
+	Predicates []predicate.MonsterProgress  `json:"-"`
+	Not        *MonsterProgressWhereInput   `json:"not,omitempty"`
+	Or         []*MonsterProgressWhereInput `json:"or,omitempty"`
+	And        []*MonsterProgressWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "unlocked_year" field predicates.
+	UnlockedYear      *int  `json:"unlockedYear,omitempty"`
+	UnlockedYearNEQ   *int  `json:"unlockedYearNEQ,omitempty"`
+	UnlockedYearIn    []int `json:"unlockedYearIn,omitempty"`
+	UnlockedYearNotIn []int `json:"unlockedYearNotIn,omitempty"`
+	UnlockedYearGT    *int  `json:"unlockedYearGT,omitempty"`
+	UnlockedYearGTE   *int  `json:"unlockedYearGTE,omitempty"`
+	UnlockedYearLT    *int  `json:"unlockedYearLT,omitempty"`
+	UnlockedYearLTE   *int  `json:"unlockedYearLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "monster_id" field predicates.
+	MonsterID      *int  `json:"monsterID,omitempty"`
+	MonsterIDNEQ   *int  `json:"monsterIDNEQ,omitempty"`
+	MonsterIDIn    []int `json:"monsterIDIn,omitempty"`
+	MonsterIDNotIn []int `json:"monsterIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+
+	// "monster" edge predicates.
+	HasMonster     *bool                `json:"hasMonster,omitempty"`
+	HasMonsterWith []*MonsterWhereInput `json:"hasMonsterWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *MonsterProgressWhereInput) AddPredicates(predicates ...predicate.MonsterProgress) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the MonsterProgressWhereInput filter on the MonsterProgressQuery builder.
+func (i *MonsterProgressWhereInput) Filter(q *MonsterProgressQuery) (*MonsterProgressQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyMonsterProgressWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyMonsterProgressWhereInput is returned in case the MonsterProgressWhereInput is empty.
+var ErrEmptyMonsterProgressWhereInput = errors.New("ent: empty predicate MonsterProgressWhereInput")
+
+// P returns a predicate for filtering monsterprogresses.
+// An error is returned if the input is empty or invalid.
+func (i *MonsterProgressWhereInput) P() (predicate.MonsterProgress, error) {
+	var predicates []predicate.MonsterProgress
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, monsterprogress.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.MonsterProgress, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, monsterprogress.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.MonsterProgress, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, monsterprogress.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, monsterprogress.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, monsterprogress.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, monsterprogress.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, monsterprogress.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, monsterprogress.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, monsterprogress.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, monsterprogress.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, monsterprogress.IDLTE(*i.IDLTE))
+	}
+	if i.UnlockedYear != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearEQ(*i.UnlockedYear))
+	}
+	if i.UnlockedYearNEQ != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearNEQ(*i.UnlockedYearNEQ))
+	}
+	if len(i.UnlockedYearIn) > 0 {
+		predicates = append(predicates, monsterprogress.UnlockedYearIn(i.UnlockedYearIn...))
+	}
+	if len(i.UnlockedYearNotIn) > 0 {
+		predicates = append(predicates, monsterprogress.UnlockedYearNotIn(i.UnlockedYearNotIn...))
+	}
+	if i.UnlockedYearGT != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearGT(*i.UnlockedYearGT))
+	}
+	if i.UnlockedYearGTE != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearGTE(*i.UnlockedYearGTE))
+	}
+	if i.UnlockedYearLT != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearLT(*i.UnlockedYearLT))
+	}
+	if i.UnlockedYearLTE != nil {
+		predicates = append(predicates, monsterprogress.UnlockedYearLTE(*i.UnlockedYearLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, monsterprogress.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, monsterprogress.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, monsterprogress.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, monsterprogress.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+	if i.MonsterID != nil {
+		predicates = append(predicates, monsterprogress.MonsterIDEQ(*i.MonsterID))
+	}
+	if i.MonsterIDNEQ != nil {
+		predicates = append(predicates, monsterprogress.MonsterIDNEQ(*i.MonsterIDNEQ))
+	}
+	if len(i.MonsterIDIn) > 0 {
+		predicates = append(predicates, monsterprogress.MonsterIDIn(i.MonsterIDIn...))
+	}
+	if len(i.MonsterIDNotIn) > 0 {
+		predicates = append(predicates, monsterprogress.MonsterIDNotIn(i.MonsterIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := monsterprogress.HasSettlement()
+		if !*i.HasSettlement {
+			p = monsterprogress.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, monsterprogress.HasSettlementWith(with...))
+	}
+	if i.HasMonster != nil {
+		p := monsterprogress.HasMonster()
+		if !*i.HasMonster {
+			p = monsterprogress.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMonsterWith) > 0 {
+		with := make([]predicate.Monster, 0, len(i.HasMonsterWith))
+		for _, w := range i.HasMonsterWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMonsterWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, monsterprogress.HasMonsterWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyMonsterProgressWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return monsterprogress.And(predicates...), nil
+	}
+}
+
+// PrincipleWhereInput represents a where input for filtering Principle queries.
+type PrincipleWhereInput struct {
+	Predicates []predicate.Principle  `json:"-"`
+	Not        *PrincipleWhereInput   `json:"not,omitempty"`
+	Or         []*PrincipleWhereInput `json:"or,omitempty"`
+	And        []*PrincipleWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name      *principle.Name  `json:"name,omitempty"`
+	NameNEQ   *principle.Name  `json:"nameNEQ,omitempty"`
+	NameIn    []principle.Name `json:"nameIn,omitempty"`
+	NameNotIn []principle.Name `json:"nameNotIn,omitempty"`
+
+	// "choice" field predicates.
+	Choice             *string  `json:"choice,omitempty"`
+	ChoiceNEQ          *string  `json:"choiceNEQ,omitempty"`
+	ChoiceIn           []string `json:"choiceIn,omitempty"`
+	ChoiceNotIn        []string `json:"choiceNotIn,omitempty"`
+	ChoiceGT           *string  `json:"choiceGT,omitempty"`
+	ChoiceGTE          *string  `json:"choiceGTE,omitempty"`
+	ChoiceLT           *string  `json:"choiceLT,omitempty"`
+	ChoiceLTE          *string  `json:"choiceLTE,omitempty"`
+	ChoiceContains     *string  `json:"choiceContains,omitempty"`
+	ChoiceHasPrefix    *string  `json:"choiceHasPrefix,omitempty"`
+	ChoiceHasSuffix    *string  `json:"choiceHasSuffix,omitempty"`
+	ChoiceEqualFold    *string  `json:"choiceEqualFold,omitempty"`
+	ChoiceContainsFold *string  `json:"choiceContainsFold,omitempty"`
+
+	// "survival_limit_bonus" field predicates.
+	SurvivalLimitBonus      *int  `json:"survivalLimitBonus,omitempty"`
+	SurvivalLimitBonusNEQ   *int  `json:"survivalLimitBonusNEQ,omitempty"`
+	SurvivalLimitBonusIn    []int `json:"survivalLimitBonusIn,omitempty"`
+	SurvivalLimitBonusNotIn []int `json:"survivalLimitBonusNotIn,omitempty"`
+	SurvivalLimitBonusGT    *int  `json:"survivalLimitBonusGT,omitempty"`
+	SurvivalLimitBonusGTE   *int  `json:"survivalLimitBonusGTE,omitempty"`
+	SurvivalLimitBonusLT    *int  `json:"survivalLimitBonusLT,omitempty"`
+	SurvivalLimitBonusLTE   *int  `json:"survivalLimitBonusLTE,omitempty"`
+
+	// "departing_survival_bonus" field predicates.
+	DepartingSurvivalBonus      *int  `json:"departingSurvivalBonus,omitempty"`
+	DepartingSurvivalBonusNEQ   *int  `json:"departingSurvivalBonusNEQ,omitempty"`
+	DepartingSurvivalBonusIn    []int `json:"departingSurvivalBonusIn,omitempty"`
+	DepartingSurvivalBonusNotIn []int `json:"departingSurvivalBonusNotIn,omitempty"`
+	DepartingSurvivalBonusGT    *int  `json:"departingSurvivalBonusGT,omitempty"`
+	DepartingSurvivalBonusGTE   *int  `json:"departingSurvivalBonusGTE,omitempty"`
+	DepartingSurvivalBonusLT    *int  `json:"departingSurvivalBonusLT,omitempty"`
+	DepartingSurvivalBonusLTE   *int  `json:"departingSurvivalBonusLTE,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PrincipleWhereInput) AddPredicates(predicates ...predicate.Principle) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PrincipleWhereInput filter on the PrincipleQuery builder.
+func (i *PrincipleWhereInput) Filter(q *PrincipleQuery) (*PrincipleQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPrincipleWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPrincipleWhereInput is returned in case the PrincipleWhereInput is empty.
+var ErrEmptyPrincipleWhereInput = errors.New("ent: empty predicate PrincipleWhereInput")
+
+// P returns a predicate for filtering principles.
+// An error is returned if the input is empty or invalid.
+func (i *PrincipleWhereInput) P() (predicate.Principle, error) {
+	var predicates []predicate.Principle
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, principle.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Principle, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, principle.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Principle, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, principle.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, principle.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, principle.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, principle.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, principle.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, principle.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, principle.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, principle.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, principle.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, principle.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, principle.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, principle.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, principle.NameNotIn(i.NameNotIn...))
+	}
+	if i.Choice != nil {
+		predicates = append(predicates, principle.ChoiceEQ(*i.Choice))
+	}
+	if i.ChoiceNEQ != nil {
+		predicates = append(predicates, principle.ChoiceNEQ(*i.ChoiceNEQ))
+	}
+	if len(i.ChoiceIn) > 0 {
+		predicates = append(predicates, principle.ChoiceIn(i.ChoiceIn...))
+	}
+	if len(i.ChoiceNotIn) > 0 {
+		predicates = append(predicates, principle.ChoiceNotIn(i.ChoiceNotIn...))
+	}
+	if i.ChoiceGT != nil {
+		predicates = append(predicates, principle.ChoiceGT(*i.ChoiceGT))
+	}
+	if i.ChoiceGTE != nil {
+		predicates = append(predicates, principle.ChoiceGTE(*i.ChoiceGTE))
+	}
+	if i.ChoiceLT != nil {
+		predicates = append(predicates, principle.ChoiceLT(*i.ChoiceLT))
+	}
+	if i.ChoiceLTE != nil {
+		predicates = append(predicates, principle.ChoiceLTE(*i.ChoiceLTE))
+	}
+	if i.ChoiceContains != nil {
+		predicates = append(predicates, principle.ChoiceContains(*i.ChoiceContains))
+	}
+	if i.ChoiceHasPrefix != nil {
+		predicates = append(predicates, principle.ChoiceHasPrefix(*i.ChoiceHasPrefix))
+	}
+	if i.ChoiceHasSuffix != nil {
+		predicates = append(predicates, principle.ChoiceHasSuffix(*i.ChoiceHasSuffix))
+	}
+	if i.ChoiceEqualFold != nil {
+		predicates = append(predicates, principle.ChoiceEqualFold(*i.ChoiceEqualFold))
+	}
+	if i.ChoiceContainsFold != nil {
+		predicates = append(predicates, principle.ChoiceContainsFold(*i.ChoiceContainsFold))
+	}
+	if i.SurvivalLimitBonus != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusEQ(*i.SurvivalLimitBonus))
+	}
+	if i.SurvivalLimitBonusNEQ != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusNEQ(*i.SurvivalLimitBonusNEQ))
+	}
+	if len(i.SurvivalLimitBonusIn) > 0 {
+		predicates = append(predicates, principle.SurvivalLimitBonusIn(i.SurvivalLimitBonusIn...))
+	}
+	if len(i.SurvivalLimitBonusNotIn) > 0 {
+		predicates = append(predicates, principle.SurvivalLimitBonusNotIn(i.SurvivalLimitBonusNotIn...))
+	}
+	if i.SurvivalLimitBonusGT != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusGT(*i.SurvivalLimitBonusGT))
+	}
+	if i.SurvivalLimitBonusGTE != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusGTE(*i.SurvivalLimitBonusGTE))
+	}
+	if i.SurvivalLimitBonusLT != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusLT(*i.SurvivalLimitBonusLT))
+	}
+	if i.SurvivalLimitBonusLTE != nil {
+		predicates = append(predicates, principle.SurvivalLimitBonusLTE(*i.SurvivalLimitBonusLTE))
+	}
+	if i.DepartingSurvivalBonus != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusEQ(*i.DepartingSurvivalBonus))
+	}
+	if i.DepartingSurvivalBonusNEQ != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusNEQ(*i.DepartingSurvivalBonusNEQ))
+	}
+	if len(i.DepartingSurvivalBonusIn) > 0 {
+		predicates = append(predicates, principle.DepartingSurvivalBonusIn(i.DepartingSurvivalBonusIn...))
+	}
+	if len(i.DepartingSurvivalBonusNotIn) > 0 {
+		predicates = append(predicates, principle.DepartingSurvivalBonusNotIn(i.DepartingSurvivalBonusNotIn...))
+	}
+	if i.DepartingSurvivalBonusGT != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusGT(*i.DepartingSurvivalBonusGT))
+	}
+	if i.DepartingSurvivalBonusGTE != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusGTE(*i.DepartingSurvivalBonusGTE))
+	}
+	if i.DepartingSurvivalBonusLT != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusLT(*i.DepartingSurvivalBonusLT))
+	}
+	if i.DepartingSurvivalBonusLTE != nil {
+		predicates = append(predicates, principle.DepartingSurvivalBonusLTE(*i.DepartingSurvivalBonusLTE))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, principle.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, principle.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, principle.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, principle.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := principle.HasSettlement()
+		if !*i.HasSettlement {
+			p = principle.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, principle.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPrincipleWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return principle.And(predicates...), nil
+	}
+}
+
+// RecipeWhereInput represents a where input for filtering Recipe queries.
+type RecipeWhereInput struct {
+	Predicates []predicate.Recipe  `json:"-"`
+	Not        *RecipeWhereInput   `json:"not,omitempty"`
+	Or         []*RecipeWhereInput `json:"or,omitempty"`
+	And        []*RecipeWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "location_id" field predicates.
+	LocationID      *int  `json:"locationID,omitempty"`
+	LocationIDNEQ   *int  `json:"locationIDNEQ,omitempty"`
+	LocationIDIn    []int `json:"locationIDIn,omitempty"`
+	LocationIDNotIn []int `json:"locationIDNotIn,omitempty"`
+
+	// "location" edge predicates.
+	HasLocation     *bool                 `json:"hasLocation,omitempty"`
+	HasLocationWith []*LocationWhereInput `json:"hasLocationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RecipeWhereInput) AddPredicates(predicates ...predicate.Recipe) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RecipeWhereInput filter on the RecipeQuery builder.
+func (i *RecipeWhereInput) Filter(q *RecipeQuery) (*RecipeQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRecipeWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRecipeWhereInput is returned in case the RecipeWhereInput is empty.
+var ErrEmptyRecipeWhereInput = errors.New("ent: empty predicate RecipeWhereInput")
+
+// P returns a predicate for filtering recipes.
+// An error is returned if the input is empty or invalid.
+func (i *RecipeWhereInput) P() (predicate.Recipe, error) {
+	var predicates []predicate.Recipe
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, recipe.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Recipe, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, recipe.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Recipe, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, recipe.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, recipe.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, recipe.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, recipe.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, recipe.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, recipe.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, recipe.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, recipe.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, recipe.IDLTE(*i.IDLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, recipe.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, recipe.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, recipe.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, recipe.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, recipe.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, recipe.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, recipe.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, recipe.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, recipe.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, recipe.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, recipe.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, recipe.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, recipe.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.LocationID != nil {
+		predicates = append(predicates, recipe.LocationIDEQ(*i.LocationID))
+	}
+	if i.LocationIDNEQ != nil {
+		predicates = append(predicates, recipe.LocationIDNEQ(*i.LocationIDNEQ))
+	}
+	if len(i.LocationIDIn) > 0 {
+		predicates = append(predicates, recipe.LocationIDIn(i.LocationIDIn...))
+	}
+	if len(i.LocationIDNotIn) > 0 {
+		predicates = append(predicates, recipe.LocationIDNotIn(i.LocationIDNotIn...))
+	}
+
+	if i.HasLocation != nil {
+		p := recipe.HasLocation()
+		if !*i.HasLocation {
+			p = recipe.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasLocationWith) > 0 {
+		with := make([]predicate.Location, 0, len(i.HasLocationWith))
+		for _, w := range i.HasLocationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLocationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, recipe.HasLocationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRecipeWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return recipe.And(predicates...), nil
+	}
+}
+
 // SettlementWhereInput represents a where input for filtering Settlement queries.
 type SettlementWhereInput struct {
 	Predicates []predicate.Settlement  `json:"-"`
@@ -28,21 +3975,572 @@ type SettlementWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "owner" field predicates.
-	Owner             *string  `json:"owner,omitempty"`
-	OwnerNEQ          *string  `json:"ownerNEQ,omitempty"`
-	OwnerIn           []string `json:"ownerIn,omitempty"`
-	OwnerNotIn        []string `json:"ownerNotIn,omitempty"`
-	OwnerGT           *string  `json:"ownerGT,omitempty"`
-	OwnerGTE          *string  `json:"ownerGTE,omitempty"`
-	OwnerLT           *string  `json:"ownerLT,omitempty"`
-	OwnerLTE          *string  `json:"ownerLTE,omitempty"`
-	OwnerContains     *string  `json:"ownerContains,omitempty"`
-	OwnerHasPrefix    *string  `json:"ownerHasPrefix,omitempty"`
-	OwnerHasSuffix    *string  `json:"ownerHasSuffix,omitempty"`
-	OwnerEqualFold    *string  `json:"ownerEqualFold,omitempty"`
-	OwnerContainsFold *string  `json:"ownerContainsFold,omitempty"`
-
+	// "owner" field predicates.
+	Owner             *string  `json:"owner,omitempty"`
+	OwnerNEQ          *string  `json:"ownerNEQ,omitempty"`
+	OwnerIn           []string `json:"ownerIn,omitempty"`
+	OwnerNotIn        []string `json:"ownerNotIn,omitempty"`
+	OwnerGT           *string  `json:"ownerGT,omitempty"`
+	OwnerGTE          *string  `json:"ownerGTE,omitempty"`
+	OwnerLT           *string  `json:"ownerLT,omitempty"`
+	OwnerLTE          *string  `json:"ownerLTE,omitempty"`
+	OwnerContains     *string  `json:"ownerContains,omitempty"`
+	OwnerHasPrefix    *string  `json:"ownerHasPrefix,omitempty"`
+	OwnerHasSuffix    *string  `json:"ownerHasSuffix,omitempty"`
+	OwnerEqualFold    *string  `json:"ownerEqualFold,omitempty"`
+	OwnerContainsFold *string  `json:"ownerContainsFold,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "survivalLimit" field predicates.
+	SurvivalLimit      *int  `json:"survivallimit,omitempty"`
+	SurvivalLimitNEQ   *int  `json:"survivallimitNEQ,omitempty"`
+	SurvivalLimitIn    []int `json:"survivallimitIn,omitempty"`
+	SurvivalLimitNotIn []int `json:"survivallimitNotIn,omitempty"`
+	SurvivalLimitGT    *int  `json:"survivallimitGT,omitempty"`
+	SurvivalLimitGTE   *int  `json:"survivallimitGTE,omitempty"`
+	SurvivalLimitLT    *int  `json:"survivallimitLT,omitempty"`
+	SurvivalLimitLTE   *int  `json:"survivallimitLTE,omitempty"`
+
+	// "departingSurvival" field predicates.
+	DepartingSurvival      *int  `json:"departingsurvival,omitempty"`
+	DepartingSurvivalNEQ   *int  `json:"departingsurvivalNEQ,omitempty"`
+	DepartingSurvivalIn    []int `json:"departingsurvivalIn,omitempty"`
+	DepartingSurvivalNotIn []int `json:"departingsurvivalNotIn,omitempty"`
+	DepartingSurvivalGT    *int  `json:"departingsurvivalGT,omitempty"`
+	DepartingSurvivalGTE   *int  `json:"departingsurvivalGTE,omitempty"`
+	DepartingSurvivalLT    *int  `json:"departingsurvivalLT,omitempty"`
+	DepartingSurvivalLTE   *int  `json:"departingsurvivalLTE,omitempty"`
+
+	// "collectiveCognition" field predicates.
+	CollectiveCognition      *int  `json:"collectivecognition,omitempty"`
+	CollectiveCognitionNEQ   *int  `json:"collectivecognitionNEQ,omitempty"`
+	CollectiveCognitionIn    []int `json:"collectivecognitionIn,omitempty"`
+	CollectiveCognitionNotIn []int `json:"collectivecognitionNotIn,omitempty"`
+	CollectiveCognitionGT    *int  `json:"collectivecognitionGT,omitempty"`
+	CollectiveCognitionGTE   *int  `json:"collectivecognitionGTE,omitempty"`
+	CollectiveCognitionLT    *int  `json:"collectivecognitionLT,omitempty"`
+	CollectiveCognitionLTE   *int  `json:"collectivecognitionLTE,omitempty"`
+
+	// "currentYear" field predicates.
+	CurrentYear      *int  `json:"currentyear,omitempty"`
+	CurrentYearNEQ   *int  `json:"currentyearNEQ,omitempty"`
+	CurrentYearIn    []int `json:"currentyearIn,omitempty"`
+	CurrentYearNotIn []int `json:"currentyearNotIn,omitempty"`
+	CurrentYearGT    *int  `json:"currentyearGT,omitempty"`
+	CurrentYearGTE   *int  `json:"currentyearGTE,omitempty"`
+	CurrentYearLT    *int  `json:"currentyearLT,omitempty"`
+	CurrentYearLTE   *int  `json:"currentyearLTE,omitempty"`
+
+	// "population" edge predicates.
+	HasPopulation     *bool                 `json:"hasPopulation,omitempty"`
+	HasPopulationWith []*SurvivorWhereInput `json:"hasPopulationWith,omitempty"`
+
+	// "members" edge predicates.
+	HasMembers     *bool                   `json:"hasMembers,omitempty"`
+	HasMembersWith []*MembershipWhereInput `json:"hasMembersWith,omitempty"`
+
+	// "timeline" edge predicates.
+	HasTimeline     *bool                      `json:"hasTimeline,omitempty"`
+	HasTimelineWith []*TimelineEventWhereInput `json:"hasTimelineWith,omitempty"`
+
+	// "storage" edge predicates.
+	HasStorage     *bool                    `json:"hasStorage,omitempty"`
+	HasStorageWith []*StorageItemWhereInput `json:"hasStorageWith,omitempty"`
+
+	// "innovations" edge predicates.
+	HasInnovations     *bool                   `json:"hasInnovations,omitempty"`
+	HasInnovationsWith []*InnovationWhereInput `json:"hasInnovationsWith,omitempty"`
+
+	// "principles" edge predicates.
+	HasPrinciples     *bool                  `json:"hasPrinciples,omitempty"`
+	HasPrinciplesWith []*PrincipleWhereInput `json:"hasPrinciplesWith,omitempty"`
+
+	// "milestones" edge predicates.
+	HasMilestones     *bool                  `json:"hasMilestones,omitempty"`
+	HasMilestonesWith []*MilestoneWhereInput `json:"hasMilestonesWith,omitempty"`
+
+	// "locations" edge predicates.
+	HasLocations     *bool                 `json:"hasLocations,omitempty"`
+	HasLocationsWith []*LocationWhereInput `json:"hasLocationsWith,omitempty"`
+
+	// "monsters" edge predicates.
+	HasMonsters     *bool                        `json:"hasMonsters,omitempty"`
+	HasMonstersWith []*MonsterProgressWhereInput `json:"hasMonstersWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SettlementWhereInput) AddPredicates(predicates ...predicate.Settlement) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SettlementWhereInput filter on the SettlementQuery builder.
+func (i *SettlementWhereInput) Filter(q *SettlementQuery) (*SettlementQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySettlementWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySettlementWhereInput is returned in case the SettlementWhereInput is empty.
+var ErrEmptySettlementWhereInput = errors.New("ent: empty predicate SettlementWhereInput")
+
+// P returns a predicate for filtering settlements.
+// An error is returned if the input is empty or invalid.
+func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
+	var predicates []predicate.Settlement
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, settlement.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Settlement, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, settlement.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Settlement, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, settlement.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, settlement.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, settlement.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, settlement.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, settlement.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, settlement.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, settlement.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, settlement.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, settlement.IDLTE(*i.IDLTE))
+	}
+	if i.Owner != nil {
+		predicates = append(predicates, settlement.OwnerEQ(*i.Owner))
+	}
+	if i.OwnerNEQ != nil {
+		predicates = append(predicates, settlement.OwnerNEQ(*i.OwnerNEQ))
+	}
+	if len(i.OwnerIn) > 0 {
+		predicates = append(predicates, settlement.OwnerIn(i.OwnerIn...))
+	}
+	if len(i.OwnerNotIn) > 0 {
+		predicates = append(predicates, settlement.OwnerNotIn(i.OwnerNotIn...))
+	}
+	if i.OwnerGT != nil {
+		predicates = append(predicates, settlement.OwnerGT(*i.OwnerGT))
+	}
+	if i.OwnerGTE != nil {
+		predicates = append(predicates, settlement.OwnerGTE(*i.OwnerGTE))
+	}
+	if i.OwnerLT != nil {
+		predicates = append(predicates, settlement.OwnerLT(*i.OwnerLT))
+	}
+	if i.OwnerLTE != nil {
+		predicates = append(predicates, settlement.OwnerLTE(*i.OwnerLTE))
+	}
+	if i.OwnerContains != nil {
+		predicates = append(predicates, settlement.OwnerContains(*i.OwnerContains))
+	}
+	if i.OwnerHasPrefix != nil {
+		predicates = append(predicates, settlement.OwnerHasPrefix(*i.OwnerHasPrefix))
+	}
+	if i.OwnerHasSuffix != nil {
+		predicates = append(predicates, settlement.OwnerHasSuffix(*i.OwnerHasSuffix))
+	}
+	if i.OwnerEqualFold != nil {
+		predicates = append(predicates, settlement.OwnerEqualFold(*i.OwnerEqualFold))
+	}
+	if i.OwnerContainsFold != nil {
+		predicates = append(predicates, settlement.OwnerContainsFold(*i.OwnerContainsFold))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, settlement.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, settlement.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, settlement.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, settlement.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, settlement.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, settlement.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, settlement.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, settlement.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, settlement.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, settlement.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, settlement.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, settlement.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, settlement.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.SurvivalLimit != nil {
+		predicates = append(predicates, settlement.SurvivalLimitEQ(*i.SurvivalLimit))
+	}
+	if i.SurvivalLimitNEQ != nil {
+		predicates = append(predicates, settlement.SurvivalLimitNEQ(*i.SurvivalLimitNEQ))
+	}
+	if len(i.SurvivalLimitIn) > 0 {
+		predicates = append(predicates, settlement.SurvivalLimitIn(i.SurvivalLimitIn...))
+	}
+	if len(i.SurvivalLimitNotIn) > 0 {
+		predicates = append(predicates, settlement.SurvivalLimitNotIn(i.SurvivalLimitNotIn...))
+	}
+	if i.SurvivalLimitGT != nil {
+		predicates = append(predicates, settlement.SurvivalLimitGT(*i.SurvivalLimitGT))
+	}
+	if i.SurvivalLimitGTE != nil {
+		predicates = append(predicates, settlement.SurvivalLimitGTE(*i.SurvivalLimitGTE))
+	}
+	if i.SurvivalLimitLT != nil {
+		predicates = append(predicates, settlement.SurvivalLimitLT(*i.SurvivalLimitLT))
+	}
+	if i.SurvivalLimitLTE != nil {
+		predicates = append(predicates, settlement.SurvivalLimitLTE(*i.SurvivalLimitLTE))
+	}
+	if i.DepartingSurvival != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalEQ(*i.DepartingSurvival))
+	}
+	if i.DepartingSurvivalNEQ != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalNEQ(*i.DepartingSurvivalNEQ))
+	}
+	if len(i.DepartingSurvivalIn) > 0 {
+		predicates = append(predicates, settlement.DepartingSurvivalIn(i.DepartingSurvivalIn...))
+	}
+	if len(i.DepartingSurvivalNotIn) > 0 {
+		predicates = append(predicates, settlement.DepartingSurvivalNotIn(i.DepartingSurvivalNotIn...))
+	}
+	if i.DepartingSurvivalGT != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalGT(*i.DepartingSurvivalGT))
+	}
+	if i.DepartingSurvivalGTE != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalGTE(*i.DepartingSurvivalGTE))
+	}
+	if i.DepartingSurvivalLT != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalLT(*i.DepartingSurvivalLT))
+	}
+	if i.DepartingSurvivalLTE != nil {
+		predicates = append(predicates, settlement.DepartingSurvivalLTE(*i.DepartingSurvivalLTE))
+	}
+	if i.CollectiveCognition != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionEQ(*i.CollectiveCognition))
+	}
+	if i.CollectiveCognitionNEQ != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionNEQ(*i.CollectiveCognitionNEQ))
+	}
+	if len(i.CollectiveCognitionIn) > 0 {
+		predicates = append(predicates, settlement.CollectiveCognitionIn(i.CollectiveCognitionIn...))
+	}
+	if len(i.CollectiveCognitionNotIn) > 0 {
+		predicates = append(predicates, settlement.CollectiveCognitionNotIn(i.CollectiveCognitionNotIn...))
+	}
+	if i.CollectiveCognitionGT != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionGT(*i.CollectiveCognitionGT))
+	}
+	if i.CollectiveCognitionGTE != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionGTE(*i.CollectiveCognitionGTE))
+	}
+	if i.CollectiveCognitionLT != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionLT(*i.CollectiveCognitionLT))
+	}
+	if i.CollectiveCognitionLTE != nil {
+		predicates = append(predicates, settlement.CollectiveCognitionLTE(*i.CollectiveCognitionLTE))
+	}
+	if i.CurrentYear != nil {
+		predicates = append(predicates, settlement.CurrentYearEQ(*i.CurrentYear))
+	}
+	if i.CurrentYearNEQ != nil {
+		predicates = append(predicates, settlement.CurrentYearNEQ(*i.CurrentYearNEQ))
+	}
+	if len(i.CurrentYearIn) > 0 {
+		predicates = append(predicates, settlement.CurrentYearIn(i.CurrentYearIn...))
+	}
+	if len(i.CurrentYearNotIn) > 0 {
+		predicates = append(predicates, settlement.CurrentYearNotIn(i.CurrentYearNotIn...))
+	}
+	if i.CurrentYearGT != nil {
+		predicates = append(predicates, settlement.CurrentYearGT(*i.CurrentYearGT))
+	}
+	if i.CurrentYearGTE != nil {
+		predicates = append(predicates, settlement.CurrentYearGTE(*i.CurrentYearGTE))
+	}
+	if i.CurrentYearLT != nil {
+		predicates = append(predicates, settlement.CurrentYearLT(*i.CurrentYearLT))
+	}
+	if i.CurrentYearLTE != nil {
+		predicates = append(predicates, settlement.CurrentYearLTE(*i.CurrentYearLTE))
+	}
+
+	if i.HasPopulation != nil {
+		p := settlement.HasPopulation()
+		if !*i.HasPopulation {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPopulationWith) > 0 {
+		with := make([]predicate.Survivor, 0, len(i.HasPopulationWith))
+		for _, w := range i.HasPopulationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPopulationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasPopulationWith(with...))
+	}
+	if i.HasMembers != nil {
+		p := settlement.HasMembers()
+		if !*i.HasMembers {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMembersWith) > 0 {
+		with := make([]predicate.Membership, 0, len(i.HasMembersWith))
+		for _, w := range i.HasMembersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMembersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasMembersWith(with...))
+	}
+	if i.HasTimeline != nil {
+		p := settlement.HasTimeline()
+		if !*i.HasTimeline {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTimelineWith) > 0 {
+		with := make([]predicate.TimelineEvent, 0, len(i.HasTimelineWith))
+		for _, w := range i.HasTimelineWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTimelineWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasTimelineWith(with...))
+	}
+	if i.HasStorage != nil {
+		p := settlement.HasStorage()
+		if !*i.HasStorage {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasStorageWith) > 0 {
+		with := make([]predicate.StorageItem, 0, len(i.HasStorageWith))
+		for _, w := range i.HasStorageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasStorageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasStorageWith(with...))
+	}
+	if i.HasInnovations != nil {
+		p := settlement.HasInnovations()
+		if !*i.HasInnovations {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasInnovationsWith) > 0 {
+		with := make([]predicate.Innovation, 0, len(i.HasInnovationsWith))
+		for _, w := range i.HasInnovationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasInnovationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasInnovationsWith(with...))
+	}
+	if i.HasPrinciples != nil {
+		p := settlement.HasPrinciples()
+		if !*i.HasPrinciples {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasPrinciplesWith) > 0 {
+		with := make([]predicate.Principle, 0, len(i.HasPrinciplesWith))
+		for _, w := range i.HasPrinciplesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPrinciplesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasPrinciplesWith(with...))
+	}
+	if i.HasMilestones != nil {
+		p := settlement.HasMilestones()
+		if !*i.HasMilestones {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMilestonesWith) > 0 {
+		with := make([]predicate.Milestone, 0, len(i.HasMilestonesWith))
+		for _, w := range i.HasMilestonesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMilestonesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasMilestonesWith(with...))
+	}
+	if i.HasLocations != nil {
+		p := settlement.HasLocations()
+		if !*i.HasLocations {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasLocationsWith) > 0 {
+		with := make([]predicate.Location, 0, len(i.HasLocationsWith))
+		for _, w := range i.HasLocationsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasLocationsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasLocationsWith(with...))
+	}
+	if i.HasMonsters != nil {
+		p := settlement.HasMonsters()
+		if !*i.HasMonsters {
+			p = settlement.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMonstersWith) > 0 {
+		with := make([]predicate.MonsterProgress, 0, len(i.HasMonstersWith))
+		for _, w := range i.HasMonstersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMonstersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, settlement.HasMonstersWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySettlementWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return settlement.And(predicates...), nil
+	}
+}
+
+// StorageItemWhereInput represents a where input for filtering StorageItem queries.
+type StorageItemWhereInput struct {
+	Predicates []predicate.StorageItem  `json:"-"`
+	Not        *StorageItemWhereInput   `json:"not,omitempty"`
+	Or         []*StorageItemWhereInput `json:"or,omitempty"`
+	And        []*StorageItemWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
 	// "name" field predicates.
 	Name             *string  `json:"name,omitempty"`
 	NameNEQ          *string  `json:"nameNEQ,omitempty"`
@@ -58,64 +4556,63 @@ type SettlementWhereInput struct {
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "survivalLimit" field predicates.
-	SurvivalLimit      *int  `json:"survivallimit,omitempty"`
-	SurvivalLimitNEQ   *int  `json:"survivallimitNEQ,omitempty"`
-	SurvivalLimitIn    []int `json:"survivallimitIn,omitempty"`
-	SurvivalLimitNotIn []int `json:"survivallimitNotIn,omitempty"`
-	SurvivalLimitGT    *int  `json:"survivallimitGT,omitempty"`
-	SurvivalLimitGTE   *int  `json:"survivallimitGTE,omitempty"`
-	SurvivalLimitLT    *int  `json:"survivallimitLT,omitempty"`
-	SurvivalLimitLTE   *int  `json:"survivallimitLTE,omitempty"`
+	// "category" field predicates.
+	Category      *storageitem.Category  `json:"category,omitempty"`
+	CategoryNEQ   *storageitem.Category  `json:"categoryNEQ,omitempty"`
+	CategoryIn    []storageitem.Category `json:"categoryIn,omitempty"`
+	CategoryNotIn []storageitem.Category `json:"categoryNotIn,omitempty"`
 
-	// "departingSurvival" field predicates.
-	DepartingSurvival      *int  `json:"departingsurvival,omitempty"`
-	DepartingSurvivalNEQ   *int  `json:"departingsurvivalNEQ,omitempty"`
-	DepartingSurvivalIn    []int `json:"departingsurvivalIn,omitempty"`
-	DepartingSurvivalNotIn []int `json:"departingsurvivalNotIn,omitempty"`
-	DepartingSurvivalGT    *int  `json:"departingsurvivalGT,omitempty"`
-	DepartingSurvivalGTE   *int  `json:"departingsurvivalGTE,omitempty"`
-	DepartingSurvivalLT    *int  `json:"departingsurvivalLT,omitempty"`
-	DepartingSurvivalLTE   *int  `json:"departingsurvivalLTE,omitempty"`
+	// "source_monster" field predicates.
+	SourceMonster             *string  `json:"sourceMonster,omitempty"`
+	SourceMonsterNEQ          *string  `json:"sourceMonsterNEQ,omitempty"`
+	SourceMonsterIn           []string `json:"sourceMonsterIn,omitempty"`
+	SourceMonsterNotIn        []string `json:"sourceMonsterNotIn,omitempty"`
+	SourceMonsterGT           *string  `json:"sourceMonsterGT,omitempty"`
+	SourceMonsterGTE          *string  `json:"sourceMonsterGTE,omitempty"`
+	SourceMonsterLT           *string  `json:"sourceMonsterLT,omitempty"`
+	SourceMonsterLTE          *string  `json:"sourceMonsterLTE,omitempty"`
+	SourceMonsterContains     *string  `json:"sourceMonsterContains,omitempty"`
+	SourceMonsterHasPrefix    *string  `json:"sourceMonsterHasPrefix,omitempty"`
+	SourceMonsterHasSuffix    *string  `json:"sourceMonsterHasSuffix,omitempty"`
+	SourceMonsterIsNil        bool     `json:"sourceMonsterIsNil,omitempty"`
+	SourceMonsterNotNil       bool     `json:"sourceMonsterNotNil,omitempty"`
+	SourceMonsterEqualFold    *string  `json:"sourceMonsterEqualFold,omitempty"`
+	SourceMonsterContainsFold *string  `json:"sourceMonsterContainsFold,omitempty"`
 
-	// "collectiveCognition" field predicates.
-	CollectiveCognition      *int  `json:"collectivecognition,omitempty"`
-	CollectiveCognitionNEQ   *int  `json:"collectivecognitionNEQ,omitempty"`
-	CollectiveCognitionIn    []int `json:"collectivecognitionIn,omitempty"`
-	CollectiveCognitionNotIn []int `json:"collectivecognitionNotIn,omitempty"`
-	CollectiveCognitionGT    *int  `json:"collectivecognitionGT,omitempty"`
-	CollectiveCognitionGTE   *int  `json:"collectivecognitionGTE,omitempty"`
-	CollectiveCognitionLT    *int  `json:"collectivecognitionLT,omitempty"`
-	CollectiveCognitionLTE   *int  `json:"collectivecognitionLTE,omitempty"`
+	// "quantity" field predicates.
+	Quantity      *int  `json:"quantity,omitempty"`
+	QuantityNEQ   *int  `json:"quantityNEQ,omitempty"`
+	QuantityIn    []int `json:"quantityIn,omitempty"`
+	QuantityNotIn []int `json:"quantityNotIn,omitempty"`
+	QuantityGT    *int  `json:"quantityGT,omitempty"`
+	QuantityGTE   *int  `json:"quantityGTE,omitempty"`
+	QuantityLT    *int  `json:"quantityLT,omitempty"`
+	QuantityLTE   *int  `json:"quantityLTE,omitempty"`
 
-	// "currentYear" field predicates.
-	CurrentYear      *int  `json:"currentyear,omitempty"`
-	CurrentYearNEQ   *int  `json:"currentyearNEQ,omitempty"`
-	CurrentYearIn    []int `json:"currentyearIn,omitempty"`
-	CurrentYearNotIn []int `json:"currentyearNotIn,omitempty"`
-	CurrentYearGT    *int  `json:"currentyearGT,omitempty"`
-	CurrentYearGTE   *int  `json:"currentyearGTE,omitempty"`
-	CurrentYearLT    *int  `json:"currentyearLT,omitempty"`
-	CurrentYearLTE   *int  `json:"currentyearLTE,omitempty"`
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
 
-	// "population" edge predicates.
-	HasPopulation     *bool                 `json:"hasPopulation,omitempty"`
-	HasPopulationWith []*SurvivorWhereInput `json:"hasPopulationWith,omitempty"`
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SettlementWhereInput) AddPredicates(predicates ...predicate.Settlement) {
+func (i *StorageItemWhereInput) AddPredicates(predicates ...predicate.StorageItem) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the SettlementWhereInput filter on the SettlementQuery builder.
-func (i *SettlementWhereInput) Filter(q *SettlementQuery) (*SettlementQuery, error) {
+// Filter applies the StorageItemWhereInput filter on the StorageItemQuery builder.
+func (i *StorageItemWhereInput) Filter(q *StorageItemQuery) (*StorageItemQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptySettlementWhereInput {
+		if err == ErrEmptyStorageItemWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -123,19 +4620,19 @@ func (i *SettlementWhereInput) Filter(q *SettlementQuery) (*SettlementQuery, err
 	return q.Where(p), nil
 }
 
-// ErrEmptySettlementWhereInput is returned in case the SettlementWhereInput is empty.
-var ErrEmptySettlementWhereInput = errors.New("ent: empty predicate SettlementWhereInput")
+// ErrEmptyStorageItemWhereInput is returned in case the StorageItemWhereInput is empty.
+var ErrEmptyStorageItemWhereInput = errors.New("ent: empty predicate StorageItemWhereInput")
 
-// P returns a predicate for filtering settlements.
+// P returns a predicate for filtering storageitems.
 // An error is returned if the input is empty or invalid.
-func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
-	var predicates []predicate.Settlement
+func (i *StorageItemWhereInput) P() (predicate.StorageItem, error) {
+	var predicates []predicate.StorageItem
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, settlement.Not(p))
+		predicates = append(predicates, storageitem.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -145,7 +4642,7 @@ func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Settlement, 0, n)
+		or := make([]predicate.StorageItem, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -153,7 +4650,7 @@ func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, settlement.Or(or...))
+		predicates = append(predicates, storageitem.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -163,7 +4660,7 @@ func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Settlement, 0, n)
+		and := make([]predicate.StorageItem, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -171,233 +4668,191 @@ func (i *SettlementWhereInput) P() (predicate.Settlement, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, settlement.And(and...))
+		predicates = append(predicates, storageitem.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, settlement.IDEQ(*i.ID))
+		predicates = append(predicates, storageitem.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, settlement.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, storageitem.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, settlement.IDIn(i.IDIn...))
+		predicates = append(predicates, storageitem.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, settlement.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, storageitem.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, settlement.IDGT(*i.IDGT))
+		predicates = append(predicates, storageitem.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, settlement.IDGTE(*i.IDGTE))
+		predicates = append(predicates, storageitem.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, settlement.IDLT(*i.IDLT))
+		predicates = append(predicates, storageitem.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, settlement.IDLTE(*i.IDLTE))
-	}
-	if i.Owner != nil {
-		predicates = append(predicates, settlement.OwnerEQ(*i.Owner))
-	}
-	if i.OwnerNEQ != nil {
-		predicates = append(predicates, settlement.OwnerNEQ(*i.OwnerNEQ))
-	}
-	if len(i.OwnerIn) > 0 {
-		predicates = append(predicates, settlement.OwnerIn(i.OwnerIn...))
-	}
-	if len(i.OwnerNotIn) > 0 {
-		predicates = append(predicates, settlement.OwnerNotIn(i.OwnerNotIn...))
-	}
-	if i.OwnerGT != nil {
-		predicates = append(predicates, settlement.OwnerGT(*i.OwnerGT))
-	}
-	if i.OwnerGTE != nil {
-		predicates = append(predicates, settlement.OwnerGTE(*i.OwnerGTE))
-	}
-	if i.OwnerLT != nil {
-		predicates = append(predicates, settlement.OwnerLT(*i.OwnerLT))
-	}
-	if i.OwnerLTE != nil {
-		predicates = append(predicates, settlement.OwnerLTE(*i.OwnerLTE))
-	}
-	if i.OwnerContains != nil {
-		predicates = append(predicates, settlement.OwnerContains(*i.OwnerContains))
-	}
-	if i.OwnerHasPrefix != nil {
-		predicates = append(predicates, settlement.OwnerHasPrefix(*i.OwnerHasPrefix))
-	}
-	if i.OwnerHasSuffix != nil {
-		predicates = append(predicates, settlement.OwnerHasSuffix(*i.OwnerHasSuffix))
-	}
-	if i.OwnerEqualFold != nil {
-		predicates = append(predicates, settlement.OwnerEqualFold(*i.OwnerEqualFold))
-	}
-	if i.OwnerContainsFold != nil {
-		predicates = append(predicates, settlement.OwnerContainsFold(*i.OwnerContainsFold))
+		predicates = append(predicates, storageitem.IDLTE(*i.IDLTE))
 	}
 	if i.Name != nil {
-		predicates = append(predicates, settlement.NameEQ(*i.Name))
+		predicates = append(predicates, storageitem.NameEQ(*i.Name))
 	}
 	if i.NameNEQ != nil {
-		predicates = append(predicates, settlement.NameNEQ(*i.NameNEQ))
+		predicates = append(predicates, storageitem.NameNEQ(*i.NameNEQ))
 	}
 	if len(i.NameIn) > 0 {
-		predicates = append(predicates, settlement.NameIn(i.NameIn...))
+		predicates = append(predicates, storageitem.NameIn(i.NameIn...))
 	}
 	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, settlement.NameNotIn(i.NameNotIn...))
+		predicates = append(predicates, storageitem.NameNotIn(i.NameNotIn...))
 	}
 	if i.NameGT != nil {
-		predicates = append(predicates, settlement.NameGT(*i.NameGT))
+		predicates = append(predicates, storageitem.NameGT(*i.NameGT))
 	}
 	if i.NameGTE != nil {
-		predicates = append(predicates, settlement.NameGTE(*i.NameGTE))
+		predicates = append(predicates, storageitem.NameGTE(*i.NameGTE))
 	}
 	if i.NameLT != nil {
-		predicates = append(predicates, settlement.NameLT(*i.NameLT))
+		predicates = append(predicates, storageitem.NameLT(*i.NameLT))
 	}
 	if i.NameLTE != nil {
-		predicates = append(predicates, settlement.NameLTE(*i.NameLTE))
+		predicates = append(predicates, storageitem.NameLTE(*i.NameLTE))
 	}
 	if i.NameContains != nil {
-		predicates = append(predicates, settlement.NameContains(*i.NameContains))
+		predicates = append(predicates, storageitem.NameContains(*i.NameContains))
 	}
 	if i.NameHasPrefix != nil {
-		predicates = append(predicates, settlement.NameHasPrefix(*i.NameHasPrefix))
+		predicates = append(predicates, storageitem.NameHasPrefix(*i.NameHasPrefix))
 	}
 	if i.NameHasSuffix != nil {
-		predicates = append(predicates, settlement.NameHasSuffix(*i.NameHasSuffix))
+		predicates = append(predicates, storageitem.NameHasSuffix(*i.NameHasSuffix))
 	}
 	if i.NameEqualFold != nil {
-		predicates = append(predicates, settlement.NameEqualFold(*i.NameEqualFold))
+		predicates = append(predicates, storageitem.NameEqualFold(*i.NameEqualFold))
 	}
 	if i.NameContainsFold != nil {
-		predicates = append(predicates, settlement.NameContainsFold(*i.NameContainsFold))
-	}
-	if i.SurvivalLimit != nil {
-		predicates = append(predicates, settlement.SurvivalLimitEQ(*i.SurvivalLimit))
-	}
-	if i.SurvivalLimitNEQ != nil {
-		predicates = append(predicates, settlement.SurvivalLimitNEQ(*i.SurvivalLimitNEQ))
+		predicates = append(predicates, storageitem.NameContainsFold(*i.NameContainsFold))
 	}
-	if len(i.SurvivalLimitIn) > 0 {
-		predicates = append(predicates, settlement.SurvivalLimitIn(i.SurvivalLimitIn...))
+	if i.Category != nil {
+		predicates = append(predicates, storageitem.CategoryEQ(*i.Category))
 	}
-	if len(i.SurvivalLimitNotIn) > 0 {
-		predicates = append(predicates, settlement.SurvivalLimitNotIn(i.SurvivalLimitNotIn...))
+	if i.CategoryNEQ != nil {
+		predicates = append(predicates, storageitem.CategoryNEQ(*i.CategoryNEQ))
 	}
-	if i.SurvivalLimitGT != nil {
-		predicates = append(predicates, settlement.SurvivalLimitGT(*i.SurvivalLimitGT))
+	if len(i.CategoryIn) > 0 {
+		predicates = append(predicates, storageitem.CategoryIn(i.CategoryIn...))
 	}
-	if i.SurvivalLimitGTE != nil {
-		predicates = append(predicates, settlement.SurvivalLimitGTE(*i.SurvivalLimitGTE))
+	if len(i.CategoryNotIn) > 0 {
+		predicates = append(predicates, storageitem.CategoryNotIn(i.CategoryNotIn...))
 	}
-	if i.SurvivalLimitLT != nil {
-		predicates = append(predicates, settlement.SurvivalLimitLT(*i.SurvivalLimitLT))
+	if i.SourceMonster != nil {
+		predicates = append(predicates, storageitem.SourceMonsterEQ(*i.SourceMonster))
 	}
-	if i.SurvivalLimitLTE != nil {
-		predicates = append(predicates, settlement.SurvivalLimitLTE(*i.SurvivalLimitLTE))
+	if i.SourceMonsterNEQ != nil {
+		predicates = append(predicates, storageitem.SourceMonsterNEQ(*i.SourceMonsterNEQ))
 	}
-	if i.DepartingSurvival != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalEQ(*i.DepartingSurvival))
+	if len(i.SourceMonsterIn) > 0 {
+		predicates = append(predicates, storageitem.SourceMonsterIn(i.SourceMonsterIn...))
 	}
-	if i.DepartingSurvivalNEQ != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalNEQ(*i.DepartingSurvivalNEQ))
+	if len(i.SourceMonsterNotIn) > 0 {
+		predicates = append(predicates, storageitem.SourceMonsterNotIn(i.SourceMonsterNotIn...))
 	}
-	if len(i.DepartingSurvivalIn) > 0 {
-		predicates = append(predicates, settlement.DepartingSurvivalIn(i.DepartingSurvivalIn...))
+	if i.SourceMonsterGT != nil {
+		predicates = append(predicates, storageitem.SourceMonsterGT(*i.SourceMonsterGT))
 	}
-	if len(i.DepartingSurvivalNotIn) > 0 {
-		predicates = append(predicates, settlement.DepartingSurvivalNotIn(i.DepartingSurvivalNotIn...))
+	if i.SourceMonsterGTE != nil {
+		predicates = append(predicates, storageitem.SourceMonsterGTE(*i.SourceMonsterGTE))
 	}
-	if i.DepartingSurvivalGT != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalGT(*i.DepartingSurvivalGT))
+	if i.SourceMonsterLT != nil {
+		predicates = append(predicates, storageitem.SourceMonsterLT(*i.SourceMonsterLT))
 	}
-	if i.DepartingSurvivalGTE != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalGTE(*i.DepartingSurvivalGTE))
+	if i.SourceMonsterLTE != nil {
+		predicates = append(predicates, storageitem.SourceMonsterLTE(*i.SourceMonsterLTE))
 	}
-	if i.DepartingSurvivalLT != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalLT(*i.DepartingSurvivalLT))
+	if i.SourceMonsterContains != nil {
+		predicates = append(predicates, storageitem.SourceMonsterContains(*i.SourceMonsterContains))
 	}
-	if i.DepartingSurvivalLTE != nil {
-		predicates = append(predicates, settlement.DepartingSurvivalLTE(*i.DepartingSurvivalLTE))
+	if i.SourceMonsterHasPrefix != nil {
+		predicates = append(predicates, storageitem.SourceMonsterHasPrefix(*i.SourceMonsterHasPrefix))
 	}
-	if i.CollectiveCognition != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionEQ(*i.CollectiveCognition))
+	if i.SourceMonsterHasSuffix != nil {
+		predicates = append(predicates, storageitem.SourceMonsterHasSuffix(*i.SourceMonsterHasSuffix))
 	}
-	if i.CollectiveCognitionNEQ != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionNEQ(*i.CollectiveCognitionNEQ))
+	if i.SourceMonsterIsNil {
+		predicates = append(predicates, storageitem.SourceMonsterIsNil())
 	}
-	if len(i.CollectiveCognitionIn) > 0 {
-		predicates = append(predicates, settlement.CollectiveCognitionIn(i.CollectiveCognitionIn...))
+	if i.SourceMonsterNotNil {
+		predicates = append(predicates, storageitem.SourceMonsterNotNil())
 	}
-	if len(i.CollectiveCognitionNotIn) > 0 {
-		predicates = append(predicates, settlement.CollectiveCognitionNotIn(i.CollectiveCognitionNotIn...))
+	if i.SourceMonsterEqualFold != nil {
+		predicates = append(predicates, storageitem.SourceMonsterEqualFold(*i.SourceMonsterEqualFold))
 	}
-	if i.CollectiveCognitionGT != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionGT(*i.CollectiveCognitionGT))
+	if i.SourceMonsterContainsFold != nil {
+		predicates = append(predicates, storageitem.SourceMonsterContainsFold(*i.SourceMonsterContainsFold))
 	}
-	if i.CollectiveCognitionGTE != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionGTE(*i.CollectiveCognitionGTE))
+	if i.Quantity != nil {
+		predicates = append(predicates, storageitem.QuantityEQ(*i.Quantity))
 	}
-	if i.CollectiveCognitionLT != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionLT(*i.CollectiveCognitionLT))
+	if i.QuantityNEQ != nil {
+		predicates = append(predicates, storageitem.QuantityNEQ(*i.QuantityNEQ))
 	}
-	if i.CollectiveCognitionLTE != nil {
-		predicates = append(predicates, settlement.CollectiveCognitionLTE(*i.CollectiveCognitionLTE))
+	if len(i.QuantityIn) > 0 {
+		predicates = append(predicates, storageitem.QuantityIn(i.QuantityIn...))
 	}
-	if i.CurrentYear != nil {
-		predicates = append(predicates, settlement.CurrentYearEQ(*i.CurrentYear))
+	if len(i.QuantityNotIn) > 0 {
+		predicates = append(predicates, storageitem.QuantityNotIn(i.QuantityNotIn...))
 	}
-	if i.CurrentYearNEQ != nil {
-		predicates = append(predicates, settlement.CurrentYearNEQ(*i.CurrentYearNEQ))
+	if i.QuantityGT != nil {
+		predicates = append(predicates, storageitem.QuantityGT(*i.QuantityGT))
 	}
-	if len(i.CurrentYearIn) > 0 {
-		predicates = append(predicates, settlement.CurrentYearIn(i.CurrentYearIn...))
+	if i.QuantityGTE != nil {
+		predicates = append(predicates, storageitem.QuantityGTE(*i.QuantityGTE))
 	}
-	if len(i.CurrentYearNotIn) > 0 {
-		predicates = append(predicates, settlement.CurrentYearNotIn(i.CurrentYearNotIn...))
+	if i.QuantityLT != nil {
+		predicates = append(predicates, storageitem.QuantityLT(*i.QuantityLT))
 	}
-	if i.CurrentYearGT != nil {
-		predicates = append(predicates, settlement.CurrentYearGT(*i.CurrentYearGT))
+	if i.QuantityLTE != nil {
+		predicates = append(predicates, storageitem.QuantityLTE(*i.QuantityLTE))
 	}
-	if i.CurrentYearGTE != nil {
-		predicates = append(predicates, settlement.CurrentYearGTE(*i.CurrentYearGTE))
+	if i.SettlementID != nil {
+		predicates = append(predicates, storageitem.SettlementIDEQ(*i.SettlementID))
 	}
-	if i.CurrentYearLT != nil {
-		predicates = append(predicates, settlement.CurrentYearLT(*i.CurrentYearLT))
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, storageitem.SettlementIDNEQ(*i.SettlementIDNEQ))
 	}
-	if i.CurrentYearLTE != nil {
-		predicates = append(predicates, settlement.CurrentYearLTE(*i.CurrentYearLTE))
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, storageitem.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, storageitem.SettlementIDNotIn(i.SettlementIDNotIn...))
 	}
 
-	if i.HasPopulation != nil {
-		p := settlement.HasPopulation()
-		if !*i.HasPopulation {
-			p = settlement.Not(p)
+	if i.HasSettlement != nil {
+		p := storageitem.HasSettlement()
+		if !*i.HasSettlement {
+			p = storageitem.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasPopulationWith) > 0 {
-		with := make([]predicate.Survivor, 0, len(i.HasPopulationWith))
-		for _, w := range i.HasPopulationWith {
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPopulationWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, settlement.HasPopulationWith(with...))
+		predicates = append(predicates, storageitem.HasSettlementWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptySettlementWhereInput
+		return nil, ErrEmptyStorageItemWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return settlement.And(predicates...), nil
+		return storageitem.And(predicates...), nil
 	}
 }
 
@@ -605,6 +5060,10 @@ type SurvivorWhereInput struct {
 	StatusChangeYearLT    *int  `json:"statusChangeYearLT,omitempty"`
 	StatusChangeYearLTE   *int  `json:"statusChangeYearLTE,omitempty"`
 
+	// "cannot_use_fighting_arts" field predicates.
+	CannotUseFightingArts    *bool `json:"cannotUseFightingArts,omitempty"`
+	CannotUseFightingArtsNEQ *bool `json:"cannotUseFightingArtsNEQ,omitempty"`
+
 	// "settlement_id" field predicates.
 	SettlementID       *int  `json:"settlementID,omitempty"`
 	SettlementIDNEQ    *int  `json:"settlementIDNEQ,omitempty"`
@@ -616,6 +5075,22 @@ type SurvivorWhereInput struct {
 	// "settlement" edge predicates.
 	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
 	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+
+	// "fighting_arts" edge predicates.
+	HasFightingArts     *bool                    `json:"hasFightingArts,omitempty"`
+	HasFightingArtsWith []*FightingArtWhereInput `json:"hasFightingArtsWith,omitempty"`
+
+	// "secret_fighting_arts" edge predicates.
+	HasSecretFightingArts     *bool                    `json:"hasSecretFightingArts,omitempty"`
+	HasSecretFightingArtsWith []*FightingArtWhereInput `json:"hasSecretFightingArtsWith,omitempty"`
+
+	// "disorders" edge predicates.
+	HasDisorders     *bool                 `json:"hasDisorders,omitempty"`
+	HasDisordersWith []*DisorderWhereInput `json:"hasDisordersWith,omitempty"`
+
+	// "abilities" edge predicates.
+	HasAbilities     *bool                `json:"hasAbilities,omitempty"`
+	HasAbilitiesWith []*AbilityWhereInput `json:"hasAbilitiesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -1160,6 +5635,12 @@ func (i *SurvivorWhereInput) P() (predicate.Survivor, error) {
 	if i.StatusChangeYearLTE != nil {
 		predicates = append(predicates, survivor.StatusChangeYearLTE(*i.StatusChangeYearLTE))
 	}
+	if i.CannotUseFightingArts != nil {
+		predicates = append(predicates, survivor.CannotUseFightingArtsEQ(*i.CannotUseFightingArts))
+	}
+	if i.CannotUseFightingArtsNEQ != nil {
+		predicates = append(predicates, survivor.CannotUseFightingArtsNEQ(*i.CannotUseFightingArtsNEQ))
+	}
 	if i.SettlementID != nil {
 		predicates = append(predicates, survivor.SettlementIDEQ(*i.SettlementID))
 	}
@@ -1197,6 +5678,78 @@ func (i *SurvivorWhereInput) P() (predicate.Survivor, error) {
 		}
 		predicates = append(predicates, survivor.HasSettlementWith(with...))
 	}
+	if i.HasFightingArts != nil {
+		p := survivor.HasFightingArts()
+		if !*i.HasFightingArts {
+			p = survivor.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFightingArtsWith) > 0 {
+		with := make([]predicate.FightingArt, 0, len(i.HasFightingArtsWith))
+		for _, w := range i.HasFightingArtsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFightingArtsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, survivor.HasFightingArtsWith(with...))
+	}
+	if i.HasSecretFightingArts != nil {
+		p := survivor.HasSecretFightingArts()
+		if !*i.HasSecretFightingArts {
+			p = survivor.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSecretFightingArtsWith) > 0 {
+		with := make([]predicate.FightingArt, 0, len(i.HasSecretFightingArtsWith))
+		for _, w := range i.HasSecretFightingArtsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSecretFightingArtsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, survivor.HasSecretFightingArtsWith(with...))
+	}
+	if i.HasDisorders != nil {
+		p := survivor.HasDisorders()
+		if !*i.HasDisorders {
+			p = survivor.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDisordersWith) > 0 {
+		with := make([]predicate.Disorder, 0, len(i.HasDisordersWith))
+		for _, w := range i.HasDisordersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDisordersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, survivor.HasDisordersWith(with...))
+	}
+	if i.HasAbilities != nil {
+		p := survivor.HasAbilities()
+		if !*i.HasAbilities {
+			p = survivor.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAbilitiesWith) > 0 {
+		with := make([]predicate.Ability, 0, len(i.HasAbilitiesWith))
+		for _, w := range i.HasAbilitiesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAbilitiesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, survivor.HasAbilitiesWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptySurvivorWhereInput
@@ -1206,3 +5759,283 @@ func (i *SurvivorWhereInput) P() (predicate.Survivor, error) {
 		return survivor.And(predicates...), nil
 	}
 }
+
+// TimelineEventWhereInput represents a where input for filtering TimelineEvent queries.
+type TimelineEventWhereInput struct {
+	Predicates []predicate.TimelineEvent  `json:"-"`
+	Not        *TimelineEventWhereInput   `json:"not,omitempty"`
+	Or         []*TimelineEventWhereInput `json:"or,omitempty"`
+	And        []*TimelineEventWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "year" field predicates.
+	Year      *int  `json:"year,omitempty"`
+	YearNEQ   *int  `json:"yearNEQ,omitempty"`
+	YearIn    []int `json:"yearIn,omitempty"`
+	YearNotIn []int `json:"yearNotIn,omitempty"`
+	YearGT    *int  `json:"yearGT,omitempty"`
+	YearGTE   *int  `json:"yearGTE,omitempty"`
+	YearLT    *int  `json:"yearLT,omitempty"`
+	YearLTE   *int  `json:"yearLTE,omitempty"`
+
+	// "type" field predicates.
+	Type      *timelineevent.Type  `json:"type,omitempty"`
+	TypeNEQ   *timelineevent.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []timelineevent.Type `json:"typeIn,omitempty"`
+	TypeNotIn []timelineevent.Type `json:"typeNotIn,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "completed" field predicates.
+	Completed    *bool `json:"completed,omitempty"`
+	CompletedNEQ *bool `json:"completedNEQ,omitempty"`
+
+	// "settlement_id" field predicates.
+	SettlementID      *int  `json:"settlementID,omitempty"`
+	SettlementIDNEQ   *int  `json:"settlementIDNEQ,omitempty"`
+	SettlementIDIn    []int `json:"settlementIDIn,omitempty"`
+	SettlementIDNotIn []int `json:"settlementIDNotIn,omitempty"`
+
+	// "settlement" edge predicates.
+	HasSettlement     *bool                   `json:"hasSettlement,omitempty"`
+	HasSettlementWith []*SettlementWhereInput `json:"hasSettlementWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TimelineEventWhereInput) AddPredicates(predicates ...predicate.TimelineEvent) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TimelineEventWhereInput filter on the TimelineEventQuery builder.
+func (i *TimelineEventWhereInput) Filter(q *TimelineEventQuery) (*TimelineEventQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTimelineEventWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyTimelineEventWhereInput is returned in case the TimelineEventWhereInput is empty.
+var ErrEmptyTimelineEventWhereInput = errors.New("ent: empty predicate TimelineEventWhereInput")
+
+// P returns a predicate for filtering timelineevents.
+// An error is returned if the input is empty or invalid.
+func (i *TimelineEventWhereInput) P() (predicate.TimelineEvent, error) {
+	var predicates []predicate.TimelineEvent
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, timelineevent.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.TimelineEvent, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, timelineevent.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.TimelineEvent, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, timelineevent.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, timelineevent.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, timelineevent.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, timelineevent.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, timelineevent.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, timelineevent.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, timelineevent.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, timelineevent.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, timelineevent.IDLTE(*i.IDLTE))
+	}
+	if i.Year != nil {
+		predicates = append(predicates, timelineevent.YearEQ(*i.Year))
+	}
+	if i.YearNEQ != nil {
+		predicates = append(predicates, timelineevent.YearNEQ(*i.YearNEQ))
+	}
+	if len(i.YearIn) > 0 {
+		predicates = append(predicates, timelineevent.YearIn(i.YearIn...))
+	}
+	if len(i.YearNotIn) > 0 {
+		predicates = append(predicates, timelineevent.YearNotIn(i.YearNotIn...))
+	}
+	if i.YearGT != nil {
+		predicates = append(predicates, timelineevent.YearGT(*i.YearGT))
+	}
+	if i.YearGTE != nil {
+		predicates = append(predicates, timelineevent.YearGTE(*i.YearGTE))
+	}
+	if i.YearLT != nil {
+		predicates = append(predicates, timelineevent.YearLT(*i.YearLT))
+	}
+	if i.YearLTE != nil {
+		predicates = append(predicates, timelineevent.YearLTE(*i.YearLTE))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, timelineevent.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, timelineevent.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, timelineevent.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, timelineevent.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, timelineevent.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, timelineevent.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, timelineevent.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, timelineevent.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, timelineevent.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, timelineevent.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, timelineevent.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, timelineevent.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, timelineevent.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, timelineevent.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, timelineevent.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, timelineevent.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, timelineevent.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Completed != nil {
+		predicates = append(predicates, timelineevent.CompletedEQ(*i.Completed))
+	}
+	if i.CompletedNEQ != nil {
+		predicates = append(predicates, timelineevent.CompletedNEQ(*i.CompletedNEQ))
+	}
+	if i.SettlementID != nil {
+		predicates = append(predicates, timelineevent.SettlementIDEQ(*i.SettlementID))
+	}
+	if i.SettlementIDNEQ != nil {
+		predicates = append(predicates, timelineevent.SettlementIDNEQ(*i.SettlementIDNEQ))
+	}
+	if len(i.SettlementIDIn) > 0 {
+		predicates = append(predicates, timelineevent.SettlementIDIn(i.SettlementIDIn...))
+	}
+	if len(i.SettlementIDNotIn) > 0 {
+		predicates = append(predicates, timelineevent.SettlementIDNotIn(i.SettlementIDNotIn...))
+	}
+
+	if i.HasSettlement != nil {
+		p := timelineevent.HasSettlement()
+		if !*i.HasSettlement {
+			p = timelineevent.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSettlementWith) > 0 {
+		with := make([]predicate.Settlement, 0, len(i.HasSettlementWith))
+		for _, w := range i.HasSettlementWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSettlementWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, timelineevent.HasSettlementWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyTimelineEventWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return timelineevent.And(predicates...), nil
+	}
+}
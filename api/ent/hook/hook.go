@@ -9,6 +9,174 @@ import (
 	"github.com/failuretoload/datamonster/ent"
 )
 
+// The AbilityFunc type is an adapter to allow the use of ordinary
+// function as Ability mutator.
+type AbilityFunc func(context.Context, *ent.AbilityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AbilityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AbilityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AbilityMutation", m)
+}
+
+// The AccessTokenFunc type is an adapter to allow the use of ordinary
+// function as AccessToken mutator.
+type AccessTokenFunc func(context.Context, *ent.AccessTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessTokenMutation", m)
+}
+
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
+// The DisorderFunc type is an adapter to allow the use of ordinary
+// function as Disorder mutator.
+type DisorderFunc func(context.Context, *ent.DisorderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DisorderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DisorderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DisorderMutation", m)
+}
+
+// The FightingArtFunc type is an adapter to allow the use of ordinary
+// function as FightingArt mutator.
+type FightingArtFunc func(context.Context, *ent.FightingArtMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FightingArtFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FightingArtMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FightingArtMutation", m)
+}
+
+// The InnovationFunc type is an adapter to allow the use of ordinary
+// function as Innovation mutator.
+type InnovationFunc func(context.Context, *ent.InnovationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InnovationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InnovationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InnovationMutation", m)
+}
+
+// The InviteFunc type is an adapter to allow the use of ordinary
+// function as Invite mutator.
+type InviteFunc func(context.Context, *ent.InviteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InviteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InviteMutation", m)
+}
+
+// The LocationFunc type is an adapter to allow the use of ordinary
+// function as Location mutator.
+type LocationFunc func(context.Context, *ent.LocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocationMutation", m)
+}
+
+// The MembershipFunc type is an adapter to allow the use of ordinary
+// function as Membership mutator.
+type MembershipFunc func(context.Context, *ent.MembershipMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MembershipFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MembershipMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MembershipMutation", m)
+}
+
+// The MilestoneFunc type is an adapter to allow the use of ordinary
+// function as Milestone mutator.
+type MilestoneFunc func(context.Context, *ent.MilestoneMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MilestoneFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MilestoneMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MilestoneMutation", m)
+}
+
+// The MonsterFunc type is an adapter to allow the use of ordinary
+// function as Monster mutator.
+type MonsterFunc func(context.Context, *ent.MonsterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MonsterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MonsterMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MonsterMutation", m)
+}
+
+// The MonsterProgressFunc type is an adapter to allow the use of ordinary
+// function as MonsterProgress mutator.
+type MonsterProgressFunc func(context.Context, *ent.MonsterProgressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MonsterProgressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MonsterProgressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MonsterProgressMutation", m)
+}
+
+// The PrincipleFunc type is an adapter to allow the use of ordinary
+// function as Principle mutator.
+type PrincipleFunc func(context.Context, *ent.PrincipleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrincipleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrincipleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrincipleMutation", m)
+}
+
+// The RecipeFunc type is an adapter to allow the use of ordinary
+// function as Recipe mutator.
+type RecipeFunc func(context.Context, *ent.RecipeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecipeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecipeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecipeMutation", m)
+}
+
 // The SettlementFunc type is an adapter to allow the use of ordinary
 // function as Settlement mutator.
 type SettlementFunc func(context.Context, *ent.SettlementMutation) (ent.Value, error)
@@ -21,6 +189,18 @@ func (f SettlementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementMutation", m)
 }
 
+// The StorageItemFunc type is an adapter to allow the use of ordinary
+// function as StorageItem mutator.
+type StorageItemFunc func(context.Context, *ent.StorageItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StorageItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StorageItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StorageItemMutation", m)
+}
+
 // The SurvivorFunc type is an adapter to allow the use of ordinary
 // function as Survivor mutator.
 type SurvivorFunc func(context.Context, *ent.SurvivorMutation) (ent.Value, error)
@@ -33,6 +213,18 @@ func (f SurvivorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SurvivorMutation", m)
 }
 
+// The TimelineEventFunc type is an adapter to allow the use of ordinary
+// function as TimelineEvent mutator.
+type TimelineEventFunc func(context.Context, *ent.TimelineEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TimelineEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TimelineEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TimelineEventMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// Innovation is the model entity for the Innovation schema.
+type Innovation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Consequences holds the value of the "consequences" field.
+	Consequences []string `json:"consequences,omitempty"`
+	// SurvivalLimitBonus holds the value of the "survival_limit_bonus" field.
+	SurvivalLimitBonus int `json:"survival_limit_bonus,omitempty"`
+	// DepartingSurvivalBonus holds the value of the "departing_survival_bonus" field.
+	DepartingSurvivalBonus int `json:"departing_survival_bonus,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InnovationQuery when eager-loading is set.
+	Edges        InnovationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InnovationEdges holds the relations/edges for other nodes in the graph.
+type InnovationEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InnovationEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Innovation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case innovation.FieldConsequences:
+			values[i] = new([]byte)
+		case innovation.FieldID, innovation.FieldSurvivalLimitBonus, innovation.FieldDepartingSurvivalBonus, innovation.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case innovation.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Innovation fields.
+func (i *Innovation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case innovation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case innovation.FieldName:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[j])
+			} else if value.Valid {
+				i.Name = value.String
+			}
+		case innovation.FieldConsequences:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field consequences", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Consequences); err != nil {
+					return fmt.Errorf("unmarshal field consequences: %w", err)
+				}
+			}
+		case innovation.FieldSurvivalLimitBonus:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field survival_limit_bonus", values[j])
+			} else if value.Valid {
+				i.SurvivalLimitBonus = int(value.Int64)
+			}
+		case innovation.FieldDepartingSurvivalBonus:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field departing_survival_bonus", values[j])
+			} else if value.Valid {
+				i.DepartingSurvivalBonus = int(value.Int64)
+			}
+		case innovation.FieldSettlementID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[j])
+			} else if value.Valid {
+				i.SettlementID = int(value.Int64)
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Innovation.
+// This includes values selected through modifiers, order, etc.
+func (i *Innovation) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the Innovation entity.
+func (i *Innovation) QuerySettlement() *SettlementQuery {
+	return NewInnovationClient(i.config).QuerySettlement(i)
+}
+
+// Update returns a builder for updating this Innovation.
+// Note that you need to call Innovation.Unwrap() before calling this method if this Innovation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Innovation) Update() *InnovationUpdateOne {
+	return NewInnovationClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Innovation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Innovation) Unwrap() *Innovation {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Innovation is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Innovation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Innovation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("name=")
+	builder.WriteString(i.Name)
+	builder.WriteString(", ")
+	builder.WriteString("consequences=")
+	builder.WriteString(fmt.Sprintf("%v", i.Consequences))
+	builder.WriteString(", ")
+	builder.WriteString("survival_limit_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", i.SurvivalLimitBonus))
+	builder.WriteString(", ")
+	builder.WriteString("departing_survival_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", i.DepartingSurvivalBonus))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Innovations is a parsable slice of Innovation.
+type Innovations []*Innovation
@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package innovation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldName, v))
+}
+
+// SurvivalLimitBonus applies equality check predicate on the "survival_limit_bonus" field. It's identical to SurvivalLimitBonusEQ.
+func SurvivalLimitBonus(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldSurvivalLimitBonus, v))
+}
+
+// DepartingSurvivalBonus applies equality check predicate on the "departing_survival_bonus" field. It's identical to DepartingSurvivalBonusEQ.
+func DepartingSurvivalBonus(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Innovation {
+	return predicate.Innovation(sql.FieldContainsFold(FieldName, v))
+}
+
+// ConsequencesIsNil applies the IsNil predicate on the "consequences" field.
+func ConsequencesIsNil() predicate.Innovation {
+	return predicate.Innovation(sql.FieldIsNull(FieldConsequences))
+}
+
+// ConsequencesNotNil applies the NotNil predicate on the "consequences" field.
+func ConsequencesNotNil() predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotNull(FieldConsequences))
+}
+
+// SurvivalLimitBonusEQ applies the EQ predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusNEQ applies the NEQ predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusNEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNEQ(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusIn applies the In predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldIn(FieldSurvivalLimitBonus, vs...))
+}
+
+// SurvivalLimitBonusNotIn applies the NotIn predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusNotIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotIn(FieldSurvivalLimitBonus, vs...))
+}
+
+// SurvivalLimitBonusGT applies the GT predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusGT(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGT(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusGTE applies the GTE predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusGTE(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGTE(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusLT applies the LT predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusLT(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLT(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusLTE applies the LTE predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusLTE(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLTE(FieldSurvivalLimitBonus, v))
+}
+
+// DepartingSurvivalBonusEQ applies the EQ predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusNEQ applies the NEQ predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusNEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusIn applies the In predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldIn(FieldDepartingSurvivalBonus, vs...))
+}
+
+// DepartingSurvivalBonusNotIn applies the NotIn predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusNotIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotIn(FieldDepartingSurvivalBonus, vs...))
+}
+
+// DepartingSurvivalBonusGT applies the GT predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusGT(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGT(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusGTE applies the GTE predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusGTE(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldGTE(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusLT applies the LT predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusLT(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLT(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusLTE applies the LTE predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusLTE(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldLTE(FieldDepartingSurvivalBonus, v))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...int) predicate.Innovation {
+	return predicate.Innovation(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// HasSettlement applies the HasEdge predicate on the "settlement" edge.
+func HasSettlement() predicate.Innovation {
+	return predicate.Innovation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementWith applies the HasEdge predicate on the "settlement" edge with a given conditions (other predicates).
+func HasSettlementWith(preds ...predicate.Settlement) predicate.Innovation {
+	return predicate.Innovation(func(s *sql.Selector) {
+		step := newSettlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Innovation) predicate.Innovation {
+	return predicate.Innovation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Innovation) predicate.Innovation {
+	return predicate.Innovation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Innovation) predicate.Innovation {
+	return predicate.Innovation(sql.NotPredicates(p))
+}
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// InnovationCreate is the builder for creating a Innovation entity.
+type InnovationCreate struct {
+	config
+	mutation *InnovationMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ic *InnovationCreate) SetName(s string) *InnovationCreate {
+	ic.mutation.SetName(s)
+	return ic
+}
+
+// SetConsequences sets the "consequences" field.
+func (ic *InnovationCreate) SetConsequences(s []string) *InnovationCreate {
+	ic.mutation.SetConsequences(s)
+	return ic
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (ic *InnovationCreate) SetSurvivalLimitBonus(i int) *InnovationCreate {
+	ic.mutation.SetSurvivalLimitBonus(i)
+	return ic
+}
+
+// SetNillableSurvivalLimitBonus sets the "survival_limit_bonus" field if the given value is not nil.
+func (ic *InnovationCreate) SetNillableSurvivalLimitBonus(i *int) *InnovationCreate {
+	if i != nil {
+		ic.SetSurvivalLimitBonus(*i)
+	}
+	return ic
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (ic *InnovationCreate) SetDepartingSurvivalBonus(i int) *InnovationCreate {
+	ic.mutation.SetDepartingSurvivalBonus(i)
+	return ic
+}
+
+// SetNillableDepartingSurvivalBonus sets the "departing_survival_bonus" field if the given value is not nil.
+func (ic *InnovationCreate) SetNillableDepartingSurvivalBonus(i *int) *InnovationCreate {
+	if i != nil {
+		ic.SetDepartingSurvivalBonus(*i)
+	}
+	return ic
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (ic *InnovationCreate) SetSettlementID(i int) *InnovationCreate {
+	ic.mutation.SetSettlementID(i)
+	return ic
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (ic *InnovationCreate) SetSettlement(s *Settlement) *InnovationCreate {
+	return ic.SetSettlementID(s.ID)
+}
+
+// Mutation returns the InnovationMutation object of the builder.
+func (ic *InnovationCreate) Mutation() *InnovationMutation {
+	return ic.mutation
+}
+
+// Save creates the Innovation in the database.
+func (ic *InnovationCreate) Save(ctx context.Context) (*Innovation, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InnovationCreate) SaveX(ctx context.Context) *Innovation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InnovationCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InnovationCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InnovationCreate) defaults() error {
+	if _, ok := ic.mutation.SurvivalLimitBonus(); !ok {
+		v := innovation.DefaultSurvivalLimitBonus
+		ic.mutation.SetSurvivalLimitBonus(v)
+	}
+	if _, ok := ic.mutation.DepartingSurvivalBonus(); !ok {
+		v := innovation.DefaultDepartingSurvivalBonus
+		ic.mutation.SetDepartingSurvivalBonus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InnovationCreate) check() error {
+	if _, ok := ic.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Innovation.name"`)}
+	}
+	if v, ok := ic.mutation.Name(); ok {
+		if err := innovation.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Innovation.name": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.SurvivalLimitBonus(); !ok {
+		return &ValidationError{Name: "survival_limit_bonus", err: errors.New(`ent: missing required field "Innovation.survival_limit_bonus"`)}
+	}
+	if v, ok := ic.mutation.SurvivalLimitBonus(); ok {
+		if err := innovation.SurvivalLimitBonusValidator(v); err != nil {
+			return &ValidationError{Name: "survival_limit_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.survival_limit_bonus": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.DepartingSurvivalBonus(); !ok {
+		return &ValidationError{Name: "departing_survival_bonus", err: errors.New(`ent: missing required field "Innovation.departing_survival_bonus"`)}
+	}
+	if v, ok := ic.mutation.DepartingSurvivalBonus(); ok {
+		if err := innovation.DepartingSurvivalBonusValidator(v); err != nil {
+			return &ValidationError{Name: "departing_survival_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.departing_survival_bonus": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "Innovation.settlement_id"`)}
+	}
+	if len(ic.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "Innovation.settlement"`)}
+	}
+	return nil
+}
+
+func (ic *InnovationCreate) sqlSave(ctx context.Context) (*Innovation, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InnovationCreate) createSpec() (*Innovation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Innovation{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(innovation.Table, sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Name(); ok {
+		_spec.SetField(innovation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ic.mutation.Consequences(); ok {
+		_spec.SetField(innovation.FieldConsequences, field.TypeJSON, value)
+		_node.Consequences = value
+	}
+	if value, ok := ic.mutation.SurvivalLimitBonus(); ok {
+		_spec.SetField(innovation.FieldSurvivalLimitBonus, field.TypeInt, value)
+		_node.SurvivalLimitBonus = value
+	}
+	if value, ok := ic.mutation.DepartingSurvivalBonus(); ok {
+		_spec.SetField(innovation.FieldDepartingSurvivalBonus, field.TypeInt, value)
+		_node.DepartingSurvivalBonus = value
+	}
+	if nodes := ic.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   innovation.SettlementTable,
+			Columns: []string{innovation.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InnovationCreateBulk is the builder for creating many Innovation entities in bulk.
+type InnovationCreateBulk struct {
+	config
+	err      error
+	builders []*InnovationCreate
+}
+
+// Save creates the Innovation entities in the database.
+func (icb *InnovationCreateBulk) Save(ctx context.Context) ([]*Innovation, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Innovation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InnovationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InnovationCreateBulk) SaveX(ctx context.Context) []*Innovation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InnovationCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InnovationCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// InnovationUpdate is the builder for updating Innovation entities.
+type InnovationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InnovationMutation
+}
+
+// Where appends a list predicates to the InnovationUpdate builder.
+func (iu *InnovationUpdate) Where(ps ...predicate.Innovation) *InnovationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetConsequences sets the "consequences" field.
+func (iu *InnovationUpdate) SetConsequences(s []string) *InnovationUpdate {
+	iu.mutation.SetConsequences(s)
+	return iu
+}
+
+// AppendConsequences appends s to the "consequences" field.
+func (iu *InnovationUpdate) AppendConsequences(s []string) *InnovationUpdate {
+	iu.mutation.AppendConsequences(s)
+	return iu
+}
+
+// ClearConsequences clears the value of the "consequences" field.
+func (iu *InnovationUpdate) ClearConsequences() *InnovationUpdate {
+	iu.mutation.ClearConsequences()
+	return iu
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (iu *InnovationUpdate) SetSurvivalLimitBonus(i int) *InnovationUpdate {
+	iu.mutation.ResetSurvivalLimitBonus()
+	iu.mutation.SetSurvivalLimitBonus(i)
+	return iu
+}
+
+// SetNillableSurvivalLimitBonus sets the "survival_limit_bonus" field if the given value is not nil.
+func (iu *InnovationUpdate) SetNillableSurvivalLimitBonus(i *int) *InnovationUpdate {
+	if i != nil {
+		iu.SetSurvivalLimitBonus(*i)
+	}
+	return iu
+}
+
+// AddSurvivalLimitBonus adds i to the "survival_limit_bonus" field.
+func (iu *InnovationUpdate) AddSurvivalLimitBonus(i int) *InnovationUpdate {
+	iu.mutation.AddSurvivalLimitBonus(i)
+	return iu
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (iu *InnovationUpdate) SetDepartingSurvivalBonus(i int) *InnovationUpdate {
+	iu.mutation.ResetDepartingSurvivalBonus()
+	iu.mutation.SetDepartingSurvivalBonus(i)
+	return iu
+}
+
+// SetNillableDepartingSurvivalBonus sets the "departing_survival_bonus" field if the given value is not nil.
+func (iu *InnovationUpdate) SetNillableDepartingSurvivalBonus(i *int) *InnovationUpdate {
+	if i != nil {
+		iu.SetDepartingSurvivalBonus(*i)
+	}
+	return iu
+}
+
+// AddDepartingSurvivalBonus adds i to the "departing_survival_bonus" field.
+func (iu *InnovationUpdate) AddDepartingSurvivalBonus(i int) *InnovationUpdate {
+	iu.mutation.AddDepartingSurvivalBonus(i)
+	return iu
+}
+
+// Mutation returns the InnovationMutation object of the builder.
+func (iu *InnovationUpdate) Mutation() *InnovationMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InnovationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InnovationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InnovationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InnovationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InnovationUpdate) check() error {
+	if v, ok := iu.mutation.SurvivalLimitBonus(); ok {
+		if err := innovation.SurvivalLimitBonusValidator(v); err != nil {
+			return &ValidationError{Name: "survival_limit_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.survival_limit_bonus": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.DepartingSurvivalBonus(); ok {
+		if err := innovation.DepartingSurvivalBonusValidator(v); err != nil {
+			return &ValidationError{Name: "departing_survival_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.departing_survival_bonus": %w`, err)}
+		}
+	}
+	if iu.mutation.SettlementCleared() && len(iu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Innovation.settlement"`)
+	}
+	return nil
+}
+
+func (iu *InnovationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(innovation.Table, innovation.Columns, sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Consequences(); ok {
+		_spec.SetField(innovation.FieldConsequences, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedConsequences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, innovation.FieldConsequences, value)
+		})
+	}
+	if iu.mutation.ConsequencesCleared() {
+		_spec.ClearField(innovation.FieldConsequences, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.SurvivalLimitBonus(); ok {
+		_spec.SetField(innovation.FieldSurvivalLimitBonus, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedSurvivalLimitBonus(); ok {
+		_spec.AddField(innovation.FieldSurvivalLimitBonus, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.DepartingSurvivalBonus(); ok {
+		_spec.SetField(innovation.FieldDepartingSurvivalBonus, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedDepartingSurvivalBonus(); ok {
+		_spec.AddField(innovation.FieldDepartingSurvivalBonus, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{innovation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InnovationUpdateOne is the builder for updating a single Innovation entity.
+type InnovationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InnovationMutation
+}
+
+// SetConsequences sets the "consequences" field.
+func (iuo *InnovationUpdateOne) SetConsequences(s []string) *InnovationUpdateOne {
+	iuo.mutation.SetConsequences(s)
+	return iuo
+}
+
+// AppendConsequences appends s to the "consequences" field.
+func (iuo *InnovationUpdateOne) AppendConsequences(s []string) *InnovationUpdateOne {
+	iuo.mutation.AppendConsequences(s)
+	return iuo
+}
+
+// ClearConsequences clears the value of the "consequences" field.
+func (iuo *InnovationUpdateOne) ClearConsequences() *InnovationUpdateOne {
+	iuo.mutation.ClearConsequences()
+	return iuo
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (iuo *InnovationUpdateOne) SetSurvivalLimitBonus(i int) *InnovationUpdateOne {
+	iuo.mutation.ResetSurvivalLimitBonus()
+	iuo.mutation.SetSurvivalLimitBonus(i)
+	return iuo
+}
+
+// SetNillableSurvivalLimitBonus sets the "survival_limit_bonus" field if the given value is not nil.
+func (iuo *InnovationUpdateOne) SetNillableSurvivalLimitBonus(i *int) *InnovationUpdateOne {
+	if i != nil {
+		iuo.SetSurvivalLimitBonus(*i)
+	}
+	return iuo
+}
+
+// AddSurvivalLimitBonus adds i to the "survival_limit_bonus" field.
+func (iuo *InnovationUpdateOne) AddSurvivalLimitBonus(i int) *InnovationUpdateOne {
+	iuo.mutation.AddSurvivalLimitBonus(i)
+	return iuo
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (iuo *InnovationUpdateOne) SetDepartingSurvivalBonus(i int) *InnovationUpdateOne {
+	iuo.mutation.ResetDepartingSurvivalBonus()
+	iuo.mutation.SetDepartingSurvivalBonus(i)
+	return iuo
+}
+
+// SetNillableDepartingSurvivalBonus sets the "departing_survival_bonus" field if the given value is not nil.
+func (iuo *InnovationUpdateOne) SetNillableDepartingSurvivalBonus(i *int) *InnovationUpdateOne {
+	if i != nil {
+		iuo.SetDepartingSurvivalBonus(*i)
+	}
+	return iuo
+}
+
+// AddDepartingSurvivalBonus adds i to the "departing_survival_bonus" field.
+func (iuo *InnovationUpdateOne) AddDepartingSurvivalBonus(i int) *InnovationUpdateOne {
+	iuo.mutation.AddDepartingSurvivalBonus(i)
+	return iuo
+}
+
+// Mutation returns the InnovationMutation object of the builder.
+func (iuo *InnovationUpdateOne) Mutation() *InnovationMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InnovationUpdate builder.
+func (iuo *InnovationUpdateOne) Where(ps ...predicate.Innovation) *InnovationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InnovationUpdateOne) Select(field string, fields ...string) *InnovationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Innovation entity.
+func (iuo *InnovationUpdateOne) Save(ctx context.Context) (*Innovation, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InnovationUpdateOne) SaveX(ctx context.Context) *Innovation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InnovationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InnovationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InnovationUpdateOne) check() error {
+	if v, ok := iuo.mutation.SurvivalLimitBonus(); ok {
+		if err := innovation.SurvivalLimitBonusValidator(v); err != nil {
+			return &ValidationError{Name: "survival_limit_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.survival_limit_bonus": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.DepartingSurvivalBonus(); ok {
+		if err := innovation.DepartingSurvivalBonusValidator(v); err != nil {
+			return &ValidationError{Name: "departing_survival_bonus", err: fmt.Errorf(`ent: validator failed for field "Innovation.departing_survival_bonus": %w`, err)}
+		}
+	}
+	if iuo.mutation.SettlementCleared() && len(iuo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Innovation.settlement"`)
+	}
+	return nil
+}
+
+func (iuo *InnovationUpdateOne) sqlSave(ctx context.Context) (_node *Innovation, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(innovation.Table, innovation.Columns, sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Innovation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, innovation.FieldID)
+		for _, f := range fields {
+			if !innovation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != innovation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Consequences(); ok {
+		_spec.SetField(innovation.FieldConsequences, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedConsequences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, innovation.FieldConsequences, value)
+		})
+	}
+	if iuo.mutation.ConsequencesCleared() {
+		_spec.ClearField(innovation.FieldConsequences, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.SurvivalLimitBonus(); ok {
+		_spec.SetField(innovation.FieldSurvivalLimitBonus, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedSurvivalLimitBonus(); ok {
+		_spec.AddField(innovation.FieldSurvivalLimitBonus, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.DepartingSurvivalBonus(); ok {
+		_spec.SetField(innovation.FieldDepartingSurvivalBonus, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedDepartingSurvivalBonus(); ok {
+		_spec.AddField(innovation.FieldDepartingSurvivalBonus, field.TypeInt, value)
+	}
+	_node = &Innovation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{innovation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
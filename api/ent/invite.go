@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// Invite is the model entity for the Invite schema.
+type Invite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// Role holds the value of the "role" field.
+	Role invite.Role `json:"role,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InviteQuery when eager-loading is set.
+	Edges        InviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InviteEdges holds the relations/edges for other nodes in the graph.
+type InviteEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InviteEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invite.FieldID, invite.FieldMaxUses, invite.FieldUses, invite.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case invite.FieldCodeHash, invite.FieldRole, invite.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case invite.FieldExpiresAt, invite.FieldRevokedAt, invite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invite fields.
+func (i *Invite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invite.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invite.FieldCodeHash:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[j])
+			} else if value.Valid {
+				i.CodeHash = value.String
+			}
+		case invite.FieldRole:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[j])
+			} else if value.Valid {
+				i.Role = invite.Role(value.String)
+			}
+		case invite.FieldMaxUses:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[j])
+			} else if value.Valid {
+				i.MaxUses = int(value.Int64)
+			}
+		case invite.FieldUses:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[j])
+			} else if value.Valid {
+				i.Uses = int(value.Int64)
+			}
+		case invite.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case invite.FieldRevokedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[j])
+			} else if value.Valid {
+				i.RevokedAt = new(time.Time)
+				*i.RevokedAt = value.Time
+			}
+		case invite.FieldCreatedBy:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[j])
+			} else if value.Valid {
+				i.CreatedBy = value.String
+			}
+		case invite.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case invite.FieldSettlementID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[j])
+			} else if value.Valid {
+				i.SettlementID = int(value.Int64)
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invite.
+// This includes values selected through modifiers, order, etc.
+func (i *Invite) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the Invite entity.
+func (i *Invite) QuerySettlement() *SettlementQuery {
+	return NewInviteClient(i.config).QuerySettlement(i)
+}
+
+// Update returns a builder for updating this Invite.
+// Note that you need to call Invite.Unwrap() before calling this method if this Invite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invite) Update() *InviteUpdateOne {
+	return NewInviteClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invite) Unwrap() *Invite {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invite is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invite) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", i.Role))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", i.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", i.Uses))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(i.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invites is a parsable slice of Invite.
+type Invites []*Invite
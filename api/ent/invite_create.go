@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (ic *InviteCreate) SetCodeHash(s string) *InviteCreate {
+	ic.mutation.SetCodeHash(s)
+	return ic
+}
+
+// SetRole sets the "role" field.
+func (ic *InviteCreate) SetRole(i invite.Role) *InviteCreate {
+	ic.mutation.SetRole(i)
+	return ic
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRole(i *invite.Role) *InviteCreate {
+	if i != nil {
+		ic.SetRole(*i)
+	}
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InviteCreate) SetMaxUses(i int) *InviteCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableMaxUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUses sets the "uses" field.
+func (ic *InviteCreate) SetUses(i int) *InviteCreate {
+	ic.mutation.SetUses(i)
+	return ic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetUses(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InviteCreate) SetExpiresAt(t time.Time) *InviteCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ic *InviteCreate) SetRevokedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetRevokedAt(t)
+	return ic
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRevokedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetRevokedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ic *InviteCreate) SetCreatedBy(s string) *InviteCreate {
+	ic.mutation.SetCreatedBy(s)
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InviteCreate) SetCreatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (ic *InviteCreate) SetSettlementID(i int) *InviteCreate {
+	ic.mutation.SetSettlementID(i)
+	return ic
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (ic *InviteCreate) SetSettlement(s *Settlement) *InviteCreate {
+	return ic.SetSettlementID(s.ID)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (ic *InviteCreate) Mutation() *InviteMutation {
+	return ic.mutation
+}
+
+// Save creates the Invite in the database.
+func (ic *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InviteCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InviteCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InviteCreate) defaults() error {
+	if _, ok := ic.mutation.Role(); !ok {
+		v := invite.DefaultRole
+		ic.mutation.SetRole(v)
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		v := invite.DefaultMaxUses
+		ic.mutation.SetMaxUses(v)
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		v := invite.DefaultUses
+		ic.mutation.SetUses(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if invite.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invite.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := invite.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InviteCreate) check() error {
+	if _, ok := ic.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "Invite.code_hash"`)}
+	}
+	if _, ok := ic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Invite.role"`)}
+	}
+	if v, ok := ic.mutation.Role(); ok {
+		if err := invite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Invite.role": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invite.max_uses"`)}
+	}
+	if v, ok := ic.mutation.MaxUses(); ok {
+		if err := invite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "Invite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invite.uses"`)}
+	}
+	if v, ok := ic.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Invite.expires_at"`)}
+	}
+	if _, ok := ic.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Invite.created_by"`)}
+	}
+	if v, ok := ic.mutation.CreatedBy(); ok {
+		if err := invite.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Invite.created_by": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invite.created_at"`)}
+	}
+	if _, ok := ic.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "Invite.settlement_id"`)}
+	}
+	if len(ic.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "Invite.settlement"`)}
+	}
+	return nil
+}
+
+func (ic *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invite.Table, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.CodeHash(); ok {
+		_spec.SetField(invite.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := ic.mutation.Role(); ok {
+		_spec.SetField(invite.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.SetField(invite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := ic.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.RevokedAt(); ok {
+		_spec.SetField(invite.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := ic.mutation.CreatedBy(); ok {
+		_spec.SetField(invite.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.SettlementTable,
+			Columns: []string{invite.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	err      error
+	builders []*InviteCreate
+}
+
+// Save creates the Invite entities in the database.
+func (icb *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invite, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iu *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUses sets the "uses" field.
+func (iu *InviteUpdate) SetUses(i int) *InviteUpdate {
+	iu.mutation.ResetUses()
+	iu.mutation.SetUses(i)
+	return iu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableUses(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetUses(*i)
+	}
+	return iu
+}
+
+// AddUses adds i to the "uses" field.
+func (iu *InviteUpdate) AddUses(i int) *InviteUpdate {
+	iu.mutation.AddUses(i)
+	return iu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iu *InviteUpdate) SetRevokedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetRevokedAt(t)
+	return iu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableRevokedAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetRevokedAt(*t)
+	}
+	return iu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iu *InviteUpdate) ClearRevokedAt() *InviteUpdate {
+	iu.mutation.ClearRevokedAt()
+	return iu
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iu *InviteUpdate) Mutation() *InviteMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InviteUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InviteUpdate) check() error {
+	if v, ok := iu.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	if iu.mutation.SettlementCleared() && len(iu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.settlement"`)
+	}
+	return nil
+}
+
+func (iu *InviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.RevokedAt(); ok {
+		_spec.SetField(invite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invite.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetUses sets the "uses" field.
+func (iuo *InviteUpdateOne) SetUses(i int) *InviteUpdateOne {
+	iuo.mutation.ResetUses()
+	iuo.mutation.SetUses(i)
+	return iuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableUses(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetUses(*i)
+	}
+	return iuo
+}
+
+// AddUses adds i to the "uses" field.
+func (iuo *InviteUpdateOne) AddUses(i int) *InviteUpdateOne {
+	iuo.mutation.AddUses(i)
+	return iuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iuo *InviteUpdateOne) SetRevokedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetRevokedAt(t)
+	return iuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableRevokedAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetRevokedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iuo *InviteUpdateOne) ClearRevokedAt() *InviteUpdateOne {
+	iuo.mutation.ClearRevokedAt()
+	return iuo
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iuo *InviteUpdateOne) Mutation() *InviteMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iuo *InviteUpdateOne) Where(ps ...predicate.Invite) *InviteUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (iuo *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InviteUpdateOne) check() error {
+	if v, ok := iuo.mutation.Uses(); ok {
+		if err := invite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "Invite.uses": %w`, err)}
+		}
+	}
+	if iuo.mutation.SettlementCleared() && len(iuo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invite.settlement"`)
+	}
+	return nil
+}
+
+func (iuo *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invite.Table, invite.Columns, sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Uses(); ok {
+		_spec.SetField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUses(); ok {
+		_spec.AddField(invite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invite.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &Invite{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
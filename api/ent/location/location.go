@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package location
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the location type in the database.
+	Label = "location"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeRecipes holds the string denoting the recipes edge name in mutations.
+	EdgeRecipes = "recipes"
+	// EdgeSettlements holds the string denoting the settlements edge name in mutations.
+	EdgeSettlements = "settlements"
+	// Table holds the table name of the location in the database.
+	Table = "locations"
+	// RecipesTable is the table that holds the recipes relation/edge.
+	RecipesTable = "recipes"
+	// RecipesInverseTable is the table name for the Recipe entity.
+	// It exists in this package in order to avoid circular dependency with the "recipe" package.
+	RecipesInverseTable = "recipes"
+	// RecipesColumn is the table column denoting the recipes relation/edge.
+	RecipesColumn = "location_id"
+	// SettlementsTable is the table that holds the settlements relation/edge. The primary key declared below.
+	SettlementsTable = "settlement_locations"
+	// SettlementsInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementsInverseTable = "settlements"
+)
+
+// Columns holds all SQL columns for location fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+var (
+	// SettlementsPrimaryKey and SettlementsColumn2 are the table columns denoting the
+	// primary key for the settlements relation (M2M).
+	SettlementsPrimaryKey = []string{"settlement_id", "location_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Location queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRecipesCount orders the results by recipes count.
+func ByRecipesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecipesStep(), opts...)
+	}
+}
+
+// ByRecipes orders the results by recipes terms.
+func ByRecipes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySettlementsCount orders the results by settlements count.
+func BySettlementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSettlementsStep(), opts...)
+	}
+}
+
+// BySettlements orders the results by settlements terms.
+func BySettlements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRecipesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecipesTable, RecipesColumn),
+	)
+}
+func newSettlementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SettlementsTable, SettlementsPrimaryKey...),
+	)
+}
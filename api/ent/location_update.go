@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// LocationUpdate is the builder for updating Location entities.
+type LocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LocationMutation
+}
+
+// Where appends a list predicates to the LocationUpdate builder.
+func (lu *LocationUpdate) Where(ps ...predicate.Location) *LocationUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetName sets the "name" field.
+func (lu *LocationUpdate) SetName(s string) *LocationUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableName(s *string) *LocationUpdate {
+	if s != nil {
+		lu.SetName(*s)
+	}
+	return lu
+}
+
+// AddRecipeIDs adds the "recipes" edge to the Recipe entity by IDs.
+func (lu *LocationUpdate) AddRecipeIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddRecipeIDs(ids...)
+	return lu
+}
+
+// AddRecipes adds the "recipes" edges to the Recipe entity.
+func (lu *LocationUpdate) AddRecipes(r ...*Recipe) *LocationUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return lu.AddRecipeIDs(ids...)
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by IDs.
+func (lu *LocationUpdate) AddSettlementIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddSettlementIDs(ids...)
+	return lu
+}
+
+// AddSettlements adds the "settlements" edges to the Settlement entity.
+func (lu *LocationUpdate) AddSettlements(s ...*Settlement) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.AddSettlementIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (lu *LocationUpdate) Mutation() *LocationMutation {
+	return lu.mutation
+}
+
+// ClearRecipes clears all "recipes" edges to the Recipe entity.
+func (lu *LocationUpdate) ClearRecipes() *LocationUpdate {
+	lu.mutation.ClearRecipes()
+	return lu
+}
+
+// RemoveRecipeIDs removes the "recipes" edge to Recipe entities by IDs.
+func (lu *LocationUpdate) RemoveRecipeIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveRecipeIDs(ids...)
+	return lu
+}
+
+// RemoveRecipes removes "recipes" edges to Recipe entities.
+func (lu *LocationUpdate) RemoveRecipes(r ...*Recipe) *LocationUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return lu.RemoveRecipeIDs(ids...)
+}
+
+// ClearSettlements clears all "settlements" edges to the Settlement entity.
+func (lu *LocationUpdate) ClearSettlements() *LocationUpdate {
+	lu.mutation.ClearSettlements()
+	return lu
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to Settlement entities by IDs.
+func (lu *LocationUpdate) RemoveSettlementIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveSettlementIDs(ids...)
+	return lu
+}
+
+// RemoveSettlements removes "settlements" edges to Settlement entities.
+func (lu *LocationUpdate) RemoveSettlements(s ...*Settlement) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.RemoveSettlementIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LocationUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LocationUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LocationUpdate) check() error {
+	if v, ok := lu.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(location.Table, location.Columns, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+	}
+	if lu.mutation.RecipesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedRecipesIDs(); len(nodes) > 0 && !lu.mutation.RecipesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RecipesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedSettlementsIDs(); len(nodes) > 0 && !lu.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.SettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LocationUpdateOne is the builder for updating a single Location entity.
+type LocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LocationMutation
+}
+
+// SetName sets the "name" field.
+func (luo *LocationUpdateOne) SetName(s string) *LocationUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableName(s *string) *LocationUpdateOne {
+	if s != nil {
+		luo.SetName(*s)
+	}
+	return luo
+}
+
+// AddRecipeIDs adds the "recipes" edge to the Recipe entity by IDs.
+func (luo *LocationUpdateOne) AddRecipeIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddRecipeIDs(ids...)
+	return luo
+}
+
+// AddRecipes adds the "recipes" edges to the Recipe entity.
+func (luo *LocationUpdateOne) AddRecipes(r ...*Recipe) *LocationUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return luo.AddRecipeIDs(ids...)
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by IDs.
+func (luo *LocationUpdateOne) AddSettlementIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddSettlementIDs(ids...)
+	return luo
+}
+
+// AddSettlements adds the "settlements" edges to the Settlement entity.
+func (luo *LocationUpdateOne) AddSettlements(s ...*Settlement) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddSettlementIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (luo *LocationUpdateOne) Mutation() *LocationMutation {
+	return luo.mutation
+}
+
+// ClearRecipes clears all "recipes" edges to the Recipe entity.
+func (luo *LocationUpdateOne) ClearRecipes() *LocationUpdateOne {
+	luo.mutation.ClearRecipes()
+	return luo
+}
+
+// RemoveRecipeIDs removes the "recipes" edge to Recipe entities by IDs.
+func (luo *LocationUpdateOne) RemoveRecipeIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveRecipeIDs(ids...)
+	return luo
+}
+
+// RemoveRecipes removes "recipes" edges to Recipe entities.
+func (luo *LocationUpdateOne) RemoveRecipes(r ...*Recipe) *LocationUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return luo.RemoveRecipeIDs(ids...)
+}
+
+// ClearSettlements clears all "settlements" edges to the Settlement entity.
+func (luo *LocationUpdateOne) ClearSettlements() *LocationUpdateOne {
+	luo.mutation.ClearSettlements()
+	return luo
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to Settlement entities by IDs.
+func (luo *LocationUpdateOne) RemoveSettlementIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveSettlementIDs(ids...)
+	return luo
+}
+
+// RemoveSettlements removes "settlements" edges to Settlement entities.
+func (luo *LocationUpdateOne) RemoveSettlements(s ...*Settlement) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveSettlementIDs(ids...)
+}
+
+// Where appends a list predicates to the LocationUpdate builder.
+func (luo *LocationUpdateOne) Where(ps ...predicate.Location) *LocationUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LocationUpdateOne) Select(field string, fields ...string) *LocationUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Location entity.
+func (luo *LocationUpdateOne) Save(ctx context.Context) (*Location, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LocationUpdateOne) SaveX(ctx context.Context) *Location {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LocationUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LocationUpdateOne) check() error {
+	if v, ok := luo.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(location.Table, location.Columns, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Location.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, location.FieldID)
+		for _, f := range fields {
+			if !location.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != location.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+	}
+	if luo.mutation.RecipesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedRecipesIDs(); len(nodes) > 0 && !luo.mutation.RecipesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RecipesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.RecipesTable,
+			Columns: []string{location.RecipesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedSettlementsIDs(); len(nodes) > 0 && !luo.mutation.SettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.SettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   location.SettlementsTable,
+			Columns: location.SettlementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Location{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// MembershipUpdate is the builder for updating Membership entities.
+type MembershipUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (mu *MembershipUpdate) Where(ps ...predicate.Membership) *MembershipUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUserID sets the "user_id" field.
+func (mu *MembershipUpdate) SetUserID(s string) *MembershipUpdate {
+	mu.mutation.SetUserID(s)
+	return mu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mu *MembershipUpdate) SetNillableUserID(s *string) *MembershipUpdate {
+	if s != nil {
+		mu.SetUserID(*s)
+	}
+	return mu
+}
+
+// SetRole sets the "role" field.
+func (mu *MembershipUpdate) SetRole(m membership.Role) *MembershipUpdate {
+	mu.mutation.SetRole(m)
+	return mu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (mu *MembershipUpdate) SetNillableRole(m *membership.Role) *MembershipUpdate {
+	if m != nil {
+		mu.SetRole(*m)
+	}
+	return mu
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (mu *MembershipUpdate) SetSettlementID(i int) *MembershipUpdate {
+	mu.mutation.SetSettlementID(i)
+	return mu
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (mu *MembershipUpdate) SetNillableSettlementID(i *int) *MembershipUpdate {
+	if i != nil {
+		mu.SetSettlementID(*i)
+	}
+	return mu
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (mu *MembershipUpdate) SetSettlement(s *Settlement) *MembershipUpdate {
+	return mu.SetSettlementID(s.ID)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (mu *MembershipUpdate) Mutation() *MembershipMutation {
+	return mu.mutation
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (mu *MembershipUpdate) ClearSettlement() *MembershipUpdate {
+	mu.mutation.ClearSettlement()
+	return mu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MembershipUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MembershipUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MembershipUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MembershipUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MembershipUpdate) check() error {
+	if v, ok := mu.mutation.UserID(); ok {
+		if err := membership.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Membership.user_id": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if mu.mutation.SettlementCleared() && len(mu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.settlement"`)
+	}
+	return nil
+}
+
+func (mu *MembershipUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UserID(); ok {
+		_spec.SetField(membership.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if mu.mutation.SettlementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.SettlementTable,
+			Columns: []string{membership.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.SettlementTable,
+			Columns: []string{membership.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MembershipUpdateOne is the builder for updating a single Membership entity.
+type MembershipUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MembershipMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (muo *MembershipUpdateOne) SetUserID(s string) *MembershipUpdateOne {
+	muo.mutation.SetUserID(s)
+	return muo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (muo *MembershipUpdateOne) SetNillableUserID(s *string) *MembershipUpdateOne {
+	if s != nil {
+		muo.SetUserID(*s)
+	}
+	return muo
+}
+
+// SetRole sets the "role" field.
+func (muo *MembershipUpdateOne) SetRole(m membership.Role) *MembershipUpdateOne {
+	muo.mutation.SetRole(m)
+	return muo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (muo *MembershipUpdateOne) SetNillableRole(m *membership.Role) *MembershipUpdateOne {
+	if m != nil {
+		muo.SetRole(*m)
+	}
+	return muo
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (muo *MembershipUpdateOne) SetSettlementID(i int) *MembershipUpdateOne {
+	muo.mutation.SetSettlementID(i)
+	return muo
+}
+
+// SetNillableSettlementID sets the "settlement_id" field if the given value is not nil.
+func (muo *MembershipUpdateOne) SetNillableSettlementID(i *int) *MembershipUpdateOne {
+	if i != nil {
+		muo.SetSettlementID(*i)
+	}
+	return muo
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (muo *MembershipUpdateOne) SetSettlement(s *Settlement) *MembershipUpdateOne {
+	return muo.SetSettlementID(s.ID)
+}
+
+// Mutation returns the MembershipMutation object of the builder.
+func (muo *MembershipUpdateOne) Mutation() *MembershipMutation {
+	return muo.mutation
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (muo *MembershipUpdateOne) ClearSettlement() *MembershipUpdateOne {
+	muo.mutation.ClearSettlement()
+	return muo
+}
+
+// Where appends a list predicates to the MembershipUpdate builder.
+func (muo *MembershipUpdateOne) Where(ps ...predicate.Membership) *MembershipUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MembershipUpdateOne) Select(field string, fields ...string) *MembershipUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Membership entity.
+func (muo *MembershipUpdateOne) Save(ctx context.Context) (*Membership, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MembershipUpdateOne) SaveX(ctx context.Context) *Membership {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MembershipUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MembershipUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MembershipUpdateOne) check() error {
+	if v, ok := muo.mutation.UserID(); ok {
+		if err := membership.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Membership.user_id": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Role(); ok {
+		if err := membership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Membership.role": %w`, err)}
+		}
+	}
+	if muo.mutation.SettlementCleared() && len(muo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Membership.settlement"`)
+	}
+	return nil
+}
+
+func (muo *MembershipUpdateOne) sqlSave(ctx context.Context) (_node *Membership, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(membership.Table, membership.Columns, sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Membership.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, membership.FieldID)
+		for _, f := range fields {
+			if !membership.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != membership.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UserID(); ok {
+		_spec.SetField(membership.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Role(); ok {
+		_spec.SetField(membership.FieldRole, field.TypeEnum, value)
+	}
+	if muo.mutation.SettlementCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.SettlementTable,
+			Columns: []string{membership.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   membership.SettlementTable,
+			Columns: []string{membership.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Membership{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{membership.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
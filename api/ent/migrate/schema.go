@@ -8,15 +8,339 @@ import (
 )
 
 var (
+	// AbilitiesColumns holds the columns for the "abilities" table.
+	AbilitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"ability", "impairment"}},
+		{Name: "rules", Type: field.TypeString, Size: 2147483647},
+		{Name: "modifiers", Type: field.TypeJSON, Nullable: true},
+	}
+	// AbilitiesTable holds the schema information for the "abilities" table.
+	AbilitiesTable = &schema.Table{
+		Name:       "abilities",
+		Columns:    AbilitiesColumns,
+		PrimaryKey: []*schema.Column{AbilitiesColumns[0]},
+	}
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"read", "read_write"}, Default: "read"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[1]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore"}},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "settlement_id", Type: field.TypeInt, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_logs_settlements_audit_log",
+				Columns:    []*schema.Column{AuditLogsColumns[8]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_settlement_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[8], AuditLogsColumns[7]},
+			},
+		},
+	}
+	// DisordersColumns holds the columns for the "disorders" table.
+	DisordersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "rules", Type: field.TypeString, Size: 2147483647},
+	}
+	// DisordersTable holds the schema information for the "disorders" table.
+	DisordersTable = &schema.Table{
+		Name:       "disorders",
+		Columns:    DisordersColumns,
+		PrimaryKey: []*schema.Column{DisordersColumns[0]},
+	}
+	// FightingArtsColumns holds the columns for the "fighting_arts" table.
+	FightingArtsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "rules", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeBool, Default: false},
+	}
+	// FightingArtsTable holds the schema information for the "fighting_arts" table.
+	FightingArtsTable = &schema.Table{
+		Name:       "fighting_arts",
+		Columns:    FightingArtsColumns,
+		PrimaryKey: []*schema.Column{FightingArtsColumns[0]},
+	}
+	// InnovationsColumns holds the columns for the "innovations" table.
+	InnovationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "consequences", Type: field.TypeJSON, Nullable: true},
+		{Name: "survival_limit_bonus", Type: field.TypeInt, Default: 0},
+		{Name: "departing_survival_bonus", Type: field.TypeInt, Default: 0},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// InnovationsTable holds the schema information for the "innovations" table.
+	InnovationsTable = &schema.Table{
+		Name:       "innovations",
+		Columns:    InnovationsColumns,
+		PrimaryKey: []*schema.Column{InnovationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "innovations_settlements_innovations",
+				Columns:    []*schema.Column{InnovationsColumns[5]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "innovation_settlement_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{InnovationsColumns[5], InnovationsColumns[1]},
+			},
+		},
+	}
+	// InvitesColumns holds the columns for the "invites" table.
+	InvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "editor", "viewer"}, Default: "viewer"},
+		{Name: "max_uses", Type: field.TypeInt, Default: 1},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// InvitesTable holds the schema information for the "invites" table.
+	InvitesTable = &schema.Table{
+		Name:       "invites",
+		Columns:    InvitesColumns,
+		PrimaryKey: []*schema.Column{InvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invites_settlements_invites",
+				Columns:    []*schema.Column{InvitesColumns[9]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// LocationsColumns holds the columns for the "locations" table.
+	LocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+	}
+	// LocationsTable holds the schema information for the "locations" table.
+	LocationsTable = &schema.Table{
+		Name:       "locations",
+		Columns:    LocationsColumns,
+		PrimaryKey: []*schema.Column{LocationsColumns[0]},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "editor", "viewer"}, Default: "viewer"},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_settlements_members",
+				Columns:    []*schema.Column{MembershipsColumns[3]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_settlement_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[3], MembershipsColumns[1]},
+			},
+		},
+	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeEnum, Enums: []string{"first_child_born", "first_death", "population_15", "five_innovations", "population_0"}},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_settlements_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[4]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_settlement_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{MilestonesColumns[4], MilestonesColumns[1]},
+			},
+		},
+	}
+	// MonstersColumns holds the columns for the "monsters" table.
+	MonstersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"quarry", "nemesis"}},
+		{Name: "levels", Type: field.TypeInt},
+	}
+	// MonstersTable holds the schema information for the "monsters" table.
+	MonstersTable = &schema.Table{
+		Name:       "monsters",
+		Columns:    MonstersColumns,
+		PrimaryKey: []*schema.Column{MonstersColumns[0]},
+	}
+	// MonsterProgressesColumns holds the columns for the "monster_progresses" table.
+	MonsterProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "unlocked_year", Type: field.TypeInt},
+		{Name: "defeats", Type: field.TypeJSON, Nullable: true},
+		{Name: "monster_id", Type: field.TypeInt},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// MonsterProgressesTable holds the schema information for the "monster_progresses" table.
+	MonsterProgressesTable = &schema.Table{
+		Name:       "monster_progresses",
+		Columns:    MonsterProgressesColumns,
+		PrimaryKey: []*schema.Column{MonsterProgressesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "monster_progresses_monsters_monster",
+				Columns:    []*schema.Column{MonsterProgressesColumns[3]},
+				RefColumns: []*schema.Column{MonstersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "monster_progresses_settlements_monsters",
+				Columns:    []*schema.Column{MonsterProgressesColumns[4]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "monsterprogress_settlement_id_monster_id",
+				Unique:  true,
+				Columns: []*schema.Column{MonsterProgressesColumns[4], MonsterProgressesColumns[3]},
+			},
+		},
+	}
+	// PrinciplesColumns holds the columns for the "principles" table.
+	PrinciplesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeEnum, Enums: []string{"new_life", "death", "society", "conviction"}},
+		{Name: "choice", Type: field.TypeString, Size: 50},
+		{Name: "survival_limit_bonus", Type: field.TypeInt, Default: 0},
+		{Name: "departing_survival_bonus", Type: field.TypeInt, Default: 0},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// PrinciplesTable holds the schema information for the "principles" table.
+	PrinciplesTable = &schema.Table{
+		Name:       "principles",
+		Columns:    PrinciplesColumns,
+		PrimaryKey: []*schema.Column{PrinciplesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "principles_settlements_principles",
+				Columns:    []*schema.Column{PrinciplesColumns[5]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "principle_settlement_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PrinciplesColumns[5], PrinciplesColumns[1]},
+			},
+		},
+	}
+	// RecipesColumns holds the columns for the "recipes" table.
+	RecipesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 50},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "costs", Type: field.TypeJSON},
+		{Name: "location_id", Type: field.TypeInt},
+	}
+	// RecipesTable holds the schema information for the "recipes" table.
+	RecipesTable = &schema.Table{
+		Name:       "recipes",
+		Columns:    RecipesColumns,
+		PrimaryKey: []*schema.Column{RecipesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recipes_locations_recipes",
+				Columns:    []*schema.Column{RecipesColumns[4]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// SettlementsColumns holds the columns for the "settlements" table.
 	SettlementsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "owner", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Size: 50},
 		{Name: "survival_limit", Type: field.TypeInt, Default: 0},
 		{Name: "departing_survival", Type: field.TypeInt, Default: 0},
 		{Name: "collective_cognition", Type: field.TypeInt, Default: 0},
 		{Name: "current_year", Type: field.TypeInt, Default: 0},
+		{Name: "share_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// SettlementsTable holds the schema information for the "settlements" table.
 	SettlementsTable = &schema.Table{
@@ -24,9 +348,41 @@ var (
 		Columns:    SettlementsColumns,
 		PrimaryKey: []*schema.Column{SettlementsColumns[0]},
 	}
+	// StorageItemsColumns holds the columns for the "storage_items" table.
+	StorageItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"basic_resource", "monster_resource", "strange_resource", "vermin_resource", "gear"}},
+		{Name: "source_monster", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "quantity", Type: field.TypeInt, Default: 0},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// StorageItemsTable holds the schema information for the "storage_items" table.
+	StorageItemsTable = &schema.Table{
+		Name:       "storage_items",
+		Columns:    StorageItemsColumns,
+		PrimaryKey: []*schema.Column{StorageItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "storage_items_settlements_storage",
+				Columns:    []*schema.Column{StorageItemsColumns[6]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "storageitem_settlement_id_category_name",
+				Unique:  true,
+				Columns: []*schema.Column{StorageItemsColumns[6], StorageItemsColumns[2], StorageItemsColumns[1]},
+			},
+		},
+	}
 	// SurvivorsColumns holds the columns for the "survivors" table.
 	SurvivorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString, Size: 50},
 		{Name: "born", Type: field.TypeInt, Default: 0},
 		{Name: "gender", Type: field.TypeEnum, Enums: []string{"M", "F"}, Default: "F"},
@@ -46,6 +402,7 @@ var (
 		{Name: "understanding", Type: field.TypeInt, Default: 0},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"alive", "dead", "ceased_to_exist", "retired", "skip_hunt"}, Default: "alive"},
 		{Name: "status_change_year", Type: field.TypeInt, Default: 0},
+		{Name: "cannot_use_fighting_arts", Type: field.TypeBool, Default: false},
 		{Name: "settlement_id", Type: field.TypeInt, Nullable: true},
 	}
 	// SurvivorsTable holds the schema information for the "survivors" table.
@@ -56,19 +413,216 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "survivors_settlements_population",
-				Columns:    []*schema.Column{SurvivorsColumns[20]},
+				Columns:    []*schema.Column{SurvivorsColumns[22]},
 				RefColumns: []*schema.Column{SettlementsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TimelineEventsColumns holds the columns for the "timeline_events" table.
+	TimelineEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"story_event", "settlement_event", "showdown", "nemesis_encounter", "special_showdown"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "completed", Type: field.TypeBool, Default: false},
+		{Name: "settlement_id", Type: field.TypeInt},
+	}
+	// TimelineEventsTable holds the schema information for the "timeline_events" table.
+	TimelineEventsTable = &schema.Table{
+		Name:       "timeline_events",
+		Columns:    TimelineEventsColumns,
+		PrimaryKey: []*schema.Column{TimelineEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "timeline_events_settlements_timeline",
+				Columns:    []*schema.Column{TimelineEventsColumns[5]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "timelineevent_settlement_id_year",
+				Unique:  false,
+				Columns: []*schema.Column{TimelineEventsColumns[5], TimelineEventsColumns[1]},
+			},
+		},
+	}
+	// SettlementLocationsColumns holds the columns for the "settlement_locations" table.
+	SettlementLocationsColumns = []*schema.Column{
+		{Name: "settlement_id", Type: field.TypeInt},
+		{Name: "location_id", Type: field.TypeInt},
+	}
+	// SettlementLocationsTable holds the schema information for the "settlement_locations" table.
+	SettlementLocationsTable = &schema.Table{
+		Name:       "settlement_locations",
+		Columns:    SettlementLocationsColumns,
+		PrimaryKey: []*schema.Column{SettlementLocationsColumns[0], SettlementLocationsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "settlement_locations_settlement_id",
+				Columns:    []*schema.Column{SettlementLocationsColumns[0]},
+				RefColumns: []*schema.Column{SettlementsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "settlement_locations_location_id",
+				Columns:    []*schema.Column{SettlementLocationsColumns[1]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SurvivorFightingArtsColumns holds the columns for the "survivor_fighting_arts" table.
+	SurvivorFightingArtsColumns = []*schema.Column{
+		{Name: "survivor_id", Type: field.TypeInt},
+		{Name: "fighting_art_id", Type: field.TypeInt},
+	}
+	// SurvivorFightingArtsTable holds the schema information for the "survivor_fighting_arts" table.
+	SurvivorFightingArtsTable = &schema.Table{
+		Name:       "survivor_fighting_arts",
+		Columns:    SurvivorFightingArtsColumns,
+		PrimaryKey: []*schema.Column{SurvivorFightingArtsColumns[0], SurvivorFightingArtsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "survivor_fighting_arts_survivor_id",
+				Columns:    []*schema.Column{SurvivorFightingArtsColumns[0]},
+				RefColumns: []*schema.Column{SurvivorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "survivor_fighting_arts_fighting_art_id",
+				Columns:    []*schema.Column{SurvivorFightingArtsColumns[1]},
+				RefColumns: []*schema.Column{FightingArtsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SurvivorSecretFightingArtsColumns holds the columns for the "survivor_secret_fighting_arts" table.
+	SurvivorSecretFightingArtsColumns = []*schema.Column{
+		{Name: "survivor_id", Type: field.TypeInt},
+		{Name: "fighting_art_id", Type: field.TypeInt},
+	}
+	// SurvivorSecretFightingArtsTable holds the schema information for the "survivor_secret_fighting_arts" table.
+	SurvivorSecretFightingArtsTable = &schema.Table{
+		Name:       "survivor_secret_fighting_arts",
+		Columns:    SurvivorSecretFightingArtsColumns,
+		PrimaryKey: []*schema.Column{SurvivorSecretFightingArtsColumns[0], SurvivorSecretFightingArtsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "survivor_secret_fighting_arts_survivor_id",
+				Columns:    []*schema.Column{SurvivorSecretFightingArtsColumns[0]},
+				RefColumns: []*schema.Column{SurvivorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "survivor_secret_fighting_arts_fighting_art_id",
+				Columns:    []*schema.Column{SurvivorSecretFightingArtsColumns[1]},
+				RefColumns: []*schema.Column{FightingArtsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SurvivorDisordersColumns holds the columns for the "survivor_disorders" table.
+	SurvivorDisordersColumns = []*schema.Column{
+		{Name: "survivor_id", Type: field.TypeInt},
+		{Name: "disorder_id", Type: field.TypeInt},
+	}
+	// SurvivorDisordersTable holds the schema information for the "survivor_disorders" table.
+	SurvivorDisordersTable = &schema.Table{
+		Name:       "survivor_disorders",
+		Columns:    SurvivorDisordersColumns,
+		PrimaryKey: []*schema.Column{SurvivorDisordersColumns[0], SurvivorDisordersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "survivor_disorders_survivor_id",
+				Columns:    []*schema.Column{SurvivorDisordersColumns[0]},
+				RefColumns: []*schema.Column{SurvivorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "survivor_disorders_disorder_id",
+				Columns:    []*schema.Column{SurvivorDisordersColumns[1]},
+				RefColumns: []*schema.Column{DisordersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SurvivorAbilitiesColumns holds the columns for the "survivor_abilities" table.
+	SurvivorAbilitiesColumns = []*schema.Column{
+		{Name: "survivor_id", Type: field.TypeInt},
+		{Name: "ability_id", Type: field.TypeInt},
+	}
+	// SurvivorAbilitiesTable holds the schema information for the "survivor_abilities" table.
+	SurvivorAbilitiesTable = &schema.Table{
+		Name:       "survivor_abilities",
+		Columns:    SurvivorAbilitiesColumns,
+		PrimaryKey: []*schema.Column{SurvivorAbilitiesColumns[0], SurvivorAbilitiesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "survivor_abilities_survivor_id",
+				Columns:    []*schema.Column{SurvivorAbilitiesColumns[0]},
+				RefColumns: []*schema.Column{SurvivorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "survivor_abilities_ability_id",
+				Columns:    []*schema.Column{SurvivorAbilitiesColumns[1]},
+				RefColumns: []*schema.Column{AbilitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AbilitiesTable,
+		AccessTokensTable,
+		AuditLogsTable,
+		DisordersTable,
+		FightingArtsTable,
+		InnovationsTable,
+		InvitesTable,
+		LocationsTable,
+		MembershipsTable,
+		MilestonesTable,
+		MonstersTable,
+		MonsterProgressesTable,
+		PrinciplesTable,
+		RecipesTable,
 		SettlementsTable,
+		StorageItemsTable,
 		SurvivorsTable,
+		TimelineEventsTable,
+		SettlementLocationsTable,
+		SurvivorFightingArtsTable,
+		SurvivorSecretFightingArtsTable,
+		SurvivorDisordersTable,
+		SurvivorAbilitiesTable,
 	}
 )
 
 func init() {
+	AuditLogsTable.ForeignKeys[0].RefTable = SettlementsTable
+	InnovationsTable.ForeignKeys[0].RefTable = SettlementsTable
+	InvitesTable.ForeignKeys[0].RefTable = SettlementsTable
+	MembershipsTable.ForeignKeys[0].RefTable = SettlementsTable
+	MilestonesTable.ForeignKeys[0].RefTable = SettlementsTable
+	MonsterProgressesTable.ForeignKeys[0].RefTable = MonstersTable
+	MonsterProgressesTable.ForeignKeys[1].RefTable = SettlementsTable
+	PrinciplesTable.ForeignKeys[0].RefTable = SettlementsTable
+	RecipesTable.ForeignKeys[0].RefTable = LocationsTable
+	StorageItemsTable.ForeignKeys[0].RefTable = SettlementsTable
 	SurvivorsTable.ForeignKeys[0].RefTable = SettlementsTable
+	TimelineEventsTable.ForeignKeys[0].RefTable = SettlementsTable
+	SettlementLocationsTable.ForeignKeys[0].RefTable = SettlementsTable
+	SettlementLocationsTable.ForeignKeys[1].RefTable = LocationsTable
+	SurvivorFightingArtsTable.ForeignKeys[0].RefTable = SurvivorsTable
+	SurvivorFightingArtsTable.ForeignKeys[1].RefTable = FightingArtsTable
+	SurvivorSecretFightingArtsTable.ForeignKeys[0].RefTable = SurvivorsTable
+	SurvivorSecretFightingArtsTable.ForeignKeys[1].RefTable = FightingArtsTable
+	SurvivorDisordersTable.ForeignKeys[0].RefTable = SurvivorsTable
+	SurvivorDisordersTable.ForeignKeys[1].RefTable = DisordersTable
+	SurvivorAbilitiesTable.ForeignKeys[0].RefTable = SurvivorsTable
+	SurvivorAbilitiesTable.ForeignKeys[1].RefTable = AbilitiesTable
 }
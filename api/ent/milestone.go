@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// Milestone is the model entity for the Milestone schema.
+type Milestone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name milestone.Name `json:"name,omitempty"`
+	// Year holds the value of the "year" field.
+	Year int `json:"year,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MilestoneQuery when eager-loading is set.
+	Edges        MilestoneEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MilestoneEdges holds the relations/edges for other nodes in the graph.
+type MilestoneEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MilestoneEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Milestone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case milestone.FieldID, milestone.FieldYear, milestone.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case milestone.FieldName:
+			values[i] = new(sql.NullString)
+		case milestone.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Milestone fields.
+func (m *Milestone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case milestone.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case milestone.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = milestone.Name(value.String)
+			}
+		case milestone.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				m.Year = int(value.Int64)
+			}
+		case milestone.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case milestone.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				m.SettlementID = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Milestone.
+// This includes values selected through modifiers, order, etc.
+func (m *Milestone) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the Milestone entity.
+func (m *Milestone) QuerySettlement() *SettlementQuery {
+	return NewMilestoneClient(m.config).QuerySettlement(m)
+}
+
+// Update returns a builder for updating this Milestone.
+// Note that you need to call Milestone.Unwrap() before calling this method if this Milestone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Milestone) Update() *MilestoneUpdateOne {
+	return NewMilestoneClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Milestone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Milestone) Unwrap() *Milestone {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Milestone is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Milestone) String() string {
+	var builder strings.Builder
+	builder.WriteString("Milestone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(fmt.Sprintf("%v", m.Name))
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", m.Year))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", m.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Milestones is a parsable slice of Milestone.
+type Milestones []*Milestone
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/monster"
+)
+
+// Monster is the model entity for the Monster schema.
+type Monster struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind monster.Kind `json:"kind,omitempty"`
+	// Levels holds the value of the "levels" field.
+	Levels       int `json:"levels,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Monster) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case monster.FieldID, monster.FieldLevels:
+			values[i] = new(sql.NullInt64)
+		case monster.FieldName, monster.FieldKind:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Monster fields.
+func (m *Monster) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case monster.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case monster.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = value.String
+			}
+		case monster.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				m.Kind = monster.Kind(value.String)
+			}
+		case monster.FieldLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field levels", values[i])
+			} else if value.Valid {
+				m.Levels = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Monster.
+// This includes values selected through modifiers, order, etc.
+func (m *Monster) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Monster.
+// Note that you need to call Monster.Unwrap() before calling this method if this Monster
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Monster) Update() *MonsterUpdateOne {
+	return NewMonsterClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Monster entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Monster) Unwrap() *Monster {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Monster is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Monster) String() string {
+	var builder strings.Builder
+	builder.WriteString("Monster(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("levels=")
+	builder.WriteString(fmt.Sprintf("%v", m.Levels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Monsters is a parsable slice of Monster.
+type Monsters []*Monster
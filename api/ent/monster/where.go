@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monster
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Monster {
+	return predicate.Monster(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Monster {
+	return predicate.Monster(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Monster {
+	return predicate.Monster(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldName, v))
+}
+
+// Levels applies equality check predicate on the "levels" field. It's identical to LevelsEQ.
+func Levels(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldLevels, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Monster {
+	return predicate.Monster(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Monster {
+	return predicate.Monster(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Monster {
+	return predicate.Monster(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Monster {
+	return predicate.Monster(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Monster {
+	return predicate.Monster(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Monster {
+	return predicate.Monster(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// LevelsEQ applies the EQ predicate on the "levels" field.
+func LevelsEQ(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldEQ(FieldLevels, v))
+}
+
+// LevelsNEQ applies the NEQ predicate on the "levels" field.
+func LevelsNEQ(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldNEQ(FieldLevels, v))
+}
+
+// LevelsIn applies the In predicate on the "levels" field.
+func LevelsIn(vs ...int) predicate.Monster {
+	return predicate.Monster(sql.FieldIn(FieldLevels, vs...))
+}
+
+// LevelsNotIn applies the NotIn predicate on the "levels" field.
+func LevelsNotIn(vs ...int) predicate.Monster {
+	return predicate.Monster(sql.FieldNotIn(FieldLevels, vs...))
+}
+
+// LevelsGT applies the GT predicate on the "levels" field.
+func LevelsGT(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldGT(FieldLevels, v))
+}
+
+// LevelsGTE applies the GTE predicate on the "levels" field.
+func LevelsGTE(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldGTE(FieldLevels, v))
+}
+
+// LevelsLT applies the LT predicate on the "levels" field.
+func LevelsLT(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldLT(FieldLevels, v))
+}
+
+// LevelsLTE applies the LTE predicate on the "levels" field.
+func LevelsLTE(v int) predicate.Monster {
+	return predicate.Monster(sql.FieldLTE(FieldLevels, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Monster) predicate.Monster {
+	return predicate.Monster(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Monster) predicate.Monster {
+	return predicate.Monster(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Monster) predicate.Monster {
+	return predicate.Monster(sql.NotPredicates(p))
+}
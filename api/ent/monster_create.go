@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monster"
+)
+
+// MonsterCreate is the builder for creating a Monster entity.
+type MonsterCreate struct {
+	config
+	mutation *MonsterMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (mc *MonsterCreate) SetName(s string) *MonsterCreate {
+	mc.mutation.SetName(s)
+	return mc
+}
+
+// SetKind sets the "kind" field.
+func (mc *MonsterCreate) SetKind(m monster.Kind) *MonsterCreate {
+	mc.mutation.SetKind(m)
+	return mc
+}
+
+// SetLevels sets the "levels" field.
+func (mc *MonsterCreate) SetLevels(i int) *MonsterCreate {
+	mc.mutation.SetLevels(i)
+	return mc
+}
+
+// Mutation returns the MonsterMutation object of the builder.
+func (mc *MonsterCreate) Mutation() *MonsterMutation {
+	return mc.mutation
+}
+
+// Save creates the Monster in the database.
+func (mc *MonsterCreate) Save(ctx context.Context) (*Monster, error) {
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MonsterCreate) SaveX(ctx context.Context) *Monster {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MonsterCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MonsterCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MonsterCreate) check() error {
+	if _, ok := mc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Monster.name"`)}
+	}
+	if v, ok := mc.mutation.Name(); ok {
+		if err := monster.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Monster.name": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Monster.kind"`)}
+	}
+	if v, ok := mc.mutation.Kind(); ok {
+		if err := monster.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Monster.kind": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Levels(); !ok {
+		return &ValidationError{Name: "levels", err: errors.New(`ent: missing required field "Monster.levels"`)}
+	}
+	if v, ok := mc.mutation.Levels(); ok {
+		if err := monster.LevelsValidator(v); err != nil {
+			return &ValidationError{Name: "levels", err: fmt.Errorf(`ent: validator failed for field "Monster.levels": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mc *MonsterCreate) sqlSave(ctx context.Context) (*Monster, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MonsterCreate) createSpec() (*Monster, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Monster{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(monster.Table, sqlgraph.NewFieldSpec(monster.FieldID, field.TypeInt))
+	)
+	if value, ok := mc.mutation.Name(); ok {
+		_spec.SetField(monster.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mc.mutation.Kind(); ok {
+		_spec.SetField(monster.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := mc.mutation.Levels(); ok {
+		_spec.SetField(monster.FieldLevels, field.TypeInt, value)
+		_node.Levels = value
+	}
+	return _node, _spec
+}
+
+// MonsterCreateBulk is the builder for creating many Monster entities in bulk.
+type MonsterCreateBulk struct {
+	config
+	err      error
+	builders []*MonsterCreate
+}
+
+// Save creates the Monster entities in the database.
+func (mcb *MonsterCreateBulk) Save(ctx context.Context) ([]*Monster, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Monster, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MonsterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MonsterCreateBulk) SaveX(ctx context.Context) []*Monster {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MonsterCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MonsterCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
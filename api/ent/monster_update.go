@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// MonsterUpdate is the builder for updating Monster entities.
+type MonsterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MonsterMutation
+}
+
+// Where appends a list predicates to the MonsterUpdate builder.
+func (mu *MonsterUpdate) Where(ps ...predicate.Monster) *MonsterUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetName sets the "name" field.
+func (mu *MonsterUpdate) SetName(s string) *MonsterUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mu *MonsterUpdate) SetNillableName(s *string) *MonsterUpdate {
+	if s != nil {
+		mu.SetName(*s)
+	}
+	return mu
+}
+
+// SetKind sets the "kind" field.
+func (mu *MonsterUpdate) SetKind(m monster.Kind) *MonsterUpdate {
+	mu.mutation.SetKind(m)
+	return mu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (mu *MonsterUpdate) SetNillableKind(m *monster.Kind) *MonsterUpdate {
+	if m != nil {
+		mu.SetKind(*m)
+	}
+	return mu
+}
+
+// SetLevels sets the "levels" field.
+func (mu *MonsterUpdate) SetLevels(i int) *MonsterUpdate {
+	mu.mutation.ResetLevels()
+	mu.mutation.SetLevels(i)
+	return mu
+}
+
+// SetNillableLevels sets the "levels" field if the given value is not nil.
+func (mu *MonsterUpdate) SetNillableLevels(i *int) *MonsterUpdate {
+	if i != nil {
+		mu.SetLevels(*i)
+	}
+	return mu
+}
+
+// AddLevels adds i to the "levels" field.
+func (mu *MonsterUpdate) AddLevels(i int) *MonsterUpdate {
+	mu.mutation.AddLevels(i)
+	return mu
+}
+
+// Mutation returns the MonsterMutation object of the builder.
+func (mu *MonsterUpdate) Mutation() *MonsterMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MonsterUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MonsterUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MonsterUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MonsterUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MonsterUpdate) check() error {
+	if v, ok := mu.mutation.Name(); ok {
+		if err := monster.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Monster.name": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Kind(); ok {
+		if err := monster.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Monster.kind": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Levels(); ok {
+		if err := monster.LevelsValidator(v); err != nil {
+			return &ValidationError{Name: "levels", err: fmt.Errorf(`ent: validator failed for field "Monster.levels": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MonsterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monster.Table, monster.Columns, sqlgraph.NewFieldSpec(monster.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.SetField(monster.FieldName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Kind(); ok {
+		_spec.SetField(monster.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Levels(); ok {
+		_spec.SetField(monster.FieldLevels, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedLevels(); ok {
+		_spec.AddField(monster.FieldLevels, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MonsterUpdateOne is the builder for updating a single Monster entity.
+type MonsterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MonsterMutation
+}
+
+// SetName sets the "name" field.
+func (muo *MonsterUpdateOne) SetName(s string) *MonsterUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (muo *MonsterUpdateOne) SetNillableName(s *string) *MonsterUpdateOne {
+	if s != nil {
+		muo.SetName(*s)
+	}
+	return muo
+}
+
+// SetKind sets the "kind" field.
+func (muo *MonsterUpdateOne) SetKind(m monster.Kind) *MonsterUpdateOne {
+	muo.mutation.SetKind(m)
+	return muo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (muo *MonsterUpdateOne) SetNillableKind(m *monster.Kind) *MonsterUpdateOne {
+	if m != nil {
+		muo.SetKind(*m)
+	}
+	return muo
+}
+
+// SetLevels sets the "levels" field.
+func (muo *MonsterUpdateOne) SetLevels(i int) *MonsterUpdateOne {
+	muo.mutation.ResetLevels()
+	muo.mutation.SetLevels(i)
+	return muo
+}
+
+// SetNillableLevels sets the "levels" field if the given value is not nil.
+func (muo *MonsterUpdateOne) SetNillableLevels(i *int) *MonsterUpdateOne {
+	if i != nil {
+		muo.SetLevels(*i)
+	}
+	return muo
+}
+
+// AddLevels adds i to the "levels" field.
+func (muo *MonsterUpdateOne) AddLevels(i int) *MonsterUpdateOne {
+	muo.mutation.AddLevels(i)
+	return muo
+}
+
+// Mutation returns the MonsterMutation object of the builder.
+func (muo *MonsterUpdateOne) Mutation() *MonsterMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MonsterUpdate builder.
+func (muo *MonsterUpdateOne) Where(ps ...predicate.Monster) *MonsterUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MonsterUpdateOne) Select(field string, fields ...string) *MonsterUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Monster entity.
+func (muo *MonsterUpdateOne) Save(ctx context.Context) (*Monster, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MonsterUpdateOne) SaveX(ctx context.Context) *Monster {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MonsterUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MonsterUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MonsterUpdateOne) check() error {
+	if v, ok := muo.mutation.Name(); ok {
+		if err := monster.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Monster.name": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Kind(); ok {
+		if err := monster.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Monster.kind": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Levels(); ok {
+		if err := monster.LevelsValidator(v); err != nil {
+			return &ValidationError{Name: "levels", err: fmt.Errorf(`ent: validator failed for field "Monster.levels": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MonsterUpdateOne) sqlSave(ctx context.Context) (_node *Monster, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monster.Table, monster.Columns, sqlgraph.NewFieldSpec(monster.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Monster.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monster.FieldID)
+		for _, f := range fields {
+			if !monster.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != monster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.SetField(monster.FieldName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Kind(); ok {
+		_spec.SetField(monster.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Levels(); ok {
+		_spec.SetField(monster.FieldLevels, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedLevels(); ok {
+		_spec.AddField(monster.FieldLevels, field.TypeInt, value)
+	}
+	_node = &Monster{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// MonsterProgress is the model entity for the MonsterProgress schema.
+type MonsterProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UnlockedYear holds the value of the "unlocked_year" field.
+	UnlockedYear int `json:"unlocked_year,omitempty"`
+	// Defeats holds the value of the "defeats" field.
+	Defeats []schematype.Defeat `json:"defeats,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// MonsterID holds the value of the "monster_id" field.
+	MonsterID int `json:"monster_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MonsterProgressQuery when eager-loading is set.
+	Edges        MonsterProgressEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MonsterProgressEdges holds the relations/edges for other nodes in the graph.
+type MonsterProgressEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// Monster holds the value of the monster edge.
+	Monster *Monster `json:"monster,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MonsterProgressEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// MonsterOrErr returns the Monster value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MonsterProgressEdges) MonsterOrErr() (*Monster, error) {
+	if e.Monster != nil {
+		return e.Monster, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: monster.Label}
+	}
+	return nil, &NotLoadedError{edge: "monster"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MonsterProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case monsterprogress.FieldDefeats:
+			values[i] = new([]byte)
+		case monsterprogress.FieldID, monsterprogress.FieldUnlockedYear, monsterprogress.FieldSettlementID, monsterprogress.FieldMonsterID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MonsterProgress fields.
+func (mp *MonsterProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case monsterprogress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mp.ID = int(value.Int64)
+		case monsterprogress.FieldUnlockedYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unlocked_year", values[i])
+			} else if value.Valid {
+				mp.UnlockedYear = int(value.Int64)
+			}
+		case monsterprogress.FieldDefeats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field defeats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mp.Defeats); err != nil {
+					return fmt.Errorf("unmarshal field defeats: %w", err)
+				}
+			}
+		case monsterprogress.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				mp.SettlementID = int(value.Int64)
+			}
+		case monsterprogress.FieldMonsterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monster_id", values[i])
+			} else if value.Valid {
+				mp.MonsterID = int(value.Int64)
+			}
+		default:
+			mp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MonsterProgress.
+// This includes values selected through modifiers, order, etc.
+func (mp *MonsterProgress) Value(name string) (ent.Value, error) {
+	return mp.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the MonsterProgress entity.
+func (mp *MonsterProgress) QuerySettlement() *SettlementQuery {
+	return NewMonsterProgressClient(mp.config).QuerySettlement(mp)
+}
+
+// QueryMonster queries the "monster" edge of the MonsterProgress entity.
+func (mp *MonsterProgress) QueryMonster() *MonsterQuery {
+	return NewMonsterProgressClient(mp.config).QueryMonster(mp)
+}
+
+// Update returns a builder for updating this MonsterProgress.
+// Note that you need to call MonsterProgress.Unwrap() before calling this method if this MonsterProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mp *MonsterProgress) Update() *MonsterProgressUpdateOne {
+	return NewMonsterProgressClient(mp.config).UpdateOne(mp)
+}
+
+// Unwrap unwraps the MonsterProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mp *MonsterProgress) Unwrap() *MonsterProgress {
+	_tx, ok := mp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MonsterProgress is not a transactional entity")
+	}
+	mp.config.driver = _tx.drv
+	return mp
+}
+
+// String implements the fmt.Stringer.
+func (mp *MonsterProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("MonsterProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mp.ID))
+	builder.WriteString("unlocked_year=")
+	builder.WriteString(fmt.Sprintf("%v", mp.UnlockedYear))
+	builder.WriteString(", ")
+	builder.WriteString("defeats=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Defeats))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", mp.SettlementID))
+	builder.WriteString(", ")
+	builder.WriteString("monster_id=")
+	builder.WriteString(fmt.Sprintf("%v", mp.MonsterID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MonsterProgresses is a parsable slice of MonsterProgress.
+type MonsterProgresses []*MonsterProgress
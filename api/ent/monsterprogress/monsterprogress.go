@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monsterprogress
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the monsterprogress type in the database.
+	Label = "monster_progress"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUnlockedYear holds the string denoting the unlocked_year field in the database.
+	FieldUnlockedYear = "unlocked_year"
+	// FieldDefeats holds the string denoting the defeats field in the database.
+	FieldDefeats = "defeats"
+	// FieldSettlementID holds the string denoting the settlement_id field in the database.
+	FieldSettlementID = "settlement_id"
+	// FieldMonsterID holds the string denoting the monster_id field in the database.
+	FieldMonsterID = "monster_id"
+	// EdgeSettlement holds the string denoting the settlement edge name in mutations.
+	EdgeSettlement = "settlement"
+	// EdgeMonster holds the string denoting the monster edge name in mutations.
+	EdgeMonster = "monster"
+	// Table holds the table name of the monsterprogress in the database.
+	Table = "monster_progresses"
+	// SettlementTable is the table that holds the settlement relation/edge.
+	SettlementTable = "monster_progresses"
+	// SettlementInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementInverseTable = "settlements"
+	// SettlementColumn is the table column denoting the settlement relation/edge.
+	SettlementColumn = "settlement_id"
+	// MonsterTable is the table that holds the monster relation/edge.
+	MonsterTable = "monster_progresses"
+	// MonsterInverseTable is the table name for the Monster entity.
+	// It exists in this package in order to avoid circular dependency with the "monster" package.
+	MonsterInverseTable = "monsters"
+	// MonsterColumn is the table column denoting the monster relation/edge.
+	MonsterColumn = "monster_id"
+)
+
+// Columns holds all SQL columns for monsterprogress fields.
+var Columns = []string{
+	FieldID,
+	FieldUnlockedYear,
+	FieldDefeats,
+	FieldSettlementID,
+	FieldMonsterID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+)
+
+// OrderOption defines the ordering options for the MonsterProgress queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUnlockedYear orders the results by the unlocked_year field.
+func ByUnlockedYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnlockedYear, opts...).ToFunc()
+}
+
+// BySettlementID orders the results by the settlement_id field.
+func BySettlementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementID, opts...).ToFunc()
+}
+
+// ByMonsterID orders the results by the monster_id field.
+func ByMonsterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonsterID, opts...).ToFunc()
+}
+
+// BySettlementField orders the results by settlement field.
+func BySettlementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMonsterField orders the results by monster field.
+func ByMonsterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMonsterStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSettlementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+	)
+}
+func newMonsterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MonsterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MonsterTable, MonsterColumn),
+	)
+}
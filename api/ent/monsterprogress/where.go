@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monsterprogress
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldLTE(FieldID, id))
+}
+
+// UnlockedYear applies equality check predicate on the "unlocked_year" field. It's identical to UnlockedYearEQ.
+func UnlockedYear(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldUnlockedYear, v))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// MonsterID applies equality check predicate on the "monster_id" field. It's identical to MonsterIDEQ.
+func MonsterID(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldMonsterID, v))
+}
+
+// UnlockedYearEQ applies the EQ predicate on the "unlocked_year" field.
+func UnlockedYearEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldUnlockedYear, v))
+}
+
+// UnlockedYearNEQ applies the NEQ predicate on the "unlocked_year" field.
+func UnlockedYearNEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNEQ(FieldUnlockedYear, v))
+}
+
+// UnlockedYearIn applies the In predicate on the "unlocked_year" field.
+func UnlockedYearIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldIn(FieldUnlockedYear, vs...))
+}
+
+// UnlockedYearNotIn applies the NotIn predicate on the "unlocked_year" field.
+func UnlockedYearNotIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNotIn(FieldUnlockedYear, vs...))
+}
+
+// UnlockedYearGT applies the GT predicate on the "unlocked_year" field.
+func UnlockedYearGT(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldGT(FieldUnlockedYear, v))
+}
+
+// UnlockedYearGTE applies the GTE predicate on the "unlocked_year" field.
+func UnlockedYearGTE(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldGTE(FieldUnlockedYear, v))
+}
+
+// UnlockedYearLT applies the LT predicate on the "unlocked_year" field.
+func UnlockedYearLT(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldLT(FieldUnlockedYear, v))
+}
+
+// UnlockedYearLTE applies the LTE predicate on the "unlocked_year" field.
+func UnlockedYearLTE(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldLTE(FieldUnlockedYear, v))
+}
+
+// DefeatsIsNil applies the IsNil predicate on the "defeats" field.
+func DefeatsIsNil() predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldIsNull(FieldDefeats))
+}
+
+// DefeatsNotNil applies the NotNil predicate on the "defeats" field.
+func DefeatsNotNil() predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNotNull(FieldDefeats))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// MonsterIDEQ applies the EQ predicate on the "monster_id" field.
+func MonsterIDEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldEQ(FieldMonsterID, v))
+}
+
+// MonsterIDNEQ applies the NEQ predicate on the "monster_id" field.
+func MonsterIDNEQ(v int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNEQ(FieldMonsterID, v))
+}
+
+// MonsterIDIn applies the In predicate on the "monster_id" field.
+func MonsterIDIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldIn(FieldMonsterID, vs...))
+}
+
+// MonsterIDNotIn applies the NotIn predicate on the "monster_id" field.
+func MonsterIDNotIn(vs ...int) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.FieldNotIn(FieldMonsterID, vs...))
+}
+
+// HasSettlement applies the HasEdge predicate on the "settlement" edge.
+func HasSettlement() predicate.MonsterProgress {
+	return predicate.MonsterProgress(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementWith applies the HasEdge predicate on the "settlement" edge with a given conditions (other predicates).
+func HasSettlementWith(preds ...predicate.Settlement) predicate.MonsterProgress {
+	return predicate.MonsterProgress(func(s *sql.Selector) {
+		step := newSettlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMonster applies the HasEdge predicate on the "monster" edge.
+func HasMonster() predicate.MonsterProgress {
+	return predicate.MonsterProgress(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MonsterTable, MonsterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMonsterWith applies the HasEdge predicate on the "monster" edge with a given conditions (other predicates).
+func HasMonsterWith(preds ...predicate.Monster) predicate.MonsterProgress {
+	return predicate.MonsterProgress(func(s *sql.Selector) {
+		step := newMonsterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MonsterProgress) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MonsterProgress) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MonsterProgress) predicate.MonsterProgress {
+	return predicate.MonsterProgress(sql.NotPredicates(p))
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// MonsterProgressCreate is the builder for creating a MonsterProgress entity.
+type MonsterProgressCreate struct {
+	config
+	mutation *MonsterProgressMutation
+	hooks    []Hook
+}
+
+// SetUnlockedYear sets the "unlocked_year" field.
+func (mpc *MonsterProgressCreate) SetUnlockedYear(i int) *MonsterProgressCreate {
+	mpc.mutation.SetUnlockedYear(i)
+	return mpc
+}
+
+// SetDefeats sets the "defeats" field.
+func (mpc *MonsterProgressCreate) SetDefeats(s []schematype.Defeat) *MonsterProgressCreate {
+	mpc.mutation.SetDefeats(s)
+	return mpc
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (mpc *MonsterProgressCreate) SetSettlementID(i int) *MonsterProgressCreate {
+	mpc.mutation.SetSettlementID(i)
+	return mpc
+}
+
+// SetMonsterID sets the "monster_id" field.
+func (mpc *MonsterProgressCreate) SetMonsterID(i int) *MonsterProgressCreate {
+	mpc.mutation.SetMonsterID(i)
+	return mpc
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (mpc *MonsterProgressCreate) SetSettlement(s *Settlement) *MonsterProgressCreate {
+	return mpc.SetSettlementID(s.ID)
+}
+
+// SetMonster sets the "monster" edge to the Monster entity.
+func (mpc *MonsterProgressCreate) SetMonster(m *Monster) *MonsterProgressCreate {
+	return mpc.SetMonsterID(m.ID)
+}
+
+// Mutation returns the MonsterProgressMutation object of the builder.
+func (mpc *MonsterProgressCreate) Mutation() *MonsterProgressMutation {
+	return mpc.mutation
+}
+
+// Save creates the MonsterProgress in the database.
+func (mpc *MonsterProgressCreate) Save(ctx context.Context) (*MonsterProgress, error) {
+	return withHooks(ctx, mpc.sqlSave, mpc.mutation, mpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpc *MonsterProgressCreate) SaveX(ctx context.Context) *MonsterProgress {
+	v, err := mpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpc *MonsterProgressCreate) Exec(ctx context.Context) error {
+	_, err := mpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpc *MonsterProgressCreate) ExecX(ctx context.Context) {
+	if err := mpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpc *MonsterProgressCreate) check() error {
+	if _, ok := mpc.mutation.UnlockedYear(); !ok {
+		return &ValidationError{Name: "unlocked_year", err: errors.New(`ent: missing required field "MonsterProgress.unlocked_year"`)}
+	}
+	if _, ok := mpc.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "MonsterProgress.settlement_id"`)}
+	}
+	if _, ok := mpc.mutation.MonsterID(); !ok {
+		return &ValidationError{Name: "monster_id", err: errors.New(`ent: missing required field "MonsterProgress.monster_id"`)}
+	}
+	if len(mpc.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "MonsterProgress.settlement"`)}
+	}
+	if len(mpc.mutation.MonsterIDs()) == 0 {
+		return &ValidationError{Name: "monster", err: errors.New(`ent: missing required edge "MonsterProgress.monster"`)}
+	}
+	return nil
+}
+
+func (mpc *MonsterProgressCreate) sqlSave(ctx context.Context) (*MonsterProgress, error) {
+	if err := mpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mpc.mutation.id = &_node.ID
+	mpc.mutation.done = true
+	return _node, nil
+}
+
+func (mpc *MonsterProgressCreate) createSpec() (*MonsterProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MonsterProgress{config: mpc.config}
+		_spec = sqlgraph.NewCreateSpec(monsterprogress.Table, sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt))
+	)
+	if value, ok := mpc.mutation.UnlockedYear(); ok {
+		_spec.SetField(monsterprogress.FieldUnlockedYear, field.TypeInt, value)
+		_node.UnlockedYear = value
+	}
+	if value, ok := mpc.mutation.Defeats(); ok {
+		_spec.SetField(monsterprogress.FieldDefeats, field.TypeJSON, value)
+		_node.Defeats = value
+	}
+	if nodes := mpc.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   monsterprogress.SettlementTable,
+			Columns: []string{monsterprogress.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := mpc.mutation.MonsterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   monsterprogress.MonsterTable,
+			Columns: []string{monsterprogress.MonsterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MonsterID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MonsterProgressCreateBulk is the builder for creating many MonsterProgress entities in bulk.
+type MonsterProgressCreateBulk struct {
+	config
+	err      error
+	builders []*MonsterProgressCreate
+}
+
+// Save creates the MonsterProgress entities in the database.
+func (mpcb *MonsterProgressCreateBulk) Save(ctx context.Context) ([]*MonsterProgress, error) {
+	if mpcb.err != nil {
+		return nil, mpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mpcb.builders))
+	nodes := make([]*MonsterProgress, len(mpcb.builders))
+	mutators := make([]Mutator, len(mpcb.builders))
+	for i := range mpcb.builders {
+		func(i int, root context.Context) {
+			builder := mpcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MonsterProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpcb *MonsterProgressCreateBulk) SaveX(ctx context.Context) []*MonsterProgress {
+	v, err := mpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpcb *MonsterProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpcb *MonsterProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := mpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
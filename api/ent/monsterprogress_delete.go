@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// MonsterProgressDelete is the builder for deleting a MonsterProgress entity.
+type MonsterProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *MonsterProgressMutation
+}
+
+// Where appends a list predicates to the MonsterProgressDelete builder.
+func (mpd *MonsterProgressDelete) Where(ps ...predicate.MonsterProgress) *MonsterProgressDelete {
+	mpd.mutation.Where(ps...)
+	return mpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpd *MonsterProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpd.sqlExec, mpd.mutation, mpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpd *MonsterProgressDelete) ExecX(ctx context.Context) int {
+	n, err := mpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpd *MonsterProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(monsterprogress.Table, sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt))
+	if ps := mpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpd.mutation.done = true
+	return affected, err
+}
+
+// MonsterProgressDeleteOne is the builder for deleting a single MonsterProgress entity.
+type MonsterProgressDeleteOne struct {
+	mpd *MonsterProgressDelete
+}
+
+// Where appends a list predicates to the MonsterProgressDelete builder.
+func (mpdo *MonsterProgressDeleteOne) Where(ps ...predicate.MonsterProgress) *MonsterProgressDeleteOne {
+	mpdo.mpd.mutation.Where(ps...)
+	return mpdo
+}
+
+// Exec executes the deletion query.
+func (mpdo *MonsterProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpdo.mpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{monsterprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpdo *MonsterProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := mpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
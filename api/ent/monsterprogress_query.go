@@ -0,0 +1,701 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// MonsterProgressQuery is the builder for querying MonsterProgress entities.
+type MonsterProgressQuery struct {
+	config
+	ctx            *QueryContext
+	order          []monsterprogress.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.MonsterProgress
+	withSettlement *SettlementQuery
+	withMonster    *MonsterQuery
+	modifiers      []func(*sql.Selector)
+	loadTotal      []func(context.Context, []*MonsterProgress) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MonsterProgressQuery builder.
+func (mpq *MonsterProgressQuery) Where(ps ...predicate.MonsterProgress) *MonsterProgressQuery {
+	mpq.predicates = append(mpq.predicates, ps...)
+	return mpq
+}
+
+// Limit the number of records to be returned by this query.
+func (mpq *MonsterProgressQuery) Limit(limit int) *MonsterProgressQuery {
+	mpq.ctx.Limit = &limit
+	return mpq
+}
+
+// Offset to start from.
+func (mpq *MonsterProgressQuery) Offset(offset int) *MonsterProgressQuery {
+	mpq.ctx.Offset = &offset
+	return mpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mpq *MonsterProgressQuery) Unique(unique bool) *MonsterProgressQuery {
+	mpq.ctx.Unique = &unique
+	return mpq
+}
+
+// Order specifies how the records should be ordered.
+func (mpq *MonsterProgressQuery) Order(o ...monsterprogress.OrderOption) *MonsterProgressQuery {
+	mpq.order = append(mpq.order, o...)
+	return mpq
+}
+
+// QuerySettlement chains the current query on the "settlement" edge.
+func (mpq *MonsterProgressQuery) QuerySettlement() *SettlementQuery {
+	query := (&SettlementClient{config: mpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monsterprogress.Table, monsterprogress.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monsterprogress.SettlementTable, monsterprogress.SettlementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMonster chains the current query on the "monster" edge.
+func (mpq *MonsterProgressQuery) QueryMonster() *MonsterQuery {
+	query := (&MonsterClient{config: mpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monsterprogress.Table, monsterprogress.FieldID, selector),
+			sqlgraph.To(monster.Table, monster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monsterprogress.MonsterTable, monsterprogress.MonsterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MonsterProgress entity from the query.
+// Returns a *NotFoundError when no MonsterProgress was found.
+func (mpq *MonsterProgressQuery) First(ctx context.Context) (*MonsterProgress, error) {
+	nodes, err := mpq.Limit(1).All(setContextOp(ctx, mpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{monsterprogress.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) FirstX(ctx context.Context) *MonsterProgress {
+	node, err := mpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MonsterProgress ID from the query.
+// Returns a *NotFoundError when no MonsterProgress ID was found.
+func (mpq *MonsterProgressQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(1).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{monsterprogress.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) FirstIDX(ctx context.Context) int {
+	id, err := mpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MonsterProgress entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MonsterProgress entity is found.
+// Returns a *NotFoundError when no MonsterProgress entities are found.
+func (mpq *MonsterProgressQuery) Only(ctx context.Context) (*MonsterProgress, error) {
+	nodes, err := mpq.Limit(2).All(setContextOp(ctx, mpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{monsterprogress.Label}
+	default:
+		return nil, &NotSingularError{monsterprogress.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) OnlyX(ctx context.Context) *MonsterProgress {
+	node, err := mpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MonsterProgress ID in the query.
+// Returns a *NotSingularError when more than one MonsterProgress ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mpq *MonsterProgressQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(2).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{monsterprogress.Label}
+	default:
+		err = &NotSingularError{monsterprogress.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MonsterProgresses.
+func (mpq *MonsterProgressQuery) All(ctx context.Context) ([]*MonsterProgress, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryAll)
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MonsterProgress, *MonsterProgressQuery]()
+	return withInterceptors[[]*MonsterProgress](ctx, mpq, qr, mpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) AllX(ctx context.Context) []*MonsterProgress {
+	nodes, err := mpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MonsterProgress IDs.
+func (mpq *MonsterProgressQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mpq.ctx.Unique == nil && mpq.path != nil {
+		mpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryIDs)
+	if err = mpq.Select(monsterprogress.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) IDsX(ctx context.Context) []int {
+	ids, err := mpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mpq *MonsterProgressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryCount)
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mpq, querierCount[*MonsterProgressQuery](), mpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) CountX(ctx context.Context) int {
+	count, err := mpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mpq *MonsterProgressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryExist)
+	switch _, err := mpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mpq *MonsterProgressQuery) ExistX(ctx context.Context) bool {
+	exist, err := mpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MonsterProgressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mpq *MonsterProgressQuery) Clone() *MonsterProgressQuery {
+	if mpq == nil {
+		return nil
+	}
+	return &MonsterProgressQuery{
+		config:         mpq.config,
+		ctx:            mpq.ctx.Clone(),
+		order:          append([]monsterprogress.OrderOption{}, mpq.order...),
+		inters:         append([]Interceptor{}, mpq.inters...),
+		predicates:     append([]predicate.MonsterProgress{}, mpq.predicates...),
+		withSettlement: mpq.withSettlement.Clone(),
+		withMonster:    mpq.withMonster.Clone(),
+		// clone intermediate query.
+		sql:  mpq.sql.Clone(),
+		path: mpq.path,
+	}
+}
+
+// WithSettlement tells the query-builder to eager-load the nodes that are connected to
+// the "settlement" edge. The optional arguments are used to configure the query builder of the edge.
+func (mpq *MonsterProgressQuery) WithSettlement(opts ...func(*SettlementQuery)) *MonsterProgressQuery {
+	query := (&SettlementClient{config: mpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mpq.withSettlement = query
+	return mpq
+}
+
+// WithMonster tells the query-builder to eager-load the nodes that are connected to
+// the "monster" edge. The optional arguments are used to configure the query builder of the edge.
+func (mpq *MonsterProgressQuery) WithMonster(opts ...func(*MonsterQuery)) *MonsterProgressQuery {
+	query := (&MonsterClient{config: mpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mpq.withMonster = query
+	return mpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UnlockedYear int `json:"unlocked_year,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MonsterProgress.Query().
+//		GroupBy(monsterprogress.FieldUnlockedYear).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mpq *MonsterProgressQuery) GroupBy(field string, fields ...string) *MonsterProgressGroupBy {
+	mpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MonsterProgressGroupBy{build: mpq}
+	grbuild.flds = &mpq.ctx.Fields
+	grbuild.label = monsterprogress.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UnlockedYear int `json:"unlocked_year,omitempty"`
+//	}
+//
+//	client.MonsterProgress.Query().
+//		Select(monsterprogress.FieldUnlockedYear).
+//		Scan(ctx, &v)
+func (mpq *MonsterProgressQuery) Select(fields ...string) *MonsterProgressSelect {
+	mpq.ctx.Fields = append(mpq.ctx.Fields, fields...)
+	sbuild := &MonsterProgressSelect{MonsterProgressQuery: mpq}
+	sbuild.label = monsterprogress.Label
+	sbuild.flds, sbuild.scan = &mpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MonsterProgressSelect configured with the given aggregations.
+func (mpq *MonsterProgressQuery) Aggregate(fns ...AggregateFunc) *MonsterProgressSelect {
+	return mpq.Select().Aggregate(fns...)
+}
+
+func (mpq *MonsterProgressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mpq.ctx.Fields {
+		if !monsterprogress.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mpq.path != nil {
+		prev, err := mpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mpq.sql = prev
+	}
+	if monsterprogress.Policy == nil {
+		return errors.New("ent: uninitialized monsterprogress.Policy (forgotten import ent/runtime?)")
+	}
+	if err := monsterprogress.Policy.EvalQuery(ctx, mpq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (mpq *MonsterProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MonsterProgress, error) {
+	var (
+		nodes       = []*MonsterProgress{}
+		_spec       = mpq.querySpec()
+		loadedTypes = [2]bool{
+			mpq.withSettlement != nil,
+			mpq.withMonster != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MonsterProgress).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MonsterProgress{config: mpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mpq.modifiers) > 0 {
+		_spec.Modifiers = mpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mpq.withSettlement; query != nil {
+		if err := mpq.loadSettlement(ctx, query, nodes, nil,
+			func(n *MonsterProgress, e *Settlement) { n.Edges.Settlement = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := mpq.withMonster; query != nil {
+		if err := mpq.loadMonster(ctx, query, nodes, nil,
+			func(n *MonsterProgress, e *Monster) { n.Edges.Monster = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range mpq.loadTotal {
+		if err := mpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mpq *MonsterProgressQuery) loadSettlement(ctx context.Context, query *SettlementQuery, nodes []*MonsterProgress, init func(*MonsterProgress), assign func(*MonsterProgress, *Settlement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MonsterProgress)
+	for i := range nodes {
+		fk := nodes[i].SettlementID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(settlement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "settlement_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (mpq *MonsterProgressQuery) loadMonster(ctx context.Context, query *MonsterQuery, nodes []*MonsterProgress, init func(*MonsterProgress), assign func(*MonsterProgress, *Monster)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*MonsterProgress)
+	for i := range nodes {
+		fk := nodes[i].MonsterID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(monster.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "monster_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mpq *MonsterProgressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mpq.querySpec()
+	if len(mpq.modifiers) > 0 {
+		_spec.Modifiers = mpq.modifiers
+	}
+	_spec.Node.Columns = mpq.ctx.Fields
+	if len(mpq.ctx.Fields) > 0 {
+		_spec.Unique = mpq.ctx.Unique != nil && *mpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mpq.driver, _spec)
+}
+
+func (mpq *MonsterProgressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(monsterprogress.Table, monsterprogress.Columns, sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt))
+	_spec.From = mpq.sql
+	if unique := mpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monsterprogress.FieldID)
+		for i := range fields {
+			if fields[i] != monsterprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mpq.withSettlement != nil {
+			_spec.Node.AddColumnOnce(monsterprogress.FieldSettlementID)
+		}
+		if mpq.withMonster != nil {
+			_spec.Node.AddColumnOnce(monsterprogress.FieldMonsterID)
+		}
+	}
+	if ps := mpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mpq *MonsterProgressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mpq.driver.Dialect())
+	t1 := builder.Table(monsterprogress.Table)
+	columns := mpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = monsterprogress.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mpq.sql != nil {
+		selector = mpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mpq.ctx.Unique != nil && *mpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mpq.predicates {
+		p(selector)
+	}
+	for _, p := range mpq.order {
+		p(selector)
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MonsterProgressGroupBy is the group-by builder for MonsterProgress entities.
+type MonsterProgressGroupBy struct {
+	selector
+	build *MonsterProgressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mpgb *MonsterProgressGroupBy) Aggregate(fns ...AggregateFunc) *MonsterProgressGroupBy {
+	mpgb.fns = append(mpgb.fns, fns...)
+	return mpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpgb *MonsterProgressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MonsterProgressQuery, *MonsterProgressGroupBy](ctx, mpgb.build, mpgb, mpgb.build.inters, v)
+}
+
+func (mpgb *MonsterProgressGroupBy) sqlScan(ctx context.Context, root *MonsterProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mpgb.fns))
+	for _, fn := range mpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mpgb.flds)+len(mpgb.fns))
+		for _, f := range *mpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MonsterProgressSelect is the builder for selecting fields of MonsterProgress entities.
+type MonsterProgressSelect struct {
+	*MonsterProgressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mps *MonsterProgressSelect) Aggregate(fns ...AggregateFunc) *MonsterProgressSelect {
+	mps.fns = append(mps.fns, fns...)
+	return mps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mps *MonsterProgressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mps.ctx, ent.OpQuerySelect)
+	if err := mps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MonsterProgressQuery, *MonsterProgressSelect](ctx, mps.MonsterProgressQuery, mps, mps.inters, v)
+}
+
+func (mps *MonsterProgressSelect) sqlScan(ctx context.Context, root *MonsterProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mps.fns))
+	for _, fn := range mps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
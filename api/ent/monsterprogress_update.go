@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// MonsterProgressUpdate is the builder for updating MonsterProgress entities.
+type MonsterProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MonsterProgressMutation
+}
+
+// Where appends a list predicates to the MonsterProgressUpdate builder.
+func (mpu *MonsterProgressUpdate) Where(ps ...predicate.MonsterProgress) *MonsterProgressUpdate {
+	mpu.mutation.Where(ps...)
+	return mpu
+}
+
+// SetUnlockedYear sets the "unlocked_year" field.
+func (mpu *MonsterProgressUpdate) SetUnlockedYear(i int) *MonsterProgressUpdate {
+	mpu.mutation.ResetUnlockedYear()
+	mpu.mutation.SetUnlockedYear(i)
+	return mpu
+}
+
+// SetNillableUnlockedYear sets the "unlocked_year" field if the given value is not nil.
+func (mpu *MonsterProgressUpdate) SetNillableUnlockedYear(i *int) *MonsterProgressUpdate {
+	if i != nil {
+		mpu.SetUnlockedYear(*i)
+	}
+	return mpu
+}
+
+// AddUnlockedYear adds i to the "unlocked_year" field.
+func (mpu *MonsterProgressUpdate) AddUnlockedYear(i int) *MonsterProgressUpdate {
+	mpu.mutation.AddUnlockedYear(i)
+	return mpu
+}
+
+// SetDefeats sets the "defeats" field.
+func (mpu *MonsterProgressUpdate) SetDefeats(s []schematype.Defeat) *MonsterProgressUpdate {
+	mpu.mutation.SetDefeats(s)
+	return mpu
+}
+
+// AppendDefeats appends s to the "defeats" field.
+func (mpu *MonsterProgressUpdate) AppendDefeats(s []schematype.Defeat) *MonsterProgressUpdate {
+	mpu.mutation.AppendDefeats(s)
+	return mpu
+}
+
+// ClearDefeats clears the value of the "defeats" field.
+func (mpu *MonsterProgressUpdate) ClearDefeats() *MonsterProgressUpdate {
+	mpu.mutation.ClearDefeats()
+	return mpu
+}
+
+// Mutation returns the MonsterProgressMutation object of the builder.
+func (mpu *MonsterProgressUpdate) Mutation() *MonsterProgressMutation {
+	return mpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpu *MonsterProgressUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpu.sqlSave, mpu.mutation, mpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpu *MonsterProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpu *MonsterProgressUpdate) Exec(ctx context.Context) error {
+	_, err := mpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpu *MonsterProgressUpdate) ExecX(ctx context.Context) {
+	if err := mpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpu *MonsterProgressUpdate) check() error {
+	if mpu.mutation.SettlementCleared() && len(mpu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonsterProgress.settlement"`)
+	}
+	if mpu.mutation.MonsterCleared() && len(mpu.mutation.MonsterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonsterProgress.monster"`)
+	}
+	return nil
+}
+
+func (mpu *MonsterProgressUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monsterprogress.Table, monsterprogress.Columns, sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt))
+	if ps := mpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpu.mutation.UnlockedYear(); ok {
+		_spec.SetField(monsterprogress.FieldUnlockedYear, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.AddedUnlockedYear(); ok {
+		_spec.AddField(monsterprogress.FieldUnlockedYear, field.TypeInt, value)
+	}
+	if value, ok := mpu.mutation.Defeats(); ok {
+		_spec.SetField(monsterprogress.FieldDefeats, field.TypeJSON, value)
+	}
+	if value, ok := mpu.mutation.AppendedDefeats(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monsterprogress.FieldDefeats, value)
+		})
+	}
+	if mpu.mutation.DefeatsCleared() {
+		_spec.ClearField(monsterprogress.FieldDefeats, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monsterprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpu.mutation.done = true
+	return n, nil
+}
+
+// MonsterProgressUpdateOne is the builder for updating a single MonsterProgress entity.
+type MonsterProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MonsterProgressMutation
+}
+
+// SetUnlockedYear sets the "unlocked_year" field.
+func (mpuo *MonsterProgressUpdateOne) SetUnlockedYear(i int) *MonsterProgressUpdateOne {
+	mpuo.mutation.ResetUnlockedYear()
+	mpuo.mutation.SetUnlockedYear(i)
+	return mpuo
+}
+
+// SetNillableUnlockedYear sets the "unlocked_year" field if the given value is not nil.
+func (mpuo *MonsterProgressUpdateOne) SetNillableUnlockedYear(i *int) *MonsterProgressUpdateOne {
+	if i != nil {
+		mpuo.SetUnlockedYear(*i)
+	}
+	return mpuo
+}
+
+// AddUnlockedYear adds i to the "unlocked_year" field.
+func (mpuo *MonsterProgressUpdateOne) AddUnlockedYear(i int) *MonsterProgressUpdateOne {
+	mpuo.mutation.AddUnlockedYear(i)
+	return mpuo
+}
+
+// SetDefeats sets the "defeats" field.
+func (mpuo *MonsterProgressUpdateOne) SetDefeats(s []schematype.Defeat) *MonsterProgressUpdateOne {
+	mpuo.mutation.SetDefeats(s)
+	return mpuo
+}
+
+// AppendDefeats appends s to the "defeats" field.
+func (mpuo *MonsterProgressUpdateOne) AppendDefeats(s []schematype.Defeat) *MonsterProgressUpdateOne {
+	mpuo.mutation.AppendDefeats(s)
+	return mpuo
+}
+
+// ClearDefeats clears the value of the "defeats" field.
+func (mpuo *MonsterProgressUpdateOne) ClearDefeats() *MonsterProgressUpdateOne {
+	mpuo.mutation.ClearDefeats()
+	return mpuo
+}
+
+// Mutation returns the MonsterProgressMutation object of the builder.
+func (mpuo *MonsterProgressUpdateOne) Mutation() *MonsterProgressMutation {
+	return mpuo.mutation
+}
+
+// Where appends a list predicates to the MonsterProgressUpdate builder.
+func (mpuo *MonsterProgressUpdateOne) Where(ps ...predicate.MonsterProgress) *MonsterProgressUpdateOne {
+	mpuo.mutation.Where(ps...)
+	return mpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpuo *MonsterProgressUpdateOne) Select(field string, fields ...string) *MonsterProgressUpdateOne {
+	mpuo.fields = append([]string{field}, fields...)
+	return mpuo
+}
+
+// Save executes the query and returns the updated MonsterProgress entity.
+func (mpuo *MonsterProgressUpdateOne) Save(ctx context.Context) (*MonsterProgress, error) {
+	return withHooks(ctx, mpuo.sqlSave, mpuo.mutation, mpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpuo *MonsterProgressUpdateOne) SaveX(ctx context.Context) *MonsterProgress {
+	node, err := mpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpuo *MonsterProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpuo *MonsterProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := mpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpuo *MonsterProgressUpdateOne) check() error {
+	if mpuo.mutation.SettlementCleared() && len(mpuo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonsterProgress.settlement"`)
+	}
+	if mpuo.mutation.MonsterCleared() && len(mpuo.mutation.MonsterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MonsterProgress.monster"`)
+	}
+	return nil
+}
+
+func (mpuo *MonsterProgressUpdateOne) sqlSave(ctx context.Context) (_node *MonsterProgress, err error) {
+	if err := mpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monsterprogress.Table, monsterprogress.Columns, sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt))
+	id, ok := mpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MonsterProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monsterprogress.FieldID)
+		for _, f := range fields {
+			if !monsterprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != monsterprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpuo.mutation.UnlockedYear(); ok {
+		_spec.SetField(monsterprogress.FieldUnlockedYear, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.AddedUnlockedYear(); ok {
+		_spec.AddField(monsterprogress.FieldUnlockedYear, field.TypeInt, value)
+	}
+	if value, ok := mpuo.mutation.Defeats(); ok {
+		_spec.SetField(monsterprogress.FieldDefeats, field.TypeJSON, value)
+	}
+	if value, ok := mpuo.mutation.AppendedDefeats(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monsterprogress.FieldDefeats, value)
+		})
+	}
+	if mpuo.mutation.DefeatsCleared() {
+		_spec.ClearField(monsterprogress.FieldDefeats, field.TypeJSON)
+	}
+	_node = &MonsterProgress{config: mpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monsterprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpuo.mutation.done = true
+	return _node, nil
+}
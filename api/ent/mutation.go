@@ -7,12 +7,30 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
 	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 const (
@@ -24,46 +42,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeSettlement = "Settlement"
-	TypeSurvivor   = "Survivor"
+	TypeAbility         = "Ability"
+	TypeAccessToken     = "AccessToken"
+	TypeAuditLog        = "AuditLog"
+	TypeDisorder        = "Disorder"
+	TypeFightingArt     = "FightingArt"
+	TypeInnovation      = "Innovation"
+	TypeInvite          = "Invite"
+	TypeLocation        = "Location"
+	TypeMembership      = "Membership"
+	TypeMilestone       = "Milestone"
+	TypeMonster         = "Monster"
+	TypeMonsterProgress = "MonsterProgress"
+	TypePrinciple       = "Principle"
+	TypeRecipe          = "Recipe"
+	TypeSettlement      = "Settlement"
+	TypeStorageItem     = "StorageItem"
+	TypeSurvivor        = "Survivor"
+	TypeTimelineEvent   = "TimelineEvent"
 )
 
-// SettlementMutation represents an operation that mutates the Settlement nodes in the graph.
-type SettlementMutation struct {
+// AbilityMutation represents an operation that mutates the Ability nodes in the graph.
+type AbilityMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	owner                  *string
-	name                   *string
-	survivalLimit          *int
-	addsurvivalLimit       *int
-	departingSurvival      *int
-	adddepartingSurvival   *int
-	collectiveCognition    *int
-	addcollectiveCognition *int
-	currentYear            *int
-	addcurrentYear         *int
-	clearedFields          map[string]struct{}
-	population             map[int]struct{}
-	removedpopulation      map[int]struct{}
-	clearedpopulation      bool
-	done                   bool
-	oldValue               func(context.Context) (*Settlement, error)
-	predicates             []predicate.Settlement
-}
-
-var _ ent.Mutation = (*SettlementMutation)(nil)
-
-// settlementOption allows management of the mutation configuration using functional options.
-type settlementOption func(*SettlementMutation)
-
-// newSettlementMutation creates new mutation for the Settlement entity.
-func newSettlementMutation(c config, op Op, opts ...settlementOption) *SettlementMutation {
-	m := &SettlementMutation{
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	kind             *ability.Kind
+	rules            *string
+	modifiers        *[]schematype.StatModifier
+	appendmodifiers  []schematype.StatModifier
+	clearedFields    map[string]struct{}
+	survivors        map[int]struct{}
+	removedsurvivors map[int]struct{}
+	clearedsurvivors bool
+	done             bool
+	oldValue         func(context.Context) (*Ability, error)
+	predicates       []predicate.Ability
+}
+
+var _ ent.Mutation = (*AbilityMutation)(nil)
+
+// abilityOption allows management of the mutation configuration using functional options.
+type abilityOption func(*AbilityMutation)
+
+// newAbilityMutation creates new mutation for the Ability entity.
+func newAbilityMutation(c config, op Op, opts ...abilityOption) *AbilityMutation {
+	m := &AbilityMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSettlement,
+		typ:           TypeAbility,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +101,20 @@ func newSettlementMutation(c config, op Op, opts ...settlementOption) *Settlemen
 	return m
 }
 
-// withSettlementID sets the ID field of the mutation.
-func withSettlementID(id int) settlementOption {
-	return func(m *SettlementMutation) {
+// withAbilityID sets the ID field of the mutation.
+func withAbilityID(id int) abilityOption {
+	return func(m *AbilityMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Settlement
+			value *Ability
 		)
-		m.oldValue = func(ctx context.Context) (*Settlement, error) {
+		m.oldValue = func(ctx context.Context) (*Ability, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Settlement.Get(ctx, id)
+					value, err = m.Client().Ability.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +123,10 @@ func withSettlementID(id int) settlementOption {
 	}
 }
 
-// withSettlement sets the old Settlement of the mutation.
-func withSettlement(node *Settlement) settlementOption {
-	return func(m *SettlementMutation) {
-		m.oldValue = func(context.Context) (*Settlement, error) {
+// withAbility sets the old Ability of the mutation.
+func withAbility(node *Ability) abilityOption {
+	return func(m *AbilityMutation) {
+		m.oldValue = func(context.Context) (*Ability, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +135,7 @@ func withSettlement(node *Settlement) settlementOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettlementMutation) Client() *Client {
+func (m AbilityMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +143,7 @@ func (m SettlementMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettlementMutation) Tx() (*Tx, error) {
+func (m AbilityMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -125,7 +154,7 @@ func (m SettlementMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettlementMutation) ID() (id int, exists bool) {
+func (m *AbilityMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -136,7 +165,7 @@ func (m *SettlementMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettlementMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AbilityMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -145,55 +174,19 @@ func (m *SettlementMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settlement.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Ability.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOwner sets the "owner" field.
-func (m *SettlementMutation) SetOwner(s string) {
-	m.owner = &s
-}
-
-// Owner returns the value of the "owner" field in the mutation.
-func (m *SettlementMutation) Owner() (r string, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOwner returns the old "owner" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldOwner(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
-	}
-	return oldValue.Owner, nil
-}
-
-// ResetOwner resets all changes to the "owner" field.
-func (m *SettlementMutation) ResetOwner() {
-	m.owner = nil
-}
-
 // SetName sets the "name" field.
-func (m *SettlementMutation) SetName(s string) {
+func (m *AbilityMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *SettlementMutation) Name() (r string, exists bool) {
+func (m *AbilityMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -201,10 +194,10 @@ func (m *SettlementMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Ability entity.
+// If the Ability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AbilityMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -219,297 +212,210 @@ func (m *SettlementMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *SettlementMutation) ResetName() {
+func (m *AbilityMutation) ResetName() {
 	m.name = nil
 }
 
-// SetSurvivalLimit sets the "survivalLimit" field.
-func (m *SettlementMutation) SetSurvivalLimit(i int) {
-	m.survivalLimit = &i
-	m.addsurvivalLimit = nil
+// SetKind sets the "kind" field.
+func (m *AbilityMutation) SetKind(a ability.Kind) {
+	m.kind = &a
 }
 
-// SurvivalLimit returns the value of the "survivalLimit" field in the mutation.
-func (m *SettlementMutation) SurvivalLimit() (r int, exists bool) {
-	v := m.survivalLimit
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AbilityMutation) Kind() (r ability.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSurvivalLimit returns the old "survivalLimit" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Ability entity.
+// If the Ability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldSurvivalLimit(ctx context.Context) (v int, err error) {
+func (m *AbilityMutation) OldKind(ctx context.Context) (v ability.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSurvivalLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSurvivalLimit requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSurvivalLimit: %w", err)
-	}
-	return oldValue.SurvivalLimit, nil
-}
-
-// AddSurvivalLimit adds i to the "survivalLimit" field.
-func (m *SettlementMutation) AddSurvivalLimit(i int) {
-	if m.addsurvivalLimit != nil {
-		*m.addsurvivalLimit += i
-	} else {
-		m.addsurvivalLimit = &i
-	}
-}
-
-// AddedSurvivalLimit returns the value that was added to the "survivalLimit" field in this mutation.
-func (m *SettlementMutation) AddedSurvivalLimit() (r int, exists bool) {
-	v := m.addsurvivalLimit
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return *v, true
+	return oldValue.Kind, nil
 }
 
-// ResetSurvivalLimit resets all changes to the "survivalLimit" field.
-func (m *SettlementMutation) ResetSurvivalLimit() {
-	m.survivalLimit = nil
-	m.addsurvivalLimit = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *AbilityMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetDepartingSurvival sets the "departingSurvival" field.
-func (m *SettlementMutation) SetDepartingSurvival(i int) {
-	m.departingSurvival = &i
-	m.adddepartingSurvival = nil
+// SetRules sets the "rules" field.
+func (m *AbilityMutation) SetRules(s string) {
+	m.rules = &s
 }
 
-// DepartingSurvival returns the value of the "departingSurvival" field in the mutation.
-func (m *SettlementMutation) DepartingSurvival() (r int, exists bool) {
-	v := m.departingSurvival
+// Rules returns the value of the "rules" field in the mutation.
+func (m *AbilityMutation) Rules() (r string, exists bool) {
+	v := m.rules
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDepartingSurvival returns the old "departingSurvival" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// OldRules returns the old "rules" field's value of the Ability entity.
+// If the Ability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldDepartingSurvival(ctx context.Context) (v int, err error) {
+func (m *AbilityMutation) OldRules(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDepartingSurvival is only allowed on UpdateOne operations")
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDepartingSurvival requires an ID field in the mutation")
+		return v, errors.New("OldRules requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDepartingSurvival: %w", err)
-	}
-	return oldValue.DepartingSurvival, nil
-}
-
-// AddDepartingSurvival adds i to the "departingSurvival" field.
-func (m *SettlementMutation) AddDepartingSurvival(i int) {
-	if m.adddepartingSurvival != nil {
-		*m.adddepartingSurvival += i
-	} else {
-		m.adddepartingSurvival = &i
-	}
-}
-
-// AddedDepartingSurvival returns the value that was added to the "departingSurvival" field in this mutation.
-func (m *SettlementMutation) AddedDepartingSurvival() (r int, exists bool) {
-	v := m.adddepartingSurvival
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
 	}
-	return *v, true
+	return oldValue.Rules, nil
 }
 
-// ResetDepartingSurvival resets all changes to the "departingSurvival" field.
-func (m *SettlementMutation) ResetDepartingSurvival() {
-	m.departingSurvival = nil
-	m.adddepartingSurvival = nil
+// ResetRules resets all changes to the "rules" field.
+func (m *AbilityMutation) ResetRules() {
+	m.rules = nil
 }
 
-// SetCollectiveCognition sets the "collectiveCognition" field.
-func (m *SettlementMutation) SetCollectiveCognition(i int) {
-	m.collectiveCognition = &i
-	m.addcollectiveCognition = nil
+// SetModifiers sets the "modifiers" field.
+func (m *AbilityMutation) SetModifiers(sm []schematype.StatModifier) {
+	m.modifiers = &sm
+	m.appendmodifiers = nil
 }
 
-// CollectiveCognition returns the value of the "collectiveCognition" field in the mutation.
-func (m *SettlementMutation) CollectiveCognition() (r int, exists bool) {
-	v := m.collectiveCognition
+// Modifiers returns the value of the "modifiers" field in the mutation.
+func (m *AbilityMutation) Modifiers() (r []schematype.StatModifier, exists bool) {
+	v := m.modifiers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCollectiveCognition returns the old "collectiveCognition" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// OldModifiers returns the old "modifiers" field's value of the Ability entity.
+// If the Ability object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldCollectiveCognition(ctx context.Context) (v int, err error) {
+func (m *AbilityMutation) OldModifiers(ctx context.Context) (v []schematype.StatModifier, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollectiveCognition is only allowed on UpdateOne operations")
+		return v, errors.New("OldModifiers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollectiveCognition requires an ID field in the mutation")
+		return v, errors.New("OldModifiers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollectiveCognition: %w", err)
+		return v, fmt.Errorf("querying old value for OldModifiers: %w", err)
 	}
-	return oldValue.CollectiveCognition, nil
+	return oldValue.Modifiers, nil
 }
 
-// AddCollectiveCognition adds i to the "collectiveCognition" field.
-func (m *SettlementMutation) AddCollectiveCognition(i int) {
-	if m.addcollectiveCognition != nil {
-		*m.addcollectiveCognition += i
-	} else {
-		m.addcollectiveCognition = &i
-	}
+// AppendModifiers adds sm to the "modifiers" field.
+func (m *AbilityMutation) AppendModifiers(sm []schematype.StatModifier) {
+	m.appendmodifiers = append(m.appendmodifiers, sm...)
 }
 
-// AddedCollectiveCognition returns the value that was added to the "collectiveCognition" field in this mutation.
-func (m *SettlementMutation) AddedCollectiveCognition() (r int, exists bool) {
-	v := m.addcollectiveCognition
-	if v == nil {
-		return
+// AppendedModifiers returns the list of values that were appended to the "modifiers" field in this mutation.
+func (m *AbilityMutation) AppendedModifiers() ([]schematype.StatModifier, bool) {
+	if len(m.appendmodifiers) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendmodifiers, true
 }
 
-// ResetCollectiveCognition resets all changes to the "collectiveCognition" field.
-func (m *SettlementMutation) ResetCollectiveCognition() {
-	m.collectiveCognition = nil
-	m.addcollectiveCognition = nil
+// ClearModifiers clears the value of the "modifiers" field.
+func (m *AbilityMutation) ClearModifiers() {
+	m.modifiers = nil
+	m.appendmodifiers = nil
+	m.clearedFields[ability.FieldModifiers] = struct{}{}
 }
 
-// SetCurrentYear sets the "currentYear" field.
-func (m *SettlementMutation) SetCurrentYear(i int) {
-	m.currentYear = &i
-	m.addcurrentYear = nil
+// ModifiersCleared returns if the "modifiers" field was cleared in this mutation.
+func (m *AbilityMutation) ModifiersCleared() bool {
+	_, ok := m.clearedFields[ability.FieldModifiers]
+	return ok
 }
 
-// CurrentYear returns the value of the "currentYear" field in the mutation.
-func (m *SettlementMutation) CurrentYear() (r int, exists bool) {
-	v := m.currentYear
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetModifiers resets all changes to the "modifiers" field.
+func (m *AbilityMutation) ResetModifiers() {
+	m.modifiers = nil
+	m.appendmodifiers = nil
+	delete(m.clearedFields, ability.FieldModifiers)
 }
 
-// OldCurrentYear returns the old "currentYear" field's value of the Settlement entity.
-// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettlementMutation) OldCurrentYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentYear requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentYear: %w", err)
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by ids.
+func (m *AbilityMutation) AddSurvivorIDs(ids ...int) {
+	if m.survivors == nil {
+		m.survivors = make(map[int]struct{})
 	}
-	return oldValue.CurrentYear, nil
-}
-
-// AddCurrentYear adds i to the "currentYear" field.
-func (m *SettlementMutation) AddCurrentYear(i int) {
-	if m.addcurrentYear != nil {
-		*m.addcurrentYear += i
-	} else {
-		m.addcurrentYear = &i
+	for i := range ids {
+		m.survivors[ids[i]] = struct{}{}
 	}
 }
 
-// AddedCurrentYear returns the value that was added to the "currentYear" field in this mutation.
-func (m *SettlementMutation) AddedCurrentYear() (r int, exists bool) {
-	v := m.addcurrentYear
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSurvivors clears the "survivors" edge to the Survivor entity.
+func (m *AbilityMutation) ClearSurvivors() {
+	m.clearedsurvivors = true
 }
 
-// ResetCurrentYear resets all changes to the "currentYear" field.
-func (m *SettlementMutation) ResetCurrentYear() {
-	m.currentYear = nil
-	m.addcurrentYear = nil
+// SurvivorsCleared reports if the "survivors" edge to the Survivor entity was cleared.
+func (m *AbilityMutation) SurvivorsCleared() bool {
+	return m.clearedsurvivors
 }
 
-// AddPopulationIDs adds the "population" edge to the Survivor entity by ids.
-func (m *SettlementMutation) AddPopulationIDs(ids ...int) {
-	if m.population == nil {
-		m.population = make(map[int]struct{})
+// RemoveSurvivorIDs removes the "survivors" edge to the Survivor entity by IDs.
+func (m *AbilityMutation) RemoveSurvivorIDs(ids ...int) {
+	if m.removedsurvivors == nil {
+		m.removedsurvivors = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.population[ids[i]] = struct{}{}
+		delete(m.survivors, ids[i])
+		m.removedsurvivors[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPopulation clears the "population" edge to the Survivor entity.
-func (m *SettlementMutation) ClearPopulation() {
-	m.clearedpopulation = true
+// RemovedSurvivors returns the removed IDs of the "survivors" edge to the Survivor entity.
+func (m *AbilityMutation) RemovedSurvivorsIDs() (ids []int) {
+	for id := range m.removedsurvivors {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PopulationCleared reports if the "population" edge to the Survivor entity was cleared.
-func (m *SettlementMutation) PopulationCleared() bool {
-	return m.clearedpopulation
+// SurvivorsIDs returns the "survivors" edge IDs in the mutation.
+func (m *AbilityMutation) SurvivorsIDs() (ids []int) {
+	for id := range m.survivors {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RemovePopulationIDs removes the "population" edge to the Survivor entity by IDs.
-func (m *SettlementMutation) RemovePopulationIDs(ids ...int) {
-	if m.removedpopulation == nil {
-		m.removedpopulation = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.population, ids[i])
-		m.removedpopulation[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedPopulation returns the removed IDs of the "population" edge to the Survivor entity.
-func (m *SettlementMutation) RemovedPopulationIDs() (ids []int) {
-	for id := range m.removedpopulation {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// PopulationIDs returns the "population" edge IDs in the mutation.
-func (m *SettlementMutation) PopulationIDs() (ids []int) {
-	for id := range m.population {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetPopulation resets all changes to the "population" edge.
-func (m *SettlementMutation) ResetPopulation() {
-	m.population = nil
-	m.clearedpopulation = false
-	m.removedpopulation = nil
+// ResetSurvivors resets all changes to the "survivors" edge.
+func (m *AbilityMutation) ResetSurvivors() {
+	m.survivors = nil
+	m.clearedsurvivors = false
+	m.removedsurvivors = nil
 }
 
-// Where appends a list predicates to the SettlementMutation builder.
-func (m *SettlementMutation) Where(ps ...predicate.Settlement) {
+// Where appends a list predicates to the AbilityMutation builder.
+func (m *AbilityMutation) Where(ps ...predicate.Ability) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettlementMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AbilityMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettlementMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Settlement, len(ps))
+func (m *AbilityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ability, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -517,42 +423,36 @@ func (m *SettlementMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettlementMutation) Op() Op {
+func (m *AbilityMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettlementMutation) SetOp(op Op) {
+func (m *AbilityMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Settlement).
-func (m *SettlementMutation) Type() string {
+// Type returns the node type of this mutation (Ability).
+func (m *AbilityMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettlementMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.owner != nil {
-		fields = append(fields, settlement.FieldOwner)
-	}
+func (m *AbilityMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.name != nil {
-		fields = append(fields, settlement.FieldName)
-	}
-	if m.survivalLimit != nil {
-		fields = append(fields, settlement.FieldSurvivalLimit)
+		fields = append(fields, ability.FieldName)
 	}
-	if m.departingSurvival != nil {
-		fields = append(fields, settlement.FieldDepartingSurvival)
+	if m.kind != nil {
+		fields = append(fields, ability.FieldKind)
 	}
-	if m.collectiveCognition != nil {
-		fields = append(fields, settlement.FieldCollectiveCognition)
+	if m.rules != nil {
+		fields = append(fields, ability.FieldRules)
 	}
-	if m.currentYear != nil {
-		fields = append(fields, settlement.FieldCurrentYear)
+	if m.modifiers != nil {
+		fields = append(fields, ability.FieldModifiers)
 	}
 	return fields
 }
@@ -560,20 +460,16 @@ func (m *SettlementMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettlementMutation) Field(name string) (ent.Value, bool) {
+func (m *AbilityMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case settlement.FieldOwner:
-		return m.Owner()
-	case settlement.FieldName:
+	case ability.FieldName:
 		return m.Name()
-	case settlement.FieldSurvivalLimit:
-		return m.SurvivalLimit()
-	case settlement.FieldDepartingSurvival:
-		return m.DepartingSurvival()
-	case settlement.FieldCollectiveCognition:
-		return m.CollectiveCognition()
-	case settlement.FieldCurrentYear:
-		return m.CurrentYear()
+	case ability.FieldKind:
+		return m.Kind()
+	case ability.FieldRules:
+		return m.Rules()
+	case ability.FieldModifiers:
+		return m.Modifiers()
 	}
 	return nil, false
 }
@@ -581,209 +477,143 @@ func (m *SettlementMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettlementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AbilityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case settlement.FieldOwner:
-		return m.OldOwner(ctx)
-	case settlement.FieldName:
+	case ability.FieldName:
 		return m.OldName(ctx)
-	case settlement.FieldSurvivalLimit:
-		return m.OldSurvivalLimit(ctx)
-	case settlement.FieldDepartingSurvival:
-		return m.OldDepartingSurvival(ctx)
-	case settlement.FieldCollectiveCognition:
-		return m.OldCollectiveCognition(ctx)
-	case settlement.FieldCurrentYear:
-		return m.OldCurrentYear(ctx)
+	case ability.FieldKind:
+		return m.OldKind(ctx)
+	case ability.FieldRules:
+		return m.OldRules(ctx)
+	case ability.FieldModifiers:
+		return m.OldModifiers(ctx)
 	}
-	return nil, fmt.Errorf("unknown Settlement field %s", name)
+	return nil, fmt.Errorf("unknown Ability field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettlementMutation) SetField(name string, value ent.Value) error {
+func (m *AbilityMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case settlement.FieldOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwner(v)
-		return nil
-	case settlement.FieldName:
+	case ability.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case settlement.FieldSurvivalLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSurvivalLimit(v)
-		return nil
-	case settlement.FieldDepartingSurvival:
-		v, ok := value.(int)
+	case ability.FieldKind:
+		v, ok := value.(ability.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDepartingSurvival(v)
+		m.SetKind(v)
 		return nil
-	case settlement.FieldCollectiveCognition:
-		v, ok := value.(int)
+	case ability.FieldRules:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCollectiveCognition(v)
+		m.SetRules(v)
 		return nil
-	case settlement.FieldCurrentYear:
-		v, ok := value.(int)
+	case ability.FieldModifiers:
+		v, ok := value.([]schematype.StatModifier)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrentYear(v)
+		m.SetModifiers(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Settlement field %s", name)
+	return fmt.Errorf("unknown Ability field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettlementMutation) AddedFields() []string {
-	var fields []string
-	if m.addsurvivalLimit != nil {
-		fields = append(fields, settlement.FieldSurvivalLimit)
-	}
-	if m.adddepartingSurvival != nil {
-		fields = append(fields, settlement.FieldDepartingSurvival)
-	}
-	if m.addcollectiveCognition != nil {
-		fields = append(fields, settlement.FieldCollectiveCognition)
-	}
-	if m.addcurrentYear != nil {
-		fields = append(fields, settlement.FieldCurrentYear)
-	}
-	return fields
+func (m *AbilityMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettlementMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case settlement.FieldSurvivalLimit:
-		return m.AddedSurvivalLimit()
-	case settlement.FieldDepartingSurvival:
-		return m.AddedDepartingSurvival()
-	case settlement.FieldCollectiveCognition:
-		return m.AddedCollectiveCognition()
-	case settlement.FieldCurrentYear:
-		return m.AddedCurrentYear()
-	}
+func (m *AbilityMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettlementMutation) AddField(name string, value ent.Value) error {
+func (m *AbilityMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case settlement.FieldSurvivalLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSurvivalLimit(v)
-		return nil
-	case settlement.FieldDepartingSurvival:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDepartingSurvival(v)
-		return nil
-	case settlement.FieldCollectiveCognition:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCollectiveCognition(v)
-		return nil
-	case settlement.FieldCurrentYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCurrentYear(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Settlement numeric field %s", name)
+	return fmt.Errorf("unknown Ability numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettlementMutation) ClearedFields() []string {
-	return nil
+func (m *AbilityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ability.FieldModifiers) {
+		fields = append(fields, ability.FieldModifiers)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettlementMutation) FieldCleared(name string) bool {
+func (m *AbilityMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettlementMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Settlement nullable field %s", name)
+func (m *AbilityMutation) ClearField(name string) error {
+	switch name {
+	case ability.FieldModifiers:
+		m.ClearModifiers()
+		return nil
+	}
+	return fmt.Errorf("unknown Ability nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettlementMutation) ResetField(name string) error {
+func (m *AbilityMutation) ResetField(name string) error {
 	switch name {
-	case settlement.FieldOwner:
-		m.ResetOwner()
-		return nil
-	case settlement.FieldName:
+	case ability.FieldName:
 		m.ResetName()
 		return nil
-	case settlement.FieldSurvivalLimit:
-		m.ResetSurvivalLimit()
-		return nil
-	case settlement.FieldDepartingSurvival:
-		m.ResetDepartingSurvival()
+	case ability.FieldKind:
+		m.ResetKind()
 		return nil
-	case settlement.FieldCollectiveCognition:
-		m.ResetCollectiveCognition()
+	case ability.FieldRules:
+		m.ResetRules()
 		return nil
-	case settlement.FieldCurrentYear:
-		m.ResetCurrentYear()
+	case ability.FieldModifiers:
+		m.ResetModifiers()
 		return nil
 	}
-	return fmt.Errorf("unknown Settlement field %s", name)
+	return fmt.Errorf("unknown Ability field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettlementMutation) AddedEdges() []string {
+func (m *AbilityMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.population != nil {
-		edges = append(edges, settlement.EdgePopulation)
+	if m.survivors != nil {
+		edges = append(edges, ability.EdgeSurvivors)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettlementMutation) AddedIDs(name string) []ent.Value {
+func (m *AbilityMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case settlement.EdgePopulation:
-		ids := make([]ent.Value, 0, len(m.population))
-		for id := range m.population {
+	case ability.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.survivors))
+		for id := range m.survivors {
 			ids = append(ids, id)
 		}
 		return ids
@@ -792,21 +622,21 @@ func (m *SettlementMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettlementMutation) RemovedEdges() []string {
+func (m *AbilityMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedpopulation != nil {
-		edges = append(edges, settlement.EdgePopulation)
+	if m.removedsurvivors != nil {
+		edges = append(edges, ability.EdgeSurvivors)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettlementMutation) RemovedIDs(name string) []ent.Value {
+func (m *AbilityMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case settlement.EdgePopulation:
-		ids := make([]ent.Value, 0, len(m.removedpopulation))
-		for id := range m.removedpopulation {
+	case ability.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.removedsurvivors))
+		for id := range m.removedsurvivors {
 			ids = append(ids, id)
 		}
 		return ids
@@ -815,103 +645,74 @@ func (m *SettlementMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettlementMutation) ClearedEdges() []string {
+func (m *AbilityMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedpopulation {
-		edges = append(edges, settlement.EdgePopulation)
+	if m.clearedsurvivors {
+		edges = append(edges, ability.EdgeSurvivors)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettlementMutation) EdgeCleared(name string) bool {
+func (m *AbilityMutation) EdgeCleared(name string) bool {
 	switch name {
-	case settlement.EdgePopulation:
-		return m.clearedpopulation
+	case ability.EdgeSurvivors:
+		return m.clearedsurvivors
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettlementMutation) ClearEdge(name string) error {
+func (m *AbilityMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Settlement unique edge %s", name)
+	return fmt.Errorf("unknown Ability unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettlementMutation) ResetEdge(name string) error {
+func (m *AbilityMutation) ResetEdge(name string) error {
 	switch name {
-	case settlement.EdgePopulation:
-		m.ResetPopulation()
+	case ability.EdgeSurvivors:
+		m.ResetSurvivors()
 		return nil
 	}
-	return fmt.Errorf("unknown Settlement edge %s", name)
+	return fmt.Errorf("unknown Ability edge %s", name)
 }
 
-// SurvivorMutation represents an operation that mutates the Survivor nodes in the graph.
-type SurvivorMutation struct {
+// AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
+type AccessTokenMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	name                  *string
-	born                  *int
-	addborn               *int
-	gender                *survivor.Gender
-	huntxp                *int
-	addhuntxp             *int
-	survival              *int
-	addsurvival           *int
-	movement              *int
-	addmovement           *int
-	accuracy              *int
-	addaccuracy           *int
-	strength              *int
-	addstrength           *int
-	evasion               *int
-	addevasion            *int
-	luck                  *int
-	addluck               *int
-	speed                 *int
-	addspeed              *int
-	systemicpressure      *int
-	addsystemicpressure   *int
-	torment               *int
-	addtorment            *int
-	insanity              *int
-	addinsanity           *int
-	lumi                  *int
-	addlumi               *int
-	courage               *int
-	addcourage            *int
-	understanding         *int
-	addunderstanding      *int
-	status                *survivor.Status
-	status_change_year    *int
-	addstatus_change_year *int
-	clearedFields         map[string]struct{}
-	settlement            *int
-	clearedsettlement     bool
-	done                  bool
-	oldValue              func(context.Context) (*Survivor, error)
-	predicates            []predicate.Survivor
-}
-
-var _ ent.Mutation = (*SurvivorMutation)(nil)
-
-// survivorOption allows management of the mutation configuration using functional options.
-type survivorOption func(*SurvivorMutation)
-
-// newSurvivorMutation creates new mutation for the Survivor entity.
-func newSurvivorMutation(c config, op Op, opts ...survivorOption) *SurvivorMutation {
-	m := &SurvivorMutation{
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	name          *string
+	token_hash    *string
+	scope         *accesstoken.Scope
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AccessToken, error)
+	predicates    []predicate.AccessToken
+}
+
+var _ ent.Mutation = (*AccessTokenMutation)(nil)
+
+// accesstokenOption allows management of the mutation configuration using functional options.
+type accesstokenOption func(*AccessTokenMutation)
+
+// newAccessTokenMutation creates new mutation for the AccessToken entity.
+func newAccessTokenMutation(c config, op Op, opts ...accesstokenOption) *AccessTokenMutation {
+	m := &AccessTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSurvivor,
+		typ:           TypeAccessToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -920,20 +721,20 @@ func newSurvivorMutation(c config, op Op, opts ...survivorOption) *SurvivorMutat
 	return m
 }
 
-// withSurvivorID sets the ID field of the mutation.
-func withSurvivorID(id int) survivorOption {
-	return func(m *SurvivorMutation) {
+// withAccessTokenID sets the ID field of the mutation.
+func withAccessTokenID(id int) accesstokenOption {
+	return func(m *AccessTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Survivor
+			value *AccessToken
 		)
-		m.oldValue = func(ctx context.Context) (*Survivor, error) {
+		m.oldValue = func(ctx context.Context) (*AccessToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Survivor.Get(ctx, id)
+					value, err = m.Client().AccessToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -942,10 +743,10 @@ func withSurvivorID(id int) survivorOption {
 	}
 }
 
-// withSurvivor sets the old Survivor of the mutation.
-func withSurvivor(node *Survivor) survivorOption {
-	return func(m *SurvivorMutation) {
-		m.oldValue = func(context.Context) (*Survivor, error) {
+// withAccessToken sets the old AccessToken of the mutation.
+func withAccessToken(node *AccessToken) accesstokenOption {
+	return func(m *AccessTokenMutation) {
+		m.oldValue = func(context.Context) (*AccessToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -954,7 +755,7 @@ func withSurvivor(node *Survivor) survivorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SurvivorMutation) Client() *Client {
+func (m AccessTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -962,7 +763,7 @@ func (m SurvivorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SurvivorMutation) Tx() (*Tx, error) {
+func (m AccessTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -973,7 +774,7 @@ func (m SurvivorMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SurvivorMutation) ID() (id int, exists bool) {
+func (m *AccessTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -984,7 +785,7 @@ func (m *SurvivorMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SurvivorMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AccessTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -993,1023 +794,1067 @@ func (m *SurvivorMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Survivor.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *SurvivorMutation) SetName(s string) {
-	m.name = &s
+// SetUserID sets the "user_id" field.
+func (m *AccessTokenMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SurvivorMutation) Name() (r string, exists bool) {
-	v := m.name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AccessTokenMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UserID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SurvivorMutation) ResetName() {
-	m.name = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AccessTokenMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetBorn sets the "born" field.
-func (m *SurvivorMutation) SetBorn(i int) {
-	m.born = &i
-	m.addborn = nil
+// SetName sets the "name" field.
+func (m *AccessTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Born returns the value of the "born" field in the mutation.
-func (m *SurvivorMutation) Born() (r int, exists bool) {
-	v := m.born
+// Name returns the value of the "name" field in the mutation.
+func (m *AccessTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBorn returns the old "born" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldBorn(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBorn is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBorn requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBorn: %w", err)
-	}
-	return oldValue.Born, nil
-}
-
-// AddBorn adds i to the "born" field.
-func (m *SurvivorMutation) AddBorn(i int) {
-	if m.addborn != nil {
-		*m.addborn += i
-	} else {
-		m.addborn = &i
-	}
-}
-
-// AddedBorn returns the value that was added to the "born" field in this mutation.
-func (m *SurvivorMutation) AddedBorn() (r int, exists bool) {
-	v := m.addborn
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetBorn resets all changes to the "born" field.
-func (m *SurvivorMutation) ResetBorn() {
-	m.born = nil
-	m.addborn = nil
+// ResetName resets all changes to the "name" field.
+func (m *AccessTokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetGender sets the "gender" field.
-func (m *SurvivorMutation) SetGender(s survivor.Gender) {
-	m.gender = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *AccessTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Gender returns the value of the "gender" field in the mutation.
-func (m *SurvivorMutation) Gender() (r survivor.Gender, exists bool) {
-	v := m.gender
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AccessTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGender returns the old "gender" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldGender(ctx context.Context) (v survivor.Gender, err error) {
+func (m *AccessTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGender requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Gender, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetGender resets all changes to the "gender" field.
-func (m *SurvivorMutation) ResetGender() {
-	m.gender = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AccessTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetHuntxp sets the "huntxp" field.
-func (m *SurvivorMutation) SetHuntxp(i int) {
-	m.huntxp = &i
-	m.addhuntxp = nil
+// SetScope sets the "scope" field.
+func (m *AccessTokenMutation) SetScope(a accesstoken.Scope) {
+	m.scope = &a
 }
 
-// Huntxp returns the value of the "huntxp" field in the mutation.
-func (m *SurvivorMutation) Huntxp() (r int, exists bool) {
-	v := m.huntxp
+// Scope returns the value of the "scope" field in the mutation.
+func (m *AccessTokenMutation) Scope() (r accesstoken.Scope, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHuntxp returns the old "huntxp" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldHuntxp(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldScope(ctx context.Context) (v accesstoken.Scope, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHuntxp is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHuntxp requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHuntxp: %w", err)
-	}
-	return oldValue.Huntxp, nil
-}
-
-// AddHuntxp adds i to the "huntxp" field.
-func (m *SurvivorMutation) AddHuntxp(i int) {
-	if m.addhuntxp != nil {
-		*m.addhuntxp += i
-	} else {
-		m.addhuntxp = &i
-	}
-}
-
-// AddedHuntxp returns the value that was added to the "huntxp" field in this mutation.
-func (m *SurvivorMutation) AddedHuntxp() (r int, exists bool) {
-	v := m.addhuntxp
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return *v, true
+	return oldValue.Scope, nil
 }
 
-// ResetHuntxp resets all changes to the "huntxp" field.
-func (m *SurvivorMutation) ResetHuntxp() {
-	m.huntxp = nil
-	m.addhuntxp = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *AccessTokenMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetSurvival sets the "survival" field.
-func (m *SurvivorMutation) SetSurvival(i int) {
-	m.survival = &i
-	m.addsurvival = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *AccessTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Survival returns the value of the "survival" field in the mutation.
-func (m *SurvivorMutation) Survival() (r int, exists bool) {
-	v := m.survival
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AccessTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSurvival returns the old "survival" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldSurvival(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSurvival is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSurvival requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSurvival: %w", err)
-	}
-	return oldValue.Survival, nil
-}
-
-// AddSurvival adds i to the "survival" field.
-func (m *SurvivorMutation) AddSurvival(i int) {
-	if m.addsurvival != nil {
-		*m.addsurvival += i
-	} else {
-		m.addsurvival = &i
-	}
-}
-
-// AddedSurvival returns the value that was added to the "survival" field in this mutation.
-func (m *SurvivorMutation) AddedSurvival() (r int, exists bool) {
-	v := m.addsurvival
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return *v, true
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetSurvival resets all changes to the "survival" field.
-func (m *SurvivorMutation) ResetSurvival() {
-	m.survival = nil
-	m.addsurvival = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AccessTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetMovement sets the "movement" field.
-func (m *SurvivorMutation) SetMovement(i int) {
-	m.movement = &i
-	m.addmovement = nil
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AccessTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Movement returns the value of the "movement" field in the mutation.
-func (m *SurvivorMutation) Movement() (r int, exists bool) {
-	v := m.movement
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AccessTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMovement returns the old "movement" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldMovement(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMovement is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMovement requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMovement: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Movement, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// AddMovement adds i to the "movement" field.
-func (m *SurvivorMutation) AddMovement(i int) {
-	if m.addmovement != nil {
-		*m.addmovement += i
-	} else {
-		m.addmovement = &i
-	}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AccessTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[accesstoken.FieldLastUsedAt] = struct{}{}
 }
 
-// AddedMovement returns the value that was added to the "movement" field in this mutation.
-func (m *SurvivorMutation) AddedMovement() (r int, exists bool) {
-	v := m.addmovement
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldLastUsedAt]
+	return ok
 }
 
-// ResetMovement resets all changes to the "movement" field.
-func (m *SurvivorMutation) ResetMovement() {
-	m.movement = nil
-	m.addmovement = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AccessTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, accesstoken.FieldLastUsedAt)
 }
 
-// SetAccuracy sets the "accuracy" field.
-func (m *SurvivorMutation) SetAccuracy(i int) {
-	m.accuracy = &i
-	m.addaccuracy = nil
+// SetRevokedAt sets the "revoked_at" field.
+func (m *AccessTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// Accuracy returns the value of the "accuracy" field in the mutation.
-func (m *SurvivorMutation) Accuracy() (r int, exists bool) {
-	v := m.accuracy
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *AccessTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccuracy returns the old "accuracy" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldAccuracy(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccuracy is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccuracy requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccuracy: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Accuracy, nil
+	return oldValue.RevokedAt, nil
 }
 
-// AddAccuracy adds i to the "accuracy" field.
-func (m *SurvivorMutation) AddAccuracy(i int) {
-	if m.addaccuracy != nil {
-		*m.addaccuracy += i
-	} else {
-		m.addaccuracy = &i
-	}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *AccessTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[accesstoken.FieldRevokedAt] = struct{}{}
 }
 
-// AddedAccuracy returns the value that was added to the "accuracy" field in this mutation.
-func (m *SurvivorMutation) AddedAccuracy() (r int, exists bool) {
-	v := m.addaccuracy
-	if v == nil {
-		return
-	}
-	return *v, true
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldRevokedAt]
+	return ok
 }
 
-// ResetAccuracy resets all changes to the "accuracy" field.
-func (m *SurvivorMutation) ResetAccuracy() {
-	m.accuracy = nil
-	m.addaccuracy = nil
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *AccessTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, accesstoken.FieldRevokedAt)
 }
 
-// SetStrength sets the "strength" field.
-func (m *SurvivorMutation) SetStrength(i int) {
-	m.strength = &i
-	m.addstrength = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *AccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Strength returns the value of the "strength" field in the mutation.
-func (m *SurvivorMutation) Strength() (r int, exists bool) {
-	v := m.strength
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrength returns the old "strength" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldStrength(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrength is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrength requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrength: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Strength, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddStrength adds i to the "strength" field.
-func (m *SurvivorMutation) AddStrength(i int) {
-	if m.addstrength != nil {
-		*m.addstrength += i
-	} else {
-		m.addstrength = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedStrength returns the value that was added to the "strength" field in this mutation.
-func (m *SurvivorMutation) AddedStrength() (r int, exists bool) {
-	v := m.addstrength
-	if v == nil {
-		return
+// Where appends a list predicates to the AccessTokenMutation builder.
+func (m *AccessTokenMutation) Where(ps ...predicate.AccessToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccessTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetStrength resets all changes to the "strength" field.
-func (m *SurvivorMutation) ResetStrength() {
-	m.strength = nil
-	m.addstrength = nil
+// Op returns the operation name.
+func (m *AccessTokenMutation) Op() Op {
+	return m.op
 }
 
-// SetEvasion sets the "evasion" field.
-func (m *SurvivorMutation) SetEvasion(i int) {
-	m.evasion = &i
-	m.addevasion = nil
+// SetOp allows setting the mutation operation.
+func (m *AccessTokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Evasion returns the value of the "evasion" field in the mutation.
-func (m *SurvivorMutation) Evasion() (r int, exists bool) {
-	v := m.evasion
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (AccessToken).
+func (m *AccessTokenMutation) Type() string {
+	return m.typ
 }
 
-// OldEvasion returns the old "evasion" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldEvasion(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEvasion is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, accesstoken.FieldUserID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEvasion requires an ID field in the mutation")
+	if m.name != nil {
+		fields = append(fields, accesstoken.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvasion: %w", err)
+	if m.token_hash != nil {
+		fields = append(fields, accesstoken.FieldTokenHash)
 	}
-	return oldValue.Evasion, nil
+	if m.scope != nil {
+		fields = append(fields, accesstoken.FieldScope)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, accesstoken.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, accesstoken.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, accesstoken.FieldCreatedAt)
+	}
+	return fields
 }
 
-// AddEvasion adds i to the "evasion" field.
-func (m *SurvivorMutation) AddEvasion(i int) {
-	if m.addevasion != nil {
-		*m.addevasion += i
-	} else {
-		m.addevasion = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accesstoken.FieldUserID:
+		return m.UserID()
+	case accesstoken.FieldName:
+		return m.Name()
+	case accesstoken.FieldTokenHash:
+		return m.TokenHash()
+	case accesstoken.FieldScope:
+		return m.Scope()
+	case accesstoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case accesstoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case accesstoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case accesstoken.FieldCreatedAt:
+		return m.CreatedAt()
 	}
+	return nil, false
 }
 
-// AddedEvasion returns the value that was added to the "evasion" field in this mutation.
-func (m *SurvivorMutation) AddedEvasion() (r int, exists bool) {
-	v := m.addevasion
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accesstoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case accesstoken.FieldName:
+		return m.OldName(ctx)
+	case accesstoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case accesstoken.FieldScope:
+		return m.OldScope(ctx)
+	case accesstoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case accesstoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case accesstoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case accesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accesstoken.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case accesstoken.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case accesstoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case accesstoken.FieldScope:
+		v, ok := value.(accesstoken.Scope)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case accesstoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case accesstoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case accesstoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
-// ResetEvasion resets all changes to the "evasion" field.
-func (m *SurvivorMutation) ResetEvasion() {
-	m.evasion = nil
-	m.addevasion = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccessTokenMutation) AddedFields() []string {
+	return nil
 }
 
-// SetLuck sets the "luck" field.
-func (m *SurvivorMutation) SetLuck(i int) {
-	m.luck = &i
-	m.addluck = nil
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccessTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Luck returns the value of the "luck" field in the mutation.
-func (m *SurvivorMutation) Luck() (r int, exists bool) {
-	v := m.luck
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AccessToken numeric field %s", name)
 }
 
-// OldLuck returns the old "luck" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldLuck(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLuck is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLuck requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccessTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accesstoken.FieldLastUsedAt) {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLuck: %w", err)
+	if m.FieldCleared(accesstoken.FieldRevokedAt) {
+		fields = append(fields, accesstoken.FieldRevokedAt)
 	}
-	return oldValue.Luck, nil
+	return fields
 }
 
-// AddLuck adds i to the "luck" field.
-func (m *SurvivorMutation) AddLuck(i int) {
-	if m.addluck != nil {
-		*m.addluck += i
-	} else {
-		m.addluck = &i
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccessTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccessTokenMutation) ClearField(name string) error {
+	switch name {
+	case accesstoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case accesstoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown AccessToken nullable field %s", name)
 }
 
-// AddedLuck returns the value that was added to the "luck" field in this mutation.
-func (m *SurvivorMutation) AddedLuck() (r int, exists bool) {
-	v := m.addluck
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccessTokenMutation) ResetField(name string) error {
+	switch name {
+	case accesstoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case accesstoken.FieldName:
+		m.ResetName()
+		return nil
+	case accesstoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case accesstoken.FieldScope:
+		m.ResetScope()
+		return nil
+	case accesstoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case accesstoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case accesstoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case accesstoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
-// ResetLuck resets all changes to the "luck" field.
-func (m *SurvivorMutation) ResetLuck() {
-	m.luck = nil
-	m.addluck = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetSpeed sets the "speed" field.
-func (m *SurvivorMutation) SetSpeed(i int) {
-	m.speed = &i
-	m.addspeed = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccessTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Speed returns the value of the "speed" field in the mutation.
-func (m *SurvivorMutation) Speed() (r int, exists bool) {
-	v := m.speed
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldSpeed returns the old "speed" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldSpeed(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpeed is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpeed requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpeed: %w", err)
-	}
-	return oldValue.Speed, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccessTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddSpeed adds i to the "speed" field.
-func (m *SurvivorMutation) AddSpeed(i int) {
-	if m.addspeed != nil {
-		*m.addspeed += i
-	} else {
-		m.addspeed = &i
-	}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddedSpeed returns the value that was added to the "speed" field in this mutation.
-func (m *SurvivorMutation) AddedSpeed() (r int, exists bool) {
-	v := m.addspeed
-	if v == nil {
-		return
-	}
-	return *v, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccessTokenMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetSpeed resets all changes to the "speed" field.
-func (m *SurvivorMutation) ResetSpeed() {
-	m.speed = nil
-	m.addspeed = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccessTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AccessToken unique edge %s", name)
 }
 
-// SetSystemicpressure sets the "systemicpressure" field.
-func (m *SurvivorMutation) SetSystemicpressure(i int) {
-	m.systemicpressure = &i
-	m.addsystemicpressure = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccessTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// Systemicpressure returns the value of the "systemicpressure" field in the mutation.
-func (m *SurvivorMutation) Systemicpressure() (r int, exists bool) {
-	v := m.systemicpressure
-	if v == nil {
-		return
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	actor             *string
+	operation         *auditlog.Operation
+	entity_type       *string
+	entity_id         *int
+	addentity_id      *int
+	changes           *[]schematype.FieldChange
+	appendchanges     []schematype.FieldChange
+	request_id        *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*AuditLog, error)
+	predicates        []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldSystemicpressure returns the old "systemicpressure" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldSystemicpressure(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSystemicpressure is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSystemicpressure requires an ID field in the mutation")
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id int) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSystemicpressure: %w", err)
+}
+
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Systemicpressure, nil
 }
 
-// AddSystemicpressure adds i to the "systemicpressure" field.
-func (m *SurvivorMutation) AddSystemicpressure(i int) {
-	if m.addsystemicpressure != nil {
-		*m.addsystemicpressure += i
-	} else {
-		m.addsystemicpressure = &i
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedSystemicpressure returns the value that was added to the "systemicpressure" field in this mutation.
-func (m *SurvivorMutation) AddedSystemicpressure() (r int, exists bool) {
-	v := m.addsystemicpressure
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetSystemicpressure resets all changes to the "systemicpressure" field.
-func (m *SurvivorMutation) ResetSystemicpressure() {
-	m.systemicpressure = nil
-	m.addsystemicpressure = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTorment sets the "torment" field.
-func (m *SurvivorMutation) SetTorment(i int) {
-	m.torment = &i
-	m.addtorment = nil
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Torment returns the value of the "torment" field in the mutation.
-func (m *SurvivorMutation) Torment() (r int, exists bool) {
-	v := m.torment
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTorment returns the old "torment" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldTorment(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTorment is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTorment requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTorment: %w", err)
-	}
-	return oldValue.Torment, nil
-}
-
-// AddTorment adds i to the "torment" field.
-func (m *SurvivorMutation) AddTorment(i int) {
-	if m.addtorment != nil {
-		*m.addtorment += i
-	} else {
-		m.addtorment = &i
-	}
-}
-
-// AddedTorment returns the value that was added to the "torment" field in this mutation.
-func (m *SurvivorMutation) AddedTorment() (r int, exists bool) {
-	v := m.addtorment
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return *v, true
+	return oldValue.Actor, nil
 }
 
-// ResetTorment resets all changes to the "torment" field.
-func (m *SurvivorMutation) ResetTorment() {
-	m.torment = nil
-	m.addtorment = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetInsanity sets the "insanity" field.
-func (m *SurvivorMutation) SetInsanity(i int) {
-	m.insanity = &i
-	m.addinsanity = nil
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(a auditlog.Operation) {
+	m.operation = &a
 }
 
-// Insanity returns the value of the "insanity" field in the mutation.
-func (m *SurvivorMutation) Insanity() (r int, exists bool) {
-	v := m.insanity
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r auditlog.Operation, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInsanity returns the old "insanity" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldInsanity(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v auditlog.Operation, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInsanity is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInsanity requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInsanity: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.Insanity, nil
+	return oldValue.Operation, nil
 }
 
-// AddInsanity adds i to the "insanity" field.
-func (m *SurvivorMutation) AddInsanity(i int) {
-	if m.addinsanity != nil {
-		*m.addinsanity += i
-	} else {
-		m.addinsanity = &i
-	}
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// AddedInsanity returns the value that was added to the "insanity" field in this mutation.
-func (m *SurvivorMutation) AddedInsanity() (r int, exists bool) {
-	v := m.addinsanity
+// SetEntityType sets the "entity_type" field.
+func (m *AuditLogMutation) SetEntityType(s string) {
+	m.entity_type = &s
+}
+
+// EntityType returns the value of the "entity_type" field in the mutation.
+func (m *AuditLogMutation) EntityType() (r string, exists bool) {
+	v := m.entity_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInsanity resets all changes to the "insanity" field.
-func (m *SurvivorMutation) ResetInsanity() {
-	m.insanity = nil
-	m.addinsanity = nil
+// OldEntityType returns the old "entity_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldEntityType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
+	}
+	return oldValue.EntityType, nil
 }
 
-// SetLumi sets the "lumi" field.
-func (m *SurvivorMutation) SetLumi(i int) {
-	m.lumi = &i
-	m.addlumi = nil
+// ResetEntityType resets all changes to the "entity_type" field.
+func (m *AuditLogMutation) ResetEntityType() {
+	m.entity_type = nil
 }
 
-// Lumi returns the value of the "lumi" field in the mutation.
-func (m *SurvivorMutation) Lumi() (r int, exists bool) {
-	v := m.lumi
+// SetEntityID sets the "entity_id" field.
+func (m *AuditLogMutation) SetEntityID(i int) {
+	m.entity_id = &i
+	m.addentity_id = nil
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *AuditLogMutation) EntityID() (r int, exists bool) {
+	v := m.entity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLumi returns the old "lumi" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityID returns the old "entity_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldLumi(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldEntityID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLumi is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLumi requires an ID field in the mutation")
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLumi: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
 	}
-	return oldValue.Lumi, nil
+	return oldValue.EntityID, nil
 }
 
-// AddLumi adds i to the "lumi" field.
-func (m *SurvivorMutation) AddLumi(i int) {
-	if m.addlumi != nil {
-		*m.addlumi += i
+// AddEntityID adds i to the "entity_id" field.
+func (m *AuditLogMutation) AddEntityID(i int) {
+	if m.addentity_id != nil {
+		*m.addentity_id += i
 	} else {
-		m.addlumi = &i
+		m.addentity_id = &i
 	}
 }
 
-// AddedLumi returns the value that was added to the "lumi" field in this mutation.
-func (m *SurvivorMutation) AddedLumi() (r int, exists bool) {
-	v := m.addlumi
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *AuditLogMutation) AddedEntityID() (r int, exists bool) {
+	v := m.addentity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLumi resets all changes to the "lumi" field.
-func (m *SurvivorMutation) ResetLumi() {
-	m.lumi = nil
-	m.addlumi = nil
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *AuditLogMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
 }
 
-// SetCourage sets the "courage" field.
-func (m *SurvivorMutation) SetCourage(i int) {
-	m.courage = &i
-	m.addcourage = nil
+// SetChanges sets the "changes" field.
+func (m *AuditLogMutation) SetChanges(sc []schematype.FieldChange) {
+	m.changes = &sc
+	m.appendchanges = nil
 }
 
-// Courage returns the value of the "courage" field in the mutation.
-func (m *SurvivorMutation) Courage() (r int, exists bool) {
-	v := m.courage
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditLogMutation) Changes() (r []schematype.FieldChange, exists bool) {
+	v := m.changes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCourage returns the old "courage" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldChanges returns the old "changes" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldCourage(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldChanges(ctx context.Context) (v []schematype.FieldChange, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCourage is only allowed on UpdateOne operations")
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCourage requires an ID field in the mutation")
+		return v, errors.New("OldChanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCourage: %w", err)
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
 	}
-	return oldValue.Courage, nil
+	return oldValue.Changes, nil
 }
 
-// AddCourage adds i to the "courage" field.
-func (m *SurvivorMutation) AddCourage(i int) {
-	if m.addcourage != nil {
-		*m.addcourage += i
-	} else {
-		m.addcourage = &i
-	}
+// AppendChanges adds sc to the "changes" field.
+func (m *AuditLogMutation) AppendChanges(sc []schematype.FieldChange) {
+	m.appendchanges = append(m.appendchanges, sc...)
 }
 
-// AddedCourage returns the value that was added to the "courage" field in this mutation.
-func (m *SurvivorMutation) AddedCourage() (r int, exists bool) {
-	v := m.addcourage
-	if v == nil {
-		return
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *AuditLogMutation) AppendedChanges() ([]schematype.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendchanges, true
 }
 
-// ResetCourage resets all changes to the "courage" field.
-func (m *SurvivorMutation) ResetCourage() {
-	m.courage = nil
-	m.addcourage = nil
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditLogMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
 }
 
-// SetUnderstanding sets the "understanding" field.
-func (m *SurvivorMutation) SetUnderstanding(i int) {
-	m.understanding = &i
-	m.addunderstanding = nil
+// SetRequestID sets the "request_id" field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// Understanding returns the value of the "understanding" field in the mutation.
-func (m *SurvivorMutation) Understanding() (r int, exists bool) {
-	v := m.understanding
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUnderstanding returns the old "understanding" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldUnderstanding(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnderstanding is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnderstanding requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnderstanding: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.Understanding, nil
+	return oldValue.RequestID, nil
 }
 
-// AddUnderstanding adds i to the "understanding" field.
-func (m *SurvivorMutation) AddUnderstanding(i int) {
-	if m.addunderstanding != nil {
-		*m.addunderstanding += i
-	} else {
-		m.addunderstanding = &i
-	}
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// AddedUnderstanding returns the value that was added to the "understanding" field in this mutation.
-func (m *SurvivorMutation) AddedUnderstanding() (r int, exists bool) {
-	v := m.addunderstanding
-	if v == nil {
-		return
-	}
-	return *v, true
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
+	return ok
 }
 
-// ResetUnderstanding resets all changes to the "understanding" field.
-func (m *SurvivorMutation) ResetUnderstanding() {
-	m.understanding = nil
-	m.addunderstanding = nil
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
 }
 
-// SetStatus sets the "status" field.
-func (m *SurvivorMutation) SetStatus(s survivor.Status) {
-	m.status = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SurvivorMutation) Status() (r survivor.Status, exists bool) {
-	v := m.status
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldStatus(ctx context.Context) (v survivor.Status, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SurvivorMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetStatusChangeYear sets the "status_change_year" field.
-func (m *SurvivorMutation) SetStatusChangeYear(i int) {
-	m.status_change_year = &i
-	m.addstatus_change_year = nil
-}
-
-// StatusChangeYear returns the value of the "status_change_year" field in the mutation.
-func (m *SurvivorMutation) StatusChangeYear() (r int, exists bool) {
-	v := m.status_change_year
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatusChangeYear returns the old "status_change_year" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldStatusChangeYear(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatusChangeYear is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatusChangeYear requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatusChangeYear: %w", err)
-	}
-	return oldValue.StatusChangeYear, nil
-}
-
-// AddStatusChangeYear adds i to the "status_change_year" field.
-func (m *SurvivorMutation) AddStatusChangeYear(i int) {
-	if m.addstatus_change_year != nil {
-		*m.addstatus_change_year += i
-	} else {
-		m.addstatus_change_year = &i
-	}
-}
-
-// AddedStatusChangeYear returns the value that was added to the "status_change_year" field in this mutation.
-func (m *SurvivorMutation) AddedStatusChangeYear() (r int, exists bool) {
-	v := m.addstatus_change_year
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetStatusChangeYear resets all changes to the "status_change_year" field.
-func (m *SurvivorMutation) ResetStatusChangeYear() {
-	m.status_change_year = nil
-	m.addstatus_change_year = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetSettlementID sets the "settlement_id" field.
-func (m *SurvivorMutation) SetSettlementID(i int) {
+func (m *AuditLogMutation) SetSettlementID(i int) {
 	m.settlement = &i
 }
 
 // SettlementID returns the value of the "settlement_id" field in the mutation.
-func (m *SurvivorMutation) SettlementID() (r int, exists bool) {
+func (m *AuditLogMutation) SettlementID() (r int, exists bool) {
 	v := m.settlement
 	if v == nil {
 		return
@@ -2017,10 +1862,10 @@ func (m *SurvivorMutation) SettlementID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldSettlementID returns the old "settlement_id" field's value of the Survivor entity.
-// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// OldSettlementID returns the old "settlement_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SurvivorMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldSettlementID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
 	}
@@ -2035,38 +1880,38 @@ func (m *SurvivorMutation) OldSettlementID(ctx context.Context) (v int, err erro
 }
 
 // ClearSettlementID clears the value of the "settlement_id" field.
-func (m *SurvivorMutation) ClearSettlementID() {
+func (m *AuditLogMutation) ClearSettlementID() {
 	m.settlement = nil
-	m.clearedFields[survivor.FieldSettlementID] = struct{}{}
+	m.clearedFields[auditlog.FieldSettlementID] = struct{}{}
 }
 
 // SettlementIDCleared returns if the "settlement_id" field was cleared in this mutation.
-func (m *SurvivorMutation) SettlementIDCleared() bool {
-	_, ok := m.clearedFields[survivor.FieldSettlementID]
+func (m *AuditLogMutation) SettlementIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldSettlementID]
 	return ok
 }
 
 // ResetSettlementID resets all changes to the "settlement_id" field.
-func (m *SurvivorMutation) ResetSettlementID() {
+func (m *AuditLogMutation) ResetSettlementID() {
 	m.settlement = nil
-	delete(m.clearedFields, survivor.FieldSettlementID)
+	delete(m.clearedFields, auditlog.FieldSettlementID)
 }
 
 // ClearSettlement clears the "settlement" edge to the Settlement entity.
-func (m *SurvivorMutation) ClearSettlement() {
+func (m *AuditLogMutation) ClearSettlement() {
 	m.clearedsettlement = true
-	m.clearedFields[survivor.FieldSettlementID] = struct{}{}
+	m.clearedFields[auditlog.FieldSettlementID] = struct{}{}
 }
 
 // SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
-func (m *SurvivorMutation) SettlementCleared() bool {
+func (m *AuditLogMutation) SettlementCleared() bool {
 	return m.SettlementIDCleared() || m.clearedsettlement
 }
 
 // SettlementIDs returns the "settlement" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SettlementID instead. It exists only for internal usage by the builders.
-func (m *SurvivorMutation) SettlementIDs() (ids []int) {
+func (m *AuditLogMutation) SettlementIDs() (ids []int) {
 	if id := m.settlement; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2074,20 +1919,20 @@ func (m *SurvivorMutation) SettlementIDs() (ids []int) {
 }
 
 // ResetSettlement resets all changes to the "settlement" edge.
-func (m *SurvivorMutation) ResetSettlement() {
+func (m *AuditLogMutation) ResetSettlement() {
 	m.settlement = nil
 	m.clearedsettlement = false
 }
 
-// Where appends a list predicates to the SurvivorMutation builder.
-func (m *SurvivorMutation) Where(ps ...predicate.Survivor) {
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SurvivorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SurvivorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Survivor, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2095,84 +1940,48 @@ func (m *SurvivorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SurvivorMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SurvivorMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Survivor).
-func (m *SurvivorMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SurvivorMutation) Fields() []string {
-	fields := make([]string, 0, 20)
-	if m.name != nil {
-		fields = append(fields, survivor.FieldName)
-	}
-	if m.born != nil {
-		fields = append(fields, survivor.FieldBorn)
-	}
-	if m.gender != nil {
-		fields = append(fields, survivor.FieldGender)
-	}
-	if m.huntxp != nil {
-		fields = append(fields, survivor.FieldHuntxp)
-	}
-	if m.survival != nil {
-		fields = append(fields, survivor.FieldSurvival)
-	}
-	if m.movement != nil {
-		fields = append(fields, survivor.FieldMovement)
-	}
-	if m.accuracy != nil {
-		fields = append(fields, survivor.FieldAccuracy)
-	}
-	if m.strength != nil {
-		fields = append(fields, survivor.FieldStrength)
-	}
-	if m.evasion != nil {
-		fields = append(fields, survivor.FieldEvasion)
-	}
-	if m.luck != nil {
-		fields = append(fields, survivor.FieldLuck)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
 	}
-	if m.speed != nil {
-		fields = append(fields, survivor.FieldSpeed)
-	}
-	if m.systemicpressure != nil {
-		fields = append(fields, survivor.FieldSystemicpressure)
-	}
-	if m.torment != nil {
-		fields = append(fields, survivor.FieldTorment)
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
 	}
-	if m.insanity != nil {
-		fields = append(fields, survivor.FieldInsanity)
-	}
-	if m.lumi != nil {
-		fields = append(fields, survivor.FieldLumi)
+	if m.entity_type != nil {
+		fields = append(fields, auditlog.FieldEntityType)
 	}
-	if m.courage != nil {
-		fields = append(fields, survivor.FieldCourage)
+	if m.entity_id != nil {
+		fields = append(fields, auditlog.FieldEntityID)
 	}
-	if m.understanding != nil {
-		fields = append(fields, survivor.FieldUnderstanding)
+	if m.changes != nil {
+		fields = append(fields, auditlog.FieldChanges)
 	}
-	if m.status != nil {
-		fields = append(fields, survivor.FieldStatus)
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
 	}
-	if m.status_change_year != nil {
-		fields = append(fields, survivor.FieldStatusChangeYear)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
 	if m.settlement != nil {
-		fields = append(fields, survivor.FieldSettlementID)
+		fields = append(fields, auditlog.FieldSettlementID)
 	}
 	return fields
 }
@@ -2180,47 +1989,23 @@ func (m *SurvivorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SurvivorMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case survivor.FieldName:
-		return m.Name()
-	case survivor.FieldBorn:
-		return m.Born()
-	case survivor.FieldGender:
-		return m.Gender()
-	case survivor.FieldHuntxp:
-		return m.Huntxp()
-	case survivor.FieldSurvival:
-		return m.Survival()
-	case survivor.FieldMovement:
-		return m.Movement()
-	case survivor.FieldAccuracy:
-		return m.Accuracy()
-	case survivor.FieldStrength:
-		return m.Strength()
-	case survivor.FieldEvasion:
-		return m.Evasion()
-	case survivor.FieldLuck:
-		return m.Luck()
-	case survivor.FieldSpeed:
-		return m.Speed()
-	case survivor.FieldSystemicpressure:
-		return m.Systemicpressure()
-	case survivor.FieldTorment:
-		return m.Torment()
-	case survivor.FieldInsanity:
-		return m.Insanity()
-	case survivor.FieldLumi:
-		return m.Lumi()
-	case survivor.FieldCourage:
-		return m.Courage()
-	case survivor.FieldUnderstanding:
-		return m.Understanding()
-	case survivor.FieldStatus:
-		return m.Status()
-	case survivor.FieldStatusChangeYear:
-		return m.StatusChangeYear()
-	case survivor.FieldSettlementID:
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldEntityType:
+		return m.EntityType()
+	case auditlog.FieldEntityID:
+		return m.EntityID()
+	case auditlog.FieldChanges:
+		return m.Changes()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldSettlementID:
 		return m.SettlementID()
 	}
 	return nil, false
@@ -2229,528 +2014,12468 @@ func (m *SurvivorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SurvivorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case survivor.FieldName:
-		return m.OldName(ctx)
-	case survivor.FieldBorn:
-		return m.OldBorn(ctx)
-	case survivor.FieldGender:
-		return m.OldGender(ctx)
-	case survivor.FieldHuntxp:
-		return m.OldHuntxp(ctx)
-	case survivor.FieldSurvival:
-		return m.OldSurvival(ctx)
-	case survivor.FieldMovement:
-		return m.OldMovement(ctx)
-	case survivor.FieldAccuracy:
-		return m.OldAccuracy(ctx)
-	case survivor.FieldStrength:
-		return m.OldStrength(ctx)
-	case survivor.FieldEvasion:
-		return m.OldEvasion(ctx)
-	case survivor.FieldLuck:
-		return m.OldLuck(ctx)
-	case survivor.FieldSpeed:
-		return m.OldSpeed(ctx)
-	case survivor.FieldSystemicpressure:
-		return m.OldSystemicpressure(ctx)
-	case survivor.FieldTorment:
-		return m.OldTorment(ctx)
-	case survivor.FieldInsanity:
-		return m.OldInsanity(ctx)
-	case survivor.FieldLumi:
-		return m.OldLumi(ctx)
-	case survivor.FieldCourage:
-		return m.OldCourage(ctx)
-	case survivor.FieldUnderstanding:
-		return m.OldUnderstanding(ctx)
-	case survivor.FieldStatus:
-		return m.OldStatus(ctx)
-	case survivor.FieldStatusChangeYear:
-		return m.OldStatusChangeYear(ctx)
-	case survivor.FieldSettlementID:
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case auditlog.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case auditlog.FieldChanges:
+		return m.OldChanges(ctx)
+	case auditlog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldSettlementID:
 		return m.OldSettlementID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Survivor field %s", name)
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SurvivorMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case survivor.FieldName:
+	case auditlog.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case survivor.FieldBorn:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBorn(v)
-		return nil
-	case survivor.FieldGender:
-		v, ok := value.(survivor.Gender)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGender(v)
+		m.SetActor(v)
 		return nil
-	case survivor.FieldHuntxp:
-		v, ok := value.(int)
+	case auditlog.FieldOperation:
+		v, ok := value.(auditlog.Operation)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHuntxp(v)
+		m.SetOperation(v)
 		return nil
-	case survivor.FieldSurvival:
-		v, ok := value.(int)
+	case auditlog.FieldEntityType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSurvival(v)
+		m.SetEntityType(v)
 		return nil
-	case survivor.FieldMovement:
+	case auditlog.FieldEntityID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMovement(v)
+		m.SetEntityID(v)
 		return nil
-	case survivor.FieldAccuracy:
-		v, ok := value.(int)
+	case auditlog.FieldChanges:
+		v, ok := value.([]schematype.FieldChange)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccuracy(v)
+		m.SetChanges(v)
 		return nil
-	case survivor.FieldStrength:
-		v, ok := value.(int)
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrength(v)
+		m.SetRequestID(v)
 		return nil
-	case survivor.FieldEvasion:
-		v, ok := value.(int)
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvasion(v)
+		m.SetCreatedAt(v)
 		return nil
-	case survivor.FieldLuck:
+	case auditlog.FieldSettlementID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLuck(v)
+		m.SetSettlementID(v)
 		return nil
-	case survivor.FieldSpeed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpeed(v)
-		return nil
-	case survivor.FieldSystemicpressure:
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addentity_id != nil {
+		fields = append(fields, auditlog.FieldEntityID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldEntityID:
+		return m.AddedEntityID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldEntityID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSystemicpressure(v)
+		m.AddEntityID(v)
 		return nil
-	case survivor.FieldTorment:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTorment(v)
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.FieldCleared(auditlog.FieldSettlementID) {
+		fields = append(fields, auditlog.FieldSettlementID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
 		return nil
-	case survivor.FieldInsanity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInsanity(v)
+	case auditlog.FieldSettlementID:
+		m.ClearSettlementID()
 		return nil
-	case survivor.FieldLumi:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLumi(v)
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldActor:
+		m.ResetActor()
 		return nil
-	case survivor.FieldCourage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCourage(v)
+	case auditlog.FieldOperation:
+		m.ResetOperation()
 		return nil
-	case survivor.FieldUnderstanding:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUnderstanding(v)
+	case auditlog.FieldEntityType:
+		m.ResetEntityType()
 		return nil
-	case survivor.FieldStatus:
-		v, ok := value.(survivor.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+	case auditlog.FieldEntityID:
+		m.ResetEntityID()
 		return nil
-	case survivor.FieldStatusChangeYear:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatusChangeYear(v)
+	case auditlog.FieldChanges:
+		m.ResetChanges()
 		return nil
-	case survivor.FieldSettlementID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSettlementID(v)
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditlog.FieldSettlementID:
+		m.ResetSettlementID()
 		return nil
 	}
-	return fmt.Errorf("unknown Survivor field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *SurvivorMutation) AddedFields() []string {
-	var fields []string
-	if m.addborn != nil {
-		fields = append(fields, survivor.FieldBorn)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, auditlog.EdgeSettlement)
 	}
-	if m.addhuntxp != nil {
-		fields = append(fields, survivor.FieldHuntxp)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case auditlog.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	if m.addsurvival != nil {
-		fields = append(fields, survivor.FieldSurvival)
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, auditlog.EdgeSettlement)
 	}
-	if m.addmovement != nil {
-		fields = append(fields, survivor.FieldMovement)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case auditlog.EdgeSettlement:
+		return m.clearedsettlement
 	}
-	if m.addaccuracy != nil {
-		fields = append(fields, survivor.FieldAccuracy)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	switch name {
+	case auditlog.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
 	}
-	if m.addstrength != nil {
-		fields = append(fields, survivor.FieldStrength)
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	switch name {
+	case auditlog.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
 	}
-	if m.addevasion != nil {
-		fields = append(fields, survivor.FieldEvasion)
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// DisorderMutation represents an operation that mutates the Disorder nodes in the graph.
+type DisorderMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	rules            *string
+	clearedFields    map[string]struct{}
+	survivors        map[int]struct{}
+	removedsurvivors map[int]struct{}
+	clearedsurvivors bool
+	done             bool
+	oldValue         func(context.Context) (*Disorder, error)
+	predicates       []predicate.Disorder
+}
+
+var _ ent.Mutation = (*DisorderMutation)(nil)
+
+// disorderOption allows management of the mutation configuration using functional options.
+type disorderOption func(*DisorderMutation)
+
+// newDisorderMutation creates new mutation for the Disorder entity.
+func newDisorderMutation(c config, op Op, opts ...disorderOption) *DisorderMutation {
+	m := &DisorderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDisorder,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.addluck != nil {
-		fields = append(fields, survivor.FieldLuck)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.addspeed != nil {
-		fields = append(fields, survivor.FieldSpeed)
+	return m
+}
+
+// withDisorderID sets the ID field of the mutation.
+func withDisorderID(id int) disorderOption {
+	return func(m *DisorderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Disorder
+		)
+		m.oldValue = func(ctx context.Context) (*Disorder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Disorder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.addsystemicpressure != nil {
-		fields = append(fields, survivor.FieldSystemicpressure)
+}
+
+// withDisorder sets the old Disorder of the mutation.
+func withDisorder(node *Disorder) disorderOption {
+	return func(m *DisorderMutation) {
+		m.oldValue = func(context.Context) (*Disorder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.addtorment != nil {
-		fields = append(fields, survivor.FieldTorment)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DisorderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DisorderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.addinsanity != nil {
-		fields = append(fields, survivor.FieldInsanity)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DisorderMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.addlumi != nil {
-		fields = append(fields, survivor.FieldLumi)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DisorderMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Disorder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DisorderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DisorderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Disorder entity.
+// If the Disorder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisorderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DisorderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRules sets the "rules" field.
+func (m *DisorderMutation) SetRules(s string) {
+	m.rules = &s
+}
+
+// Rules returns the value of the "rules" field in the mutation.
+func (m *DisorderMutation) Rules() (r string, exists bool) {
+	v := m.rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRules returns the old "rules" field's value of the Disorder entity.
+// If the Disorder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DisorderMutation) OldRules(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
+	}
+	return oldValue.Rules, nil
+}
+
+// ResetRules resets all changes to the "rules" field.
+func (m *DisorderMutation) ResetRules() {
+	m.rules = nil
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by ids.
+func (m *DisorderMutation) AddSurvivorIDs(ids ...int) {
+	if m.survivors == nil {
+		m.survivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.survivors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSurvivors clears the "survivors" edge to the Survivor entity.
+func (m *DisorderMutation) ClearSurvivors() {
+	m.clearedsurvivors = true
+}
+
+// SurvivorsCleared reports if the "survivors" edge to the Survivor entity was cleared.
+func (m *DisorderMutation) SurvivorsCleared() bool {
+	return m.clearedsurvivors
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to the Survivor entity by IDs.
+func (m *DisorderMutation) RemoveSurvivorIDs(ids ...int) {
+	if m.removedsurvivors == nil {
+		m.removedsurvivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.survivors, ids[i])
+		m.removedsurvivors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSurvivors returns the removed IDs of the "survivors" edge to the Survivor entity.
+func (m *DisorderMutation) RemovedSurvivorsIDs() (ids []int) {
+	for id := range m.removedsurvivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SurvivorsIDs returns the "survivors" edge IDs in the mutation.
+func (m *DisorderMutation) SurvivorsIDs() (ids []int) {
+	for id := range m.survivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSurvivors resets all changes to the "survivors" edge.
+func (m *DisorderMutation) ResetSurvivors() {
+	m.survivors = nil
+	m.clearedsurvivors = false
+	m.removedsurvivors = nil
+}
+
+// Where appends a list predicates to the DisorderMutation builder.
+func (m *DisorderMutation) Where(ps ...predicate.Disorder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DisorderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DisorderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Disorder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DisorderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DisorderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Disorder).
+func (m *DisorderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DisorderMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, disorder.FieldName)
+	}
+	if m.rules != nil {
+		fields = append(fields, disorder.FieldRules)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DisorderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case disorder.FieldName:
+		return m.Name()
+	case disorder.FieldRules:
+		return m.Rules()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DisorderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case disorder.FieldName:
+		return m.OldName(ctx)
+	case disorder.FieldRules:
+		return m.OldRules(ctx)
+	}
+	return nil, fmt.Errorf("unknown Disorder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisorderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case disorder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case disorder.FieldRules:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRules(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Disorder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DisorderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DisorderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DisorderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Disorder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DisorderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DisorderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DisorderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Disorder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DisorderMutation) ResetField(name string) error {
+	switch name {
+	case disorder.FieldName:
+		m.ResetName()
+		return nil
+	case disorder.FieldRules:
+		m.ResetRules()
+		return nil
+	}
+	return fmt.Errorf("unknown Disorder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DisorderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.survivors != nil {
+		edges = append(edges, disorder.EdgeSurvivors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DisorderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case disorder.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.survivors))
+		for id := range m.survivors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DisorderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsurvivors != nil {
+		edges = append(edges, disorder.EdgeSurvivors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DisorderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case disorder.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.removedsurvivors))
+		for id := range m.removedsurvivors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DisorderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsurvivors {
+		edges = append(edges, disorder.EdgeSurvivors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DisorderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case disorder.EdgeSurvivors:
+		return m.clearedsurvivors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DisorderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Disorder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DisorderMutation) ResetEdge(name string) error {
+	switch name {
+	case disorder.EdgeSurvivors:
+		m.ResetSurvivors()
+		return nil
+	}
+	return fmt.Errorf("unknown Disorder edge %s", name)
+}
+
+// FightingArtMutation represents an operation that mutates the FightingArt nodes in the graph.
+type FightingArtMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	rules                   *string
+	secret                  *bool
+	clearedFields           map[string]struct{}
+	survivors               map[int]struct{}
+	removedsurvivors        map[int]struct{}
+	clearedsurvivors        bool
+	secret_survivors        map[int]struct{}
+	removedsecret_survivors map[int]struct{}
+	clearedsecret_survivors bool
+	done                    bool
+	oldValue                func(context.Context) (*FightingArt, error)
+	predicates              []predicate.FightingArt
+}
+
+var _ ent.Mutation = (*FightingArtMutation)(nil)
+
+// fightingartOption allows management of the mutation configuration using functional options.
+type fightingartOption func(*FightingArtMutation)
+
+// newFightingArtMutation creates new mutation for the FightingArt entity.
+func newFightingArtMutation(c config, op Op, opts ...fightingartOption) *FightingArtMutation {
+	m := &FightingArtMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFightingArt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFightingArtID sets the ID field of the mutation.
+func withFightingArtID(id int) fightingartOption {
+	return func(m *FightingArtMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FightingArt
+		)
+		m.oldValue = func(ctx context.Context) (*FightingArt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FightingArt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFightingArt sets the old FightingArt of the mutation.
+func withFightingArt(node *FightingArt) fightingartOption {
+	return func(m *FightingArtMutation) {
+		m.oldValue = func(context.Context) (*FightingArt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FightingArtMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FightingArtMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FightingArtMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FightingArtMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FightingArt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FightingArtMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FightingArtMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FightingArt entity.
+// If the FightingArt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FightingArtMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FightingArtMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRules sets the "rules" field.
+func (m *FightingArtMutation) SetRules(s string) {
+	m.rules = &s
+}
+
+// Rules returns the value of the "rules" field in the mutation.
+func (m *FightingArtMutation) Rules() (r string, exists bool) {
+	v := m.rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRules returns the old "rules" field's value of the FightingArt entity.
+// If the FightingArt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FightingArtMutation) OldRules(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
+	}
+	return oldValue.Rules, nil
+}
+
+// ResetRules resets all changes to the "rules" field.
+func (m *FightingArtMutation) ResetRules() {
+	m.rules = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *FightingArtMutation) SetSecret(b bool) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *FightingArtMutation) Secret() (r bool, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the FightingArt entity.
+// If the FightingArt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FightingArtMutation) OldSecret(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *FightingArtMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// AddSurvivorIDs adds the "survivors" edge to the Survivor entity by ids.
+func (m *FightingArtMutation) AddSurvivorIDs(ids ...int) {
+	if m.survivors == nil {
+		m.survivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.survivors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSurvivors clears the "survivors" edge to the Survivor entity.
+func (m *FightingArtMutation) ClearSurvivors() {
+	m.clearedsurvivors = true
+}
+
+// SurvivorsCleared reports if the "survivors" edge to the Survivor entity was cleared.
+func (m *FightingArtMutation) SurvivorsCleared() bool {
+	return m.clearedsurvivors
+}
+
+// RemoveSurvivorIDs removes the "survivors" edge to the Survivor entity by IDs.
+func (m *FightingArtMutation) RemoveSurvivorIDs(ids ...int) {
+	if m.removedsurvivors == nil {
+		m.removedsurvivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.survivors, ids[i])
+		m.removedsurvivors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSurvivors returns the removed IDs of the "survivors" edge to the Survivor entity.
+func (m *FightingArtMutation) RemovedSurvivorsIDs() (ids []int) {
+	for id := range m.removedsurvivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SurvivorsIDs returns the "survivors" edge IDs in the mutation.
+func (m *FightingArtMutation) SurvivorsIDs() (ids []int) {
+	for id := range m.survivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSurvivors resets all changes to the "survivors" edge.
+func (m *FightingArtMutation) ResetSurvivors() {
+	m.survivors = nil
+	m.clearedsurvivors = false
+	m.removedsurvivors = nil
+}
+
+// AddSecretSurvivorIDs adds the "secret_survivors" edge to the Survivor entity by ids.
+func (m *FightingArtMutation) AddSecretSurvivorIDs(ids ...int) {
+	if m.secret_survivors == nil {
+		m.secret_survivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.secret_survivors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecretSurvivors clears the "secret_survivors" edge to the Survivor entity.
+func (m *FightingArtMutation) ClearSecretSurvivors() {
+	m.clearedsecret_survivors = true
+}
+
+// SecretSurvivorsCleared reports if the "secret_survivors" edge to the Survivor entity was cleared.
+func (m *FightingArtMutation) SecretSurvivorsCleared() bool {
+	return m.clearedsecret_survivors
+}
+
+// RemoveSecretSurvivorIDs removes the "secret_survivors" edge to the Survivor entity by IDs.
+func (m *FightingArtMutation) RemoveSecretSurvivorIDs(ids ...int) {
+	if m.removedsecret_survivors == nil {
+		m.removedsecret_survivors = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.secret_survivors, ids[i])
+		m.removedsecret_survivors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecretSurvivors returns the removed IDs of the "secret_survivors" edge to the Survivor entity.
+func (m *FightingArtMutation) RemovedSecretSurvivorsIDs() (ids []int) {
+	for id := range m.removedsecret_survivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretSurvivorsIDs returns the "secret_survivors" edge IDs in the mutation.
+func (m *FightingArtMutation) SecretSurvivorsIDs() (ids []int) {
+	for id := range m.secret_survivors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecretSurvivors resets all changes to the "secret_survivors" edge.
+func (m *FightingArtMutation) ResetSecretSurvivors() {
+	m.secret_survivors = nil
+	m.clearedsecret_survivors = false
+	m.removedsecret_survivors = nil
+}
+
+// Where appends a list predicates to the FightingArtMutation builder.
+func (m *FightingArtMutation) Where(ps ...predicate.FightingArt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FightingArtMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FightingArtMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FightingArt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FightingArtMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FightingArtMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FightingArt).
+func (m *FightingArtMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FightingArtMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, fightingart.FieldName)
+	}
+	if m.rules != nil {
+		fields = append(fields, fightingart.FieldRules)
+	}
+	if m.secret != nil {
+		fields = append(fields, fightingart.FieldSecret)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FightingArtMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fightingart.FieldName:
+		return m.Name()
+	case fightingart.FieldRules:
+		return m.Rules()
+	case fightingart.FieldSecret:
+		return m.Secret()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FightingArtMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fightingart.FieldName:
+		return m.OldName(ctx)
+	case fightingart.FieldRules:
+		return m.OldRules(ctx)
+	case fightingart.FieldSecret:
+		return m.OldSecret(ctx)
+	}
+	return nil, fmt.Errorf("unknown FightingArt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FightingArtMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fightingart.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case fightingart.FieldRules:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRules(v)
+		return nil
+	case fightingart.FieldSecret:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FightingArt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FightingArtMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FightingArtMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FightingArtMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FightingArt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FightingArtMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FightingArtMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FightingArtMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FightingArt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FightingArtMutation) ResetField(name string) error {
+	switch name {
+	case fightingart.FieldName:
+		m.ResetName()
+		return nil
+	case fightingart.FieldRules:
+		m.ResetRules()
+		return nil
+	case fightingart.FieldSecret:
+		m.ResetSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown FightingArt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FightingArtMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.survivors != nil {
+		edges = append(edges, fightingart.EdgeSurvivors)
+	}
+	if m.secret_survivors != nil {
+		edges = append(edges, fightingart.EdgeSecretSurvivors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FightingArtMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fightingart.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.survivors))
+		for id := range m.survivors {
+			ids = append(ids, id)
+		}
+		return ids
+	case fightingart.EdgeSecretSurvivors:
+		ids := make([]ent.Value, 0, len(m.secret_survivors))
+		for id := range m.secret_survivors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FightingArtMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedsurvivors != nil {
+		edges = append(edges, fightingart.EdgeSurvivors)
+	}
+	if m.removedsecret_survivors != nil {
+		edges = append(edges, fightingart.EdgeSecretSurvivors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FightingArtMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case fightingart.EdgeSurvivors:
+		ids := make([]ent.Value, 0, len(m.removedsurvivors))
+		for id := range m.removedsurvivors {
+			ids = append(ids, id)
+		}
+		return ids
+	case fightingart.EdgeSecretSurvivors:
+		ids := make([]ent.Value, 0, len(m.removedsecret_survivors))
+		for id := range m.removedsecret_survivors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FightingArtMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsurvivors {
+		edges = append(edges, fightingart.EdgeSurvivors)
+	}
+	if m.clearedsecret_survivors {
+		edges = append(edges, fightingart.EdgeSecretSurvivors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FightingArtMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fightingart.EdgeSurvivors:
+		return m.clearedsurvivors
+	case fightingart.EdgeSecretSurvivors:
+		return m.clearedsecret_survivors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FightingArtMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FightingArt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FightingArtMutation) ResetEdge(name string) error {
+	switch name {
+	case fightingart.EdgeSurvivors:
+		m.ResetSurvivors()
+		return nil
+	case fightingart.EdgeSecretSurvivors:
+		m.ResetSecretSurvivors()
+		return nil
+	}
+	return fmt.Errorf("unknown FightingArt edge %s", name)
+}
+
+// InnovationMutation represents an operation that mutates the Innovation nodes in the graph.
+type InnovationMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	name                        *string
+	consequences                *[]string
+	appendconsequences          []string
+	survival_limit_bonus        *int
+	addsurvival_limit_bonus     *int
+	departing_survival_bonus    *int
+	adddeparting_survival_bonus *int
+	clearedFields               map[string]struct{}
+	settlement                  *int
+	clearedsettlement           bool
+	done                        bool
+	oldValue                    func(context.Context) (*Innovation, error)
+	predicates                  []predicate.Innovation
+}
+
+var _ ent.Mutation = (*InnovationMutation)(nil)
+
+// innovationOption allows management of the mutation configuration using functional options.
+type innovationOption func(*InnovationMutation)
+
+// newInnovationMutation creates new mutation for the Innovation entity.
+func newInnovationMutation(c config, op Op, opts ...innovationOption) *InnovationMutation {
+	m := &InnovationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInnovation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInnovationID sets the ID field of the mutation.
+func withInnovationID(id int) innovationOption {
+	return func(m *InnovationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Innovation
+		)
+		m.oldValue = func(ctx context.Context) (*Innovation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Innovation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInnovation sets the old Innovation of the mutation.
+func withInnovation(node *Innovation) innovationOption {
+	return func(m *InnovationMutation) {
+		m.oldValue = func(context.Context) (*Innovation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InnovationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InnovationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InnovationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InnovationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Innovation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *InnovationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *InnovationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Innovation entity.
+// If the Innovation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InnovationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *InnovationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetConsequences sets the "consequences" field.
+func (m *InnovationMutation) SetConsequences(s []string) {
+	m.consequences = &s
+	m.appendconsequences = nil
+}
+
+// Consequences returns the value of the "consequences" field in the mutation.
+func (m *InnovationMutation) Consequences() (r []string, exists bool) {
+	v := m.consequences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsequences returns the old "consequences" field's value of the Innovation entity.
+// If the Innovation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InnovationMutation) OldConsequences(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsequences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsequences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsequences: %w", err)
+	}
+	return oldValue.Consequences, nil
+}
+
+// AppendConsequences adds s to the "consequences" field.
+func (m *InnovationMutation) AppendConsequences(s []string) {
+	m.appendconsequences = append(m.appendconsequences, s...)
+}
+
+// AppendedConsequences returns the list of values that were appended to the "consequences" field in this mutation.
+func (m *InnovationMutation) AppendedConsequences() ([]string, bool) {
+	if len(m.appendconsequences) == 0 {
+		return nil, false
+	}
+	return m.appendconsequences, true
+}
+
+// ClearConsequences clears the value of the "consequences" field.
+func (m *InnovationMutation) ClearConsequences() {
+	m.consequences = nil
+	m.appendconsequences = nil
+	m.clearedFields[innovation.FieldConsequences] = struct{}{}
+}
+
+// ConsequencesCleared returns if the "consequences" field was cleared in this mutation.
+func (m *InnovationMutation) ConsequencesCleared() bool {
+	_, ok := m.clearedFields[innovation.FieldConsequences]
+	return ok
+}
+
+// ResetConsequences resets all changes to the "consequences" field.
+func (m *InnovationMutation) ResetConsequences() {
+	m.consequences = nil
+	m.appendconsequences = nil
+	delete(m.clearedFields, innovation.FieldConsequences)
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (m *InnovationMutation) SetSurvivalLimitBonus(i int) {
+	m.survival_limit_bonus = &i
+	m.addsurvival_limit_bonus = nil
+}
+
+// SurvivalLimitBonus returns the value of the "survival_limit_bonus" field in the mutation.
+func (m *InnovationMutation) SurvivalLimitBonus() (r int, exists bool) {
+	v := m.survival_limit_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurvivalLimitBonus returns the old "survival_limit_bonus" field's value of the Innovation entity.
+// If the Innovation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InnovationMutation) OldSurvivalLimitBonus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurvivalLimitBonus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurvivalLimitBonus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurvivalLimitBonus: %w", err)
+	}
+	return oldValue.SurvivalLimitBonus, nil
+}
+
+// AddSurvivalLimitBonus adds i to the "survival_limit_bonus" field.
+func (m *InnovationMutation) AddSurvivalLimitBonus(i int) {
+	if m.addsurvival_limit_bonus != nil {
+		*m.addsurvival_limit_bonus += i
+	} else {
+		m.addsurvival_limit_bonus = &i
+	}
+}
+
+// AddedSurvivalLimitBonus returns the value that was added to the "survival_limit_bonus" field in this mutation.
+func (m *InnovationMutation) AddedSurvivalLimitBonus() (r int, exists bool) {
+	v := m.addsurvival_limit_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSurvivalLimitBonus resets all changes to the "survival_limit_bonus" field.
+func (m *InnovationMutation) ResetSurvivalLimitBonus() {
+	m.survival_limit_bonus = nil
+	m.addsurvival_limit_bonus = nil
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (m *InnovationMutation) SetDepartingSurvivalBonus(i int) {
+	m.departing_survival_bonus = &i
+	m.adddeparting_survival_bonus = nil
+}
+
+// DepartingSurvivalBonus returns the value of the "departing_survival_bonus" field in the mutation.
+func (m *InnovationMutation) DepartingSurvivalBonus() (r int, exists bool) {
+	v := m.departing_survival_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartingSurvivalBonus returns the old "departing_survival_bonus" field's value of the Innovation entity.
+// If the Innovation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InnovationMutation) OldDepartingSurvivalBonus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartingSurvivalBonus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartingSurvivalBonus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartingSurvivalBonus: %w", err)
+	}
+	return oldValue.DepartingSurvivalBonus, nil
+}
+
+// AddDepartingSurvivalBonus adds i to the "departing_survival_bonus" field.
+func (m *InnovationMutation) AddDepartingSurvivalBonus(i int) {
+	if m.adddeparting_survival_bonus != nil {
+		*m.adddeparting_survival_bonus += i
+	} else {
+		m.adddeparting_survival_bonus = &i
+	}
+}
+
+// AddedDepartingSurvivalBonus returns the value that was added to the "departing_survival_bonus" field in this mutation.
+func (m *InnovationMutation) AddedDepartingSurvivalBonus() (r int, exists bool) {
+	v := m.adddeparting_survival_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDepartingSurvivalBonus resets all changes to the "departing_survival_bonus" field.
+func (m *InnovationMutation) ResetDepartingSurvivalBonus() {
+	m.departing_survival_bonus = nil
+	m.adddeparting_survival_bonus = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *InnovationMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *InnovationMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Innovation entity.
+// If the Innovation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InnovationMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *InnovationMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *InnovationMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[innovation.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *InnovationMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *InnovationMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *InnovationMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the InnovationMutation builder.
+func (m *InnovationMutation) Where(ps ...predicate.Innovation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InnovationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InnovationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Innovation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InnovationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InnovationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Innovation).
+func (m *InnovationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InnovationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, innovation.FieldName)
+	}
+	if m.consequences != nil {
+		fields = append(fields, innovation.FieldConsequences)
+	}
+	if m.survival_limit_bonus != nil {
+		fields = append(fields, innovation.FieldSurvivalLimitBonus)
+	}
+	if m.departing_survival_bonus != nil {
+		fields = append(fields, innovation.FieldDepartingSurvivalBonus)
+	}
+	if m.settlement != nil {
+		fields = append(fields, innovation.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InnovationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case innovation.FieldName:
+		return m.Name()
+	case innovation.FieldConsequences:
+		return m.Consequences()
+	case innovation.FieldSurvivalLimitBonus:
+		return m.SurvivalLimitBonus()
+	case innovation.FieldDepartingSurvivalBonus:
+		return m.DepartingSurvivalBonus()
+	case innovation.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InnovationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case innovation.FieldName:
+		return m.OldName(ctx)
+	case innovation.FieldConsequences:
+		return m.OldConsequences(ctx)
+	case innovation.FieldSurvivalLimitBonus:
+		return m.OldSurvivalLimitBonus(ctx)
+	case innovation.FieldDepartingSurvivalBonus:
+		return m.OldDepartingSurvivalBonus(ctx)
+	case innovation.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Innovation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InnovationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case innovation.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case innovation.FieldConsequences:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsequences(v)
+		return nil
+	case innovation.FieldSurvivalLimitBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurvivalLimitBonus(v)
+		return nil
+	case innovation.FieldDepartingSurvivalBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartingSurvivalBonus(v)
+		return nil
+	case innovation.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InnovationMutation) AddedFields() []string {
+	var fields []string
+	if m.addsurvival_limit_bonus != nil {
+		fields = append(fields, innovation.FieldSurvivalLimitBonus)
+	}
+	if m.adddeparting_survival_bonus != nil {
+		fields = append(fields, innovation.FieldDepartingSurvivalBonus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InnovationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case innovation.FieldSurvivalLimitBonus:
+		return m.AddedSurvivalLimitBonus()
+	case innovation.FieldDepartingSurvivalBonus:
+		return m.AddedDepartingSurvivalBonus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InnovationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case innovation.FieldSurvivalLimitBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSurvivalLimitBonus(v)
+		return nil
+	case innovation.FieldDepartingSurvivalBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepartingSurvivalBonus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InnovationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(innovation.FieldConsequences) {
+		fields = append(fields, innovation.FieldConsequences)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InnovationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InnovationMutation) ClearField(name string) error {
+	switch name {
+	case innovation.FieldConsequences:
+		m.ClearConsequences()
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InnovationMutation) ResetField(name string) error {
+	switch name {
+	case innovation.FieldName:
+		m.ResetName()
+		return nil
+	case innovation.FieldConsequences:
+		m.ResetConsequences()
+		return nil
+	case innovation.FieldSurvivalLimitBonus:
+		m.ResetSurvivalLimitBonus()
+		return nil
+	case innovation.FieldDepartingSurvivalBonus:
+		m.ResetDepartingSurvivalBonus()
+		return nil
+	case innovation.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InnovationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, innovation.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InnovationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case innovation.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InnovationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InnovationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InnovationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, innovation.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InnovationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case innovation.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InnovationMutation) ClearEdge(name string) error {
+	switch name {
+	case innovation.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InnovationMutation) ResetEdge(name string) error {
+	switch name {
+	case innovation.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Innovation edge %s", name)
+}
+
+// InviteMutation represents an operation that mutates the Invite nodes in the graph.
+type InviteMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	code_hash         *string
+	role              *invite.Role
+	max_uses          *int
+	addmax_uses       *int
+	uses              *int
+	adduses           *int
+	expires_at        *time.Time
+	revoked_at        *time.Time
+	created_by        *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*Invite, error)
+	predicates        []predicate.Invite
+}
+
+var _ ent.Mutation = (*InviteMutation)(nil)
+
+// inviteOption allows management of the mutation configuration using functional options.
+type inviteOption func(*InviteMutation)
+
+// newInviteMutation creates new mutation for the Invite entity.
+func newInviteMutation(c config, op Op, opts ...inviteOption) *InviteMutation {
+	m := &InviteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInviteID sets the ID field of the mutation.
+func withInviteID(id int) inviteOption {
+	return func(m *InviteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invite
+		)
+		m.oldValue = func(ctx context.Context) (*Invite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvite sets the old Invite of the mutation.
+func withInvite(node *Invite) inviteOption {
+	return func(m *InviteMutation) {
+		m.oldValue = func(context.Context) (*Invite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InviteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InviteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InviteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InviteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Invite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *InviteMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *InviteMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *InviteMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetRole sets the "role" field.
+func (m *InviteMutation) SetRole(i invite.Role) {
+	m.role = &i
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *InviteMutation) Role() (r invite.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldRole(ctx context.Context) (v invite.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *InviteMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *InviteMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *InviteMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *InviteMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *InviteMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *InviteMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+}
+
+// SetUses sets the "uses" field.
+func (m *InviteMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *InviteMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUses returns the old "uses" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
+}
+
+// AddUses adds i to the "uses" field.
+func (m *InviteMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
+}
+
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *InviteMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUses resets all changes to the "uses" field.
+func (m *InviteMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *InviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *InviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *InviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *InviteMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *InviteMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *InviteMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[invite.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *InviteMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[invite.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *InviteMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, invite.FieldRevokedAt)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *InviteMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *InviteMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *InviteMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InviteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *InviteMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *InviteMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Invite entity.
+// If the Invite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InviteMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *InviteMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *InviteMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[invite.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *InviteMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *InviteMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *InviteMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the InviteMutation builder.
+func (m *InviteMutation) Where(ps ...predicate.Invite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InviteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InviteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InviteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invite).
+func (m *InviteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InviteMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.code_hash != nil {
+		fields = append(fields, invite.FieldCodeHash)
+	}
+	if m.role != nil {
+		fields = append(fields, invite.FieldRole)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
+	}
+	if m.uses != nil {
+		fields = append(fields, invite.FieldUses)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, invite.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, invite.FieldRevokedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, invite.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invite.FieldCreatedAt)
+	}
+	if m.settlement != nil {
+		fields = append(fields, invite.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InviteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invite.FieldCodeHash:
+		return m.CodeHash()
+	case invite.FieldRole:
+		return m.Role()
+	case invite.FieldMaxUses:
+		return m.MaxUses()
+	case invite.FieldUses:
+		return m.Uses()
+	case invite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case invite.FieldRevokedAt:
+		return m.RevokedAt()
+	case invite.FieldCreatedBy:
+		return m.CreatedBy()
+	case invite.FieldCreatedAt:
+		return m.CreatedAt()
+	case invite.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invite.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case invite.FieldRole:
+		return m.OldRole(ctx)
+	case invite.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case invite.FieldUses:
+		return m.OldUses(ctx)
+	case invite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case invite.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case invite.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case invite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case invite.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InviteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invite.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case invite.FieldRole:
+		v, ok := value.(invite.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case invite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	case invite.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case invite.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case invite.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case invite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case invite.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InviteMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, invite.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, invite.FieldUses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InviteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invite.FieldMaxUses:
+		return m.AddedMaxUses()
+	case invite.FieldUses:
+		return m.AddedUses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InviteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case invite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InviteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invite.FieldRevokedAt) {
+		fields = append(fields, invite.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InviteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InviteMutation) ClearField(name string) error {
+	switch name {
+	case invite.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Invite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InviteMutation) ResetField(name string) error {
+	switch name {
+	case invite.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case invite.FieldRole:
+		m.ResetRole()
+		return nil
+	case invite.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case invite.FieldUses:
+		m.ResetUses()
+		return nil
+	case invite.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case invite.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case invite.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case invite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case invite.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Invite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InviteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, invite.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InviteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invite.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InviteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InviteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InviteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, invite.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InviteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invite.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InviteMutation) ClearEdge(name string) error {
+	switch name {
+	case invite.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Invite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InviteMutation) ResetEdge(name string) error {
+	switch name {
+	case invite.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Invite edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	clearedFields      map[string]struct{}
+	recipes            map[int]struct{}
+	removedrecipes     map[int]struct{}
+	clearedrecipes     bool
+	settlements        map[int]struct{}
+	removedsettlements map[int]struct{}
+	clearedsettlements bool
+	done               bool
+	oldValue           func(context.Context) (*Location, error)
+	predicates         []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id int) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+}
+
+// AddRecipeIDs adds the "recipes" edge to the Recipe entity by ids.
+func (m *LocationMutation) AddRecipeIDs(ids ...int) {
+	if m.recipes == nil {
+		m.recipes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.recipes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecipes clears the "recipes" edge to the Recipe entity.
+func (m *LocationMutation) ClearRecipes() {
+	m.clearedrecipes = true
+}
+
+// RecipesCleared reports if the "recipes" edge to the Recipe entity was cleared.
+func (m *LocationMutation) RecipesCleared() bool {
+	return m.clearedrecipes
+}
+
+// RemoveRecipeIDs removes the "recipes" edge to the Recipe entity by IDs.
+func (m *LocationMutation) RemoveRecipeIDs(ids ...int) {
+	if m.removedrecipes == nil {
+		m.removedrecipes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.recipes, ids[i])
+		m.removedrecipes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecipes returns the removed IDs of the "recipes" edge to the Recipe entity.
+func (m *LocationMutation) RemovedRecipesIDs() (ids []int) {
+	for id := range m.removedrecipes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecipesIDs returns the "recipes" edge IDs in the mutation.
+func (m *LocationMutation) RecipesIDs() (ids []int) {
+	for id := range m.recipes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecipes resets all changes to the "recipes" edge.
+func (m *LocationMutation) ResetRecipes() {
+	m.recipes = nil
+	m.clearedrecipes = false
+	m.removedrecipes = nil
+}
+
+// AddSettlementIDs adds the "settlements" edge to the Settlement entity by ids.
+func (m *LocationMutation) AddSettlementIDs(ids ...int) {
+	if m.settlements == nil {
+		m.settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.settlements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSettlements clears the "settlements" edge to the Settlement entity.
+func (m *LocationMutation) ClearSettlements() {
+	m.clearedsettlements = true
+}
+
+// SettlementsCleared reports if the "settlements" edge to the Settlement entity was cleared.
+func (m *LocationMutation) SettlementsCleared() bool {
+	return m.clearedsettlements
+}
+
+// RemoveSettlementIDs removes the "settlements" edge to the Settlement entity by IDs.
+func (m *LocationMutation) RemoveSettlementIDs(ids ...int) {
+	if m.removedsettlements == nil {
+		m.removedsettlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.settlements, ids[i])
+		m.removedsettlements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSettlements returns the removed IDs of the "settlements" edge to the Settlement entity.
+func (m *LocationMutation) RemovedSettlementsIDs() (ids []int) {
+	for id := range m.removedsettlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SettlementsIDs returns the "settlements" edge IDs in the mutation.
+func (m *LocationMutation) SettlementsIDs() (ids []int) {
+	for id := range m.settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSettlements resets all changes to the "settlements" edge.
+func (m *LocationMutation) ResetSettlements() {
+	m.settlements = nil
+	m.clearedsettlements = false
+	m.removedsettlements = nil
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.recipes != nil {
+		edges = append(edges, location.EdgeRecipes)
+	}
+	if m.settlements != nil {
+		edges = append(edges, location.EdgeSettlements)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeRecipes:
+		ids := make([]ent.Value, 0, len(m.recipes))
+		for id := range m.recipes {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeSettlements:
+		ids := make([]ent.Value, 0, len(m.settlements))
+		for id := range m.settlements {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedrecipes != nil {
+		edges = append(edges, location.EdgeRecipes)
+	}
+	if m.removedsettlements != nil {
+		edges = append(edges, location.EdgeSettlements)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeRecipes:
+		ids := make([]ent.Value, 0, len(m.removedrecipes))
+		for id := range m.removedrecipes {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeSettlements:
+		ids := make([]ent.Value, 0, len(m.removedsettlements))
+		for id := range m.removedsettlements {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrecipes {
+		edges = append(edges, location.EdgeRecipes)
+	}
+	if m.clearedsettlements {
+		edges = append(edges, location.EdgeSettlements)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case location.EdgeRecipes:
+		return m.clearedrecipes
+	case location.EdgeSettlements:
+		return m.clearedsettlements
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMutation) ResetEdge(name string) error {
+	switch name {
+	case location.EdgeRecipes:
+		m.ResetRecipes()
+		return nil
+	case location.EdgeSettlements:
+		m.ResetSettlements()
+		return nil
+	}
+	return fmt.Errorf("unknown Location edge %s", name)
+}
+
+// MembershipMutation represents an operation that mutates the Membership nodes in the graph.
+type MembershipMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	user_id           *string
+	role              *membership.Role
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*Membership, error)
+	predicates        []predicate.Membership
+}
+
+var _ ent.Mutation = (*MembershipMutation)(nil)
+
+// membershipOption allows management of the mutation configuration using functional options.
+type membershipOption func(*MembershipMutation)
+
+// newMembershipMutation creates new mutation for the Membership entity.
+func newMembershipMutation(c config, op Op, opts ...membershipOption) *MembershipMutation {
+	m := &MembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMembershipID sets the ID field of the mutation.
+func withMembershipID(id int) membershipOption {
+	return func(m *MembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Membership
+		)
+		m.oldValue = func(ctx context.Context) (*Membership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Membership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMembership sets the old Membership of the mutation.
+func withMembership(node *Membership) membershipOption {
+	return func(m *MembershipMutation) {
+		m.oldValue = func(context.Context) (*Membership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MembershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MembershipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Membership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MembershipMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MembershipMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MembershipMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetRole sets the "role" field.
+func (m *MembershipMutation) SetRole(value membership.Role) {
+	m.role = &value
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *MembershipMutation) Role() (r membership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldRole(ctx context.Context) (v membership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *MembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *MembershipMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *MembershipMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Membership entity.
+// If the Membership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MembershipMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *MembershipMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *MembershipMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[membership.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *MembershipMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *MembershipMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *MembershipMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the MembershipMutation builder.
+func (m *MembershipMutation) Where(ps ...predicate.Membership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MembershipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MembershipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Membership, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MembershipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MembershipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Membership).
+func (m *MembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MembershipMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, membership.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, membership.FieldRole)
+	}
+	if m.settlement != nil {
+		fields = append(fields, membership.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case membership.FieldUserID:
+		return m.UserID()
+	case membership.FieldRole:
+		return m.Role()
+	case membership.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case membership.FieldUserID:
+		return m.OldUserID(ctx)
+	case membership.FieldRole:
+		return m.OldRole(ctx)
+	case membership.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Membership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case membership.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case membership.FieldRole:
+		v, ok := value.(membership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case membership.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Membership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MembershipMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MembershipMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Membership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Membership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MembershipMutation) ResetField(name string) error {
+	switch name {
+	case membership.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case membership.FieldRole:
+		m.ResetRole()
+		return nil
+	case membership.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, membership.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case membership.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MembershipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, membership.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case membership.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case membership.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case membership.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Membership edge %s", name)
+}
+
+// MilestoneMutation represents an operation that mutates the Milestone nodes in the graph.
+type MilestoneMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *milestone.Name
+	year              *int
+	addyear           *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*Milestone, error)
+	predicates        []predicate.Milestone
+}
+
+var _ ent.Mutation = (*MilestoneMutation)(nil)
+
+// milestoneOption allows management of the mutation configuration using functional options.
+type milestoneOption func(*MilestoneMutation)
+
+// newMilestoneMutation creates new mutation for the Milestone entity.
+func newMilestoneMutation(c config, op Op, opts ...milestoneOption) *MilestoneMutation {
+	m := &MilestoneMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMilestone,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMilestoneID sets the ID field of the mutation.
+func withMilestoneID(id int) milestoneOption {
+	return func(m *MilestoneMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Milestone
+		)
+		m.oldValue = func(ctx context.Context) (*Milestone, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Milestone.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMilestone sets the old Milestone of the mutation.
+func withMilestone(node *Milestone) milestoneOption {
+	return func(m *MilestoneMutation) {
+		m.oldValue = func(context.Context) (*Milestone, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MilestoneMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MilestoneMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MilestoneMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MilestoneMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Milestone.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MilestoneMutation) SetName(value milestone.Name) {
+	m.name = &value
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MilestoneMutation) Name() (r milestone.Name, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldName(ctx context.Context) (v milestone.Name, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MilestoneMutation) ResetName() {
+	m.name = nil
+}
+
+// SetYear sets the "year" field.
+func (m *MilestoneMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *MilestoneMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *MilestoneMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *MilestoneMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *MilestoneMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MilestoneMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MilestoneMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MilestoneMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *MilestoneMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *MilestoneMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Milestone entity.
+// If the Milestone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MilestoneMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *MilestoneMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *MilestoneMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[milestone.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *MilestoneMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *MilestoneMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *MilestoneMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the MilestoneMutation builder.
+func (m *MilestoneMutation) Where(ps ...predicate.Milestone) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MilestoneMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MilestoneMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Milestone, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MilestoneMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MilestoneMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Milestone).
+func (m *MilestoneMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MilestoneMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, milestone.FieldName)
+	}
+	if m.year != nil {
+		fields = append(fields, milestone.FieldYear)
+	}
+	if m.created_at != nil {
+		fields = append(fields, milestone.FieldCreatedAt)
+	}
+	if m.settlement != nil {
+		fields = append(fields, milestone.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MilestoneMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case milestone.FieldName:
+		return m.Name()
+	case milestone.FieldYear:
+		return m.Year()
+	case milestone.FieldCreatedAt:
+		return m.CreatedAt()
+	case milestone.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MilestoneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case milestone.FieldName:
+		return m.OldName(ctx)
+	case milestone.FieldYear:
+		return m.OldYear(ctx)
+	case milestone.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case milestone.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MilestoneMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case milestone.FieldName:
+		v, ok := value.(milestone.Name)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case milestone.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case milestone.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case milestone.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MilestoneMutation) AddedFields() []string {
+	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, milestone.FieldYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MilestoneMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case milestone.FieldYear:
+		return m.AddedYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MilestoneMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case milestone.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MilestoneMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MilestoneMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MilestoneMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Milestone nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MilestoneMutation) ResetField(name string) error {
+	switch name {
+	case milestone.FieldName:
+		m.ResetName()
+		return nil
+	case milestone.FieldYear:
+		m.ResetYear()
+		return nil
+	case milestone.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case milestone.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MilestoneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, milestone.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MilestoneMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case milestone.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MilestoneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MilestoneMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MilestoneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, milestone.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MilestoneMutation) EdgeCleared(name string) bool {
+	switch name {
+	case milestone.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MilestoneMutation) ClearEdge(name string) error {
+	switch name {
+	case milestone.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MilestoneMutation) ResetEdge(name string) error {
+	switch name {
+	case milestone.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Milestone edge %s", name)
+}
+
+// MonsterMutation represents an operation that mutates the Monster nodes in the graph.
+type MonsterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	kind          *monster.Kind
+	levels        *int
+	addlevels     *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Monster, error)
+	predicates    []predicate.Monster
+}
+
+var _ ent.Mutation = (*MonsterMutation)(nil)
+
+// monsterOption allows management of the mutation configuration using functional options.
+type monsterOption func(*MonsterMutation)
+
+// newMonsterMutation creates new mutation for the Monster entity.
+func newMonsterMutation(c config, op Op, opts ...monsterOption) *MonsterMutation {
+	m := &MonsterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonster,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonsterID sets the ID field of the mutation.
+func withMonsterID(id int) monsterOption {
+	return func(m *MonsterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Monster
+		)
+		m.oldValue = func(ctx context.Context) (*Monster, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Monster.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonster sets the old Monster of the mutation.
+func withMonster(node *Monster) monsterOption {
+	return func(m *MonsterMutation) {
+		m.oldValue = func(context.Context) (*Monster, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonsterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonsterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonsterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonsterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Monster.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *MonsterMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MonsterMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Monster entity.
+// If the Monster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MonsterMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *MonsterMutation) SetKind(value monster.Kind) {
+	m.kind = &value
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *MonsterMutation) Kind() (r monster.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Monster entity.
+// If the Monster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterMutation) OldKind(ctx context.Context) (v monster.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *MonsterMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetLevels sets the "levels" field.
+func (m *MonsterMutation) SetLevels(i int) {
+	m.levels = &i
+	m.addlevels = nil
+}
+
+// Levels returns the value of the "levels" field in the mutation.
+func (m *MonsterMutation) Levels() (r int, exists bool) {
+	v := m.levels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevels returns the old "levels" field's value of the Monster entity.
+// If the Monster object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterMutation) OldLevels(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevels: %w", err)
+	}
+	return oldValue.Levels, nil
+}
+
+// AddLevels adds i to the "levels" field.
+func (m *MonsterMutation) AddLevels(i int) {
+	if m.addlevels != nil {
+		*m.addlevels += i
+	} else {
+		m.addlevels = &i
+	}
+}
+
+// AddedLevels returns the value that was added to the "levels" field in this mutation.
+func (m *MonsterMutation) AddedLevels() (r int, exists bool) {
+	v := m.addlevels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevels resets all changes to the "levels" field.
+func (m *MonsterMutation) ResetLevels() {
+	m.levels = nil
+	m.addlevels = nil
+}
+
+// Where appends a list predicates to the MonsterMutation builder.
+func (m *MonsterMutation) Where(ps ...predicate.Monster) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonsterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonsterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Monster, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonsterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonsterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Monster).
+func (m *MonsterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonsterMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, monster.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, monster.FieldKind)
+	}
+	if m.levels != nil {
+		fields = append(fields, monster.FieldLevels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonsterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monster.FieldName:
+		return m.Name()
+	case monster.FieldKind:
+		return m.Kind()
+	case monster.FieldLevels:
+		return m.Levels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonsterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monster.FieldName:
+		return m.OldName(ctx)
+	case monster.FieldKind:
+		return m.OldKind(ctx)
+	case monster.FieldLevels:
+		return m.OldLevels(ctx)
+	}
+	return nil, fmt.Errorf("unknown Monster field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonsterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monster.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case monster.FieldKind:
+		v, ok := value.(monster.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case monster.FieldLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Monster field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonsterMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevels != nil {
+		fields = append(fields, monster.FieldLevels)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonsterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monster.FieldLevels:
+		return m.AddedLevels()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonsterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monster.FieldLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Monster numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonsterMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonsterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonsterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Monster nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonsterMutation) ResetField(name string) error {
+	switch name {
+	case monster.FieldName:
+		m.ResetName()
+		return nil
+	case monster.FieldKind:
+		m.ResetKind()
+		return nil
+	case monster.FieldLevels:
+		m.ResetLevels()
+		return nil
+	}
+	return fmt.Errorf("unknown Monster field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonsterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonsterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonsterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonsterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonsterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonsterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonsterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Monster unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonsterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Monster edge %s", name)
+}
+
+// MonsterProgressMutation represents an operation that mutates the MonsterProgress nodes in the graph.
+type MonsterProgressMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	unlocked_year     *int
+	addunlocked_year  *int
+	defeats           *[]schematype.Defeat
+	appenddefeats     []schematype.Defeat
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	monster           *int
+	clearedmonster    bool
+	done              bool
+	oldValue          func(context.Context) (*MonsterProgress, error)
+	predicates        []predicate.MonsterProgress
+}
+
+var _ ent.Mutation = (*MonsterProgressMutation)(nil)
+
+// monsterprogressOption allows management of the mutation configuration using functional options.
+type monsterprogressOption func(*MonsterProgressMutation)
+
+// newMonsterProgressMutation creates new mutation for the MonsterProgress entity.
+func newMonsterProgressMutation(c config, op Op, opts ...monsterprogressOption) *MonsterProgressMutation {
+	m := &MonsterProgressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonsterProgress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonsterProgressID sets the ID field of the mutation.
+func withMonsterProgressID(id int) monsterprogressOption {
+	return func(m *MonsterProgressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MonsterProgress
+		)
+		m.oldValue = func(ctx context.Context) (*MonsterProgress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MonsterProgress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonsterProgress sets the old MonsterProgress of the mutation.
+func withMonsterProgress(node *MonsterProgress) monsterprogressOption {
+	return func(m *MonsterProgressMutation) {
+		m.oldValue = func(context.Context) (*MonsterProgress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonsterProgressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonsterProgressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonsterProgressMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonsterProgressMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MonsterProgress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUnlockedYear sets the "unlocked_year" field.
+func (m *MonsterProgressMutation) SetUnlockedYear(i int) {
+	m.unlocked_year = &i
+	m.addunlocked_year = nil
+}
+
+// UnlockedYear returns the value of the "unlocked_year" field in the mutation.
+func (m *MonsterProgressMutation) UnlockedYear() (r int, exists bool) {
+	v := m.unlocked_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnlockedYear returns the old "unlocked_year" field's value of the MonsterProgress entity.
+// If the MonsterProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterProgressMutation) OldUnlockedYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnlockedYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnlockedYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnlockedYear: %w", err)
+	}
+	return oldValue.UnlockedYear, nil
+}
+
+// AddUnlockedYear adds i to the "unlocked_year" field.
+func (m *MonsterProgressMutation) AddUnlockedYear(i int) {
+	if m.addunlocked_year != nil {
+		*m.addunlocked_year += i
+	} else {
+		m.addunlocked_year = &i
+	}
+}
+
+// AddedUnlockedYear returns the value that was added to the "unlocked_year" field in this mutation.
+func (m *MonsterProgressMutation) AddedUnlockedYear() (r int, exists bool) {
+	v := m.addunlocked_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUnlockedYear resets all changes to the "unlocked_year" field.
+func (m *MonsterProgressMutation) ResetUnlockedYear() {
+	m.unlocked_year = nil
+	m.addunlocked_year = nil
+}
+
+// SetDefeats sets the "defeats" field.
+func (m *MonsterProgressMutation) SetDefeats(s []schematype.Defeat) {
+	m.defeats = &s
+	m.appenddefeats = nil
+}
+
+// Defeats returns the value of the "defeats" field in the mutation.
+func (m *MonsterProgressMutation) Defeats() (r []schematype.Defeat, exists bool) {
+	v := m.defeats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefeats returns the old "defeats" field's value of the MonsterProgress entity.
+// If the MonsterProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterProgressMutation) OldDefeats(ctx context.Context) (v []schematype.Defeat, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefeats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefeats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefeats: %w", err)
+	}
+	return oldValue.Defeats, nil
+}
+
+// AppendDefeats adds s to the "defeats" field.
+func (m *MonsterProgressMutation) AppendDefeats(s []schematype.Defeat) {
+	m.appenddefeats = append(m.appenddefeats, s...)
+}
+
+// AppendedDefeats returns the list of values that were appended to the "defeats" field in this mutation.
+func (m *MonsterProgressMutation) AppendedDefeats() ([]schematype.Defeat, bool) {
+	if len(m.appenddefeats) == 0 {
+		return nil, false
+	}
+	return m.appenddefeats, true
+}
+
+// ClearDefeats clears the value of the "defeats" field.
+func (m *MonsterProgressMutation) ClearDefeats() {
+	m.defeats = nil
+	m.appenddefeats = nil
+	m.clearedFields[monsterprogress.FieldDefeats] = struct{}{}
+}
+
+// DefeatsCleared returns if the "defeats" field was cleared in this mutation.
+func (m *MonsterProgressMutation) DefeatsCleared() bool {
+	_, ok := m.clearedFields[monsterprogress.FieldDefeats]
+	return ok
+}
+
+// ResetDefeats resets all changes to the "defeats" field.
+func (m *MonsterProgressMutation) ResetDefeats() {
+	m.defeats = nil
+	m.appenddefeats = nil
+	delete(m.clearedFields, monsterprogress.FieldDefeats)
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *MonsterProgressMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *MonsterProgressMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the MonsterProgress entity.
+// If the MonsterProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterProgressMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *MonsterProgressMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// SetMonsterID sets the "monster_id" field.
+func (m *MonsterProgressMutation) SetMonsterID(i int) {
+	m.monster = &i
+}
+
+// MonsterID returns the value of the "monster_id" field in the mutation.
+func (m *MonsterProgressMutation) MonsterID() (r int, exists bool) {
+	v := m.monster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonsterID returns the old "monster_id" field's value of the MonsterProgress entity.
+// If the MonsterProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonsterProgressMutation) OldMonsterID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonsterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonsterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonsterID: %w", err)
+	}
+	return oldValue.MonsterID, nil
+}
+
+// ResetMonsterID resets all changes to the "monster_id" field.
+func (m *MonsterProgressMutation) ResetMonsterID() {
+	m.monster = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *MonsterProgressMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[monsterprogress.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *MonsterProgressMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *MonsterProgressMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *MonsterProgressMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// ClearMonster clears the "monster" edge to the Monster entity.
+func (m *MonsterProgressMutation) ClearMonster() {
+	m.clearedmonster = true
+	m.clearedFields[monsterprogress.FieldMonsterID] = struct{}{}
+}
+
+// MonsterCleared reports if the "monster" edge to the Monster entity was cleared.
+func (m *MonsterProgressMutation) MonsterCleared() bool {
+	return m.clearedmonster
+}
+
+// MonsterIDs returns the "monster" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MonsterID instead. It exists only for internal usage by the builders.
+func (m *MonsterProgressMutation) MonsterIDs() (ids []int) {
+	if id := m.monster; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMonster resets all changes to the "monster" edge.
+func (m *MonsterProgressMutation) ResetMonster() {
+	m.monster = nil
+	m.clearedmonster = false
+}
+
+// Where appends a list predicates to the MonsterProgressMutation builder.
+func (m *MonsterProgressMutation) Where(ps ...predicate.MonsterProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonsterProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonsterProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MonsterProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonsterProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonsterProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MonsterProgress).
+func (m *MonsterProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonsterProgressMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.unlocked_year != nil {
+		fields = append(fields, monsterprogress.FieldUnlockedYear)
+	}
+	if m.defeats != nil {
+		fields = append(fields, monsterprogress.FieldDefeats)
+	}
+	if m.settlement != nil {
+		fields = append(fields, monsterprogress.FieldSettlementID)
+	}
+	if m.monster != nil {
+		fields = append(fields, monsterprogress.FieldMonsterID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonsterProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		return m.UnlockedYear()
+	case monsterprogress.FieldDefeats:
+		return m.Defeats()
+	case monsterprogress.FieldSettlementID:
+		return m.SettlementID()
+	case monsterprogress.FieldMonsterID:
+		return m.MonsterID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonsterProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		return m.OldUnlockedYear(ctx)
+	case monsterprogress.FieldDefeats:
+		return m.OldDefeats(ctx)
+	case monsterprogress.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	case monsterprogress.FieldMonsterID:
+		return m.OldMonsterID(ctx)
+	}
+	return nil, fmt.Errorf("unknown MonsterProgress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonsterProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnlockedYear(v)
+		return nil
+	case monsterprogress.FieldDefeats:
+		v, ok := value.([]schematype.Defeat)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefeats(v)
+		return nil
+	case monsterprogress.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	case monsterprogress.FieldMonsterID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonsterID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonsterProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addunlocked_year != nil {
+		fields = append(fields, monsterprogress.FieldUnlockedYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonsterProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		return m.AddedUnlockedYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonsterProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnlockedYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonsterProgressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(monsterprogress.FieldDefeats) {
+		fields = append(fields, monsterprogress.FieldDefeats)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonsterProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonsterProgressMutation) ClearField(name string) error {
+	switch name {
+	case monsterprogress.FieldDefeats:
+		m.ClearDefeats()
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonsterProgressMutation) ResetField(name string) error {
+	switch name {
+	case monsterprogress.FieldUnlockedYear:
+		m.ResetUnlockedYear()
+		return nil
+	case monsterprogress.FieldDefeats:
+		m.ResetDefeats()
+		return nil
+	case monsterprogress.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	case monsterprogress.FieldMonsterID:
+		m.ResetMonsterID()
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonsterProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.settlement != nil {
+		edges = append(edges, monsterprogress.EdgeSettlement)
+	}
+	if m.monster != nil {
+		edges = append(edges, monsterprogress.EdgeMonster)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonsterProgressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monsterprogress.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	case monsterprogress.EdgeMonster:
+		if id := m.monster; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonsterProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonsterProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonsterProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsettlement {
+		edges = append(edges, monsterprogress.EdgeSettlement)
+	}
+	if m.clearedmonster {
+		edges = append(edges, monsterprogress.EdgeMonster)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonsterProgressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monsterprogress.EdgeSettlement:
+		return m.clearedsettlement
+	case monsterprogress.EdgeMonster:
+		return m.clearedmonster
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonsterProgressMutation) ClearEdge(name string) error {
+	switch name {
+	case monsterprogress.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	case monsterprogress.EdgeMonster:
+		m.ClearMonster()
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonsterProgressMutation) ResetEdge(name string) error {
+	switch name {
+	case monsterprogress.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	case monsterprogress.EdgeMonster:
+		m.ResetMonster()
+		return nil
+	}
+	return fmt.Errorf("unknown MonsterProgress edge %s", name)
+}
+
+// PrincipleMutation represents an operation that mutates the Principle nodes in the graph.
+type PrincipleMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	name                        *principle.Name
+	choice                      *string
+	survival_limit_bonus        *int
+	addsurvival_limit_bonus     *int
+	departing_survival_bonus    *int
+	adddeparting_survival_bonus *int
+	clearedFields               map[string]struct{}
+	settlement                  *int
+	clearedsettlement           bool
+	done                        bool
+	oldValue                    func(context.Context) (*Principle, error)
+	predicates                  []predicate.Principle
+}
+
+var _ ent.Mutation = (*PrincipleMutation)(nil)
+
+// principleOption allows management of the mutation configuration using functional options.
+type principleOption func(*PrincipleMutation)
+
+// newPrincipleMutation creates new mutation for the Principle entity.
+func newPrincipleMutation(c config, op Op, opts ...principleOption) *PrincipleMutation {
+	m := &PrincipleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrinciple,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrincipleID sets the ID field of the mutation.
+func withPrincipleID(id int) principleOption {
+	return func(m *PrincipleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Principle
+		)
+		m.oldValue = func(ctx context.Context) (*Principle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Principle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrinciple sets the old Principle of the mutation.
+func withPrinciple(node *Principle) principleOption {
+	return func(m *PrincipleMutation) {
+		m.oldValue = func(context.Context) (*Principle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrincipleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrincipleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrincipleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrincipleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Principle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PrincipleMutation) SetName(pr principle.Name) {
+	m.name = &pr
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PrincipleMutation) Name() (r principle.Name, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Principle entity.
+// If the Principle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrincipleMutation) OldName(ctx context.Context) (v principle.Name, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PrincipleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetChoice sets the "choice" field.
+func (m *PrincipleMutation) SetChoice(s string) {
+	m.choice = &s
+}
+
+// Choice returns the value of the "choice" field in the mutation.
+func (m *PrincipleMutation) Choice() (r string, exists bool) {
+	v := m.choice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoice returns the old "choice" field's value of the Principle entity.
+// If the Principle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrincipleMutation) OldChoice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoice: %w", err)
+	}
+	return oldValue.Choice, nil
+}
+
+// ResetChoice resets all changes to the "choice" field.
+func (m *PrincipleMutation) ResetChoice() {
+	m.choice = nil
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (m *PrincipleMutation) SetSurvivalLimitBonus(i int) {
+	m.survival_limit_bonus = &i
+	m.addsurvival_limit_bonus = nil
+}
+
+// SurvivalLimitBonus returns the value of the "survival_limit_bonus" field in the mutation.
+func (m *PrincipleMutation) SurvivalLimitBonus() (r int, exists bool) {
+	v := m.survival_limit_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurvivalLimitBonus returns the old "survival_limit_bonus" field's value of the Principle entity.
+// If the Principle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrincipleMutation) OldSurvivalLimitBonus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurvivalLimitBonus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurvivalLimitBonus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurvivalLimitBonus: %w", err)
+	}
+	return oldValue.SurvivalLimitBonus, nil
+}
+
+// AddSurvivalLimitBonus adds i to the "survival_limit_bonus" field.
+func (m *PrincipleMutation) AddSurvivalLimitBonus(i int) {
+	if m.addsurvival_limit_bonus != nil {
+		*m.addsurvival_limit_bonus += i
+	} else {
+		m.addsurvival_limit_bonus = &i
+	}
+}
+
+// AddedSurvivalLimitBonus returns the value that was added to the "survival_limit_bonus" field in this mutation.
+func (m *PrincipleMutation) AddedSurvivalLimitBonus() (r int, exists bool) {
+	v := m.addsurvival_limit_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSurvivalLimitBonus resets all changes to the "survival_limit_bonus" field.
+func (m *PrincipleMutation) ResetSurvivalLimitBonus() {
+	m.survival_limit_bonus = nil
+	m.addsurvival_limit_bonus = nil
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (m *PrincipleMutation) SetDepartingSurvivalBonus(i int) {
+	m.departing_survival_bonus = &i
+	m.adddeparting_survival_bonus = nil
+}
+
+// DepartingSurvivalBonus returns the value of the "departing_survival_bonus" field in the mutation.
+func (m *PrincipleMutation) DepartingSurvivalBonus() (r int, exists bool) {
+	v := m.departing_survival_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartingSurvivalBonus returns the old "departing_survival_bonus" field's value of the Principle entity.
+// If the Principle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrincipleMutation) OldDepartingSurvivalBonus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartingSurvivalBonus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartingSurvivalBonus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartingSurvivalBonus: %w", err)
+	}
+	return oldValue.DepartingSurvivalBonus, nil
+}
+
+// AddDepartingSurvivalBonus adds i to the "departing_survival_bonus" field.
+func (m *PrincipleMutation) AddDepartingSurvivalBonus(i int) {
+	if m.adddeparting_survival_bonus != nil {
+		*m.adddeparting_survival_bonus += i
+	} else {
+		m.adddeparting_survival_bonus = &i
+	}
+}
+
+// AddedDepartingSurvivalBonus returns the value that was added to the "departing_survival_bonus" field in this mutation.
+func (m *PrincipleMutation) AddedDepartingSurvivalBonus() (r int, exists bool) {
+	v := m.adddeparting_survival_bonus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDepartingSurvivalBonus resets all changes to the "departing_survival_bonus" field.
+func (m *PrincipleMutation) ResetDepartingSurvivalBonus() {
+	m.departing_survival_bonus = nil
+	m.adddeparting_survival_bonus = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *PrincipleMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *PrincipleMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Principle entity.
+// If the Principle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrincipleMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *PrincipleMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *PrincipleMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[principle.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *PrincipleMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *PrincipleMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *PrincipleMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the PrincipleMutation builder.
+func (m *PrincipleMutation) Where(ps ...predicate.Principle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrincipleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrincipleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Principle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrincipleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrincipleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Principle).
+func (m *PrincipleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrincipleMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, principle.FieldName)
+	}
+	if m.choice != nil {
+		fields = append(fields, principle.FieldChoice)
+	}
+	if m.survival_limit_bonus != nil {
+		fields = append(fields, principle.FieldSurvivalLimitBonus)
+	}
+	if m.departing_survival_bonus != nil {
+		fields = append(fields, principle.FieldDepartingSurvivalBonus)
+	}
+	if m.settlement != nil {
+		fields = append(fields, principle.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrincipleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case principle.FieldName:
+		return m.Name()
+	case principle.FieldChoice:
+		return m.Choice()
+	case principle.FieldSurvivalLimitBonus:
+		return m.SurvivalLimitBonus()
+	case principle.FieldDepartingSurvivalBonus:
+		return m.DepartingSurvivalBonus()
+	case principle.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrincipleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case principle.FieldName:
+		return m.OldName(ctx)
+	case principle.FieldChoice:
+		return m.OldChoice(ctx)
+	case principle.FieldSurvivalLimitBonus:
+		return m.OldSurvivalLimitBonus(ctx)
+	case principle.FieldDepartingSurvivalBonus:
+		return m.OldDepartingSurvivalBonus(ctx)
+	case principle.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Principle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrincipleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case principle.FieldName:
+		v, ok := value.(principle.Name)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case principle.FieldChoice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoice(v)
+		return nil
+	case principle.FieldSurvivalLimitBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurvivalLimitBonus(v)
+		return nil
+	case principle.FieldDepartingSurvivalBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartingSurvivalBonus(v)
+		return nil
+	case principle.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Principle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrincipleMutation) AddedFields() []string {
+	var fields []string
+	if m.addsurvival_limit_bonus != nil {
+		fields = append(fields, principle.FieldSurvivalLimitBonus)
+	}
+	if m.adddeparting_survival_bonus != nil {
+		fields = append(fields, principle.FieldDepartingSurvivalBonus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrincipleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case principle.FieldSurvivalLimitBonus:
+		return m.AddedSurvivalLimitBonus()
+	case principle.FieldDepartingSurvivalBonus:
+		return m.AddedDepartingSurvivalBonus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrincipleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case principle.FieldSurvivalLimitBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSurvivalLimitBonus(v)
+		return nil
+	case principle.FieldDepartingSurvivalBonus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepartingSurvivalBonus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Principle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrincipleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrincipleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrincipleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Principle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrincipleMutation) ResetField(name string) error {
+	switch name {
+	case principle.FieldName:
+		m.ResetName()
+		return nil
+	case principle.FieldChoice:
+		m.ResetChoice()
+		return nil
+	case principle.FieldSurvivalLimitBonus:
+		m.ResetSurvivalLimitBonus()
+		return nil
+	case principle.FieldDepartingSurvivalBonus:
+		m.ResetDepartingSurvivalBonus()
+		return nil
+	case principle.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Principle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrincipleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, principle.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrincipleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case principle.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrincipleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrincipleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrincipleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, principle.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrincipleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case principle.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrincipleMutation) ClearEdge(name string) error {
+	switch name {
+	case principle.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Principle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrincipleMutation) ResetEdge(name string) error {
+	switch name {
+	case principle.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Principle edge %s", name)
+}
+
+// RecipeMutation represents an operation that mutates the Recipe nodes in the graph.
+type RecipeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	keywords        *[]string
+	appendkeywords  []string
+	costs           *[]schematype.ResourceCost
+	appendcosts     []schematype.ResourceCost
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*Recipe, error)
+	predicates      []predicate.Recipe
+}
+
+var _ ent.Mutation = (*RecipeMutation)(nil)
+
+// recipeOption allows management of the mutation configuration using functional options.
+type recipeOption func(*RecipeMutation)
+
+// newRecipeMutation creates new mutation for the Recipe entity.
+func newRecipeMutation(c config, op Op, opts ...recipeOption) *RecipeMutation {
+	m := &RecipeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecipe,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecipeID sets the ID field of the mutation.
+func withRecipeID(id int) recipeOption {
+	return func(m *RecipeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Recipe
+		)
+		m.oldValue = func(ctx context.Context) (*Recipe, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Recipe.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecipe sets the old Recipe of the mutation.
+func withRecipe(node *Recipe) recipeOption {
+	return func(m *RecipeMutation) {
+		m.oldValue = func(context.Context) (*Recipe, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecipeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecipeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecipeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecipeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Recipe.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RecipeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RecipeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RecipeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKeywords sets the "keywords" field.
+func (m *RecipeMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
+}
+
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *RecipeMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old "keywords" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords adds s to the "keywords" field.
+func (m *RecipeMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
+}
+
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *RecipeMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (m *RecipeMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[recipe.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *RecipeMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[recipe.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *RecipeMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, recipe.FieldKeywords)
+}
+
+// SetCosts sets the "costs" field.
+func (m *RecipeMutation) SetCosts(sc []schematype.ResourceCost) {
+	m.costs = &sc
+	m.appendcosts = nil
+}
+
+// Costs returns the value of the "costs" field in the mutation.
+func (m *RecipeMutation) Costs() (r []schematype.ResourceCost, exists bool) {
+	v := m.costs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCosts returns the old "costs" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldCosts(ctx context.Context) (v []schematype.ResourceCost, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCosts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCosts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCosts: %w", err)
+	}
+	return oldValue.Costs, nil
+}
+
+// AppendCosts adds sc to the "costs" field.
+func (m *RecipeMutation) AppendCosts(sc []schematype.ResourceCost) {
+	m.appendcosts = append(m.appendcosts, sc...)
+}
+
+// AppendedCosts returns the list of values that were appended to the "costs" field in this mutation.
+func (m *RecipeMutation) AppendedCosts() ([]schematype.ResourceCost, bool) {
+	if len(m.appendcosts) == 0 {
+		return nil, false
+	}
+	return m.appendcosts, true
+}
+
+// ResetCosts resets all changes to the "costs" field.
+func (m *RecipeMutation) ResetCosts() {
+	m.costs = nil
+	m.appendcosts = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *RecipeMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *RecipeMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the Recipe entity.
+// If the Recipe object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecipeMutation) OldLocationID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *RecipeMutation) ResetLocationID() {
+	m.location = nil
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *RecipeMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[recipe.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *RecipeMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *RecipeMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *RecipeMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the RecipeMutation builder.
+func (m *RecipeMutation) Where(ps ...predicate.Recipe) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecipeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecipeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Recipe, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecipeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecipeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Recipe).
+func (m *RecipeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecipeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, recipe.FieldName)
+	}
+	if m.keywords != nil {
+		fields = append(fields, recipe.FieldKeywords)
+	}
+	if m.costs != nil {
+		fields = append(fields, recipe.FieldCosts)
+	}
+	if m.location != nil {
+		fields = append(fields, recipe.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecipeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recipe.FieldName:
+		return m.Name()
+	case recipe.FieldKeywords:
+		return m.Keywords()
+	case recipe.FieldCosts:
+		return m.Costs()
+	case recipe.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecipeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recipe.FieldName:
+		return m.OldName(ctx)
+	case recipe.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case recipe.FieldCosts:
+		return m.OldCosts(ctx)
+	case recipe.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recipe.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case recipe.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case recipe.FieldCosts:
+		v, ok := value.([]schematype.ResourceCost)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCosts(v)
+		return nil
+	case recipe.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecipeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecipeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecipeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Recipe numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecipeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recipe.FieldKeywords) {
+		fields = append(fields, recipe.FieldKeywords)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecipeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecipeMutation) ClearField(name string) error {
+	switch name {
+	case recipe.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecipeMutation) ResetField(name string) error {
+	switch name {
+	case recipe.FieldName:
+		m.ResetName()
+		return nil
+	case recipe.FieldKeywords:
+		m.ResetKeywords()
+		return nil
+	case recipe.FieldCosts:
+		m.ResetCosts()
+		return nil
+	case recipe.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecipeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, recipe.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecipeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recipe.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecipeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecipeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecipeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, recipe.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecipeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recipe.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecipeMutation) ClearEdge(name string) error {
+	switch name {
+	case recipe.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecipeMutation) ResetEdge(name string) error {
+	switch name {
+	case recipe.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Recipe edge %s", name)
+}
+
+// SettlementMutation represents an operation that mutates the Settlement nodes in the graph.
+type SettlementMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	deleted_at             *time.Time
+	owner                  *string
+	name                   *string
+	survivalLimit          *int
+	addsurvivalLimit       *int
+	departingSurvival      *int
+	adddepartingSurvival   *int
+	collectiveCognition    *int
+	addcollectiveCognition *int
+	currentYear            *int
+	addcurrentYear         *int
+	share_token_hash       *string
+	clearedFields          map[string]struct{}
+	population             map[int]struct{}
+	removedpopulation      map[int]struct{}
+	clearedpopulation      bool
+	members                map[int]struct{}
+	removedmembers         map[int]struct{}
+	clearedmembers         bool
+	invites                map[int]struct{}
+	removedinvites         map[int]struct{}
+	clearedinvites         bool
+	audit_log              map[int]struct{}
+	removedaudit_log       map[int]struct{}
+	clearedaudit_log       bool
+	timeline               map[int]struct{}
+	removedtimeline        map[int]struct{}
+	clearedtimeline        bool
+	storage                map[int]struct{}
+	removedstorage         map[int]struct{}
+	clearedstorage         bool
+	innovations            map[int]struct{}
+	removedinnovations     map[int]struct{}
+	clearedinnovations     bool
+	principles             map[int]struct{}
+	removedprinciples      map[int]struct{}
+	clearedprinciples      bool
+	milestones             map[int]struct{}
+	removedmilestones      map[int]struct{}
+	clearedmilestones      bool
+	locations              map[int]struct{}
+	removedlocations       map[int]struct{}
+	clearedlocations       bool
+	monsters               map[int]struct{}
+	removedmonsters        map[int]struct{}
+	clearedmonsters        bool
+	done                   bool
+	oldValue               func(context.Context) (*Settlement, error)
+	predicates             []predicate.Settlement
+}
+
+var _ ent.Mutation = (*SettlementMutation)(nil)
+
+// settlementOption allows management of the mutation configuration using functional options.
+type settlementOption func(*SettlementMutation)
+
+// newSettlementMutation creates new mutation for the Settlement entity.
+func newSettlementMutation(c config, op Op, opts ...settlementOption) *SettlementMutation {
+	m := &SettlementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettlement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettlementID sets the ID field of the mutation.
+func withSettlementID(id int) settlementOption {
+	return func(m *SettlementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settlement
+		)
+		m.oldValue = func(ctx context.Context) (*Settlement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settlement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettlement sets the old Settlement of the mutation.
+func withSettlement(node *Settlement) settlementOption {
+	return func(m *SettlementMutation) {
+		m.oldValue = func(context.Context) (*Settlement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettlementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettlementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettlementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettlementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settlement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SettlementMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SettlementMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SettlementMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[settlement.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SettlementMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[settlement.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SettlementMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, settlement.FieldDeletedAt)
+}
+
+// SetOwner sets the "owner" field.
+func (m *SettlementMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *SettlementMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *SettlementMutation) ResetOwner() {
+	m.owner = nil
+}
+
+// SetName sets the "name" field.
+func (m *SettlementMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SettlementMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SettlementMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSurvivalLimit sets the "survivalLimit" field.
+func (m *SettlementMutation) SetSurvivalLimit(i int) {
+	m.survivalLimit = &i
+	m.addsurvivalLimit = nil
+}
+
+// SurvivalLimit returns the value of the "survivalLimit" field in the mutation.
+func (m *SettlementMutation) SurvivalLimit() (r int, exists bool) {
+	v := m.survivalLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurvivalLimit returns the old "survivalLimit" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldSurvivalLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurvivalLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurvivalLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurvivalLimit: %w", err)
+	}
+	return oldValue.SurvivalLimit, nil
+}
+
+// AddSurvivalLimit adds i to the "survivalLimit" field.
+func (m *SettlementMutation) AddSurvivalLimit(i int) {
+	if m.addsurvivalLimit != nil {
+		*m.addsurvivalLimit += i
+	} else {
+		m.addsurvivalLimit = &i
+	}
+}
+
+// AddedSurvivalLimit returns the value that was added to the "survivalLimit" field in this mutation.
+func (m *SettlementMutation) AddedSurvivalLimit() (r int, exists bool) {
+	v := m.addsurvivalLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSurvivalLimit resets all changes to the "survivalLimit" field.
+func (m *SettlementMutation) ResetSurvivalLimit() {
+	m.survivalLimit = nil
+	m.addsurvivalLimit = nil
+}
+
+// SetDepartingSurvival sets the "departingSurvival" field.
+func (m *SettlementMutation) SetDepartingSurvival(i int) {
+	m.departingSurvival = &i
+	m.adddepartingSurvival = nil
+}
+
+// DepartingSurvival returns the value of the "departingSurvival" field in the mutation.
+func (m *SettlementMutation) DepartingSurvival() (r int, exists bool) {
+	v := m.departingSurvival
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartingSurvival returns the old "departingSurvival" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldDepartingSurvival(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartingSurvival is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartingSurvival requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartingSurvival: %w", err)
+	}
+	return oldValue.DepartingSurvival, nil
+}
+
+// AddDepartingSurvival adds i to the "departingSurvival" field.
+func (m *SettlementMutation) AddDepartingSurvival(i int) {
+	if m.adddepartingSurvival != nil {
+		*m.adddepartingSurvival += i
+	} else {
+		m.adddepartingSurvival = &i
+	}
+}
+
+// AddedDepartingSurvival returns the value that was added to the "departingSurvival" field in this mutation.
+func (m *SettlementMutation) AddedDepartingSurvival() (r int, exists bool) {
+	v := m.adddepartingSurvival
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDepartingSurvival resets all changes to the "departingSurvival" field.
+func (m *SettlementMutation) ResetDepartingSurvival() {
+	m.departingSurvival = nil
+	m.adddepartingSurvival = nil
+}
+
+// SetCollectiveCognition sets the "collectiveCognition" field.
+func (m *SettlementMutation) SetCollectiveCognition(i int) {
+	m.collectiveCognition = &i
+	m.addcollectiveCognition = nil
+}
+
+// CollectiveCognition returns the value of the "collectiveCognition" field in the mutation.
+func (m *SettlementMutation) CollectiveCognition() (r int, exists bool) {
+	v := m.collectiveCognition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectiveCognition returns the old "collectiveCognition" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldCollectiveCognition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectiveCognition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectiveCognition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectiveCognition: %w", err)
+	}
+	return oldValue.CollectiveCognition, nil
+}
+
+// AddCollectiveCognition adds i to the "collectiveCognition" field.
+func (m *SettlementMutation) AddCollectiveCognition(i int) {
+	if m.addcollectiveCognition != nil {
+		*m.addcollectiveCognition += i
+	} else {
+		m.addcollectiveCognition = &i
+	}
+}
+
+// AddedCollectiveCognition returns the value that was added to the "collectiveCognition" field in this mutation.
+func (m *SettlementMutation) AddedCollectiveCognition() (r int, exists bool) {
+	v := m.addcollectiveCognition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCollectiveCognition resets all changes to the "collectiveCognition" field.
+func (m *SettlementMutation) ResetCollectiveCognition() {
+	m.collectiveCognition = nil
+	m.addcollectiveCognition = nil
+}
+
+// SetCurrentYear sets the "currentYear" field.
+func (m *SettlementMutation) SetCurrentYear(i int) {
+	m.currentYear = &i
+	m.addcurrentYear = nil
+}
+
+// CurrentYear returns the value of the "currentYear" field in the mutation.
+func (m *SettlementMutation) CurrentYear() (r int, exists bool) {
+	v := m.currentYear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentYear returns the old "currentYear" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldCurrentYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentYear: %w", err)
+	}
+	return oldValue.CurrentYear, nil
+}
+
+// AddCurrentYear adds i to the "currentYear" field.
+func (m *SettlementMutation) AddCurrentYear(i int) {
+	if m.addcurrentYear != nil {
+		*m.addcurrentYear += i
+	} else {
+		m.addcurrentYear = &i
+	}
+}
+
+// AddedCurrentYear returns the value that was added to the "currentYear" field in this mutation.
+func (m *SettlementMutation) AddedCurrentYear() (r int, exists bool) {
+	v := m.addcurrentYear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentYear resets all changes to the "currentYear" field.
+func (m *SettlementMutation) ResetCurrentYear() {
+	m.currentYear = nil
+	m.addcurrentYear = nil
+}
+
+// SetShareTokenHash sets the "share_token_hash" field.
+func (m *SettlementMutation) SetShareTokenHash(s string) {
+	m.share_token_hash = &s
+}
+
+// ShareTokenHash returns the value of the "share_token_hash" field in the mutation.
+func (m *SettlementMutation) ShareTokenHash() (r string, exists bool) {
+	v := m.share_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareTokenHash returns the old "share_token_hash" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldShareTokenHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareTokenHash: %w", err)
+	}
+	return oldValue.ShareTokenHash, nil
+}
+
+// ClearShareTokenHash clears the value of the "share_token_hash" field.
+func (m *SettlementMutation) ClearShareTokenHash() {
+	m.share_token_hash = nil
+	m.clearedFields[settlement.FieldShareTokenHash] = struct{}{}
+}
+
+// ShareTokenHashCleared returns if the "share_token_hash" field was cleared in this mutation.
+func (m *SettlementMutation) ShareTokenHashCleared() bool {
+	_, ok := m.clearedFields[settlement.FieldShareTokenHash]
+	return ok
+}
+
+// ResetShareTokenHash resets all changes to the "share_token_hash" field.
+func (m *SettlementMutation) ResetShareTokenHash() {
+	m.share_token_hash = nil
+	delete(m.clearedFields, settlement.FieldShareTokenHash)
+}
+
+// AddPopulationIDs adds the "population" edge to the Survivor entity by ids.
+func (m *SettlementMutation) AddPopulationIDs(ids ...int) {
+	if m.population == nil {
+		m.population = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.population[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPopulation clears the "population" edge to the Survivor entity.
+func (m *SettlementMutation) ClearPopulation() {
+	m.clearedpopulation = true
+}
+
+// PopulationCleared reports if the "population" edge to the Survivor entity was cleared.
+func (m *SettlementMutation) PopulationCleared() bool {
+	return m.clearedpopulation
+}
+
+// RemovePopulationIDs removes the "population" edge to the Survivor entity by IDs.
+func (m *SettlementMutation) RemovePopulationIDs(ids ...int) {
+	if m.removedpopulation == nil {
+		m.removedpopulation = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.population, ids[i])
+		m.removedpopulation[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPopulation returns the removed IDs of the "population" edge to the Survivor entity.
+func (m *SettlementMutation) RemovedPopulationIDs() (ids []int) {
+	for id := range m.removedpopulation {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PopulationIDs returns the "population" edge IDs in the mutation.
+func (m *SettlementMutation) PopulationIDs() (ids []int) {
+	for id := range m.population {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPopulation resets all changes to the "population" edge.
+func (m *SettlementMutation) ResetPopulation() {
+	m.population = nil
+	m.clearedpopulation = false
+	m.removedpopulation = nil
+}
+
+// AddMemberIDs adds the "members" edge to the Membership entity by ids.
+func (m *SettlementMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the Membership entity.
+func (m *SettlementMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the Membership entity was cleared.
+func (m *SettlementMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the Membership entity by IDs.
+func (m *SettlementMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the Membership entity.
+func (m *SettlementMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *SettlementMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *SettlementMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by ids.
+func (m *SettlementMutation) AddInviteIDs(ids ...int) {
+	if m.invites == nil {
+		m.invites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvites clears the "invites" edge to the Invite entity.
+func (m *SettlementMutation) ClearInvites() {
+	m.clearedinvites = true
+}
+
+// InvitesCleared reports if the "invites" edge to the Invite entity was cleared.
+func (m *SettlementMutation) InvitesCleared() bool {
+	return m.clearedinvites
+}
+
+// RemoveInviteIDs removes the "invites" edge to the Invite entity by IDs.
+func (m *SettlementMutation) RemoveInviteIDs(ids ...int) {
+	if m.removedinvites == nil {
+		m.removedinvites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invites, ids[i])
+		m.removedinvites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvites returns the removed IDs of the "invites" edge to the Invite entity.
+func (m *SettlementMutation) RemovedInvitesIDs() (ids []int) {
+	for id := range m.removedinvites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitesIDs returns the "invites" edge IDs in the mutation.
+func (m *SettlementMutation) InvitesIDs() (ids []int) {
+	for id := range m.invites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvites resets all changes to the "invites" edge.
+func (m *SettlementMutation) ResetInvites() {
+	m.invites = nil
+	m.clearedinvites = false
+	m.removedinvites = nil
+}
+
+// AddAuditLogIDs adds the "audit_log" edge to the AuditLog entity by ids.
+func (m *SettlementMutation) AddAuditLogIDs(ids ...int) {
+	if m.audit_log == nil {
+		m.audit_log = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.audit_log[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuditLog clears the "audit_log" edge to the AuditLog entity.
+func (m *SettlementMutation) ClearAuditLog() {
+	m.clearedaudit_log = true
+}
+
+// AuditLogCleared reports if the "audit_log" edge to the AuditLog entity was cleared.
+func (m *SettlementMutation) AuditLogCleared() bool {
+	return m.clearedaudit_log
+}
+
+// RemoveAuditLogIDs removes the "audit_log" edge to the AuditLog entity by IDs.
+func (m *SettlementMutation) RemoveAuditLogIDs(ids ...int) {
+	if m.removedaudit_log == nil {
+		m.removedaudit_log = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.audit_log, ids[i])
+		m.removedaudit_log[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuditLog returns the removed IDs of the "audit_log" edge to the AuditLog entity.
+func (m *SettlementMutation) RemovedAuditLogIDs() (ids []int) {
+	for id := range m.removedaudit_log {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuditLogIDs returns the "audit_log" edge IDs in the mutation.
+func (m *SettlementMutation) AuditLogIDs() (ids []int) {
+	for id := range m.audit_log {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuditLog resets all changes to the "audit_log" edge.
+func (m *SettlementMutation) ResetAuditLog() {
+	m.audit_log = nil
+	m.clearedaudit_log = false
+	m.removedaudit_log = nil
+}
+
+// AddTimelineIDs adds the "timeline" edge to the TimelineEvent entity by ids.
+func (m *SettlementMutation) AddTimelineIDs(ids ...int) {
+	if m.timeline == nil {
+		m.timeline = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.timeline[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTimeline clears the "timeline" edge to the TimelineEvent entity.
+func (m *SettlementMutation) ClearTimeline() {
+	m.clearedtimeline = true
+}
+
+// TimelineCleared reports if the "timeline" edge to the TimelineEvent entity was cleared.
+func (m *SettlementMutation) TimelineCleared() bool {
+	return m.clearedtimeline
+}
+
+// RemoveTimelineIDs removes the "timeline" edge to the TimelineEvent entity by IDs.
+func (m *SettlementMutation) RemoveTimelineIDs(ids ...int) {
+	if m.removedtimeline == nil {
+		m.removedtimeline = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.timeline, ids[i])
+		m.removedtimeline[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTimeline returns the removed IDs of the "timeline" edge to the TimelineEvent entity.
+func (m *SettlementMutation) RemovedTimelineIDs() (ids []int) {
+	for id := range m.removedtimeline {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TimelineIDs returns the "timeline" edge IDs in the mutation.
+func (m *SettlementMutation) TimelineIDs() (ids []int) {
+	for id := range m.timeline {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTimeline resets all changes to the "timeline" edge.
+func (m *SettlementMutation) ResetTimeline() {
+	m.timeline = nil
+	m.clearedtimeline = false
+	m.removedtimeline = nil
+}
+
+// AddStorageIDs adds the "storage" edge to the StorageItem entity by ids.
+func (m *SettlementMutation) AddStorageIDs(ids ...int) {
+	if m.storage == nil {
+		m.storage = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.storage[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStorage clears the "storage" edge to the StorageItem entity.
+func (m *SettlementMutation) ClearStorage() {
+	m.clearedstorage = true
+}
+
+// StorageCleared reports if the "storage" edge to the StorageItem entity was cleared.
+func (m *SettlementMutation) StorageCleared() bool {
+	return m.clearedstorage
+}
+
+// RemoveStorageIDs removes the "storage" edge to the StorageItem entity by IDs.
+func (m *SettlementMutation) RemoveStorageIDs(ids ...int) {
+	if m.removedstorage == nil {
+		m.removedstorage = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.storage, ids[i])
+		m.removedstorage[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStorage returns the removed IDs of the "storage" edge to the StorageItem entity.
+func (m *SettlementMutation) RemovedStorageIDs() (ids []int) {
+	for id := range m.removedstorage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StorageIDs returns the "storage" edge IDs in the mutation.
+func (m *SettlementMutation) StorageIDs() (ids []int) {
+	for id := range m.storage {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStorage resets all changes to the "storage" edge.
+func (m *SettlementMutation) ResetStorage() {
+	m.storage = nil
+	m.clearedstorage = false
+	m.removedstorage = nil
+}
+
+// AddInnovationIDs adds the "innovations" edge to the Innovation entity by ids.
+func (m *SettlementMutation) AddInnovationIDs(ids ...int) {
+	if m.innovations == nil {
+		m.innovations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.innovations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInnovations clears the "innovations" edge to the Innovation entity.
+func (m *SettlementMutation) ClearInnovations() {
+	m.clearedinnovations = true
+}
+
+// InnovationsCleared reports if the "innovations" edge to the Innovation entity was cleared.
+func (m *SettlementMutation) InnovationsCleared() bool {
+	return m.clearedinnovations
+}
+
+// RemoveInnovationIDs removes the "innovations" edge to the Innovation entity by IDs.
+func (m *SettlementMutation) RemoveInnovationIDs(ids ...int) {
+	if m.removedinnovations == nil {
+		m.removedinnovations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.innovations, ids[i])
+		m.removedinnovations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInnovations returns the removed IDs of the "innovations" edge to the Innovation entity.
+func (m *SettlementMutation) RemovedInnovationsIDs() (ids []int) {
+	for id := range m.removedinnovations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InnovationsIDs returns the "innovations" edge IDs in the mutation.
+func (m *SettlementMutation) InnovationsIDs() (ids []int) {
+	for id := range m.innovations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInnovations resets all changes to the "innovations" edge.
+func (m *SettlementMutation) ResetInnovations() {
+	m.innovations = nil
+	m.clearedinnovations = false
+	m.removedinnovations = nil
+}
+
+// AddPrincipleIDs adds the "principles" edge to the Principle entity by ids.
+func (m *SettlementMutation) AddPrincipleIDs(ids ...int) {
+	if m.principles == nil {
+		m.principles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.principles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPrinciples clears the "principles" edge to the Principle entity.
+func (m *SettlementMutation) ClearPrinciples() {
+	m.clearedprinciples = true
+}
+
+// PrinciplesCleared reports if the "principles" edge to the Principle entity was cleared.
+func (m *SettlementMutation) PrinciplesCleared() bool {
+	return m.clearedprinciples
+}
+
+// RemovePrincipleIDs removes the "principles" edge to the Principle entity by IDs.
+func (m *SettlementMutation) RemovePrincipleIDs(ids ...int) {
+	if m.removedprinciples == nil {
+		m.removedprinciples = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.principles, ids[i])
+		m.removedprinciples[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPrinciples returns the removed IDs of the "principles" edge to the Principle entity.
+func (m *SettlementMutation) RemovedPrinciplesIDs() (ids []int) {
+	for id := range m.removedprinciples {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PrinciplesIDs returns the "principles" edge IDs in the mutation.
+func (m *SettlementMutation) PrinciplesIDs() (ids []int) {
+	for id := range m.principles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPrinciples resets all changes to the "principles" edge.
+func (m *SettlementMutation) ResetPrinciples() {
+	m.principles = nil
+	m.clearedprinciples = false
+	m.removedprinciples = nil
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by ids.
+func (m *SettlementMutation) AddMilestoneIDs(ids ...int) {
+	if m.milestones == nil {
+		m.milestones = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.milestones[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMilestones clears the "milestones" edge to the Milestone entity.
+func (m *SettlementMutation) ClearMilestones() {
+	m.clearedmilestones = true
+}
+
+// MilestonesCleared reports if the "milestones" edge to the Milestone entity was cleared.
+func (m *SettlementMutation) MilestonesCleared() bool {
+	return m.clearedmilestones
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to the Milestone entity by IDs.
+func (m *SettlementMutation) RemoveMilestoneIDs(ids ...int) {
+	if m.removedmilestones == nil {
+		m.removedmilestones = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.milestones, ids[i])
+		m.removedmilestones[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMilestones returns the removed IDs of the "milestones" edge to the Milestone entity.
+func (m *SettlementMutation) RemovedMilestonesIDs() (ids []int) {
+	for id := range m.removedmilestones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MilestonesIDs returns the "milestones" edge IDs in the mutation.
+func (m *SettlementMutation) MilestonesIDs() (ids []int) {
+	for id := range m.milestones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMilestones resets all changes to the "milestones" edge.
+func (m *SettlementMutation) ResetMilestones() {
+	m.milestones = nil
+	m.clearedmilestones = false
+	m.removedmilestones = nil
+}
+
+// AddLocationIDs adds the "locations" edge to the Location entity by ids.
+func (m *SettlementMutation) AddLocationIDs(ids ...int) {
+	if m.locations == nil {
+		m.locations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.locations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLocations clears the "locations" edge to the Location entity.
+func (m *SettlementMutation) ClearLocations() {
+	m.clearedlocations = true
+}
+
+// LocationsCleared reports if the "locations" edge to the Location entity was cleared.
+func (m *SettlementMutation) LocationsCleared() bool {
+	return m.clearedlocations
+}
+
+// RemoveLocationIDs removes the "locations" edge to the Location entity by IDs.
+func (m *SettlementMutation) RemoveLocationIDs(ids ...int) {
+	if m.removedlocations == nil {
+		m.removedlocations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.locations, ids[i])
+		m.removedlocations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLocations returns the removed IDs of the "locations" edge to the Location entity.
+func (m *SettlementMutation) RemovedLocationsIDs() (ids []int) {
+	for id := range m.removedlocations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LocationsIDs returns the "locations" edge IDs in the mutation.
+func (m *SettlementMutation) LocationsIDs() (ids []int) {
+	for id := range m.locations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLocations resets all changes to the "locations" edge.
+func (m *SettlementMutation) ResetLocations() {
+	m.locations = nil
+	m.clearedlocations = false
+	m.removedlocations = nil
+}
+
+// AddMonsterIDs adds the "monsters" edge to the MonsterProgress entity by ids.
+func (m *SettlementMutation) AddMonsterIDs(ids ...int) {
+	if m.monsters == nil {
+		m.monsters = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.monsters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMonsters clears the "monsters" edge to the MonsterProgress entity.
+func (m *SettlementMutation) ClearMonsters() {
+	m.clearedmonsters = true
+}
+
+// MonstersCleared reports if the "monsters" edge to the MonsterProgress entity was cleared.
+func (m *SettlementMutation) MonstersCleared() bool {
+	return m.clearedmonsters
+}
+
+// RemoveMonsterIDs removes the "monsters" edge to the MonsterProgress entity by IDs.
+func (m *SettlementMutation) RemoveMonsterIDs(ids ...int) {
+	if m.removedmonsters == nil {
+		m.removedmonsters = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.monsters, ids[i])
+		m.removedmonsters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMonsters returns the removed IDs of the "monsters" edge to the MonsterProgress entity.
+func (m *SettlementMutation) RemovedMonstersIDs() (ids []int) {
+	for id := range m.removedmonsters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MonstersIDs returns the "monsters" edge IDs in the mutation.
+func (m *SettlementMutation) MonstersIDs() (ids []int) {
+	for id := range m.monsters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMonsters resets all changes to the "monsters" edge.
+func (m *SettlementMutation) ResetMonsters() {
+	m.monsters = nil
+	m.clearedmonsters = false
+	m.removedmonsters = nil
+}
+
+// Where appends a list predicates to the SettlementMutation builder.
+func (m *SettlementMutation) Where(ps ...predicate.Settlement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettlementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettlementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settlement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettlementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettlementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settlement).
+func (m *SettlementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettlementMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.deleted_at != nil {
+		fields = append(fields, settlement.FieldDeletedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, settlement.FieldOwner)
+	}
+	if m.name != nil {
+		fields = append(fields, settlement.FieldName)
+	}
+	if m.survivalLimit != nil {
+		fields = append(fields, settlement.FieldSurvivalLimit)
+	}
+	if m.departingSurvival != nil {
+		fields = append(fields, settlement.FieldDepartingSurvival)
+	}
+	if m.collectiveCognition != nil {
+		fields = append(fields, settlement.FieldCollectiveCognition)
+	}
+	if m.currentYear != nil {
+		fields = append(fields, settlement.FieldCurrentYear)
+	}
+	if m.share_token_hash != nil {
+		fields = append(fields, settlement.FieldShareTokenHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettlementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldDeletedAt:
+		return m.DeletedAt()
+	case settlement.FieldOwner:
+		return m.Owner()
+	case settlement.FieldName:
+		return m.Name()
+	case settlement.FieldSurvivalLimit:
+		return m.SurvivalLimit()
+	case settlement.FieldDepartingSurvival:
+		return m.DepartingSurvival()
+	case settlement.FieldCollectiveCognition:
+		return m.CollectiveCognition()
+	case settlement.FieldCurrentYear:
+		return m.CurrentYear()
+	case settlement.FieldShareTokenHash:
+		return m.ShareTokenHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettlementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settlement.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case settlement.FieldOwner:
+		return m.OldOwner(ctx)
+	case settlement.FieldName:
+		return m.OldName(ctx)
+	case settlement.FieldSurvivalLimit:
+		return m.OldSurvivalLimit(ctx)
+	case settlement.FieldDepartingSurvival:
+		return m.OldDepartingSurvival(ctx)
+	case settlement.FieldCollectiveCognition:
+		return m.OldCollectiveCognition(ctx)
+	case settlement.FieldCurrentYear:
+		return m.OldCurrentYear(ctx)
+	case settlement.FieldShareTokenHash:
+		return m.OldShareTokenHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case settlement.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case settlement.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case settlement.FieldSurvivalLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurvivalLimit(v)
+		return nil
+	case settlement.FieldDepartingSurvival:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartingSurvival(v)
+		return nil
+	case settlement.FieldCollectiveCognition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectiveCognition(v)
+		return nil
+	case settlement.FieldCurrentYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentYear(v)
+		return nil
+	case settlement.FieldShareTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareTokenHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettlementMutation) AddedFields() []string {
+	var fields []string
+	if m.addsurvivalLimit != nil {
+		fields = append(fields, settlement.FieldSurvivalLimit)
+	}
+	if m.adddepartingSurvival != nil {
+		fields = append(fields, settlement.FieldDepartingSurvival)
+	}
+	if m.addcollectiveCognition != nil {
+		fields = append(fields, settlement.FieldCollectiveCognition)
+	}
+	if m.addcurrentYear != nil {
+		fields = append(fields, settlement.FieldCurrentYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettlementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldSurvivalLimit:
+		return m.AddedSurvivalLimit()
+	case settlement.FieldDepartingSurvival:
+		return m.AddedDepartingSurvival()
+	case settlement.FieldCollectiveCognition:
+		return m.AddedCollectiveCognition()
+	case settlement.FieldCurrentYear:
+		return m.AddedCurrentYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldSurvivalLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSurvivalLimit(v)
+		return nil
+	case settlement.FieldDepartingSurvival:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDepartingSurvival(v)
+		return nil
+	case settlement.FieldCollectiveCognition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCollectiveCognition(v)
+		return nil
+	case settlement.FieldCurrentYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettlementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(settlement.FieldDeletedAt) {
+		fields = append(fields, settlement.FieldDeletedAt)
+	}
+	if m.FieldCleared(settlement.FieldShareTokenHash) {
+		fields = append(fields, settlement.FieldShareTokenHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettlementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettlementMutation) ClearField(name string) error {
+	switch name {
+	case settlement.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case settlement.FieldShareTokenHash:
+		m.ClearShareTokenHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettlementMutation) ResetField(name string) error {
+	switch name {
+	case settlement.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case settlement.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case settlement.FieldName:
+		m.ResetName()
+		return nil
+	case settlement.FieldSurvivalLimit:
+		m.ResetSurvivalLimit()
+		return nil
+	case settlement.FieldDepartingSurvival:
+		m.ResetDepartingSurvival()
+		return nil
+	case settlement.FieldCollectiveCognition:
+		m.ResetCollectiveCognition()
+		return nil
+	case settlement.FieldCurrentYear:
+		m.ResetCurrentYear()
+		return nil
+	case settlement.FieldShareTokenHash:
+		m.ResetShareTokenHash()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettlementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.population != nil {
+		edges = append(edges, settlement.EdgePopulation)
+	}
+	if m.members != nil {
+		edges = append(edges, settlement.EdgeMembers)
+	}
+	if m.invites != nil {
+		edges = append(edges, settlement.EdgeInvites)
+	}
+	if m.audit_log != nil {
+		edges = append(edges, settlement.EdgeAuditLog)
+	}
+	if m.timeline != nil {
+		edges = append(edges, settlement.EdgeTimeline)
+	}
+	if m.storage != nil {
+		edges = append(edges, settlement.EdgeStorage)
+	}
+	if m.innovations != nil {
+		edges = append(edges, settlement.EdgeInnovations)
+	}
+	if m.principles != nil {
+		edges = append(edges, settlement.EdgePrinciples)
+	}
+	if m.milestones != nil {
+		edges = append(edges, settlement.EdgeMilestones)
+	}
+	if m.locations != nil {
+		edges = append(edges, settlement.EdgeLocations)
+	}
+	if m.monsters != nil {
+		edges = append(edges, settlement.EdgeMonsters)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettlementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case settlement.EdgePopulation:
+		ids := make([]ent.Value, 0, len(m.population))
+		for id := range m.population {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.invites))
+		for id := range m.invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeAuditLog:
+		ids := make([]ent.Value, 0, len(m.audit_log))
+		for id := range m.audit_log {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeTimeline:
+		ids := make([]ent.Value, 0, len(m.timeline))
+		for id := range m.timeline {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeStorage:
+		ids := make([]ent.Value, 0, len(m.storage))
+		for id := range m.storage {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeInnovations:
+		ids := make([]ent.Value, 0, len(m.innovations))
+		for id := range m.innovations {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgePrinciples:
+		ids := make([]ent.Value, 0, len(m.principles))
+		for id := range m.principles {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMilestones:
+		ids := make([]ent.Value, 0, len(m.milestones))
+		for id := range m.milestones {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.locations))
+		for id := range m.locations {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMonsters:
+		ids := make([]ent.Value, 0, len(m.monsters))
+		for id := range m.monsters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettlementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.removedpopulation != nil {
+		edges = append(edges, settlement.EdgePopulation)
+	}
+	if m.removedmembers != nil {
+		edges = append(edges, settlement.EdgeMembers)
+	}
+	if m.removedinvites != nil {
+		edges = append(edges, settlement.EdgeInvites)
+	}
+	if m.removedaudit_log != nil {
+		edges = append(edges, settlement.EdgeAuditLog)
+	}
+	if m.removedtimeline != nil {
+		edges = append(edges, settlement.EdgeTimeline)
+	}
+	if m.removedstorage != nil {
+		edges = append(edges, settlement.EdgeStorage)
+	}
+	if m.removedinnovations != nil {
+		edges = append(edges, settlement.EdgeInnovations)
+	}
+	if m.removedprinciples != nil {
+		edges = append(edges, settlement.EdgePrinciples)
+	}
+	if m.removedmilestones != nil {
+		edges = append(edges, settlement.EdgeMilestones)
+	}
+	if m.removedlocations != nil {
+		edges = append(edges, settlement.EdgeLocations)
+	}
+	if m.removedmonsters != nil {
+		edges = append(edges, settlement.EdgeMonsters)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettlementMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case settlement.EdgePopulation:
+		ids := make([]ent.Value, 0, len(m.removedpopulation))
+		for id := range m.removedpopulation {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.removedinvites))
+		for id := range m.removedinvites {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeAuditLog:
+		ids := make([]ent.Value, 0, len(m.removedaudit_log))
+		for id := range m.removedaudit_log {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeTimeline:
+		ids := make([]ent.Value, 0, len(m.removedtimeline))
+		for id := range m.removedtimeline {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeStorage:
+		ids := make([]ent.Value, 0, len(m.removedstorage))
+		for id := range m.removedstorage {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeInnovations:
+		ids := make([]ent.Value, 0, len(m.removedinnovations))
+		for id := range m.removedinnovations {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgePrinciples:
+		ids := make([]ent.Value, 0, len(m.removedprinciples))
+		for id := range m.removedprinciples {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMilestones:
+		ids := make([]ent.Value, 0, len(m.removedmilestones))
+		for id := range m.removedmilestones {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeLocations:
+		ids := make([]ent.Value, 0, len(m.removedlocations))
+		for id := range m.removedlocations {
+			ids = append(ids, id)
+		}
+		return ids
+	case settlement.EdgeMonsters:
+		ids := make([]ent.Value, 0, len(m.removedmonsters))
+		for id := range m.removedmonsters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettlementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 11)
+	if m.clearedpopulation {
+		edges = append(edges, settlement.EdgePopulation)
+	}
+	if m.clearedmembers {
+		edges = append(edges, settlement.EdgeMembers)
+	}
+	if m.clearedinvites {
+		edges = append(edges, settlement.EdgeInvites)
+	}
+	if m.clearedaudit_log {
+		edges = append(edges, settlement.EdgeAuditLog)
+	}
+	if m.clearedtimeline {
+		edges = append(edges, settlement.EdgeTimeline)
+	}
+	if m.clearedstorage {
+		edges = append(edges, settlement.EdgeStorage)
+	}
+	if m.clearedinnovations {
+		edges = append(edges, settlement.EdgeInnovations)
+	}
+	if m.clearedprinciples {
+		edges = append(edges, settlement.EdgePrinciples)
+	}
+	if m.clearedmilestones {
+		edges = append(edges, settlement.EdgeMilestones)
+	}
+	if m.clearedlocations {
+		edges = append(edges, settlement.EdgeLocations)
+	}
+	if m.clearedmonsters {
+		edges = append(edges, settlement.EdgeMonsters)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettlementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case settlement.EdgePopulation:
+		return m.clearedpopulation
+	case settlement.EdgeMembers:
+		return m.clearedmembers
+	case settlement.EdgeInvites:
+		return m.clearedinvites
+	case settlement.EdgeAuditLog:
+		return m.clearedaudit_log
+	case settlement.EdgeTimeline:
+		return m.clearedtimeline
+	case settlement.EdgeStorage:
+		return m.clearedstorage
+	case settlement.EdgeInnovations:
+		return m.clearedinnovations
+	case settlement.EdgePrinciples:
+		return m.clearedprinciples
+	case settlement.EdgeMilestones:
+		return m.clearedmilestones
+	case settlement.EdgeLocations:
+		return m.clearedlocations
+	case settlement.EdgeMonsters:
+		return m.clearedmonsters
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettlementMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Settlement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettlementMutation) ResetEdge(name string) error {
+	switch name {
+	case settlement.EdgePopulation:
+		m.ResetPopulation()
+		return nil
+	case settlement.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case settlement.EdgeInvites:
+		m.ResetInvites()
+		return nil
+	case settlement.EdgeAuditLog:
+		m.ResetAuditLog()
+		return nil
+	case settlement.EdgeTimeline:
+		m.ResetTimeline()
+		return nil
+	case settlement.EdgeStorage:
+		m.ResetStorage()
+		return nil
+	case settlement.EdgeInnovations:
+		m.ResetInnovations()
+		return nil
+	case settlement.EdgePrinciples:
+		m.ResetPrinciples()
+		return nil
+	case settlement.EdgeMilestones:
+		m.ResetMilestones()
+		return nil
+	case settlement.EdgeLocations:
+		m.ResetLocations()
+		return nil
+	case settlement.EdgeMonsters:
+		m.ResetMonsters()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement edge %s", name)
+}
+
+// StorageItemMutation represents an operation that mutates the StorageItem nodes in the graph.
+type StorageItemMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	category          *storageitem.Category
+	source_monster    *string
+	keywords          *[]string
+	appendkeywords    []string
+	quantity          *int
+	addquantity       *int
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*StorageItem, error)
+	predicates        []predicate.StorageItem
+}
+
+var _ ent.Mutation = (*StorageItemMutation)(nil)
+
+// storageitemOption allows management of the mutation configuration using functional options.
+type storageitemOption func(*StorageItemMutation)
+
+// newStorageItemMutation creates new mutation for the StorageItem entity.
+func newStorageItemMutation(c config, op Op, opts ...storageitemOption) *StorageItemMutation {
+	m := &StorageItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStorageItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStorageItemID sets the ID field of the mutation.
+func withStorageItemID(id int) storageitemOption {
+	return func(m *StorageItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StorageItem
+		)
+		m.oldValue = func(ctx context.Context) (*StorageItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StorageItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStorageItem sets the old StorageItem of the mutation.
+func withStorageItem(node *StorageItem) storageitemOption {
+	return func(m *StorageItemMutation) {
+		m.oldValue = func(context.Context) (*StorageItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StorageItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StorageItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StorageItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StorageItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StorageItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *StorageItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StorageItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StorageItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *StorageItemMutation) SetCategory(s storageitem.Category) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *StorageItemMutation) Category() (r storageitem.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldCategory(ctx context.Context) (v storageitem.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *StorageItemMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetSourceMonster sets the "source_monster" field.
+func (m *StorageItemMutation) SetSourceMonster(s string) {
+	m.source_monster = &s
+}
+
+// SourceMonster returns the value of the "source_monster" field in the mutation.
+func (m *StorageItemMutation) SourceMonster() (r string, exists bool) {
+	v := m.source_monster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceMonster returns the old "source_monster" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldSourceMonster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceMonster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceMonster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceMonster: %w", err)
+	}
+	return oldValue.SourceMonster, nil
+}
+
+// ClearSourceMonster clears the value of the "source_monster" field.
+func (m *StorageItemMutation) ClearSourceMonster() {
+	m.source_monster = nil
+	m.clearedFields[storageitem.FieldSourceMonster] = struct{}{}
+}
+
+// SourceMonsterCleared returns if the "source_monster" field was cleared in this mutation.
+func (m *StorageItemMutation) SourceMonsterCleared() bool {
+	_, ok := m.clearedFields[storageitem.FieldSourceMonster]
+	return ok
+}
+
+// ResetSourceMonster resets all changes to the "source_monster" field.
+func (m *StorageItemMutation) ResetSourceMonster() {
+	m.source_monster = nil
+	delete(m.clearedFields, storageitem.FieldSourceMonster)
+}
+
+// SetKeywords sets the "keywords" field.
+func (m *StorageItemMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
+}
+
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *StorageItemMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old "keywords" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords adds s to the "keywords" field.
+func (m *StorageItemMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
+}
+
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *StorageItemMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (m *StorageItemMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[storageitem.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *StorageItemMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[storageitem.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *StorageItemMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, storageitem.FieldKeywords)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *StorageItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *StorageItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *StorageItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *StorageItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *StorageItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *StorageItemMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *StorageItemMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the StorageItem entity.
+// If the StorageItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StorageItemMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *StorageItemMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *StorageItemMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[storageitem.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *StorageItemMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *StorageItemMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *StorageItemMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the StorageItemMutation builder.
+func (m *StorageItemMutation) Where(ps ...predicate.StorageItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StorageItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StorageItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StorageItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StorageItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StorageItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StorageItem).
+func (m *StorageItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StorageItemMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, storageitem.FieldName)
+	}
+	if m.category != nil {
+		fields = append(fields, storageitem.FieldCategory)
+	}
+	if m.source_monster != nil {
+		fields = append(fields, storageitem.FieldSourceMonster)
+	}
+	if m.keywords != nil {
+		fields = append(fields, storageitem.FieldKeywords)
+	}
+	if m.quantity != nil {
+		fields = append(fields, storageitem.FieldQuantity)
+	}
+	if m.settlement != nil {
+		fields = append(fields, storageitem.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StorageItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case storageitem.FieldName:
+		return m.Name()
+	case storageitem.FieldCategory:
+		return m.Category()
+	case storageitem.FieldSourceMonster:
+		return m.SourceMonster()
+	case storageitem.FieldKeywords:
+		return m.Keywords()
+	case storageitem.FieldQuantity:
+		return m.Quantity()
+	case storageitem.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StorageItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case storageitem.FieldName:
+		return m.OldName(ctx)
+	case storageitem.FieldCategory:
+		return m.OldCategory(ctx)
+	case storageitem.FieldSourceMonster:
+		return m.OldSourceMonster(ctx)
+	case storageitem.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case storageitem.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case storageitem.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown StorageItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case storageitem.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case storageitem.FieldCategory:
+		v, ok := value.(storageitem.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case storageitem.FieldSourceMonster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceMonster(v)
+		return nil
+	case storageitem.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case storageitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case storageitem.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StorageItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, storageitem.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StorageItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case storageitem.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StorageItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case storageitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StorageItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(storageitem.FieldSourceMonster) {
+		fields = append(fields, storageitem.FieldSourceMonster)
+	}
+	if m.FieldCleared(storageitem.FieldKeywords) {
+		fields = append(fields, storageitem.FieldKeywords)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StorageItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StorageItemMutation) ClearField(name string) error {
+	switch name {
+	case storageitem.FieldSourceMonster:
+		m.ClearSourceMonster()
+		return nil
+	case storageitem.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StorageItemMutation) ResetField(name string) error {
+	switch name {
+	case storageitem.FieldName:
+		m.ResetName()
+		return nil
+	case storageitem.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case storageitem.FieldSourceMonster:
+		m.ResetSourceMonster()
+		return nil
+	case storageitem.FieldKeywords:
+		m.ResetKeywords()
+		return nil
+	case storageitem.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case storageitem.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StorageItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.settlement != nil {
+		edges = append(edges, storageitem.EdgeSettlement)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StorageItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case storageitem.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StorageItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StorageItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StorageItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsettlement {
+		edges = append(edges, storageitem.EdgeSettlement)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StorageItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case storageitem.EdgeSettlement:
+		return m.clearedsettlement
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StorageItemMutation) ClearEdge(name string) error {
+	switch name {
+	case storageitem.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StorageItemMutation) ResetEdge(name string) error {
+	switch name {
+	case storageitem.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown StorageItem edge %s", name)
+}
+
+// SurvivorMutation represents an operation that mutates the Survivor nodes in the graph.
+type SurvivorMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	deleted_at                  *time.Time
+	name                        *string
+	born                        *int
+	addborn                     *int
+	gender                      *survivor.Gender
+	huntxp                      *int
+	addhuntxp                   *int
+	survival                    *int
+	addsurvival                 *int
+	movement                    *int
+	addmovement                 *int
+	accuracy                    *int
+	addaccuracy                 *int
+	strength                    *int
+	addstrength                 *int
+	evasion                     *int
+	addevasion                  *int
+	luck                        *int
+	addluck                     *int
+	speed                       *int
+	addspeed                    *int
+	systemicpressure            *int
+	addsystemicpressure         *int
+	torment                     *int
+	addtorment                  *int
+	insanity                    *int
+	addinsanity                 *int
+	lumi                        *int
+	addlumi                     *int
+	courage                     *int
+	addcourage                  *int
+	understanding               *int
+	addunderstanding            *int
+	status                      *survivor.Status
+	status_change_year          *int
+	addstatus_change_year       *int
+	cannot_use_fighting_arts    *bool
+	clearedFields               map[string]struct{}
+	settlement                  *int
+	clearedsettlement           bool
+	fighting_arts               map[int]struct{}
+	removedfighting_arts        map[int]struct{}
+	clearedfighting_arts        bool
+	secret_fighting_arts        map[int]struct{}
+	removedsecret_fighting_arts map[int]struct{}
+	clearedsecret_fighting_arts bool
+	disorders                   map[int]struct{}
+	removeddisorders            map[int]struct{}
+	cleareddisorders            bool
+	abilities                   map[int]struct{}
+	removedabilities            map[int]struct{}
+	clearedabilities            bool
+	done                        bool
+	oldValue                    func(context.Context) (*Survivor, error)
+	predicates                  []predicate.Survivor
+}
+
+var _ ent.Mutation = (*SurvivorMutation)(nil)
+
+// survivorOption allows management of the mutation configuration using functional options.
+type survivorOption func(*SurvivorMutation)
+
+// newSurvivorMutation creates new mutation for the Survivor entity.
+func newSurvivorMutation(c config, op Op, opts ...survivorOption) *SurvivorMutation {
+	m := &SurvivorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSurvivor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSurvivorID sets the ID field of the mutation.
+func withSurvivorID(id int) survivorOption {
+	return func(m *SurvivorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Survivor
+		)
+		m.oldValue = func(ctx context.Context) (*Survivor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Survivor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSurvivor sets the old Survivor of the mutation.
+func withSurvivor(node *Survivor) survivorOption {
+	return func(m *SurvivorMutation) {
+		m.oldValue = func(context.Context) (*Survivor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SurvivorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SurvivorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SurvivorMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SurvivorMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Survivor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *SurvivorMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *SurvivorMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *SurvivorMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[survivor.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *SurvivorMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[survivor.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *SurvivorMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, survivor.FieldDeletedAt)
+}
+
+// SetName sets the "name" field.
+func (m *SurvivorMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SurvivorMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SurvivorMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBorn sets the "born" field.
+func (m *SurvivorMutation) SetBorn(i int) {
+	m.born = &i
+	m.addborn = nil
+}
+
+// Born returns the value of the "born" field in the mutation.
+func (m *SurvivorMutation) Born() (r int, exists bool) {
+	v := m.born
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorn returns the old "born" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldBorn(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorn: %w", err)
+	}
+	return oldValue.Born, nil
+}
+
+// AddBorn adds i to the "born" field.
+func (m *SurvivorMutation) AddBorn(i int) {
+	if m.addborn != nil {
+		*m.addborn += i
+	} else {
+		m.addborn = &i
+	}
+}
+
+// AddedBorn returns the value that was added to the "born" field in this mutation.
+func (m *SurvivorMutation) AddedBorn() (r int, exists bool) {
+	v := m.addborn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBorn resets all changes to the "born" field.
+func (m *SurvivorMutation) ResetBorn() {
+	m.born = nil
+	m.addborn = nil
+}
+
+// SetGender sets the "gender" field.
+func (m *SurvivorMutation) SetGender(s survivor.Gender) {
+	m.gender = &s
+}
+
+// Gender returns the value of the "gender" field in the mutation.
+func (m *SurvivorMutation) Gender() (r survivor.Gender, exists bool) {
+	v := m.gender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGender returns the old "gender" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldGender(ctx context.Context) (v survivor.Gender, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+	}
+	return oldValue.Gender, nil
+}
+
+// ResetGender resets all changes to the "gender" field.
+func (m *SurvivorMutation) ResetGender() {
+	m.gender = nil
+}
+
+// SetHuntxp sets the "huntxp" field.
+func (m *SurvivorMutation) SetHuntxp(i int) {
+	m.huntxp = &i
+	m.addhuntxp = nil
+}
+
+// Huntxp returns the value of the "huntxp" field in the mutation.
+func (m *SurvivorMutation) Huntxp() (r int, exists bool) {
+	v := m.huntxp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHuntxp returns the old "huntxp" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldHuntxp(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHuntxp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHuntxp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHuntxp: %w", err)
+	}
+	return oldValue.Huntxp, nil
+}
+
+// AddHuntxp adds i to the "huntxp" field.
+func (m *SurvivorMutation) AddHuntxp(i int) {
+	if m.addhuntxp != nil {
+		*m.addhuntxp += i
+	} else {
+		m.addhuntxp = &i
+	}
+}
+
+// AddedHuntxp returns the value that was added to the "huntxp" field in this mutation.
+func (m *SurvivorMutation) AddedHuntxp() (r int, exists bool) {
+	v := m.addhuntxp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHuntxp resets all changes to the "huntxp" field.
+func (m *SurvivorMutation) ResetHuntxp() {
+	m.huntxp = nil
+	m.addhuntxp = nil
+}
+
+// SetSurvival sets the "survival" field.
+func (m *SurvivorMutation) SetSurvival(i int) {
+	m.survival = &i
+	m.addsurvival = nil
+}
+
+// Survival returns the value of the "survival" field in the mutation.
+func (m *SurvivorMutation) Survival() (r int, exists bool) {
+	v := m.survival
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSurvival returns the old "survival" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldSurvival(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSurvival is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSurvival requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSurvival: %w", err)
+	}
+	return oldValue.Survival, nil
+}
+
+// AddSurvival adds i to the "survival" field.
+func (m *SurvivorMutation) AddSurvival(i int) {
+	if m.addsurvival != nil {
+		*m.addsurvival += i
+	} else {
+		m.addsurvival = &i
+	}
+}
+
+// AddedSurvival returns the value that was added to the "survival" field in this mutation.
+func (m *SurvivorMutation) AddedSurvival() (r int, exists bool) {
+	v := m.addsurvival
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSurvival resets all changes to the "survival" field.
+func (m *SurvivorMutation) ResetSurvival() {
+	m.survival = nil
+	m.addsurvival = nil
+}
+
+// SetMovement sets the "movement" field.
+func (m *SurvivorMutation) SetMovement(i int) {
+	m.movement = &i
+	m.addmovement = nil
+}
+
+// Movement returns the value of the "movement" field in the mutation.
+func (m *SurvivorMutation) Movement() (r int, exists bool) {
+	v := m.movement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMovement returns the old "movement" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldMovement(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMovement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMovement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMovement: %w", err)
+	}
+	return oldValue.Movement, nil
+}
+
+// AddMovement adds i to the "movement" field.
+func (m *SurvivorMutation) AddMovement(i int) {
+	if m.addmovement != nil {
+		*m.addmovement += i
+	} else {
+		m.addmovement = &i
+	}
+}
+
+// AddedMovement returns the value that was added to the "movement" field in this mutation.
+func (m *SurvivorMutation) AddedMovement() (r int, exists bool) {
+	v := m.addmovement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMovement resets all changes to the "movement" field.
+func (m *SurvivorMutation) ResetMovement() {
+	m.movement = nil
+	m.addmovement = nil
+}
+
+// SetAccuracy sets the "accuracy" field.
+func (m *SurvivorMutation) SetAccuracy(i int) {
+	m.accuracy = &i
+	m.addaccuracy = nil
+}
+
+// Accuracy returns the value of the "accuracy" field in the mutation.
+func (m *SurvivorMutation) Accuracy() (r int, exists bool) {
+	v := m.accuracy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccuracy returns the old "accuracy" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldAccuracy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccuracy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccuracy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccuracy: %w", err)
+	}
+	return oldValue.Accuracy, nil
+}
+
+// AddAccuracy adds i to the "accuracy" field.
+func (m *SurvivorMutation) AddAccuracy(i int) {
+	if m.addaccuracy != nil {
+		*m.addaccuracy += i
+	} else {
+		m.addaccuracy = &i
+	}
+}
+
+// AddedAccuracy returns the value that was added to the "accuracy" field in this mutation.
+func (m *SurvivorMutation) AddedAccuracy() (r int, exists bool) {
+	v := m.addaccuracy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccuracy resets all changes to the "accuracy" field.
+func (m *SurvivorMutation) ResetAccuracy() {
+	m.accuracy = nil
+	m.addaccuracy = nil
+}
+
+// SetStrength sets the "strength" field.
+func (m *SurvivorMutation) SetStrength(i int) {
+	m.strength = &i
+	m.addstrength = nil
+}
+
+// Strength returns the value of the "strength" field in the mutation.
+func (m *SurvivorMutation) Strength() (r int, exists bool) {
+	v := m.strength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrength returns the old "strength" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldStrength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrength: %w", err)
+	}
+	return oldValue.Strength, nil
+}
+
+// AddStrength adds i to the "strength" field.
+func (m *SurvivorMutation) AddStrength(i int) {
+	if m.addstrength != nil {
+		*m.addstrength += i
+	} else {
+		m.addstrength = &i
+	}
+}
+
+// AddedStrength returns the value that was added to the "strength" field in this mutation.
+func (m *SurvivorMutation) AddedStrength() (r int, exists bool) {
+	v := m.addstrength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStrength resets all changes to the "strength" field.
+func (m *SurvivorMutation) ResetStrength() {
+	m.strength = nil
+	m.addstrength = nil
+}
+
+// SetEvasion sets the "evasion" field.
+func (m *SurvivorMutation) SetEvasion(i int) {
+	m.evasion = &i
+	m.addevasion = nil
+}
+
+// Evasion returns the value of the "evasion" field in the mutation.
+func (m *SurvivorMutation) Evasion() (r int, exists bool) {
+	v := m.evasion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvasion returns the old "evasion" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldEvasion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvasion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvasion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvasion: %w", err)
+	}
+	return oldValue.Evasion, nil
+}
+
+// AddEvasion adds i to the "evasion" field.
+func (m *SurvivorMutation) AddEvasion(i int) {
+	if m.addevasion != nil {
+		*m.addevasion += i
+	} else {
+		m.addevasion = &i
+	}
+}
+
+// AddedEvasion returns the value that was added to the "evasion" field in this mutation.
+func (m *SurvivorMutation) AddedEvasion() (r int, exists bool) {
+	v := m.addevasion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEvasion resets all changes to the "evasion" field.
+func (m *SurvivorMutation) ResetEvasion() {
+	m.evasion = nil
+	m.addevasion = nil
+}
+
+// SetLuck sets the "luck" field.
+func (m *SurvivorMutation) SetLuck(i int) {
+	m.luck = &i
+	m.addluck = nil
+}
+
+// Luck returns the value of the "luck" field in the mutation.
+func (m *SurvivorMutation) Luck() (r int, exists bool) {
+	v := m.luck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLuck returns the old "luck" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldLuck(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLuck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLuck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLuck: %w", err)
+	}
+	return oldValue.Luck, nil
+}
+
+// AddLuck adds i to the "luck" field.
+func (m *SurvivorMutation) AddLuck(i int) {
+	if m.addluck != nil {
+		*m.addluck += i
+	} else {
+		m.addluck = &i
+	}
+}
+
+// AddedLuck returns the value that was added to the "luck" field in this mutation.
+func (m *SurvivorMutation) AddedLuck() (r int, exists bool) {
+	v := m.addluck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLuck resets all changes to the "luck" field.
+func (m *SurvivorMutation) ResetLuck() {
+	m.luck = nil
+	m.addluck = nil
+}
+
+// SetSpeed sets the "speed" field.
+func (m *SurvivorMutation) SetSpeed(i int) {
+	m.speed = &i
+	m.addspeed = nil
+}
+
+// Speed returns the value of the "speed" field in the mutation.
+func (m *SurvivorMutation) Speed() (r int, exists bool) {
+	v := m.speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeed returns the old "speed" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldSpeed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeed: %w", err)
+	}
+	return oldValue.Speed, nil
+}
+
+// AddSpeed adds i to the "speed" field.
+func (m *SurvivorMutation) AddSpeed(i int) {
+	if m.addspeed != nil {
+		*m.addspeed += i
+	} else {
+		m.addspeed = &i
+	}
+}
+
+// AddedSpeed returns the value that was added to the "speed" field in this mutation.
+func (m *SurvivorMutation) AddedSpeed() (r int, exists bool) {
+	v := m.addspeed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpeed resets all changes to the "speed" field.
+func (m *SurvivorMutation) ResetSpeed() {
+	m.speed = nil
+	m.addspeed = nil
+}
+
+// SetSystemicpressure sets the "systemicpressure" field.
+func (m *SurvivorMutation) SetSystemicpressure(i int) {
+	m.systemicpressure = &i
+	m.addsystemicpressure = nil
+}
+
+// Systemicpressure returns the value of the "systemicpressure" field in the mutation.
+func (m *SurvivorMutation) Systemicpressure() (r int, exists bool) {
+	v := m.systemicpressure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSystemicpressure returns the old "systemicpressure" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldSystemicpressure(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSystemicpressure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSystemicpressure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSystemicpressure: %w", err)
+	}
+	return oldValue.Systemicpressure, nil
+}
+
+// AddSystemicpressure adds i to the "systemicpressure" field.
+func (m *SurvivorMutation) AddSystemicpressure(i int) {
+	if m.addsystemicpressure != nil {
+		*m.addsystemicpressure += i
+	} else {
+		m.addsystemicpressure = &i
+	}
+}
+
+// AddedSystemicpressure returns the value that was added to the "systemicpressure" field in this mutation.
+func (m *SurvivorMutation) AddedSystemicpressure() (r int, exists bool) {
+	v := m.addsystemicpressure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSystemicpressure resets all changes to the "systemicpressure" field.
+func (m *SurvivorMutation) ResetSystemicpressure() {
+	m.systemicpressure = nil
+	m.addsystemicpressure = nil
+}
+
+// SetTorment sets the "torment" field.
+func (m *SurvivorMutation) SetTorment(i int) {
+	m.torment = &i
+	m.addtorment = nil
+}
+
+// Torment returns the value of the "torment" field in the mutation.
+func (m *SurvivorMutation) Torment() (r int, exists bool) {
+	v := m.torment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTorment returns the old "torment" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldTorment(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTorment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTorment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTorment: %w", err)
+	}
+	return oldValue.Torment, nil
+}
+
+// AddTorment adds i to the "torment" field.
+func (m *SurvivorMutation) AddTorment(i int) {
+	if m.addtorment != nil {
+		*m.addtorment += i
+	} else {
+		m.addtorment = &i
+	}
+}
+
+// AddedTorment returns the value that was added to the "torment" field in this mutation.
+func (m *SurvivorMutation) AddedTorment() (r int, exists bool) {
+	v := m.addtorment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTorment resets all changes to the "torment" field.
+func (m *SurvivorMutation) ResetTorment() {
+	m.torment = nil
+	m.addtorment = nil
+}
+
+// SetInsanity sets the "insanity" field.
+func (m *SurvivorMutation) SetInsanity(i int) {
+	m.insanity = &i
+	m.addinsanity = nil
+}
+
+// Insanity returns the value of the "insanity" field in the mutation.
+func (m *SurvivorMutation) Insanity() (r int, exists bool) {
+	v := m.insanity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInsanity returns the old "insanity" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldInsanity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInsanity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInsanity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInsanity: %w", err)
+	}
+	return oldValue.Insanity, nil
+}
+
+// AddInsanity adds i to the "insanity" field.
+func (m *SurvivorMutation) AddInsanity(i int) {
+	if m.addinsanity != nil {
+		*m.addinsanity += i
+	} else {
+		m.addinsanity = &i
+	}
+}
+
+// AddedInsanity returns the value that was added to the "insanity" field in this mutation.
+func (m *SurvivorMutation) AddedInsanity() (r int, exists bool) {
+	v := m.addinsanity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInsanity resets all changes to the "insanity" field.
+func (m *SurvivorMutation) ResetInsanity() {
+	m.insanity = nil
+	m.addinsanity = nil
+}
+
+// SetLumi sets the "lumi" field.
+func (m *SurvivorMutation) SetLumi(i int) {
+	m.lumi = &i
+	m.addlumi = nil
+}
+
+// Lumi returns the value of the "lumi" field in the mutation.
+func (m *SurvivorMutation) Lumi() (r int, exists bool) {
+	v := m.lumi
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLumi returns the old "lumi" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldLumi(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLumi is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLumi requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLumi: %w", err)
+	}
+	return oldValue.Lumi, nil
+}
+
+// AddLumi adds i to the "lumi" field.
+func (m *SurvivorMutation) AddLumi(i int) {
+	if m.addlumi != nil {
+		*m.addlumi += i
+	} else {
+		m.addlumi = &i
+	}
+}
+
+// AddedLumi returns the value that was added to the "lumi" field in this mutation.
+func (m *SurvivorMutation) AddedLumi() (r int, exists bool) {
+	v := m.addlumi
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLumi resets all changes to the "lumi" field.
+func (m *SurvivorMutation) ResetLumi() {
+	m.lumi = nil
+	m.addlumi = nil
+}
+
+// SetCourage sets the "courage" field.
+func (m *SurvivorMutation) SetCourage(i int) {
+	m.courage = &i
+	m.addcourage = nil
+}
+
+// Courage returns the value of the "courage" field in the mutation.
+func (m *SurvivorMutation) Courage() (r int, exists bool) {
+	v := m.courage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCourage returns the old "courage" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldCourage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCourage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCourage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCourage: %w", err)
+	}
+	return oldValue.Courage, nil
+}
+
+// AddCourage adds i to the "courage" field.
+func (m *SurvivorMutation) AddCourage(i int) {
+	if m.addcourage != nil {
+		*m.addcourage += i
+	} else {
+		m.addcourage = &i
+	}
+}
+
+// AddedCourage returns the value that was added to the "courage" field in this mutation.
+func (m *SurvivorMutation) AddedCourage() (r int, exists bool) {
+	v := m.addcourage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCourage resets all changes to the "courage" field.
+func (m *SurvivorMutation) ResetCourage() {
+	m.courage = nil
+	m.addcourage = nil
+}
+
+// SetUnderstanding sets the "understanding" field.
+func (m *SurvivorMutation) SetUnderstanding(i int) {
+	m.understanding = &i
+	m.addunderstanding = nil
+}
+
+// Understanding returns the value of the "understanding" field in the mutation.
+func (m *SurvivorMutation) Understanding() (r int, exists bool) {
+	v := m.understanding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnderstanding returns the old "understanding" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldUnderstanding(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnderstanding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnderstanding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnderstanding: %w", err)
+	}
+	return oldValue.Understanding, nil
+}
+
+// AddUnderstanding adds i to the "understanding" field.
+func (m *SurvivorMutation) AddUnderstanding(i int) {
+	if m.addunderstanding != nil {
+		*m.addunderstanding += i
+	} else {
+		m.addunderstanding = &i
+	}
+}
+
+// AddedUnderstanding returns the value that was added to the "understanding" field in this mutation.
+func (m *SurvivorMutation) AddedUnderstanding() (r int, exists bool) {
+	v := m.addunderstanding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUnderstanding resets all changes to the "understanding" field.
+func (m *SurvivorMutation) ResetUnderstanding() {
+	m.understanding = nil
+	m.addunderstanding = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SurvivorMutation) SetStatus(s survivor.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SurvivorMutation) Status() (r survivor.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldStatus(ctx context.Context) (v survivor.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SurvivorMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStatusChangeYear sets the "status_change_year" field.
+func (m *SurvivorMutation) SetStatusChangeYear(i int) {
+	m.status_change_year = &i
+	m.addstatus_change_year = nil
+}
+
+// StatusChangeYear returns the value of the "status_change_year" field in the mutation.
+func (m *SurvivorMutation) StatusChangeYear() (r int, exists bool) {
+	v := m.status_change_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusChangeYear returns the old "status_change_year" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldStatusChangeYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusChangeYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusChangeYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusChangeYear: %w", err)
+	}
+	return oldValue.StatusChangeYear, nil
+}
+
+// AddStatusChangeYear adds i to the "status_change_year" field.
+func (m *SurvivorMutation) AddStatusChangeYear(i int) {
+	if m.addstatus_change_year != nil {
+		*m.addstatus_change_year += i
+	} else {
+		m.addstatus_change_year = &i
+	}
+}
+
+// AddedStatusChangeYear returns the value that was added to the "status_change_year" field in this mutation.
+func (m *SurvivorMutation) AddedStatusChangeYear() (r int, exists bool) {
+	v := m.addstatus_change_year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatusChangeYear resets all changes to the "status_change_year" field.
+func (m *SurvivorMutation) ResetStatusChangeYear() {
+	m.status_change_year = nil
+	m.addstatus_change_year = nil
+}
+
+// SetCannotUseFightingArts sets the "cannot_use_fighting_arts" field.
+func (m *SurvivorMutation) SetCannotUseFightingArts(b bool) {
+	m.cannot_use_fighting_arts = &b
+}
+
+// CannotUseFightingArts returns the value of the "cannot_use_fighting_arts" field in the mutation.
+func (m *SurvivorMutation) CannotUseFightingArts() (r bool, exists bool) {
+	v := m.cannot_use_fighting_arts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCannotUseFightingArts returns the old "cannot_use_fighting_arts" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldCannotUseFightingArts(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCannotUseFightingArts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCannotUseFightingArts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCannotUseFightingArts: %w", err)
+	}
+	return oldValue.CannotUseFightingArts, nil
+}
+
+// ResetCannotUseFightingArts resets all changes to the "cannot_use_fighting_arts" field.
+func (m *SurvivorMutation) ResetCannotUseFightingArts() {
+	m.cannot_use_fighting_arts = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *SurvivorMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *SurvivorMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the Survivor entity.
+// If the Survivor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SurvivorMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ClearSettlementID clears the value of the "settlement_id" field.
+func (m *SurvivorMutation) ClearSettlementID() {
+	m.settlement = nil
+	m.clearedFields[survivor.FieldSettlementID] = struct{}{}
+}
+
+// SettlementIDCleared returns if the "settlement_id" field was cleared in this mutation.
+func (m *SurvivorMutation) SettlementIDCleared() bool {
+	_, ok := m.clearedFields[survivor.FieldSettlementID]
+	return ok
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *SurvivorMutation) ResetSettlementID() {
+	m.settlement = nil
+	delete(m.clearedFields, survivor.FieldSettlementID)
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *SurvivorMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[survivor.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *SurvivorMutation) SettlementCleared() bool {
+	return m.SettlementIDCleared() || m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *SurvivorMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *SurvivorMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// AddFightingArtIDs adds the "fighting_arts" edge to the FightingArt entity by ids.
+func (m *SurvivorMutation) AddFightingArtIDs(ids ...int) {
+	if m.fighting_arts == nil {
+		m.fighting_arts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.fighting_arts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFightingArts clears the "fighting_arts" edge to the FightingArt entity.
+func (m *SurvivorMutation) ClearFightingArts() {
+	m.clearedfighting_arts = true
+}
+
+// FightingArtsCleared reports if the "fighting_arts" edge to the FightingArt entity was cleared.
+func (m *SurvivorMutation) FightingArtsCleared() bool {
+	return m.clearedfighting_arts
+}
+
+// RemoveFightingArtIDs removes the "fighting_arts" edge to the FightingArt entity by IDs.
+func (m *SurvivorMutation) RemoveFightingArtIDs(ids ...int) {
+	if m.removedfighting_arts == nil {
+		m.removedfighting_arts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.fighting_arts, ids[i])
+		m.removedfighting_arts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFightingArts returns the removed IDs of the "fighting_arts" edge to the FightingArt entity.
+func (m *SurvivorMutation) RemovedFightingArtsIDs() (ids []int) {
+	for id := range m.removedfighting_arts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FightingArtsIDs returns the "fighting_arts" edge IDs in the mutation.
+func (m *SurvivorMutation) FightingArtsIDs() (ids []int) {
+	for id := range m.fighting_arts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFightingArts resets all changes to the "fighting_arts" edge.
+func (m *SurvivorMutation) ResetFightingArts() {
+	m.fighting_arts = nil
+	m.clearedfighting_arts = false
+	m.removedfighting_arts = nil
+}
+
+// AddSecretFightingArtIDs adds the "secret_fighting_arts" edge to the FightingArt entity by ids.
+func (m *SurvivorMutation) AddSecretFightingArtIDs(ids ...int) {
+	if m.secret_fighting_arts == nil {
+		m.secret_fighting_arts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.secret_fighting_arts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecretFightingArts clears the "secret_fighting_arts" edge to the FightingArt entity.
+func (m *SurvivorMutation) ClearSecretFightingArts() {
+	m.clearedsecret_fighting_arts = true
+}
+
+// SecretFightingArtsCleared reports if the "secret_fighting_arts" edge to the FightingArt entity was cleared.
+func (m *SurvivorMutation) SecretFightingArtsCleared() bool {
+	return m.clearedsecret_fighting_arts
+}
+
+// RemoveSecretFightingArtIDs removes the "secret_fighting_arts" edge to the FightingArt entity by IDs.
+func (m *SurvivorMutation) RemoveSecretFightingArtIDs(ids ...int) {
+	if m.removedsecret_fighting_arts == nil {
+		m.removedsecret_fighting_arts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.secret_fighting_arts, ids[i])
+		m.removedsecret_fighting_arts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecretFightingArts returns the removed IDs of the "secret_fighting_arts" edge to the FightingArt entity.
+func (m *SurvivorMutation) RemovedSecretFightingArtsIDs() (ids []int) {
+	for id := range m.removedsecret_fighting_arts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretFightingArtsIDs returns the "secret_fighting_arts" edge IDs in the mutation.
+func (m *SurvivorMutation) SecretFightingArtsIDs() (ids []int) {
+	for id := range m.secret_fighting_arts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecretFightingArts resets all changes to the "secret_fighting_arts" edge.
+func (m *SurvivorMutation) ResetSecretFightingArts() {
+	m.secret_fighting_arts = nil
+	m.clearedsecret_fighting_arts = false
+	m.removedsecret_fighting_arts = nil
+}
+
+// AddDisorderIDs adds the "disorders" edge to the Disorder entity by ids.
+func (m *SurvivorMutation) AddDisorderIDs(ids ...int) {
+	if m.disorders == nil {
+		m.disorders = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.disorders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDisorders clears the "disorders" edge to the Disorder entity.
+func (m *SurvivorMutation) ClearDisorders() {
+	m.cleareddisorders = true
+}
+
+// DisordersCleared reports if the "disorders" edge to the Disorder entity was cleared.
+func (m *SurvivorMutation) DisordersCleared() bool {
+	return m.cleareddisorders
+}
+
+// RemoveDisorderIDs removes the "disorders" edge to the Disorder entity by IDs.
+func (m *SurvivorMutation) RemoveDisorderIDs(ids ...int) {
+	if m.removeddisorders == nil {
+		m.removeddisorders = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.disorders, ids[i])
+		m.removeddisorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDisorders returns the removed IDs of the "disorders" edge to the Disorder entity.
+func (m *SurvivorMutation) RemovedDisordersIDs() (ids []int) {
+	for id := range m.removeddisorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DisordersIDs returns the "disorders" edge IDs in the mutation.
+func (m *SurvivorMutation) DisordersIDs() (ids []int) {
+	for id := range m.disorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDisorders resets all changes to the "disorders" edge.
+func (m *SurvivorMutation) ResetDisorders() {
+	m.disorders = nil
+	m.cleareddisorders = false
+	m.removeddisorders = nil
+}
+
+// AddAbilityIDs adds the "abilities" edge to the Ability entity by ids.
+func (m *SurvivorMutation) AddAbilityIDs(ids ...int) {
+	if m.abilities == nil {
+		m.abilities = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.abilities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAbilities clears the "abilities" edge to the Ability entity.
+func (m *SurvivorMutation) ClearAbilities() {
+	m.clearedabilities = true
+}
+
+// AbilitiesCleared reports if the "abilities" edge to the Ability entity was cleared.
+func (m *SurvivorMutation) AbilitiesCleared() bool {
+	return m.clearedabilities
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to the Ability entity by IDs.
+func (m *SurvivorMutation) RemoveAbilityIDs(ids ...int) {
+	if m.removedabilities == nil {
+		m.removedabilities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.abilities, ids[i])
+		m.removedabilities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAbilities returns the removed IDs of the "abilities" edge to the Ability entity.
+func (m *SurvivorMutation) RemovedAbilitiesIDs() (ids []int) {
+	for id := range m.removedabilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AbilitiesIDs returns the "abilities" edge IDs in the mutation.
+func (m *SurvivorMutation) AbilitiesIDs() (ids []int) {
+	for id := range m.abilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAbilities resets all changes to the "abilities" edge.
+func (m *SurvivorMutation) ResetAbilities() {
+	m.abilities = nil
+	m.clearedabilities = false
+	m.removedabilities = nil
+}
+
+// Where appends a list predicates to the SurvivorMutation builder.
+func (m *SurvivorMutation) Where(ps ...predicate.Survivor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SurvivorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SurvivorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Survivor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SurvivorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SurvivorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Survivor).
+func (m *SurvivorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SurvivorMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.deleted_at != nil {
+		fields = append(fields, survivor.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, survivor.FieldName)
+	}
+	if m.born != nil {
+		fields = append(fields, survivor.FieldBorn)
+	}
+	if m.gender != nil {
+		fields = append(fields, survivor.FieldGender)
+	}
+	if m.huntxp != nil {
+		fields = append(fields, survivor.FieldHuntxp)
+	}
+	if m.survival != nil {
+		fields = append(fields, survivor.FieldSurvival)
+	}
+	if m.movement != nil {
+		fields = append(fields, survivor.FieldMovement)
+	}
+	if m.accuracy != nil {
+		fields = append(fields, survivor.FieldAccuracy)
+	}
+	if m.strength != nil {
+		fields = append(fields, survivor.FieldStrength)
+	}
+	if m.evasion != nil {
+		fields = append(fields, survivor.FieldEvasion)
+	}
+	if m.luck != nil {
+		fields = append(fields, survivor.FieldLuck)
+	}
+	if m.speed != nil {
+		fields = append(fields, survivor.FieldSpeed)
+	}
+	if m.systemicpressure != nil {
+		fields = append(fields, survivor.FieldSystemicpressure)
+	}
+	if m.torment != nil {
+		fields = append(fields, survivor.FieldTorment)
+	}
+	if m.insanity != nil {
+		fields = append(fields, survivor.FieldInsanity)
+	}
+	if m.lumi != nil {
+		fields = append(fields, survivor.FieldLumi)
+	}
+	if m.courage != nil {
+		fields = append(fields, survivor.FieldCourage)
+	}
+	if m.understanding != nil {
+		fields = append(fields, survivor.FieldUnderstanding)
+	}
+	if m.status != nil {
+		fields = append(fields, survivor.FieldStatus)
+	}
+	if m.status_change_year != nil {
+		fields = append(fields, survivor.FieldStatusChangeYear)
+	}
+	if m.cannot_use_fighting_arts != nil {
+		fields = append(fields, survivor.FieldCannotUseFightingArts)
+	}
+	if m.settlement != nil {
+		fields = append(fields, survivor.FieldSettlementID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SurvivorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case survivor.FieldDeletedAt:
+		return m.DeletedAt()
+	case survivor.FieldName:
+		return m.Name()
+	case survivor.FieldBorn:
+		return m.Born()
+	case survivor.FieldGender:
+		return m.Gender()
+	case survivor.FieldHuntxp:
+		return m.Huntxp()
+	case survivor.FieldSurvival:
+		return m.Survival()
+	case survivor.FieldMovement:
+		return m.Movement()
+	case survivor.FieldAccuracy:
+		return m.Accuracy()
+	case survivor.FieldStrength:
+		return m.Strength()
+	case survivor.FieldEvasion:
+		return m.Evasion()
+	case survivor.FieldLuck:
+		return m.Luck()
+	case survivor.FieldSpeed:
+		return m.Speed()
+	case survivor.FieldSystemicpressure:
+		return m.Systemicpressure()
+	case survivor.FieldTorment:
+		return m.Torment()
+	case survivor.FieldInsanity:
+		return m.Insanity()
+	case survivor.FieldLumi:
+		return m.Lumi()
+	case survivor.FieldCourage:
+		return m.Courage()
+	case survivor.FieldUnderstanding:
+		return m.Understanding()
+	case survivor.FieldStatus:
+		return m.Status()
+	case survivor.FieldStatusChangeYear:
+		return m.StatusChangeYear()
+	case survivor.FieldCannotUseFightingArts:
+		return m.CannotUseFightingArts()
+	case survivor.FieldSettlementID:
+		return m.SettlementID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SurvivorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case survivor.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case survivor.FieldName:
+		return m.OldName(ctx)
+	case survivor.FieldBorn:
+		return m.OldBorn(ctx)
+	case survivor.FieldGender:
+		return m.OldGender(ctx)
+	case survivor.FieldHuntxp:
+		return m.OldHuntxp(ctx)
+	case survivor.FieldSurvival:
+		return m.OldSurvival(ctx)
+	case survivor.FieldMovement:
+		return m.OldMovement(ctx)
+	case survivor.FieldAccuracy:
+		return m.OldAccuracy(ctx)
+	case survivor.FieldStrength:
+		return m.OldStrength(ctx)
+	case survivor.FieldEvasion:
+		return m.OldEvasion(ctx)
+	case survivor.FieldLuck:
+		return m.OldLuck(ctx)
+	case survivor.FieldSpeed:
+		return m.OldSpeed(ctx)
+	case survivor.FieldSystemicpressure:
+		return m.OldSystemicpressure(ctx)
+	case survivor.FieldTorment:
+		return m.OldTorment(ctx)
+	case survivor.FieldInsanity:
+		return m.OldInsanity(ctx)
+	case survivor.FieldLumi:
+		return m.OldLumi(ctx)
+	case survivor.FieldCourage:
+		return m.OldCourage(ctx)
+	case survivor.FieldUnderstanding:
+		return m.OldUnderstanding(ctx)
+	case survivor.FieldStatus:
+		return m.OldStatus(ctx)
+	case survivor.FieldStatusChangeYear:
+		return m.OldStatusChangeYear(ctx)
+	case survivor.FieldCannotUseFightingArts:
+		return m.OldCannotUseFightingArts(ctx)
+	case survivor.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Survivor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SurvivorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case survivor.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case survivor.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case survivor.FieldBorn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorn(v)
+		return nil
+	case survivor.FieldGender:
+		v, ok := value.(survivor.Gender)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGender(v)
+		return nil
+	case survivor.FieldHuntxp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHuntxp(v)
+		return nil
+	case survivor.FieldSurvival:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSurvival(v)
+		return nil
+	case survivor.FieldMovement:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMovement(v)
+		return nil
+	case survivor.FieldAccuracy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccuracy(v)
+		return nil
+	case survivor.FieldStrength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrength(v)
+		return nil
+	case survivor.FieldEvasion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvasion(v)
+		return nil
+	case survivor.FieldLuck:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLuck(v)
+		return nil
+	case survivor.FieldSpeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeed(v)
+		return nil
+	case survivor.FieldSystemicpressure:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSystemicpressure(v)
+		return nil
+	case survivor.FieldTorment:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTorment(v)
+		return nil
+	case survivor.FieldInsanity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInsanity(v)
+		return nil
+	case survivor.FieldLumi:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLumi(v)
+		return nil
+	case survivor.FieldCourage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCourage(v)
+		return nil
+	case survivor.FieldUnderstanding:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnderstanding(v)
+		return nil
+	case survivor.FieldStatus:
+		v, ok := value.(survivor.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case survivor.FieldStatusChangeYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusChangeYear(v)
+		return nil
+	case survivor.FieldCannotUseFightingArts:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCannotUseFightingArts(v)
+		return nil
+	case survivor.FieldSettlementID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettlementID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SurvivorMutation) AddedFields() []string {
+	var fields []string
+	if m.addborn != nil {
+		fields = append(fields, survivor.FieldBorn)
+	}
+	if m.addhuntxp != nil {
+		fields = append(fields, survivor.FieldHuntxp)
+	}
+	if m.addsurvival != nil {
+		fields = append(fields, survivor.FieldSurvival)
+	}
+	if m.addmovement != nil {
+		fields = append(fields, survivor.FieldMovement)
+	}
+	if m.addaccuracy != nil {
+		fields = append(fields, survivor.FieldAccuracy)
+	}
+	if m.addstrength != nil {
+		fields = append(fields, survivor.FieldStrength)
+	}
+	if m.addevasion != nil {
+		fields = append(fields, survivor.FieldEvasion)
+	}
+	if m.addluck != nil {
+		fields = append(fields, survivor.FieldLuck)
+	}
+	if m.addspeed != nil {
+		fields = append(fields, survivor.FieldSpeed)
+	}
+	if m.addsystemicpressure != nil {
+		fields = append(fields, survivor.FieldSystemicpressure)
+	}
+	if m.addtorment != nil {
+		fields = append(fields, survivor.FieldTorment)
+	}
+	if m.addinsanity != nil {
+		fields = append(fields, survivor.FieldInsanity)
+	}
+	if m.addlumi != nil {
+		fields = append(fields, survivor.FieldLumi)
 	}
 	if m.addcourage != nil {
 		fields = append(fields, survivor.FieldCourage)
 	}
-	if m.addunderstanding != nil {
-		fields = append(fields, survivor.FieldUnderstanding)
+	if m.addunderstanding != nil {
+		fields = append(fields, survivor.FieldUnderstanding)
+	}
+	if m.addstatus_change_year != nil {
+		fields = append(fields, survivor.FieldStatusChangeYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SurvivorMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case survivor.FieldBorn:
+		return m.AddedBorn()
+	case survivor.FieldHuntxp:
+		return m.AddedHuntxp()
+	case survivor.FieldSurvival:
+		return m.AddedSurvival()
+	case survivor.FieldMovement:
+		return m.AddedMovement()
+	case survivor.FieldAccuracy:
+		return m.AddedAccuracy()
+	case survivor.FieldStrength:
+		return m.AddedStrength()
+	case survivor.FieldEvasion:
+		return m.AddedEvasion()
+	case survivor.FieldLuck:
+		return m.AddedLuck()
+	case survivor.FieldSpeed:
+		return m.AddedSpeed()
+	case survivor.FieldSystemicpressure:
+		return m.AddedSystemicpressure()
+	case survivor.FieldTorment:
+		return m.AddedTorment()
+	case survivor.FieldInsanity:
+		return m.AddedInsanity()
+	case survivor.FieldLumi:
+		return m.AddedLumi()
+	case survivor.FieldCourage:
+		return m.AddedCourage()
+	case survivor.FieldUnderstanding:
+		return m.AddedUnderstanding()
+	case survivor.FieldStatusChangeYear:
+		return m.AddedStatusChangeYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SurvivorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case survivor.FieldBorn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBorn(v)
+		return nil
+	case survivor.FieldHuntxp:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHuntxp(v)
+		return nil
+	case survivor.FieldSurvival:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSurvival(v)
+		return nil
+	case survivor.FieldMovement:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMovement(v)
+		return nil
+	case survivor.FieldAccuracy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccuracy(v)
+		return nil
+	case survivor.FieldStrength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStrength(v)
+		return nil
+	case survivor.FieldEvasion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEvasion(v)
+		return nil
+	case survivor.FieldLuck:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLuck(v)
+		return nil
+	case survivor.FieldSpeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeed(v)
+		return nil
+	case survivor.FieldSystemicpressure:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSystemicpressure(v)
+		return nil
+	case survivor.FieldTorment:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTorment(v)
+		return nil
+	case survivor.FieldInsanity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInsanity(v)
+		return nil
+	case survivor.FieldLumi:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLumi(v)
+		return nil
+	case survivor.FieldCourage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCourage(v)
+		return nil
+	case survivor.FieldUnderstanding:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnderstanding(v)
+		return nil
+	case survivor.FieldStatusChangeYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusChangeYear(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SurvivorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(survivor.FieldDeletedAt) {
+		fields = append(fields, survivor.FieldDeletedAt)
+	}
+	if m.FieldCleared(survivor.FieldSettlementID) {
+		fields = append(fields, survivor.FieldSettlementID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SurvivorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SurvivorMutation) ClearField(name string) error {
+	switch name {
+	case survivor.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case survivor.FieldSettlementID:
+		m.ClearSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SurvivorMutation) ResetField(name string) error {
+	switch name {
+	case survivor.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case survivor.FieldName:
+		m.ResetName()
+		return nil
+	case survivor.FieldBorn:
+		m.ResetBorn()
+		return nil
+	case survivor.FieldGender:
+		m.ResetGender()
+		return nil
+	case survivor.FieldHuntxp:
+		m.ResetHuntxp()
+		return nil
+	case survivor.FieldSurvival:
+		m.ResetSurvival()
+		return nil
+	case survivor.FieldMovement:
+		m.ResetMovement()
+		return nil
+	case survivor.FieldAccuracy:
+		m.ResetAccuracy()
+		return nil
+	case survivor.FieldStrength:
+		m.ResetStrength()
+		return nil
+	case survivor.FieldEvasion:
+		m.ResetEvasion()
+		return nil
+	case survivor.FieldLuck:
+		m.ResetLuck()
+		return nil
+	case survivor.FieldSpeed:
+		m.ResetSpeed()
+		return nil
+	case survivor.FieldSystemicpressure:
+		m.ResetSystemicpressure()
+		return nil
+	case survivor.FieldTorment:
+		m.ResetTorment()
+		return nil
+	case survivor.FieldInsanity:
+		m.ResetInsanity()
+		return nil
+	case survivor.FieldLumi:
+		m.ResetLumi()
+		return nil
+	case survivor.FieldCourage:
+		m.ResetCourage()
+		return nil
+	case survivor.FieldUnderstanding:
+		m.ResetUnderstanding()
+		return nil
+	case survivor.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case survivor.FieldStatusChangeYear:
+		m.ResetStatusChangeYear()
+		return nil
+	case survivor.FieldCannotUseFightingArts:
+		m.ResetCannotUseFightingArts()
+		return nil
+	case survivor.FieldSettlementID:
+		m.ResetSettlementID()
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SurvivorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.settlement != nil {
+		edges = append(edges, survivor.EdgeSettlement)
+	}
+	if m.fighting_arts != nil {
+		edges = append(edges, survivor.EdgeFightingArts)
+	}
+	if m.secret_fighting_arts != nil {
+		edges = append(edges, survivor.EdgeSecretFightingArts)
+	}
+	if m.disorders != nil {
+		edges = append(edges, survivor.EdgeDisorders)
+	}
+	if m.abilities != nil {
+		edges = append(edges, survivor.EdgeAbilities)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SurvivorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case survivor.EdgeSettlement:
+		if id := m.settlement; id != nil {
+			return []ent.Value{*id}
+		}
+	case survivor.EdgeFightingArts:
+		ids := make([]ent.Value, 0, len(m.fighting_arts))
+		for id := range m.fighting_arts {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeSecretFightingArts:
+		ids := make([]ent.Value, 0, len(m.secret_fighting_arts))
+		for id := range m.secret_fighting_arts {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeDisorders:
+		ids := make([]ent.Value, 0, len(m.disorders))
+		for id := range m.disorders {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeAbilities:
+		ids := make([]ent.Value, 0, len(m.abilities))
+		for id := range m.abilities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SurvivorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedfighting_arts != nil {
+		edges = append(edges, survivor.EdgeFightingArts)
+	}
+	if m.removedsecret_fighting_arts != nil {
+		edges = append(edges, survivor.EdgeSecretFightingArts)
+	}
+	if m.removeddisorders != nil {
+		edges = append(edges, survivor.EdgeDisorders)
+	}
+	if m.removedabilities != nil {
+		edges = append(edges, survivor.EdgeAbilities)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SurvivorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case survivor.EdgeFightingArts:
+		ids := make([]ent.Value, 0, len(m.removedfighting_arts))
+		for id := range m.removedfighting_arts {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeSecretFightingArts:
+		ids := make([]ent.Value, 0, len(m.removedsecret_fighting_arts))
+		for id := range m.removedsecret_fighting_arts {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeDisorders:
+		ids := make([]ent.Value, 0, len(m.removeddisorders))
+		for id := range m.removeddisorders {
+			ids = append(ids, id)
+		}
+		return ids
+	case survivor.EdgeAbilities:
+		ids := make([]ent.Value, 0, len(m.removedabilities))
+		for id := range m.removedabilities {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SurvivorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedsettlement {
+		edges = append(edges, survivor.EdgeSettlement)
+	}
+	if m.clearedfighting_arts {
+		edges = append(edges, survivor.EdgeFightingArts)
+	}
+	if m.clearedsecret_fighting_arts {
+		edges = append(edges, survivor.EdgeSecretFightingArts)
+	}
+	if m.cleareddisorders {
+		edges = append(edges, survivor.EdgeDisorders)
+	}
+	if m.clearedabilities {
+		edges = append(edges, survivor.EdgeAbilities)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SurvivorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case survivor.EdgeSettlement:
+		return m.clearedsettlement
+	case survivor.EdgeFightingArts:
+		return m.clearedfighting_arts
+	case survivor.EdgeSecretFightingArts:
+		return m.clearedsecret_fighting_arts
+	case survivor.EdgeDisorders:
+		return m.cleareddisorders
+	case survivor.EdgeAbilities:
+		return m.clearedabilities
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SurvivorMutation) ClearEdge(name string) error {
+	switch name {
+	case survivor.EdgeSettlement:
+		m.ClearSettlement()
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SurvivorMutation) ResetEdge(name string) error {
+	switch name {
+	case survivor.EdgeSettlement:
+		m.ResetSettlement()
+		return nil
+	case survivor.EdgeFightingArts:
+		m.ResetFightingArts()
+		return nil
+	case survivor.EdgeSecretFightingArts:
+		m.ResetSecretFightingArts()
+		return nil
+	case survivor.EdgeDisorders:
+		m.ResetDisorders()
+		return nil
+	case survivor.EdgeAbilities:
+		m.ResetAbilities()
+		return nil
+	}
+	return fmt.Errorf("unknown Survivor edge %s", name)
+}
+
+// TimelineEventMutation represents an operation that mutates the TimelineEvent nodes in the graph.
+type TimelineEventMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	year              *int
+	addyear           *int
+	_type             *timelineevent.Type
+	name              *string
+	completed         *bool
+	clearedFields     map[string]struct{}
+	settlement        *int
+	clearedsettlement bool
+	done              bool
+	oldValue          func(context.Context) (*TimelineEvent, error)
+	predicates        []predicate.TimelineEvent
+}
+
+var _ ent.Mutation = (*TimelineEventMutation)(nil)
+
+// timelineeventOption allows management of the mutation configuration using functional options.
+type timelineeventOption func(*TimelineEventMutation)
+
+// newTimelineEventMutation creates new mutation for the TimelineEvent entity.
+func newTimelineEventMutation(c config, op Op, opts ...timelineeventOption) *TimelineEventMutation {
+	m := &TimelineEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTimelineEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTimelineEventID sets the ID field of the mutation.
+func withTimelineEventID(id int) timelineeventOption {
+	return func(m *TimelineEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TimelineEvent
+		)
+		m.oldValue = func(ctx context.Context) (*TimelineEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TimelineEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTimelineEvent sets the old TimelineEvent of the mutation.
+func withTimelineEvent(node *TimelineEvent) timelineeventOption {
+	return func(m *TimelineEventMutation) {
+		m.oldValue = func(context.Context) (*TimelineEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TimelineEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TimelineEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TimelineEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TimelineEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TimelineEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetYear sets the "year" field.
+func (m *TimelineEventMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *TimelineEventMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the TimelineEvent entity.
+// If the TimelineEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEventMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *TimelineEventMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *TimelineEventMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *TimelineEventMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+}
+
+// SetType sets the "type" field.
+func (m *TimelineEventMutation) SetType(t timelineevent.Type) {
+	m._type = &t
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TimelineEventMutation) GetType() (r timelineevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the TimelineEvent entity.
+// If the TimelineEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEventMutation) OldType(ctx context.Context) (v timelineevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TimelineEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetName sets the "name" field.
+func (m *TimelineEventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TimelineEventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TimelineEvent entity.
+// If the TimelineEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TimelineEventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCompleted sets the "completed" field.
+func (m *TimelineEventMutation) SetCompleted(b bool) {
+	m.completed = &b
+}
+
+// Completed returns the value of the "completed" field in the mutation.
+func (m *TimelineEventMutation) Completed() (r bool, exists bool) {
+	v := m.completed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompleted returns the old "completed" field's value of the TimelineEvent entity.
+// If the TimelineEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEventMutation) OldCompleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+	}
+	return oldValue.Completed, nil
+}
+
+// ResetCompleted resets all changes to the "completed" field.
+func (m *TimelineEventMutation) ResetCompleted() {
+	m.completed = nil
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (m *TimelineEventMutation) SetSettlementID(i int) {
+	m.settlement = &i
+}
+
+// SettlementID returns the value of the "settlement_id" field in the mutation.
+func (m *TimelineEventMutation) SettlementID() (r int, exists bool) {
+	v := m.settlement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettlementID returns the old "settlement_id" field's value of the TimelineEvent entity.
+// If the TimelineEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TimelineEventMutation) OldSettlementID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettlementID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettlementID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettlementID: %w", err)
+	}
+	return oldValue.SettlementID, nil
+}
+
+// ResetSettlementID resets all changes to the "settlement_id" field.
+func (m *TimelineEventMutation) ResetSettlementID() {
+	m.settlement = nil
+}
+
+// ClearSettlement clears the "settlement" edge to the Settlement entity.
+func (m *TimelineEventMutation) ClearSettlement() {
+	m.clearedsettlement = true
+	m.clearedFields[timelineevent.FieldSettlementID] = struct{}{}
+}
+
+// SettlementCleared reports if the "settlement" edge to the Settlement entity was cleared.
+func (m *TimelineEventMutation) SettlementCleared() bool {
+	return m.clearedsettlement
+}
+
+// SettlementIDs returns the "settlement" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettlementID instead. It exists only for internal usage by the builders.
+func (m *TimelineEventMutation) SettlementIDs() (ids []int) {
+	if id := m.settlement; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSettlement resets all changes to the "settlement" edge.
+func (m *TimelineEventMutation) ResetSettlement() {
+	m.settlement = nil
+	m.clearedsettlement = false
+}
+
+// Where appends a list predicates to the TimelineEventMutation builder.
+func (m *TimelineEventMutation) Where(ps ...predicate.TimelineEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TimelineEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TimelineEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TimelineEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TimelineEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TimelineEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TimelineEvent).
+func (m *TimelineEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TimelineEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.year != nil {
+		fields = append(fields, timelineevent.FieldYear)
+	}
+	if m._type != nil {
+		fields = append(fields, timelineevent.FieldType)
+	}
+	if m.name != nil {
+		fields = append(fields, timelineevent.FieldName)
 	}
-	if m.addstatus_change_year != nil {
-		fields = append(fields, survivor.FieldStatusChangeYear)
+	if m.completed != nil {
+		fields = append(fields, timelineevent.FieldCompleted)
+	}
+	if m.settlement != nil {
+		fields = append(fields, timelineevent.FieldSettlementID)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *SurvivorMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TimelineEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case survivor.FieldBorn:
-		return m.AddedBorn()
-	case survivor.FieldHuntxp:
-		return m.AddedHuntxp()
-	case survivor.FieldSurvival:
-		return m.AddedSurvival()
-	case survivor.FieldMovement:
-		return m.AddedMovement()
-	case survivor.FieldAccuracy:
-		return m.AddedAccuracy()
-	case survivor.FieldStrength:
-		return m.AddedStrength()
-	case survivor.FieldEvasion:
-		return m.AddedEvasion()
-	case survivor.FieldLuck:
-		return m.AddedLuck()
-	case survivor.FieldSpeed:
-		return m.AddedSpeed()
-	case survivor.FieldSystemicpressure:
-		return m.AddedSystemicpressure()
-	case survivor.FieldTorment:
-		return m.AddedTorment()
-	case survivor.FieldInsanity:
-		return m.AddedInsanity()
-	case survivor.FieldLumi:
-		return m.AddedLumi()
-	case survivor.FieldCourage:
-		return m.AddedCourage()
-	case survivor.FieldUnderstanding:
-		return m.AddedUnderstanding()
-	case survivor.FieldStatusChangeYear:
-		return m.AddedStatusChangeYear()
+	case timelineevent.FieldYear:
+		return m.Year()
+	case timelineevent.FieldType:
+		return m.GetType()
+	case timelineevent.FieldName:
+		return m.Name()
+	case timelineevent.FieldCompleted:
+		return m.Completed()
+	case timelineevent.FieldSettlementID:
+		return m.SettlementID()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TimelineEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case timelineevent.FieldYear:
+		return m.OldYear(ctx)
+	case timelineevent.FieldType:
+		return m.OldType(ctx)
+	case timelineevent.FieldName:
+		return m.OldName(ctx)
+	case timelineevent.FieldCompleted:
+		return m.OldCompleted(ctx)
+	case timelineevent.FieldSettlementID:
+		return m.OldSettlementID(ctx)
+	}
+	return nil, fmt.Errorf("unknown TimelineEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SurvivorMutation) AddField(name string, value ent.Value) error {
+func (m *TimelineEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case survivor.FieldBorn:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBorn(v)
-		return nil
-	case survivor.FieldHuntxp:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddHuntxp(v)
-		return nil
-	case survivor.FieldSurvival:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSurvival(v)
-		return nil
-	case survivor.FieldMovement:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMovement(v)
-		return nil
-	case survivor.FieldAccuracy:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAccuracy(v)
-		return nil
-	case survivor.FieldStrength:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStrength(v)
-		return nil
-	case survivor.FieldEvasion:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEvasion(v)
-		return nil
-	case survivor.FieldLuck:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLuck(v)
-		return nil
-	case survivor.FieldSpeed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpeed(v)
-		return nil
-	case survivor.FieldSystemicpressure:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSystemicpressure(v)
-		return nil
-	case survivor.FieldTorment:
+	case timelineevent.FieldYear:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTorment(v)
+		m.SetYear(v)
 		return nil
-	case survivor.FieldInsanity:
-		v, ok := value.(int)
+	case timelineevent.FieldType:
+		v, ok := value.(timelineevent.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInsanity(v)
+		m.SetType(v)
 		return nil
-	case survivor.FieldLumi:
-		v, ok := value.(int)
+	case timelineevent.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLumi(v)
+		m.SetName(v)
 		return nil
-	case survivor.FieldCourage:
-		v, ok := value.(int)
+	case timelineevent.FieldCompleted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCourage(v)
+		m.SetCompleted(v)
 		return nil
-	case survivor.FieldUnderstanding:
+	case timelineevent.FieldSettlementID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUnderstanding(v)
+		m.SetSettlementID(v)
 		return nil
-	case survivor.FieldStatusChangeYear:
+	}
+	return fmt.Errorf("unknown TimelineEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TimelineEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, timelineevent.FieldYear)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TimelineEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case timelineevent.FieldYear:
+		return m.AddedYear()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TimelineEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case timelineevent.FieldYear:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatusChangeYear(v)
+		m.AddYear(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Survivor numeric field %s", name)
+	return fmt.Errorf("unknown TimelineEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SurvivorMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(survivor.FieldSettlementID) {
-		fields = append(fields, survivor.FieldSettlementID)
-	}
-	return fields
+func (m *TimelineEventMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SurvivorMutation) FieldCleared(name string) bool {
+func (m *TimelineEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SurvivorMutation) ClearField(name string) error {
-	switch name {
-	case survivor.FieldSettlementID:
-		m.ClearSettlementID()
-		return nil
-	}
-	return fmt.Errorf("unknown Survivor nullable field %s", name)
+func (m *TimelineEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TimelineEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SurvivorMutation) ResetField(name string) error {
+func (m *TimelineEventMutation) ResetField(name string) error {
 	switch name {
-	case survivor.FieldName:
-		m.ResetName()
-		return nil
-	case survivor.FieldBorn:
-		m.ResetBorn()
-		return nil
-	case survivor.FieldGender:
-		m.ResetGender()
+	case timelineevent.FieldYear:
+		m.ResetYear()
 		return nil
-	case survivor.FieldHuntxp:
-		m.ResetHuntxp()
-		return nil
-	case survivor.FieldSurvival:
-		m.ResetSurvival()
-		return nil
-	case survivor.FieldMovement:
-		m.ResetMovement()
-		return nil
-	case survivor.FieldAccuracy:
-		m.ResetAccuracy()
-		return nil
-	case survivor.FieldStrength:
-		m.ResetStrength()
-		return nil
-	case survivor.FieldEvasion:
-		m.ResetEvasion()
-		return nil
-	case survivor.FieldLuck:
-		m.ResetLuck()
-		return nil
-	case survivor.FieldSpeed:
-		m.ResetSpeed()
-		return nil
-	case survivor.FieldSystemicpressure:
-		m.ResetSystemicpressure()
-		return nil
-	case survivor.FieldTorment:
-		m.ResetTorment()
-		return nil
-	case survivor.FieldInsanity:
-		m.ResetInsanity()
+	case timelineevent.FieldType:
+		m.ResetType()
 		return nil
-	case survivor.FieldLumi:
-		m.ResetLumi()
-		return nil
-	case survivor.FieldCourage:
-		m.ResetCourage()
-		return nil
-	case survivor.FieldUnderstanding:
-		m.ResetUnderstanding()
-		return nil
-	case survivor.FieldStatus:
-		m.ResetStatus()
+	case timelineevent.FieldName:
+		m.ResetName()
 		return nil
-	case survivor.FieldStatusChangeYear:
-		m.ResetStatusChangeYear()
+	case timelineevent.FieldCompleted:
+		m.ResetCompleted()
 		return nil
-	case survivor.FieldSettlementID:
+	case timelineevent.FieldSettlementID:
 		m.ResetSettlementID()
 		return nil
 	}
-	return fmt.Errorf("unknown Survivor field %s", name)
+	return fmt.Errorf("unknown TimelineEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SurvivorMutation) AddedEdges() []string {
+func (m *TimelineEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.settlement != nil {
-		edges = append(edges, survivor.EdgeSettlement)
+		edges = append(edges, timelineevent.EdgeSettlement)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SurvivorMutation) AddedIDs(name string) []ent.Value {
+func (m *TimelineEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case survivor.EdgeSettlement:
+	case timelineevent.EdgeSettlement:
 		if id := m.settlement; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2759,31 +14484,31 @@ func (m *SurvivorMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SurvivorMutation) RemovedEdges() []string {
+func (m *TimelineEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SurvivorMutation) RemovedIDs(name string) []ent.Value {
+func (m *TimelineEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SurvivorMutation) ClearedEdges() []string {
+func (m *TimelineEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedsettlement {
-		edges = append(edges, survivor.EdgeSettlement)
+		edges = append(edges, timelineevent.EdgeSettlement)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SurvivorMutation) EdgeCleared(name string) bool {
+func (m *TimelineEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case survivor.EdgeSettlement:
+	case timelineevent.EdgeSettlement:
 		return m.clearedsettlement
 	}
 	return false
@@ -2791,22 +14516,22 @@ func (m *SurvivorMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SurvivorMutation) ClearEdge(name string) error {
+func (m *TimelineEventMutation) ClearEdge(name string) error {
 	switch name {
-	case survivor.EdgeSettlement:
+	case timelineevent.EdgeSettlement:
 		m.ClearSettlement()
 		return nil
 	}
-	return fmt.Errorf("unknown Survivor unique edge %s", name)
+	return fmt.Errorf("unknown TimelineEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SurvivorMutation) ResetEdge(name string) error {
+func (m *TimelineEventMutation) ResetEdge(name string) error {
 	switch name {
-	case survivor.EdgeSettlement:
+	case timelineevent.EdgeSettlement:
 		m.ResetSettlement()
 		return nil
 	}
-	return fmt.Errorf("unknown Survivor edge %s", name)
+	return fmt.Errorf("unknown TimelineEvent edge %s", name)
 }
@@ -6,8 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Ability is the predicate function for ability builders.
+type Ability func(*sql.Selector)
+
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Disorder is the predicate function for disorder builders.
+type Disorder func(*sql.Selector)
+
+// FightingArt is the predicate function for fightingart builders.
+type FightingArt func(*sql.Selector)
+
+// Innovation is the predicate function for innovation builders.
+type Innovation func(*sql.Selector)
+
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
+// Location is the predicate function for location builders.
+type Location func(*sql.Selector)
+
+// Membership is the predicate function for membership builders.
+type Membership func(*sql.Selector)
+
+// Milestone is the predicate function for milestone builders.
+type Milestone func(*sql.Selector)
+
+// Monster is the predicate function for monster builders.
+type Monster func(*sql.Selector)
+
+// MonsterProgress is the predicate function for monsterprogress builders.
+type MonsterProgress func(*sql.Selector)
+
+// Principle is the predicate function for principle builders.
+type Principle func(*sql.Selector)
+
+// Recipe is the predicate function for recipe builders.
+type Recipe func(*sql.Selector)
+
 // Settlement is the predicate function for settlement builders.
 type Settlement func(*sql.Selector)
 
+// StorageItem is the predicate function for storageitem builders.
+type StorageItem func(*sql.Selector)
+
 // Survivor is the predicate function for survivor builders.
 type Survivor func(*sql.Selector)
+
+// TimelineEvent is the predicate function for timelineevent builders.
+type TimelineEvent func(*sql.Selector)
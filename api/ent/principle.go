@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// Principle is the model entity for the Principle schema.
+type Principle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name principle.Name `json:"name,omitempty"`
+	// Choice holds the value of the "choice" field.
+	Choice string `json:"choice,omitempty"`
+	// SurvivalLimitBonus holds the value of the "survival_limit_bonus" field.
+	SurvivalLimitBonus int `json:"survival_limit_bonus,omitempty"`
+	// DepartingSurvivalBonus holds the value of the "departing_survival_bonus" field.
+	DepartingSurvivalBonus int `json:"departing_survival_bonus,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PrincipleQuery when eager-loading is set.
+	Edges        PrincipleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PrincipleEdges holds the relations/edges for other nodes in the graph.
+type PrincipleEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PrincipleEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Principle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case principle.FieldID, principle.FieldSurvivalLimitBonus, principle.FieldDepartingSurvivalBonus, principle.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case principle.FieldName, principle.FieldChoice:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Principle fields.
+func (pr *Principle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case principle.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case principle.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = principle.Name(value.String)
+			}
+		case principle.FieldChoice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field choice", values[i])
+			} else if value.Valid {
+				pr.Choice = value.String
+			}
+		case principle.FieldSurvivalLimitBonus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field survival_limit_bonus", values[i])
+			} else if value.Valid {
+				pr.SurvivalLimitBonus = int(value.Int64)
+			}
+		case principle.FieldDepartingSurvivalBonus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field departing_survival_bonus", values[i])
+			} else if value.Valid {
+				pr.DepartingSurvivalBonus = int(value.Int64)
+			}
+		case principle.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				pr.SettlementID = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Principle.
+// This includes values selected through modifiers, order, etc.
+func (pr *Principle) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the Principle entity.
+func (pr *Principle) QuerySettlement() *SettlementQuery {
+	return NewPrincipleClient(pr.config).QuerySettlement(pr)
+}
+
+// Update returns a builder for updating this Principle.
+// Note that you need to call Principle.Unwrap() before calling this method if this Principle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Principle) Update() *PrincipleUpdateOne {
+	return NewPrincipleClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Principle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Principle) Unwrap() *Principle {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Principle is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Principle) String() string {
+	var builder strings.Builder
+	builder.WriteString("Principle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Name))
+	builder.WriteString(", ")
+	builder.WriteString("choice=")
+	builder.WriteString(pr.Choice)
+	builder.WriteString(", ")
+	builder.WriteString("survival_limit_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SurvivalLimitBonus))
+	builder.WriteString(", ")
+	builder.WriteString("departing_survival_bonus=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DepartingSurvivalBonus))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Principles is a parsable slice of Principle.
+type Principles []*Principle
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package principle
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the principle type in the database.
+	Label = "principle"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldChoice holds the string denoting the choice field in the database.
+	FieldChoice = "choice"
+	// FieldSurvivalLimitBonus holds the string denoting the survival_limit_bonus field in the database.
+	FieldSurvivalLimitBonus = "survival_limit_bonus"
+	// FieldDepartingSurvivalBonus holds the string denoting the departing_survival_bonus field in the database.
+	FieldDepartingSurvivalBonus = "departing_survival_bonus"
+	// FieldSettlementID holds the string denoting the settlement_id field in the database.
+	FieldSettlementID = "settlement_id"
+	// EdgeSettlement holds the string denoting the settlement edge name in mutations.
+	EdgeSettlement = "settlement"
+	// Table holds the table name of the principle in the database.
+	Table = "principles"
+	// SettlementTable is the table that holds the settlement relation/edge.
+	SettlementTable = "principles"
+	// SettlementInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	SettlementInverseTable = "settlements"
+	// SettlementColumn is the table column denoting the settlement relation/edge.
+	SettlementColumn = "settlement_id"
+)
+
+// Columns holds all SQL columns for principle fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldChoice,
+	FieldSurvivalLimitBonus,
+	FieldDepartingSurvivalBonus,
+	FieldSettlementID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// ChoiceValidator is a validator for the "choice" field. It is called by the builders before save.
+	ChoiceValidator func(string) error
+	// DefaultSurvivalLimitBonus holds the default value on creation for the "survival_limit_bonus" field.
+	DefaultSurvivalLimitBonus int
+	// SurvivalLimitBonusValidator is a validator for the "survival_limit_bonus" field. It is called by the builders before save.
+	SurvivalLimitBonusValidator func(int) error
+	// DefaultDepartingSurvivalBonus holds the default value on creation for the "departing_survival_bonus" field.
+	DefaultDepartingSurvivalBonus int
+	// DepartingSurvivalBonusValidator is a validator for the "departing_survival_bonus" field. It is called by the builders before save.
+	DepartingSurvivalBonusValidator func(int) error
+)
+
+// Name defines the type for the "name" enum field.
+type Name string
+
+// Name values.
+const (
+	NameNewLife    Name = "new_life"
+	NameDeath      Name = "death"
+	NameSociety    Name = "society"
+	NameConviction Name = "conviction"
+)
+
+func (n Name) String() string {
+	return string(n)
+}
+
+// NameValidator is a validator for the "name" field enum values. It is called by the builders before save.
+func NameValidator(n Name) error {
+	switch n {
+	case NameNewLife, NameDeath, NameSociety, NameConviction:
+		return nil
+	default:
+		return fmt.Errorf("principle: invalid enum value for name field: %q", n)
+	}
+}
+
+// OrderOption defines the ordering options for the Principle queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByChoice orders the results by the choice field.
+func ByChoice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChoice, opts...).ToFunc()
+}
+
+// BySurvivalLimitBonus orders the results by the survival_limit_bonus field.
+func BySurvivalLimitBonus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSurvivalLimitBonus, opts...).ToFunc()
+}
+
+// ByDepartingSurvivalBonus orders the results by the departing_survival_bonus field.
+func ByDepartingSurvivalBonus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepartingSurvivalBonus, opts...).ToFunc()
+}
+
+// BySettlementID orders the results by the settlement_id field.
+func BySettlementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettlementID, opts...).ToFunc()
+}
+
+// BySettlementField orders the results by settlement field.
+func BySettlementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSettlementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSettlementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SettlementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Name) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Name) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Name(str)
+	if err := NameValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Name", str)
+	}
+	return nil
+}
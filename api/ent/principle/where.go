@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package principle
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Principle {
+	return predicate.Principle(sql.FieldLTE(FieldID, id))
+}
+
+// Choice applies equality check predicate on the "choice" field. It's identical to ChoiceEQ.
+func Choice(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldChoice, v))
+}
+
+// SurvivalLimitBonus applies equality check predicate on the "survival_limit_bonus" field. It's identical to SurvivalLimitBonusEQ.
+func SurvivalLimitBonus(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldSurvivalLimitBonus, v))
+}
+
+// DepartingSurvivalBonus applies equality check predicate on the "departing_survival_bonus" field. It's identical to DepartingSurvivalBonusEQ.
+func DepartingSurvivalBonus(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v Name) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v Name) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...Name) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...Name) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldName, vs...))
+}
+
+// ChoiceEQ applies the EQ predicate on the "choice" field.
+func ChoiceEQ(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldChoice, v))
+}
+
+// ChoiceNEQ applies the NEQ predicate on the "choice" field.
+func ChoiceNEQ(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldChoice, v))
+}
+
+// ChoiceIn applies the In predicate on the "choice" field.
+func ChoiceIn(vs ...string) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldChoice, vs...))
+}
+
+// ChoiceNotIn applies the NotIn predicate on the "choice" field.
+func ChoiceNotIn(vs ...string) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldChoice, vs...))
+}
+
+// ChoiceGT applies the GT predicate on the "choice" field.
+func ChoiceGT(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldGT(FieldChoice, v))
+}
+
+// ChoiceGTE applies the GTE predicate on the "choice" field.
+func ChoiceGTE(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldGTE(FieldChoice, v))
+}
+
+// ChoiceLT applies the LT predicate on the "choice" field.
+func ChoiceLT(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldLT(FieldChoice, v))
+}
+
+// ChoiceLTE applies the LTE predicate on the "choice" field.
+func ChoiceLTE(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldLTE(FieldChoice, v))
+}
+
+// ChoiceContains applies the Contains predicate on the "choice" field.
+func ChoiceContains(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldContains(FieldChoice, v))
+}
+
+// ChoiceHasPrefix applies the HasPrefix predicate on the "choice" field.
+func ChoiceHasPrefix(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldHasPrefix(FieldChoice, v))
+}
+
+// ChoiceHasSuffix applies the HasSuffix predicate on the "choice" field.
+func ChoiceHasSuffix(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldHasSuffix(FieldChoice, v))
+}
+
+// ChoiceEqualFold applies the EqualFold predicate on the "choice" field.
+func ChoiceEqualFold(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldEqualFold(FieldChoice, v))
+}
+
+// ChoiceContainsFold applies the ContainsFold predicate on the "choice" field.
+func ChoiceContainsFold(v string) predicate.Principle {
+	return predicate.Principle(sql.FieldContainsFold(FieldChoice, v))
+}
+
+// SurvivalLimitBonusEQ applies the EQ predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusNEQ applies the NEQ predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusNEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusIn applies the In predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldSurvivalLimitBonus, vs...))
+}
+
+// SurvivalLimitBonusNotIn applies the NotIn predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusNotIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldSurvivalLimitBonus, vs...))
+}
+
+// SurvivalLimitBonusGT applies the GT predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusGT(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldGT(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusGTE applies the GTE predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusGTE(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldGTE(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusLT applies the LT predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusLT(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldLT(FieldSurvivalLimitBonus, v))
+}
+
+// SurvivalLimitBonusLTE applies the LTE predicate on the "survival_limit_bonus" field.
+func SurvivalLimitBonusLTE(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldLTE(FieldSurvivalLimitBonus, v))
+}
+
+// DepartingSurvivalBonusEQ applies the EQ predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusNEQ applies the NEQ predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusNEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusIn applies the In predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldDepartingSurvivalBonus, vs...))
+}
+
+// DepartingSurvivalBonusNotIn applies the NotIn predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusNotIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldDepartingSurvivalBonus, vs...))
+}
+
+// DepartingSurvivalBonusGT applies the GT predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusGT(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldGT(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusGTE applies the GTE predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusGTE(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldGTE(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusLT applies the LT predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusLT(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldLT(FieldDepartingSurvivalBonus, v))
+}
+
+// DepartingSurvivalBonusLTE applies the LTE predicate on the "departing_survival_bonus" field.
+func DepartingSurvivalBonusLTE(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldLTE(FieldDepartingSurvivalBonus, v))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v int) predicate.Principle {
+	return predicate.Principle(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...int) predicate.Principle {
+	return predicate.Principle(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// HasSettlement applies the HasEdge predicate on the "settlement" edge.
+func HasSettlement() predicate.Principle {
+	return predicate.Principle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementWith applies the HasEdge predicate on the "settlement" edge with a given conditions (other predicates).
+func HasSettlementWith(preds ...predicate.Settlement) predicate.Principle {
+	return predicate.Principle(func(s *sql.Selector) {
+		step := newSettlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Principle) predicate.Principle {
+	return predicate.Principle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Principle) predicate.Principle {
+	return predicate.Principle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Principle) predicate.Principle {
+	return predicate.Principle(sql.NotPredicates(p))
+}
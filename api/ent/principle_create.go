@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/settlement"
+)
+
+// PrincipleCreate is the builder for creating a Principle entity.
+type PrincipleCreate struct {
+	config
+	mutation *PrincipleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *PrincipleCreate) SetName(pr principle.Name) *PrincipleCreate {
+	pc.mutation.SetName(pr)
+	return pc
+}
+
+// SetChoice sets the "choice" field.
+func (pc *PrincipleCreate) SetChoice(s string) *PrincipleCreate {
+	pc.mutation.SetChoice(s)
+	return pc
+}
+
+// SetSurvivalLimitBonus sets the "survival_limit_bonus" field.
+func (pc *PrincipleCreate) SetSurvivalLimitBonus(i int) *PrincipleCreate {
+	pc.mutation.SetSurvivalLimitBonus(i)
+	return pc
+}
+
+// SetNillableSurvivalLimitBonus sets the "survival_limit_bonus" field if the given value is not nil.
+func (pc *PrincipleCreate) SetNillableSurvivalLimitBonus(i *int) *PrincipleCreate {
+	if i != nil {
+		pc.SetSurvivalLimitBonus(*i)
+	}
+	return pc
+}
+
+// SetDepartingSurvivalBonus sets the "departing_survival_bonus" field.
+func (pc *PrincipleCreate) SetDepartingSurvivalBonus(i int) *PrincipleCreate {
+	pc.mutation.SetDepartingSurvivalBonus(i)
+	return pc
+}
+
+// SetNillableDepartingSurvivalBonus sets the "departing_survival_bonus" field if the given value is not nil.
+func (pc *PrincipleCreate) SetNillableDepartingSurvivalBonus(i *int) *PrincipleCreate {
+	if i != nil {
+		pc.SetDepartingSurvivalBonus(*i)
+	}
+	return pc
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (pc *PrincipleCreate) SetSettlementID(i int) *PrincipleCreate {
+	pc.mutation.SetSettlementID(i)
+	return pc
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (pc *PrincipleCreate) SetSettlement(s *Settlement) *PrincipleCreate {
+	return pc.SetSettlementID(s.ID)
+}
+
+// Mutation returns the PrincipleMutation object of the builder.
+func (pc *PrincipleCreate) Mutation() *PrincipleMutation {
+	return pc.mutation
+}
+
+// Save creates the Principle in the database.
+func (pc *PrincipleCreate) Save(ctx context.Context) (*Principle, error) {
+	if err := pc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PrincipleCreate) SaveX(ctx context.Context) *Principle {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PrincipleCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PrincipleCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PrincipleCreate) defaults() error {
+	if _, ok := pc.mutation.SurvivalLimitBonus(); !ok {
+		v := principle.DefaultSurvivalLimitBonus
+		pc.mutation.SetSurvivalLimitBonus(v)
+	}
+	if _, ok := pc.mutation.DepartingSurvivalBonus(); !ok {
+		v := principle.DefaultDepartingSurvivalBonus
+		pc.mutation.SetDepartingSurvivalBonus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PrincipleCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Principle.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := principle.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Principle.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Choice(); !ok {
+		return &ValidationError{Name: "choice", err: errors.New(`ent: missing required field "Principle.choice"`)}
+	}
+	if v, ok := pc.mutation.Choice(); ok {
+		if err := principle.ChoiceValidator(v); err != nil {
+			return &ValidationError{Name: "choice", err: fmt.Errorf(`ent: validator failed for field "Principle.choice": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.SurvivalLimitBonus(); !ok {
+		return &ValidationError{Name: "survival_limit_bonus", err: errors.New(`ent: missing required field "Principle.survival_limit_bonus"`)}
+	}
+	if v, ok := pc.mutation.SurvivalLimitBonus(); ok {
+		if err := principle.SurvivalLimitBonusValidator(v); err != nil {
+			return &ValidationError{Name: "survival_limit_bonus", err: fmt.Errorf(`ent: validator failed for field "Principle.survival_limit_bonus": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.DepartingSurvivalBonus(); !ok {
+		return &ValidationError{Name: "departing_survival_bonus", err: errors.New(`ent: missing required field "Principle.departing_survival_bonus"`)}
+	}
+	if v, ok := pc.mutation.DepartingSurvivalBonus(); ok {
+		if err := principle.DepartingSurvivalBonusValidator(v); err != nil {
+			return &ValidationError{Name: "departing_survival_bonus", err: fmt.Errorf(`ent: validator failed for field "Principle.departing_survival_bonus": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "Principle.settlement_id"`)}
+	}
+	if len(pc.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "Principle.settlement"`)}
+	}
+	return nil
+}
+
+func (pc *PrincipleCreate) sqlSave(ctx context.Context) (*Principle, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PrincipleCreate) createSpec() (*Principle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Principle{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(principle.Table, sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt))
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(principle.FieldName, field.TypeEnum, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Choice(); ok {
+		_spec.SetField(principle.FieldChoice, field.TypeString, value)
+		_node.Choice = value
+	}
+	if value, ok := pc.mutation.SurvivalLimitBonus(); ok {
+		_spec.SetField(principle.FieldSurvivalLimitBonus, field.TypeInt, value)
+		_node.SurvivalLimitBonus = value
+	}
+	if value, ok := pc.mutation.DepartingSurvivalBonus(); ok {
+		_spec.SetField(principle.FieldDepartingSurvivalBonus, field.TypeInt, value)
+		_node.DepartingSurvivalBonus = value
+	}
+	if nodes := pc.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   principle.SettlementTable,
+			Columns: []string{principle.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PrincipleCreateBulk is the builder for creating many Principle entities in bulk.
+type PrincipleCreateBulk struct {
+	config
+	err      error
+	builders []*PrincipleCreate
+}
+
+// Save creates the Principle entities in the database.
+func (pcb *PrincipleCreateBulk) Save(ctx context.Context) ([]*Principle, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Principle, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrincipleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PrincipleCreateBulk) SaveX(ctx context.Context) []*Principle {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PrincipleCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PrincipleCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
+)
+
+// PrincipleUpdate is the builder for updating Principle entities.
+type PrincipleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrincipleMutation
+}
+
+// Where appends a list predicates to the PrincipleUpdate builder.
+func (pu *PrincipleUpdate) Where(ps ...predicate.Principle) *PrincipleUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// Mutation returns the PrincipleMutation object of the builder.
+func (pu *PrincipleUpdate) Mutation() *PrincipleMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PrincipleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PrincipleUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PrincipleUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PrincipleUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PrincipleUpdate) check() error {
+	if pu.mutation.SettlementCleared() && len(pu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Principle.settlement"`)
+	}
+	return nil
+}
+
+func (pu *PrincipleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(principle.Table, principle.Columns, sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{principle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PrincipleUpdateOne is the builder for updating a single Principle entity.
+type PrincipleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrincipleMutation
+}
+
+// Mutation returns the PrincipleMutation object of the builder.
+func (puo *PrincipleUpdateOne) Mutation() *PrincipleMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PrincipleUpdate builder.
+func (puo *PrincipleUpdateOne) Where(ps ...predicate.Principle) *PrincipleUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PrincipleUpdateOne) Select(field string, fields ...string) *PrincipleUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Principle entity.
+func (puo *PrincipleUpdateOne) Save(ctx context.Context) (*Principle, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PrincipleUpdateOne) SaveX(ctx context.Context) *Principle {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PrincipleUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PrincipleUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PrincipleUpdateOne) check() error {
+	if puo.mutation.SettlementCleared() && len(puo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Principle.settlement"`)
+	}
+	return nil
+}
+
+func (puo *PrincipleUpdateOne) sqlSave(ctx context.Context) (_node *Principle, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(principle.Table, principle.Columns, sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Principle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, principle.FieldID)
+		for _, f := range fields {
+			if !principle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != principle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &Principle{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{principle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacy
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+
+	"entgo.io/ent/privacy"
+)
+
+var (
+	// Allow may be returned by rules to indicate that the policy
+	// evaluation should terminate with allow decision.
+	Allow = privacy.Allow
+
+	// Deny may be returned by rules to indicate that the policy
+	// evaluation should terminate with deny decision.
+	Deny = privacy.Deny
+
+	// Skip may be returned by rules to indicate that the policy
+	// evaluation should continue to the next rule.
+	Skip = privacy.Skip
+)
+
+// Allowf returns a formatted wrapped Allow decision.
+func Allowf(format string, a ...any) error {
+	return privacy.Allowf(format, a...)
+}
+
+// Denyf returns a formatted wrapped Deny decision.
+func Denyf(format string, a ...any) error {
+	return privacy.Denyf(format, a...)
+}
+
+// Skipf returns a formatted wrapped Skip decision.
+func Skipf(format string, a ...any) error {
+	return privacy.Skipf(format, a...)
+}
+
+// DecisionContext creates a new context from the given parent context with
+// a policy decision attach to it.
+func DecisionContext(parent context.Context, decision error) context.Context {
+	return privacy.DecisionContext(parent, decision)
+}
+
+// DecisionFromContext retrieves the policy decision from the context.
+func DecisionFromContext(ctx context.Context) (error, bool) {
+	return privacy.DecisionFromContext(ctx)
+}
+
+type (
+	// Policy groups query and mutation policies.
+	Policy = privacy.Policy
+
+	// QueryRule defines the interface deciding whether a
+	// query is allowed and optionally modify it.
+	QueryRule = privacy.QueryRule
+	// QueryPolicy combines multiple query rules into a single policy.
+	QueryPolicy = privacy.QueryPolicy
+
+	// MutationRule defines the interface which decides whether a
+	// mutation is allowed and optionally modifies it.
+	MutationRule = privacy.MutationRule
+	// MutationPolicy combines multiple mutation rules into a single policy.
+	MutationPolicy = privacy.MutationPolicy
+	// MutationRuleFunc type is an adapter which allows the use of
+	// ordinary functions as mutation rules.
+	MutationRuleFunc = privacy.MutationRuleFunc
+
+	// QueryMutationRule is an interface which groups query and mutation rules.
+	QueryMutationRule = privacy.QueryMutationRule
+)
+
+// QueryRuleFunc type is an adapter to allow the use of
+// ordinary functions as query rules.
+type QueryRuleFunc func(context.Context, ent.Query) error
+
+// Eval returns f(ctx, q).
+func (f QueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	return f(ctx, q)
+}
+
+// AlwaysAllowRule returns a rule that returns an allow decision.
+func AlwaysAllowRule() QueryMutationRule {
+	return privacy.AlwaysAllowRule()
+}
+
+// AlwaysDenyRule returns a rule that returns a deny decision.
+func AlwaysDenyRule() QueryMutationRule {
+	return privacy.AlwaysDenyRule()
+}
+
+// ContextQueryMutationRule creates a query/mutation rule from a context eval func.
+func ContextQueryMutationRule(eval func(context.Context) error) QueryMutationRule {
+	return privacy.ContextQueryMutationRule(eval)
+}
+
+// OnMutationOperation evaluates the given rule only on a given mutation operation.
+func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
+	return privacy.OnMutationOperation(rule, op)
+}
+
+// DenyMutationOperationRule returns a rule denying specified mutation operation.
+func DenyMutationOperationRule(op ent.Op) MutationRule {
+	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
+		return Denyf("ent/privacy: operation %s is not allowed", m.Op())
+	})
+	return OnMutationOperation(rule, op)
+}
+
+// The AbilityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AbilityQueryRuleFunc func(context.Context, *ent.AbilityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AbilityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AbilityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AbilityQuery", q)
+}
+
+// The AbilityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AbilityMutationRuleFunc func(context.Context, *ent.AbilityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AbilityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AbilityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AbilityMutation", m)
+}
+
+// The AccessTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AccessTokenQueryRuleFunc func(context.Context, *ent.AccessTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AccessTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AccessTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AccessTokenQuery", q)
+}
+
+// The AccessTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AccessTokenMutationRuleFunc func(context.Context, *ent.AccessTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AccessTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AccessTokenMutation", m)
+}
+
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The DisorderQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DisorderQueryRuleFunc func(context.Context, *ent.DisorderQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DisorderQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DisorderQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DisorderQuery", q)
+}
+
+// The DisorderMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DisorderMutationRuleFunc func(context.Context, *ent.DisorderMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DisorderMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DisorderMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DisorderMutation", m)
+}
+
+// The FightingArtQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FightingArtQueryRuleFunc func(context.Context, *ent.FightingArtQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FightingArtQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FightingArtQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FightingArtQuery", q)
+}
+
+// The FightingArtMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FightingArtMutationRuleFunc func(context.Context, *ent.FightingArtMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FightingArtMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FightingArtMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FightingArtMutation", m)
+}
+
+// The InnovationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InnovationQueryRuleFunc func(context.Context, *ent.InnovationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InnovationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InnovationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InnovationQuery", q)
+}
+
+// The InnovationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InnovationMutationRuleFunc func(context.Context, *ent.InnovationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InnovationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InnovationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InnovationMutation", m)
+}
+
+// The InviteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InviteQueryRuleFunc func(context.Context, *ent.InviteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InviteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InviteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InviteQuery", q)
+}
+
+// The InviteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InviteMutationRuleFunc func(context.Context, *ent.InviteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InviteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InviteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InviteMutation", m)
+}
+
+// The LocationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LocationQueryRuleFunc func(context.Context, *ent.LocationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LocationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LocationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LocationQuery", q)
+}
+
+// The LocationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LocationMutationRuleFunc func(context.Context, *ent.LocationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LocationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LocationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LocationMutation", m)
+}
+
+// The MembershipQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MembershipQueryRuleFunc func(context.Context, *ent.MembershipQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MembershipQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MembershipQuery", q)
+}
+
+// The MembershipMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MembershipMutationRuleFunc func(context.Context, *ent.MembershipMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MembershipMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MembershipMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MembershipMutation", m)
+}
+
+// The MilestoneQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MilestoneQueryRuleFunc func(context.Context, *ent.MilestoneQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MilestoneQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MilestoneQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MilestoneQuery", q)
+}
+
+// The MilestoneMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MilestoneMutationRuleFunc func(context.Context, *ent.MilestoneMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MilestoneMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MilestoneMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MilestoneMutation", m)
+}
+
+// The MonsterQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MonsterQueryRuleFunc func(context.Context, *ent.MonsterQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MonsterQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MonsterQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MonsterQuery", q)
+}
+
+// The MonsterMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MonsterMutationRuleFunc func(context.Context, *ent.MonsterMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MonsterMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MonsterMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MonsterMutation", m)
+}
+
+// The MonsterProgressQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MonsterProgressQueryRuleFunc func(context.Context, *ent.MonsterProgressQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MonsterProgressQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MonsterProgressQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MonsterProgressQuery", q)
+}
+
+// The MonsterProgressMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MonsterProgressMutationRuleFunc func(context.Context, *ent.MonsterProgressMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MonsterProgressMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MonsterProgressMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MonsterProgressMutation", m)
+}
+
+// The PrincipleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PrincipleQueryRuleFunc func(context.Context, *ent.PrincipleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PrincipleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PrincipleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PrincipleQuery", q)
+}
+
+// The PrincipleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PrincipleMutationRuleFunc func(context.Context, *ent.PrincipleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PrincipleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PrincipleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PrincipleMutation", m)
+}
+
+// The RecipeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RecipeQueryRuleFunc func(context.Context, *ent.RecipeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RecipeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RecipeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RecipeQuery", q)
+}
+
+// The RecipeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RecipeMutationRuleFunc func(context.Context, *ent.RecipeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RecipeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RecipeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RecipeMutation", m)
+}
+
+// The SettlementQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SettlementQueryRuleFunc func(context.Context, *ent.SettlementQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SettlementQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SettlementQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SettlementQuery", q)
+}
+
+// The SettlementMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SettlementMutationRuleFunc func(context.Context, *ent.SettlementMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SettlementMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SettlementMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SettlementMutation", m)
+}
+
+// The StorageItemQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type StorageItemQueryRuleFunc func(context.Context, *ent.StorageItemQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f StorageItemQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.StorageItemQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.StorageItemQuery", q)
+}
+
+// The StorageItemMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type StorageItemMutationRuleFunc func(context.Context, *ent.StorageItemMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f StorageItemMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.StorageItemMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.StorageItemMutation", m)
+}
+
+// The SurvivorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SurvivorQueryRuleFunc func(context.Context, *ent.SurvivorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SurvivorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SurvivorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SurvivorQuery", q)
+}
+
+// The SurvivorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SurvivorMutationRuleFunc func(context.Context, *ent.SurvivorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SurvivorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SurvivorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SurvivorMutation", m)
+}
+
+// The TimelineEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TimelineEventQueryRuleFunc func(context.Context, *ent.TimelineEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TimelineEventQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TimelineEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TimelineEventQuery", q)
+}
+
+// The TimelineEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TimelineEventMutationRuleFunc func(context.Context, *ent.TimelineEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TimelineEventMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TimelineEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TimelineEventMutation", m)
+}
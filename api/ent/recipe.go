@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Recipe is the model entity for the Recipe schema.
+type Recipe struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// Costs holds the value of the "costs" field.
+	Costs []schematype.ResourceCost `json:"costs,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID int `json:"location_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RecipeQuery when eager-loading is set.
+	Edges        RecipeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RecipeEdges holds the relations/edges for other nodes in the graph.
+type RecipeEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RecipeEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Recipe) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recipe.FieldKeywords, recipe.FieldCosts:
+			values[i] = new([]byte)
+		case recipe.FieldID, recipe.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case recipe.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Recipe fields.
+func (r *Recipe) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recipe.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case recipe.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				r.Name = value.String
+			}
+		case recipe.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case recipe.FieldCosts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field costs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Costs); err != nil {
+					return fmt.Errorf("unmarshal field costs: %w", err)
+				}
+			}
+		case recipe.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				r.LocationID = int(value.Int64)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Recipe.
+// This includes values selected through modifiers, order, etc.
+func (r *Recipe) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the Recipe entity.
+func (r *Recipe) QueryLocation() *LocationQuery {
+	return NewRecipeClient(r.config).QueryLocation(r)
+}
+
+// Update returns a builder for updating this Recipe.
+// Note that you need to call Recipe.Unwrap() before calling this method if this Recipe
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Recipe) Update() *RecipeUpdateOne {
+	return NewRecipeClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Recipe entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Recipe) Unwrap() *Recipe {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Recipe is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Recipe) String() string {
+	var builder strings.Builder
+	builder.WriteString("Recipe(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("name=")
+	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", r.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("costs=")
+	builder.WriteString(fmt.Sprintf("%v", r.Costs))
+	builder.WriteString(", ")
+	builder.WriteString("location_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.LocationID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Recipes is a parsable slice of Recipe.
+type Recipes []*Recipe
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// RecipeCreate is the builder for creating a Recipe entity.
+type RecipeCreate struct {
+	config
+	mutation *RecipeMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (rc *RecipeCreate) SetName(s string) *RecipeCreate {
+	rc.mutation.SetName(s)
+	return rc
+}
+
+// SetKeywords sets the "keywords" field.
+func (rc *RecipeCreate) SetKeywords(s []string) *RecipeCreate {
+	rc.mutation.SetKeywords(s)
+	return rc
+}
+
+// SetCosts sets the "costs" field.
+func (rc *RecipeCreate) SetCosts(sc []schematype.ResourceCost) *RecipeCreate {
+	rc.mutation.SetCosts(sc)
+	return rc
+}
+
+// SetLocationID sets the "location_id" field.
+func (rc *RecipeCreate) SetLocationID(i int) *RecipeCreate {
+	rc.mutation.SetLocationID(i)
+	return rc
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (rc *RecipeCreate) SetLocation(l *Location) *RecipeCreate {
+	return rc.SetLocationID(l.ID)
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (rc *RecipeCreate) Mutation() *RecipeMutation {
+	return rc.mutation
+}
+
+// Save creates the Recipe in the database.
+func (rc *RecipeCreate) Save(ctx context.Context) (*Recipe, error) {
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *RecipeCreate) SaveX(ctx context.Context) *Recipe {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *RecipeCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *RecipeCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *RecipeCreate) check() error {
+	if _, ok := rc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Recipe.name"`)}
+	}
+	if v, ok := rc.mutation.Name(); ok {
+		if err := recipe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Recipe.name": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Costs(); !ok {
+		return &ValidationError{Name: "costs", err: errors.New(`ent: missing required field "Recipe.costs"`)}
+	}
+	if _, ok := rc.mutation.LocationID(); !ok {
+		return &ValidationError{Name: "location_id", err: errors.New(`ent: missing required field "Recipe.location_id"`)}
+	}
+	if len(rc.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "Recipe.location"`)}
+	}
+	return nil
+}
+
+func (rc *RecipeCreate) sqlSave(ctx context.Context) (*Recipe, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *RecipeCreate) createSpec() (*Recipe, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Recipe{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(recipe.Table, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rc.mutation.Keywords(); ok {
+		_spec.SetField(recipe.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := rc.mutation.Costs(); ok {
+		_spec.SetField(recipe.FieldCosts, field.TypeJSON, value)
+		_node.Costs = value
+	}
+	if nodes := rc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipe.LocationTable,
+			Columns: []string{recipe.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RecipeCreateBulk is the builder for creating many Recipe entities in bulk.
+type RecipeCreateBulk struct {
+	config
+	err      error
+	builders []*RecipeCreate
+}
+
+// Save creates the Recipe entities in the database.
+func (rcb *RecipeCreateBulk) Save(ctx context.Context) ([]*Recipe, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Recipe, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecipeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *RecipeCreateBulk) SaveX(ctx context.Context) []*Recipe {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *RecipeCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *RecipeCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
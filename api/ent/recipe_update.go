@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// RecipeUpdate is the builder for updating Recipe entities.
+type RecipeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecipeMutation
+}
+
+// Where appends a list predicates to the RecipeUpdate builder.
+func (ru *RecipeUpdate) Where(ps ...predicate.Recipe) *RecipeUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetName sets the "name" field.
+func (ru *RecipeUpdate) SetName(s string) *RecipeUpdate {
+	ru.mutation.SetName(s)
+	return ru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableName(s *string) *RecipeUpdate {
+	if s != nil {
+		ru.SetName(*s)
+	}
+	return ru
+}
+
+// SetKeywords sets the "keywords" field.
+func (ru *RecipeUpdate) SetKeywords(s []string) *RecipeUpdate {
+	ru.mutation.SetKeywords(s)
+	return ru
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (ru *RecipeUpdate) AppendKeywords(s []string) *RecipeUpdate {
+	ru.mutation.AppendKeywords(s)
+	return ru
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (ru *RecipeUpdate) ClearKeywords() *RecipeUpdate {
+	ru.mutation.ClearKeywords()
+	return ru
+}
+
+// SetCosts sets the "costs" field.
+func (ru *RecipeUpdate) SetCosts(sc []schematype.ResourceCost) *RecipeUpdate {
+	ru.mutation.SetCosts(sc)
+	return ru
+}
+
+// AppendCosts appends sc to the "costs" field.
+func (ru *RecipeUpdate) AppendCosts(sc []schematype.ResourceCost) *RecipeUpdate {
+	ru.mutation.AppendCosts(sc)
+	return ru
+}
+
+// SetLocationID sets the "location_id" field.
+func (ru *RecipeUpdate) SetLocationID(i int) *RecipeUpdate {
+	ru.mutation.SetLocationID(i)
+	return ru
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ru *RecipeUpdate) SetNillableLocationID(i *int) *RecipeUpdate {
+	if i != nil {
+		ru.SetLocationID(*i)
+	}
+	return ru
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ru *RecipeUpdate) SetLocation(l *Location) *RecipeUpdate {
+	return ru.SetLocationID(l.ID)
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (ru *RecipeUpdate) Mutation() *RecipeMutation {
+	return ru.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (ru *RecipeUpdate) ClearLocation() *RecipeUpdate {
+	ru.mutation.ClearLocation()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *RecipeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *RecipeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *RecipeUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *RecipeUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *RecipeUpdate) check() error {
+	if v, ok := ru.mutation.Name(); ok {
+		if err := recipe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Recipe.name": %w`, err)}
+		}
+	}
+	if ru.mutation.LocationCleared() && len(ru.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Recipe.location"`)
+	}
+	return nil
+}
+
+func (ru *RecipeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipe.Table, recipe.Columns, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Keywords(); ok {
+		_spec.SetField(recipe.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipe.FieldKeywords, value)
+		})
+	}
+	if ru.mutation.KeywordsCleared() {
+		_spec.ClearField(recipe.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.Costs(); ok {
+		_spec.SetField(recipe.FieldCosts, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedCosts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipe.FieldCosts, value)
+		})
+	}
+	if ru.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipe.LocationTable,
+			Columns: []string{recipe.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipe.LocationTable,
+			Columns: []string{recipe.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RecipeUpdateOne is the builder for updating a single Recipe entity.
+type RecipeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecipeMutation
+}
+
+// SetName sets the "name" field.
+func (ruo *RecipeUpdateOne) SetName(s string) *RecipeUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableName(s *string) *RecipeUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetKeywords sets the "keywords" field.
+func (ruo *RecipeUpdateOne) SetKeywords(s []string) *RecipeUpdateOne {
+	ruo.mutation.SetKeywords(s)
+	return ruo
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (ruo *RecipeUpdateOne) AppendKeywords(s []string) *RecipeUpdateOne {
+	ruo.mutation.AppendKeywords(s)
+	return ruo
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (ruo *RecipeUpdateOne) ClearKeywords() *RecipeUpdateOne {
+	ruo.mutation.ClearKeywords()
+	return ruo
+}
+
+// SetCosts sets the "costs" field.
+func (ruo *RecipeUpdateOne) SetCosts(sc []schematype.ResourceCost) *RecipeUpdateOne {
+	ruo.mutation.SetCosts(sc)
+	return ruo
+}
+
+// AppendCosts appends sc to the "costs" field.
+func (ruo *RecipeUpdateOne) AppendCosts(sc []schematype.ResourceCost) *RecipeUpdateOne {
+	ruo.mutation.AppendCosts(sc)
+	return ruo
+}
+
+// SetLocationID sets the "location_id" field.
+func (ruo *RecipeUpdateOne) SetLocationID(i int) *RecipeUpdateOne {
+	ruo.mutation.SetLocationID(i)
+	return ruo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ruo *RecipeUpdateOne) SetNillableLocationID(i *int) *RecipeUpdateOne {
+	if i != nil {
+		ruo.SetLocationID(*i)
+	}
+	return ruo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ruo *RecipeUpdateOne) SetLocation(l *Location) *RecipeUpdateOne {
+	return ruo.SetLocationID(l.ID)
+}
+
+// Mutation returns the RecipeMutation object of the builder.
+func (ruo *RecipeUpdateOne) Mutation() *RecipeMutation {
+	return ruo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (ruo *RecipeUpdateOne) ClearLocation() *RecipeUpdateOne {
+	ruo.mutation.ClearLocation()
+	return ruo
+}
+
+// Where appends a list predicates to the RecipeUpdate builder.
+func (ruo *RecipeUpdateOne) Where(ps ...predicate.Recipe) *RecipeUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *RecipeUpdateOne) Select(field string, fields ...string) *RecipeUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Recipe entity.
+func (ruo *RecipeUpdateOne) Save(ctx context.Context) (*Recipe, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *RecipeUpdateOne) SaveX(ctx context.Context) *Recipe {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *RecipeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *RecipeUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *RecipeUpdateOne) check() error {
+	if v, ok := ruo.mutation.Name(); ok {
+		if err := recipe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Recipe.name": %w`, err)}
+		}
+	}
+	if ruo.mutation.LocationCleared() && len(ruo.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Recipe.location"`)
+	}
+	return nil
+}
+
+func (ruo *RecipeUpdateOne) sqlSave(ctx context.Context) (_node *Recipe, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recipe.Table, recipe.Columns, sqlgraph.NewFieldSpec(recipe.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Recipe.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recipe.FieldID)
+		for _, f := range fields {
+			if !recipe.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recipe.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Name(); ok {
+		_spec.SetField(recipe.FieldName, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Keywords(); ok {
+		_spec.SetField(recipe.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipe.FieldKeywords, value)
+		})
+	}
+	if ruo.mutation.KeywordsCleared() {
+		_spec.ClearField(recipe.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.Costs(); ok {
+		_spec.SetField(recipe.FieldCosts, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedCosts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, recipe.FieldCosts, value)
+		})
+	}
+	if ruo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipe.LocationTable,
+			Columns: []string{recipe.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   recipe.LocationTable,
+			Columns: []string{recipe.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Recipe{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recipe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -2,7 +2,730 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/failuretoload/datamonster/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/recipe"
+	"github.com/failuretoload/datamonster/ent/schema"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	ability.Policy = privacy.NewPolicies(schema.Ability{})
+	ability.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := ability.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	abilityFields := schema.Ability{}.Fields()
+	_ = abilityFields
+	// abilityDescName is the schema descriptor for name field.
+	abilityDescName := abilityFields[0].Descriptor()
+	// ability.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ability.NameValidator = func() func(string) error {
+		validators := abilityDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	accesstoken.Policy = privacy.NewPolicies(schema.AccessToken{})
+	accesstoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := accesstoken.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescUserID is the schema descriptor for user_id field.
+	accesstokenDescUserID := accesstokenFields[0].Descriptor()
+	// accesstoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	accesstoken.UserIDValidator = accesstokenDescUserID.Validators[0].(func(string) error)
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[1].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = func() func(string) error {
+		validators := accesstokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[7].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	auditlog.Policy = privacy.NewPolicies(schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	disorder.Policy = privacy.NewPolicies(schema.Disorder{})
+	disorder.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := disorder.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	disorderFields := schema.Disorder{}.Fields()
+	_ = disorderFields
+	// disorderDescName is the schema descriptor for name field.
+	disorderDescName := disorderFields[0].Descriptor()
+	// disorder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	disorder.NameValidator = func() func(string) error {
+		validators := disorderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	fightingart.Policy = privacy.NewPolicies(schema.FightingArt{})
+	fightingart.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := fightingart.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	fightingartFields := schema.FightingArt{}.Fields()
+	_ = fightingartFields
+	// fightingartDescName is the schema descriptor for name field.
+	fightingartDescName := fightingartFields[0].Descriptor()
+	// fightingart.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	fightingart.NameValidator = func() func(string) error {
+		validators := fightingartDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fightingartDescSecret is the schema descriptor for secret field.
+	fightingartDescSecret := fightingartFields[2].Descriptor()
+	// fightingart.DefaultSecret holds the default value on creation for the secret field.
+	fightingart.DefaultSecret = fightingartDescSecret.Default.(bool)
+	innovation.Policy = privacy.NewPolicies(schema.Innovation{})
+	innovation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := innovation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	innovationHooks := schema.Innovation{}.Hooks()
+
+	innovation.Hooks[1] = innovationHooks[0]
+	innovationFields := schema.Innovation{}.Fields()
+	_ = innovationFields
+	// innovationDescName is the schema descriptor for name field.
+	innovationDescName := innovationFields[0].Descriptor()
+	// innovation.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	innovation.NameValidator = func() func(string) error {
+		validators := innovationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// innovationDescSurvivalLimitBonus is the schema descriptor for survival_limit_bonus field.
+	innovationDescSurvivalLimitBonus := innovationFields[2].Descriptor()
+	// innovation.DefaultSurvivalLimitBonus holds the default value on creation for the survival_limit_bonus field.
+	innovation.DefaultSurvivalLimitBonus = innovationDescSurvivalLimitBonus.Default.(int)
+	// innovation.SurvivalLimitBonusValidator is a validator for the "survival_limit_bonus" field. It is called by the builders before save.
+	innovation.SurvivalLimitBonusValidator = innovationDescSurvivalLimitBonus.Validators[0].(func(int) error)
+	// innovationDescDepartingSurvivalBonus is the schema descriptor for departing_survival_bonus field.
+	innovationDescDepartingSurvivalBonus := innovationFields[3].Descriptor()
+	// innovation.DefaultDepartingSurvivalBonus holds the default value on creation for the departing_survival_bonus field.
+	innovation.DefaultDepartingSurvivalBonus = innovationDescDepartingSurvivalBonus.Default.(int)
+	// innovation.DepartingSurvivalBonusValidator is a validator for the "departing_survival_bonus" field. It is called by the builders before save.
+	innovation.DepartingSurvivalBonusValidator = innovationDescDepartingSurvivalBonus.Validators[0].(func(int) error)
+	invite.Policy = privacy.NewPolicies(schema.Invite{})
+	invite.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invite.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[2].Descriptor()
+	// invite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invite.DefaultMaxUses = inviteDescMaxUses.Default.(int)
+	// invite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invite.MaxUsesValidator = inviteDescMaxUses.Validators[0].(func(int) error)
+	// inviteDescUses is the schema descriptor for uses field.
+	inviteDescUses := inviteFields[3].Descriptor()
+	// invite.DefaultUses holds the default value on creation for the uses field.
+	invite.DefaultUses = inviteDescUses.Default.(int)
+	// invite.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	invite.UsesValidator = inviteDescUses.Validators[0].(func(int) error)
+	// inviteDescCreatedBy is the schema descriptor for created_by field.
+	inviteDescCreatedBy := inviteFields[6].Descriptor()
+	// invite.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	invite.CreatedByValidator = inviteDescCreatedBy.Validators[0].(func(string) error)
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteFields[7].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	location.Policy = privacy.NewPolicies(schema.Location{})
+	location.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := location.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	locationFields := schema.Location{}.Fields()
+	_ = locationFields
+	// locationDescName is the schema descriptor for name field.
+	locationDescName := locationFields[0].Descriptor()
+	// location.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	location.NameValidator = func() func(string) error {
+		validators := locationDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	membership.Policy = privacy.NewPolicies(schema.Membership{})
+	membership.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := membership.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescUserID is the schema descriptor for user_id field.
+	membershipDescUserID := membershipFields[0].Descriptor()
+	// membership.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	membership.UserIDValidator = membershipDescUserID.Validators[0].(func(string) error)
+	milestone.Policy = privacy.NewPolicies(schema.Milestone{})
+	milestone.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := milestone.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescCreatedAt is the schema descriptor for created_at field.
+	milestoneDescCreatedAt := milestoneFields[2].Descriptor()
+	// milestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	milestone.DefaultCreatedAt = milestoneDescCreatedAt.Default.(func() time.Time)
+	monster.Policy = privacy.NewPolicies(schema.Monster{})
+	monster.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := monster.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	monsterFields := schema.Monster{}.Fields()
+	_ = monsterFields
+	// monsterDescName is the schema descriptor for name field.
+	monsterDescName := monsterFields[0].Descriptor()
+	// monster.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	monster.NameValidator = func() func(string) error {
+		validators := monsterDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// monsterDescLevels is the schema descriptor for levels field.
+	monsterDescLevels := monsterFields[2].Descriptor()
+	// monster.LevelsValidator is a validator for the "levels" field. It is called by the builders before save.
+	monster.LevelsValidator = monsterDescLevels.Validators[0].(func(int) error)
+	monsterprogress.Policy = privacy.NewPolicies(schema.MonsterProgress{})
+	monsterprogress.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := monsterprogress.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	principle.Policy = privacy.NewPolicies(schema.Principle{})
+	principle.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := principle.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	principleFields := schema.Principle{}.Fields()
+	_ = principleFields
+	// principleDescChoice is the schema descriptor for choice field.
+	principleDescChoice := principleFields[1].Descriptor()
+	// principle.ChoiceValidator is a validator for the "choice" field. It is called by the builders before save.
+	principle.ChoiceValidator = func() func(string) error {
+		validators := principleDescChoice.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(choice string) error {
+			for _, fn := range fns {
+				if err := fn(choice); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// principleDescSurvivalLimitBonus is the schema descriptor for survival_limit_bonus field.
+	principleDescSurvivalLimitBonus := principleFields[2].Descriptor()
+	// principle.DefaultSurvivalLimitBonus holds the default value on creation for the survival_limit_bonus field.
+	principle.DefaultSurvivalLimitBonus = principleDescSurvivalLimitBonus.Default.(int)
+	// principle.SurvivalLimitBonusValidator is a validator for the "survival_limit_bonus" field. It is called by the builders before save.
+	principle.SurvivalLimitBonusValidator = principleDescSurvivalLimitBonus.Validators[0].(func(int) error)
+	// principleDescDepartingSurvivalBonus is the schema descriptor for departing_survival_bonus field.
+	principleDescDepartingSurvivalBonus := principleFields[3].Descriptor()
+	// principle.DefaultDepartingSurvivalBonus holds the default value on creation for the departing_survival_bonus field.
+	principle.DefaultDepartingSurvivalBonus = principleDescDepartingSurvivalBonus.Default.(int)
+	// principle.DepartingSurvivalBonusValidator is a validator for the "departing_survival_bonus" field. It is called by the builders before save.
+	principle.DepartingSurvivalBonusValidator = principleDescDepartingSurvivalBonus.Validators[0].(func(int) error)
+	recipe.Policy = privacy.NewPolicies(schema.Recipe{})
+	recipe.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := recipe.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	recipeFields := schema.Recipe{}.Fields()
+	_ = recipeFields
+	// recipeDescName is the schema descriptor for name field.
+	recipeDescName := recipeFields[0].Descriptor()
+	// recipe.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	recipe.NameValidator = func() func(string) error {
+		validators := recipeDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	settlementMixin := schema.Settlement{}.Mixin()
+	settlement.Policy = privacy.NewPolicies(schema.Settlement{})
+	settlement.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := settlement.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	settlementMixinHooks0 := settlementMixin[0].Hooks()
+	settlementHooks := schema.Settlement{}.Hooks()
+
+	settlement.Hooks[1] = settlementMixinHooks0[0]
+
+	settlement.Hooks[2] = settlementHooks[0]
+
+	settlement.Hooks[3] = settlementHooks[1]
+
+	settlement.Hooks[4] = settlementHooks[2]
+
+	settlement.Hooks[5] = settlementHooks[3]
+	settlementMixinInters0 := settlementMixin[0].Interceptors()
+	settlement.Interceptors[0] = settlementMixinInters0[0]
+	settlementFields := schema.Settlement{}.Fields()
+	_ = settlementFields
+	// settlementDescOwner is the schema descriptor for owner field.
+	settlementDescOwner := settlementFields[0].Descriptor()
+	// settlement.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	settlement.OwnerValidator = settlementDescOwner.Validators[0].(func(string) error)
+	// settlementDescName is the schema descriptor for name field.
+	settlementDescName := settlementFields[1].Descriptor()
+	// settlement.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	settlement.NameValidator = func() func(string) error {
+		validators := settlementDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// settlementDescSurvivalLimit is the schema descriptor for survivalLimit field.
+	settlementDescSurvivalLimit := settlementFields[2].Descriptor()
+	// settlement.DefaultSurvivalLimit holds the default value on creation for the survivalLimit field.
+	settlement.DefaultSurvivalLimit = settlementDescSurvivalLimit.Default.(int)
+	// settlement.SurvivalLimitValidator is a validator for the "survivalLimit" field. It is called by the builders before save.
+	settlement.SurvivalLimitValidator = settlementDescSurvivalLimit.Validators[0].(func(int) error)
+	// settlementDescDepartingSurvival is the schema descriptor for departingSurvival field.
+	settlementDescDepartingSurvival := settlementFields[3].Descriptor()
+	// settlement.DefaultDepartingSurvival holds the default value on creation for the departingSurvival field.
+	settlement.DefaultDepartingSurvival = settlementDescDepartingSurvival.Default.(int)
+	// settlement.DepartingSurvivalValidator is a validator for the "departingSurvival" field. It is called by the builders before save.
+	settlement.DepartingSurvivalValidator = settlementDescDepartingSurvival.Validators[0].(func(int) error)
+	// settlementDescCollectiveCognition is the schema descriptor for collectiveCognition field.
+	settlementDescCollectiveCognition := settlementFields[4].Descriptor()
+	// settlement.DefaultCollectiveCognition holds the default value on creation for the collectiveCognition field.
+	settlement.DefaultCollectiveCognition = settlementDescCollectiveCognition.Default.(int)
+	// settlement.CollectiveCognitionValidator is a validator for the "collectiveCognition" field. It is called by the builders before save.
+	settlement.CollectiveCognitionValidator = settlementDescCollectiveCognition.Validators[0].(func(int) error)
+	// settlementDescCurrentYear is the schema descriptor for currentYear field.
+	settlementDescCurrentYear := settlementFields[5].Descriptor()
+	// settlement.DefaultCurrentYear holds the default value on creation for the currentYear field.
+	settlement.DefaultCurrentYear = settlementDescCurrentYear.Default.(int)
+	// settlement.CurrentYearValidator is a validator for the "currentYear" field. It is called by the builders before save.
+	settlement.CurrentYearValidator = settlementDescCurrentYear.Validators[0].(func(int) error)
+	storageitem.Policy = privacy.NewPolicies(schema.StorageItem{})
+	storageitem.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := storageitem.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	storageitemFields := schema.StorageItem{}.Fields()
+	_ = storageitemFields
+	// storageitemDescName is the schema descriptor for name field.
+	storageitemDescName := storageitemFields[0].Descriptor()
+	// storageitem.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	storageitem.NameValidator = func() func(string) error {
+		validators := storageitemDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// storageitemDescSourceMonster is the schema descriptor for source_monster field.
+	storageitemDescSourceMonster := storageitemFields[2].Descriptor()
+	// storageitem.SourceMonsterValidator is a validator for the "source_monster" field. It is called by the builders before save.
+	storageitem.SourceMonsterValidator = func() func(string) error {
+		validators := storageitemDescSourceMonster.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source_monster string) error {
+			for _, fn := range fns {
+				if err := fn(source_monster); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// storageitemDescQuantity is the schema descriptor for quantity field.
+	storageitemDescQuantity := storageitemFields[4].Descriptor()
+	// storageitem.DefaultQuantity holds the default value on creation for the quantity field.
+	storageitem.DefaultQuantity = storageitemDescQuantity.Default.(int)
+	// storageitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	storageitem.QuantityValidator = storageitemDescQuantity.Validators[0].(func(int) error)
+	survivorMixin := schema.Survivor{}.Mixin()
+	survivor.Policy = privacy.NewPolicies(schema.Survivor{})
+	survivor.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := survivor.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	survivorMixinHooks0 := survivorMixin[0].Hooks()
+	survivorHooks := schema.Survivor{}.Hooks()
+
+	survivor.Hooks[1] = survivorMixinHooks0[0]
+
+	survivor.Hooks[2] = survivorHooks[0]
+
+	survivor.Hooks[3] = survivorHooks[1]
+
+	survivor.Hooks[4] = survivorHooks[2]
+	survivorMixinInters0 := survivorMixin[0].Interceptors()
+	survivor.Interceptors[0] = survivorMixinInters0[0]
+	survivorFields := schema.Survivor{}.Fields()
+	_ = survivorFields
+	// survivorDescName is the schema descriptor for name field.
+	survivorDescName := survivorFields[0].Descriptor()
+	// survivor.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	survivor.NameValidator = func() func(string) error {
+		validators := survivorDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// survivorDescBorn is the schema descriptor for born field.
+	survivorDescBorn := survivorFields[1].Descriptor()
+	// survivor.DefaultBorn holds the default value on creation for the born field.
+	survivor.DefaultBorn = survivorDescBorn.Default.(int)
+	// survivor.BornValidator is a validator for the "born" field. It is called by the builders before save.
+	survivor.BornValidator = survivorDescBorn.Validators[0].(func(int) error)
+	// survivorDescHuntxp is the schema descriptor for huntxp field.
+	survivorDescHuntxp := survivorFields[3].Descriptor()
+	// survivor.DefaultHuntxp holds the default value on creation for the huntxp field.
+	survivor.DefaultHuntxp = survivorDescHuntxp.Default.(int)
+	// survivor.HuntxpValidator is a validator for the "huntxp" field. It is called by the builders before save.
+	survivor.HuntxpValidator = survivorDescHuntxp.Validators[0].(func(int) error)
+	// survivorDescSurvival is the schema descriptor for survival field.
+	survivorDescSurvival := survivorFields[4].Descriptor()
+	// survivor.DefaultSurvival holds the default value on creation for the survival field.
+	survivor.DefaultSurvival = survivorDescSurvival.Default.(int)
+	// survivor.SurvivalValidator is a validator for the "survival" field. It is called by the builders before save.
+	survivor.SurvivalValidator = survivorDescSurvival.Validators[0].(func(int) error)
+	// survivorDescMovement is the schema descriptor for movement field.
+	survivorDescMovement := survivorFields[5].Descriptor()
+	// survivor.DefaultMovement holds the default value on creation for the movement field.
+	survivor.DefaultMovement = survivorDescMovement.Default.(int)
+	// survivor.MovementValidator is a validator for the "movement" field. It is called by the builders before save.
+	survivor.MovementValidator = survivorDescMovement.Validators[0].(func(int) error)
+	// survivorDescAccuracy is the schema descriptor for accuracy field.
+	survivorDescAccuracy := survivorFields[6].Descriptor()
+	// survivor.DefaultAccuracy holds the default value on creation for the accuracy field.
+	survivor.DefaultAccuracy = survivorDescAccuracy.Default.(int)
+	// survivor.AccuracyValidator is a validator for the "accuracy" field. It is called by the builders before save.
+	survivor.AccuracyValidator = survivorDescAccuracy.Validators[0].(func(int) error)
+	// survivorDescStrength is the schema descriptor for strength field.
+	survivorDescStrength := survivorFields[7].Descriptor()
+	// survivor.DefaultStrength holds the default value on creation for the strength field.
+	survivor.DefaultStrength = survivorDescStrength.Default.(int)
+	// survivor.StrengthValidator is a validator for the "strength" field. It is called by the builders before save.
+	survivor.StrengthValidator = survivorDescStrength.Validators[0].(func(int) error)
+	// survivorDescEvasion is the schema descriptor for evasion field.
+	survivorDescEvasion := survivorFields[8].Descriptor()
+	// survivor.DefaultEvasion holds the default value on creation for the evasion field.
+	survivor.DefaultEvasion = survivorDescEvasion.Default.(int)
+	// survivor.EvasionValidator is a validator for the "evasion" field. It is called by the builders before save.
+	survivor.EvasionValidator = survivorDescEvasion.Validators[0].(func(int) error)
+	// survivorDescLuck is the schema descriptor for luck field.
+	survivorDescLuck := survivorFields[9].Descriptor()
+	// survivor.DefaultLuck holds the default value on creation for the luck field.
+	survivor.DefaultLuck = survivorDescLuck.Default.(int)
+	// survivor.LuckValidator is a validator for the "luck" field. It is called by the builders before save.
+	survivor.LuckValidator = survivorDescLuck.Validators[0].(func(int) error)
+	// survivorDescSpeed is the schema descriptor for speed field.
+	survivorDescSpeed := survivorFields[10].Descriptor()
+	// survivor.DefaultSpeed holds the default value on creation for the speed field.
+	survivor.DefaultSpeed = survivorDescSpeed.Default.(int)
+	// survivor.SpeedValidator is a validator for the "speed" field. It is called by the builders before save.
+	survivor.SpeedValidator = survivorDescSpeed.Validators[0].(func(int) error)
+	// survivorDescSystemicpressure is the schema descriptor for systemicpressure field.
+	survivorDescSystemicpressure := survivorFields[11].Descriptor()
+	// survivor.DefaultSystemicpressure holds the default value on creation for the systemicpressure field.
+	survivor.DefaultSystemicpressure = survivorDescSystemicpressure.Default.(int)
+	// survivor.SystemicpressureValidator is a validator for the "systemicpressure" field. It is called by the builders before save.
+	survivor.SystemicpressureValidator = survivorDescSystemicpressure.Validators[0].(func(int) error)
+	// survivorDescTorment is the schema descriptor for torment field.
+	survivorDescTorment := survivorFields[12].Descriptor()
+	// survivor.DefaultTorment holds the default value on creation for the torment field.
+	survivor.DefaultTorment = survivorDescTorment.Default.(int)
+	// survivor.TormentValidator is a validator for the "torment" field. It is called by the builders before save.
+	survivor.TormentValidator = survivorDescTorment.Validators[0].(func(int) error)
+	// survivorDescInsanity is the schema descriptor for insanity field.
+	survivorDescInsanity := survivorFields[13].Descriptor()
+	// survivor.DefaultInsanity holds the default value on creation for the insanity field.
+	survivor.DefaultInsanity = survivorDescInsanity.Default.(int)
+	// survivor.InsanityValidator is a validator for the "insanity" field. It is called by the builders before save.
+	survivor.InsanityValidator = survivorDescInsanity.Validators[0].(func(int) error)
+	// survivorDescLumi is the schema descriptor for lumi field.
+	survivorDescLumi := survivorFields[14].Descriptor()
+	// survivor.DefaultLumi holds the default value on creation for the lumi field.
+	survivor.DefaultLumi = survivorDescLumi.Default.(int)
+	// survivor.LumiValidator is a validator for the "lumi" field. It is called by the builders before save.
+	survivor.LumiValidator = survivorDescLumi.Validators[0].(func(int) error)
+	// survivorDescCourage is the schema descriptor for courage field.
+	survivorDescCourage := survivorFields[15].Descriptor()
+	// survivor.DefaultCourage holds the default value on creation for the courage field.
+	survivor.DefaultCourage = survivorDescCourage.Default.(int)
+	// survivor.CourageValidator is a validator for the "courage" field. It is called by the builders before save.
+	survivor.CourageValidator = survivorDescCourage.Validators[0].(func(int) error)
+	// survivorDescUnderstanding is the schema descriptor for understanding field.
+	survivorDescUnderstanding := survivorFields[16].Descriptor()
+	// survivor.DefaultUnderstanding holds the default value on creation for the understanding field.
+	survivor.DefaultUnderstanding = survivorDescUnderstanding.Default.(int)
+	// survivor.UnderstandingValidator is a validator for the "understanding" field. It is called by the builders before save.
+	survivor.UnderstandingValidator = survivorDescUnderstanding.Validators[0].(func(int) error)
+	// survivorDescStatusChangeYear is the schema descriptor for status_change_year field.
+	survivorDescStatusChangeYear := survivorFields[18].Descriptor()
+	// survivor.DefaultStatusChangeYear holds the default value on creation for the status_change_year field.
+	survivor.DefaultStatusChangeYear = survivorDescStatusChangeYear.Default.(int)
+	// survivorDescCannotUseFightingArts is the schema descriptor for cannot_use_fighting_arts field.
+	survivorDescCannotUseFightingArts := survivorFields[19].Descriptor()
+	// survivor.DefaultCannotUseFightingArts holds the default value on creation for the cannot_use_fighting_arts field.
+	survivor.DefaultCannotUseFightingArts = survivorDescCannotUseFightingArts.Default.(bool)
+	timelineevent.Policy = privacy.NewPolicies(schema.TimelineEvent{})
+	timelineevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := timelineevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	timelineeventFields := schema.TimelineEvent{}.Fields()
+	_ = timelineeventFields
+	// timelineeventDescYear is the schema descriptor for year field.
+	timelineeventDescYear := timelineeventFields[0].Descriptor()
+	// timelineevent.YearValidator is a validator for the "year" field. It is called by the builders before save.
+	timelineevent.YearValidator = timelineeventDescYear.Validators[0].(func(int) error)
+	// timelineeventDescName is the schema descriptor for name field.
+	timelineeventDescName := timelineeventFields[2].Descriptor()
+	// timelineevent.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	timelineevent.NameValidator = func() func(string) error {
+		validators := timelineeventDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// timelineeventDescCompleted is the schema descriptor for completed field.
+	timelineeventDescCompleted := timelineeventFields[3].Descriptor()
+	// timelineevent.DefaultCompleted holds the default value on creation for the completed field.
+	timelineevent.DefaultCompleted = timelineeventDescCompleted.Default.(bool)
+}
 
 const (
 	Version = "v0.14.0"                                         // Version of ent codegen.
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Ability is an ability or impairment from the catalog, with the modifiers it
+// makes to the stats of the survivors who have it.
+type Ability struct {
+	ent.Schema
+}
+
+// Fields of the Ability.
+func (Ability) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+		field.Enum("kind").Values("ability", "impairment").Annotations(entgql.OrderField("KIND")),
+		field.Text("rules"),
+		field.JSON("modifiers", []schematype.StatModifier{}).
+			Optional().
+			Annotations(entgql.Type("[StatModifier!]")),
+	}
+}
+
+// Edges of the Ability.
+func (Ability) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("survivors", Survivor.Type).
+			Ref("abilities").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+	}
+}
+
+// Policy of the Ability makes it part of the read-only catalog.
+func (Ability) Policy() ent.Policy {
+	return catalogPolicy()
+}
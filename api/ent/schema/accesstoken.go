@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity, a
+// personal access token scripts use in place of a session.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty().Immutable(),
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Immutable(),
+		field.String("token_hash").Unique().Immutable().Sensitive().Annotations(entgql.Skip()),
+		field.Enum("scope").Values("read", "read_write").Default("read").Immutable(),
+		field.Time("expires_at").Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Policy of the AccessToken keeps tokens private to the user they belong to.
+func (AccessToken) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAccessTokenOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterAccessTokenOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
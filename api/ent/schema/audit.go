@@ -0,0 +1,275 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"github.com/failuretoload/datamonster/config"
+	gen "github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/rule"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// auditedMutation is implemented by the generated mutations of audited entities.
+type auditedMutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *gen.Client
+}
+
+// auditEntry is an audit log row waiting for its mutation to succeed.
+type auditEntry struct {
+	entityID     int
+	settlementID *int
+	changes      []schematype.FieldChange
+}
+
+// audit records every mutation of the entity in the audit log, including the
+// value of each touched field before and after the change. Entries are written
+// in the mutation's transaction, so they're rolled back along with it.
+func audit(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		am, ok := m.(auditedMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			id, _ := am.ID()
+			settlementID, err := settlementOf(ctx, m, nil, id)
+			if err != nil {
+				return nil, err
+			}
+			entry := auditEntry{entityID: id, settlementID: settlementID, changes: createdChanges(m)}
+			return v, writeAudit(ctx, am, entry)
+		}
+		ids, err := am.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Old values have to be read before the mutation runs.
+		bypass := rule.Bypass(ctx)
+		entries := make([]auditEntry, 0, len(ids))
+		for _, id := range ids {
+			stored, err := storedMutation(am, id)
+			if err != nil {
+				return nil, err
+			}
+			changes, err := changedFields(bypass, m, stored)
+			if err != nil {
+				return nil, err
+			}
+			settlementID, err := settlementOf(bypass, m, stored, id)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, auditEntry{entityID: id, settlementID: settlementID, changes: changes})
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if err := writeAudit(ctx, am, entry); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+// Sensitive fields of the audited entities. Changes to them are logged without
+// their values, since every member can read the audit log.
+var (
+	sensitiveSettlementFields = sensitiveFields(Settlement{}.Fields())
+	sensitiveSurvivorFields   = sensitiveFields(Survivor{}.Fields())
+)
+
+func sensitiveFields(fields []ent.Field) map[string]bool {
+	names := map[string]bool{}
+	for _, f := range fields {
+		if d := f.Descriptor(); d.Sensitive {
+			names[d.Name] = true
+		}
+	}
+	return names
+}
+
+// redact drops the values of a change to a sensitive field, keeping only the
+// fact that it changed.
+func redact(m ent.Mutation, change schematype.FieldChange) schematype.FieldChange {
+	var sensitive map[string]bool
+	switch m.(type) {
+	case *gen.SettlementMutation:
+		sensitive = sensitiveSettlementFields
+	case *gen.SurvivorMutation:
+		sensitive = sensitiveSurvivorFields
+	}
+	if sensitive[change.Field] {
+		return schematype.FieldChange{Field: change.Field}
+	}
+	return change
+}
+
+// createdChanges lists the values and edges a create mutation sets.
+func createdChanges(m ent.Mutation) []schematype.FieldChange {
+	var changes []schematype.FieldChange
+	for _, name := range m.Fields() {
+		value, _ := m.Field(name)
+		changes = append(changes, redact(m, schematype.FieldChange{Field: name, After: value}))
+	}
+	for _, name := range m.AddedEdges() {
+		changes = append(changes, schematype.FieldChange{Field: name, After: m.AddedIDs(name)})
+	}
+	return changes
+}
+
+// changedFields compares the fields touched by m with their stored values. A
+// delete reports every field of the stored entity.
+func changedFields(ctx context.Context, m, stored ent.Mutation) ([]schematype.FieldChange, error) {
+	deleted := m.Op().Is(ent.OpDelete | ent.OpDeleteOne)
+	var names []string
+	switch {
+	case deleted:
+		names = storedFields(m)
+	default:
+		names = append(append(m.Fields(), m.ClearedFields()...), m.AddedFields()...)
+	}
+	seen := map[string]bool{}
+	var changes []schematype.FieldChange
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		before, err := stored.OldField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		change := schematype.FieldChange{Field: name, Before: before}
+		if !deleted {
+			change.After = newValue(m, name, before)
+		}
+		changes = append(changes, redact(m, change))
+	}
+	for _, name := range m.RemovedEdges() {
+		changes = append(changes, schematype.FieldChange{Field: name, Before: m.RemovedIDs(name)})
+	}
+	for _, name := range m.AddedEdges() {
+		changes = append(changes, schematype.FieldChange{Field: name, After: m.AddedIDs(name)})
+	}
+	return changes, nil
+}
+
+// newValue is the value field name holds once m is applied.
+func newValue(m ent.Mutation, name string, before ent.Value) ent.Value {
+	if value, ok := m.Field(name); ok {
+		return value
+	}
+	if delta, ok := m.AddedField(name); ok {
+		if old, ok := before.(int); ok {
+			if add, ok := delta.(int); ok {
+				return old + add
+			}
+		}
+		return delta
+	}
+	// The field was cleared.
+	return nil
+}
+
+// storedMutation returns an update mutation for the stored entity id. It is
+// only used to read the entity's current values through OldField.
+func storedMutation(m auditedMutation, id int) (ent.Mutation, error) {
+	switch m.(type) {
+	case *gen.SettlementMutation:
+		return m.Client().Settlement.UpdateOneID(id).Mutation(), nil
+	case *gen.SurvivorMutation:
+		return m.Client().Survivor.UpdateOneID(id).Mutation(), nil
+	default:
+		return nil, fmt.Errorf("unexpected mutation type %T", m)
+	}
+}
+
+// storedFields lists the fields a delete of m's entity type reports.
+func storedFields(m ent.Mutation) []string {
+	var columns []string
+	switch m.(type) {
+	case *gen.SettlementMutation:
+		columns = settlement.Columns
+	case *gen.SurvivorMutation:
+		columns = survivor.Columns
+	}
+	fields := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column != "id" {
+			fields = append(fields, column)
+		}
+	}
+	return fields
+}
+
+// settlementOf returns the settlement an audited entity belongs to, so the
+// entry shows up in that settlement's audit log. Survivors moved to another
+// settlement are logged with the settlement they moved to.
+func settlementOf(ctx context.Context, m, stored ent.Mutation, id int) (*int, error) {
+	if _, ok := m.(*gen.SettlementMutation); ok {
+		// Entries can't point at a settlement that is deleted for good.
+		if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			return nil, nil
+		}
+		return &id, nil
+	}
+	value, ok := m.Field(survivor.FieldSettlementID)
+	if !ok && stored != nil {
+		old, err := stored.OldField(ctx, survivor.FieldSettlementID)
+		if err != nil {
+			return nil, err
+		}
+		value = old
+	}
+	// Survivors without a settlement store a zero id.
+	if settlementID, ok := value.(int); ok && settlementID != 0 {
+		return &settlementID, nil
+	}
+	return nil, nil
+}
+
+func writeAudit(ctx context.Context, m auditedMutation, entry auditEntry) error {
+	actor, _ := config.UserID(ctx)
+	// The audit log can't be written through its own policy.
+	return m.Client().AuditLog.Create().
+		SetActor(actor).
+		SetOperation(auditOperation(m)).
+		SetEntityType(m.Type()).
+		SetEntityID(entry.entityID).
+		SetChanges(entry.changes).
+		SetRequestID(middleware.GetReqID(ctx)).
+		SetNillableSettlementID(entry.settlementID).
+		Exec(rule.Bypass(ctx))
+}
+
+// auditOperation names the mutation's operation. Soft deletes and restores
+// are updates of deleted_at, but are logged as what they mean.
+func auditOperation(m ent.Mutation) auditlog.Operation {
+	_, softDeleted := m.Field(deletedAt)
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return auditlog.OperationCreate
+	case m.Op().Is(ent.OpDelete|ent.OpDeleteOne) || softDeleted:
+		return auditlog.OperationDelete
+	case m.FieldCleared(deletedAt):
+		return auditlog.OperationRestore
+	default:
+		return auditlog.OperationUpdate
+	}
+}
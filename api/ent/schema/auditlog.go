@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity. Entries are
+// written by the audit hook and never change afterwards.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor").Immutable(),
+		field.Enum("operation").Values("create", "update", "delete", "restore").Immutable(),
+		field.String("entity_type").Immutable(),
+		field.Int("entity_id").Immutable(),
+		field.JSON("changes", []schematype.FieldChange{}).
+			Immutable().
+			Annotations(entgql.Type("[AuditChange!]")),
+		field.String("request_id").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entgql.OrderField("CREATED_AT")),
+		field.Int("settlement_id").Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("audit_log").
+			Unique().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "created_at"),
+	}
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
+
+// Policy of the AuditLog lets settlement members read their settlement's log.
+// Nobody may write it directly, the audit hook bypasses the policy.
+func (AuditLog) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterAuditLogMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
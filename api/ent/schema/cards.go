@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"github.com/failuretoload/datamonster/apperr"
+	gen "github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/hook"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+const (
+	// maxFightingArts counts secret fighting arts as well.
+	maxFightingArts = 3
+	maxDisorders    = 3
+)
+
+// limitCards keeps survivors within the card limits of the rules. Limits are
+// checked against the cards held once the mutation applies, so swapping a card
+// for another at the limit is allowed.
+func limitCards(next ent.Mutator) ent.Mutator {
+	return hook.SurvivorFunc(func(ctx context.Context, m *gen.SurvivorMutation) (ent.Value, error) {
+		arts := len(m.FightingArtsIDs()) + len(m.SecretFightingArtsIDs())
+		if arts == 0 && len(m.DisordersIDs()) == 0 {
+			return next.Mutate(ctx, m)
+		}
+		ids := []int{0}
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = m.IDs(rule.Bypass(ctx)); err != nil {
+				return nil, err
+			}
+		}
+		for _, id := range ids {
+			if err := checkCardLimits(rule.Bypass(ctx), m, id); err != nil {
+				return nil, err
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// checkCardLimits counts the cards survivor id holds once m applies. Survivors
+// being created hold no cards yet and are passed as id 0.
+func checkCardLimits(ctx context.Context, m *gen.SurvivorMutation, id int) error {
+	stored := m.Client().Survivor.Query().Where(survivor.ID(id))
+	var arts, secret, disorders []int
+	if id != 0 {
+		var err error
+		if arts, err = stored.Clone().QueryFightingArts().IDs(ctx); err != nil {
+			return err
+		}
+		if secret, err = stored.Clone().QuerySecretFightingArts().IDs(ctx); err != nil {
+			return err
+		}
+		if disorders, err = stored.Clone().QueryDisorders().IDs(ctx); err != nil {
+			return err
+		}
+	}
+	held := heldCards(arts, m.FightingArtsIDs(), m.RemovedFightingArtsIDs()) +
+		heldCards(secret, m.SecretFightingArtsIDs(), m.RemovedSecretFightingArtsIDs())
+	if held > maxFightingArts {
+		return apperr.BadUserInput(fmt.Sprintf("a survivor can have at most %d fighting arts, secret ones included", maxFightingArts))
+	}
+	if heldCards(disorders, m.DisordersIDs(), m.RemovedDisordersIDs()) > maxDisorders {
+		return apperr.BadUserInput(fmt.Sprintf("a survivor can have at most %d disorders", maxDisorders))
+	}
+	return nil
+}
+
+// heldCards counts the cards of an edge once removed and added are applied.
+func heldCards(current, added, removed []int) int {
+	held := make(map[int]bool, len(current)+len(added))
+	for _, id := range current {
+		held[id] = true
+	}
+	for _, id := range removed {
+		delete(held, id)
+	}
+	for _, id := range added {
+		held[id] = true
+	}
+	return len(held)
+}
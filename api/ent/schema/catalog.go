@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// catalogPolicy shares catalog entries with every signed in user. The catalog
+// is only written by catalog.Seed, which bypasses the policy.
+func catalogPolicy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
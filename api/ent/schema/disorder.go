@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Disorder is a disorder card from the catalog.
+type Disorder struct {
+	ent.Schema
+}
+
+// Fields of the Disorder.
+func (Disorder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+		field.Text("rules"),
+	}
+}
+
+// Edges of the Disorder.
+func (Disorder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("survivors", Survivor.Type).
+			Ref("disorders").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+	}
+}
+
+// Policy of the Disorder makes it part of the read-only catalog.
+func (Disorder) Policy() ent.Policy {
+	return catalogPolicy()
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// FightingArt is a fighting art card from the catalog. Secret fighting arts
+// are granted through their own edge but count towards the same limit.
+type FightingArt struct {
+	ent.Schema
+}
+
+// Fields of the FightingArt.
+func (FightingArt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+		field.Text("rules"),
+		field.Bool("secret").Default(false),
+	}
+}
+
+// Edges of the FightingArt.
+func (FightingArt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("survivors", Survivor.Type).
+			Ref("fighting_arts").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		edge.From("secret_survivors", Survivor.Type).
+			Ref("secret_fighting_arts").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+	}
+}
+
+// Policy of the FightingArt makes it part of the read-only catalog.
+func (FightingArt) Policy() ent.Policy {
+	return catalogPolicy()
+}
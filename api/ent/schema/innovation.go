@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/hook"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Innovation is an innovation a settlement has adopted, such as Language or
+// Ammonia. Its consequences are the innovations it adds to the innovation
+// deck, and its bonuses count towards the settlement's survival limit and the
+// survival survivors depart with.
+type Innovation struct {
+	ent.Schema
+}
+
+// Fields of the Innovation.
+func (Innovation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Immutable().Annotations(entgql.OrderField("NAME")),
+		field.Strings("consequences").Optional(),
+		field.Int("survival_limit_bonus").Validate(schematype.Range(0, 10)).Default(0),
+		field.Int("departing_survival_bonus").Validate(schematype.Range(0, 10)).Default(0),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the Innovation.
+func (Innovation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("innovations").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (Innovation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "name").Unique(),
+	}
+}
+
+// Hooks of the Innovation.
+func (Innovation) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(innovationMilestones, ent.OpCreate),
+	}
+}
+
+func (Innovation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Mutations(entgql.MutationCreate()),
+	}
+}
+
+// Policy of the Innovation shares the settlement's innovations with its members
+// and lets editors change them.
+func (Innovation) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfInnovationEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterInnovationMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
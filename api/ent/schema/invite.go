@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Invite holds the schema definition for the Invite entity. Redeeming the
+// invite's code through joinSettlement grants its role on the settlement.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").Unique().Immutable().Sensitive().Annotations(entgql.Skip()),
+		field.Enum("role").Values("owner", "editor", "viewer").Default("viewer").Immutable(),
+		field.Int("max_uses").Validate(schematype.Min(1)).Default(1).Immutable(),
+		field.Int("uses").NonNegative().Default(0),
+		field.Time("expires_at").Immutable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.String("created_by").NotEmpty().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the Invite.
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("invites").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+// Policy of the Invite keeps invites visible and manageable by settlement owners only.
+func (Invite) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfInviteOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterInviteOwner(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Location is a settlement location from the catalog, such as the Bone Smith.
+// Settlements build locations to craft the location's recipes.
+type Location struct {
+	ent.Schema
+}
+
+// Fields of the Location.
+func (Location) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+	}
+}
+
+// Edges of the Location.
+func (Location) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("recipes", Recipe.Type),
+		edge.From("settlements", Settlement.Type).
+			Ref("locations").
+			Annotations(entgql.Skip(entgql.SkipAll)),
+	}
+}
+
+// Policy of the Location makes it part of the read-only catalog.
+func (Location) Policy() ent.Policy {
+	return catalogPolicy()
+}
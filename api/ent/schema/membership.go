@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Membership grants a user a role on a settlement.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty(),
+		field.Enum("role").Values("owner", "editor", "viewer").Default("viewer"),
+		field.Int("settlement_id"),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("members").
+			Unique().
+			Required().
+			Field("settlement_id"),
+	}
+}
+
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "user_id").Unique(),
+	}
+}
+
+// Policy of the Membership lets members see who else is in the campaign and
+// leaves managing collaborators to owners.
+func (Membership) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfMembershipOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMembershipMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Milestone records a settlement milestone, such as the first child being
+// born, and the lantern year it was reached. Milestones are only written by
+// the milestone hooks, which also queue the milestone's story event.
+type Milestone struct {
+	ent.Schema
+}
+
+// Fields of the Milestone.
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("name").
+			Values("first_child_born", "first_death", "population_15", "five_innovations", "population_0").
+			Immutable(),
+		field.Int("year").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the Milestone.
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("milestones").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "name").Unique(),
+	}
+}
+
+// Policy of the Milestone shares a settlement's milestones with its members.
+// They are only written by the milestone hooks.
+func (Milestone) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMilestoneMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	gen "github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/hook"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// milestoneStories lists the milestones in the order they are checked, with
+// the story event each one queues on the timeline.
+var milestoneStories = []struct {
+	name  milestone.Name
+	story string
+}{
+	{milestone.NameFirstChildBorn, "Principle: New Life"},
+	{milestone.NameFirstDeath, "Principle: Death"},
+	{milestone.NamePopulation15, "Principle: Society"},
+	{milestone.NameFiveInnovations, "Hooded Knight"},
+	{milestone.NamePopulation0, "Game Over"},
+}
+
+// survivorMilestones checks the milestones of the settlements whose population
+// changed: a survivor was created, changed status or moved in. Survivors
+// created with a birth year after year 0 are children born to the settlement.
+func survivorMilestones(next ent.Mutator) ent.Mutator {
+	return hook.SurvivorFunc(func(ctx context.Context, m *gen.SurvivorMutation) (ent.Value, error) {
+		_, status := m.Status()
+		_, moved := m.SettlementID()
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			s := v.(*gen.Survivor)
+			if s.SettlementID == 0 {
+				return v, nil
+			}
+			return v, reachMilestones(ctx, m.Client(), s.SettlementID, s.Born > 0)
+		}
+		if !status && !moved {
+			return next.Mutate(ctx, m)
+		}
+		ids, err := m.IDs(rule.Bypass(ctx))
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		settlementIDs, err := m.Client().Survivor.Query().
+			Where(survivor.IDIn(ids...), survivor.HasSettlement()).
+			Unique(true).
+			Select(survivor.FieldSettlementID).
+			Ints(rule.Bypass(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range settlementIDs {
+			if err := reachMilestones(ctx, m.Client(), id, false); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	})
+}
+
+// settlementMilestones checks the milestones of a settlement that took in
+// survivors through its population edge, as createSettlement does. Survivors
+// without a settlement yet were just created for it, and those born after
+// year 0 are its children.
+func settlementMilestones(next ent.Mutator) ent.Mutator {
+	return hook.SettlementFunc(func(ctx context.Context, m *gen.SettlementMutation) (ent.Value, error) {
+		added := m.PopulationIDs()
+		if len(added) == 0 {
+			return next.Mutate(ctx, m)
+		}
+		childBorn, err := m.Client().Survivor.Query().
+			Where(survivor.IDIn(added...), survivor.Not(survivor.HasSettlement()), survivor.BornGT(0)).
+			Exist(rule.Bypass(ctx))
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := v.(*gen.Settlement)
+		if !ok {
+			return v, nil
+		}
+		return v, reachMilestones(ctx, m.Client(), s.ID, childBorn)
+	})
+}
+
+// innovationMilestones checks the milestones of a settlement that innovated.
+func innovationMilestones(next ent.Mutator) ent.Mutator {
+	return hook.InnovationFunc(func(ctx context.Context, m *gen.InnovationMutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		return v, reachMilestones(ctx, m.Client(), v.(*gen.Innovation).SettlementID, false)
+	})
+}
+
+// reachMilestones records the milestones the settlement meets and hasn't
+// reached yet, and queues their story events for the settlement's current
+// lantern year. childBorn tells whether the mutation was a birth.
+func reachMilestones(ctx context.Context, c *gen.Client, settlementID int, childBorn bool) error {
+	// Milestones are reached on behalf of the settlement, not the caller.
+	ctx = rule.Bypass(ctx)
+	reached, err := c.Milestone.Query().Where(milestone.SettlementID(settlementID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	done := map[milestone.Name]bool{}
+	for _, r := range reached {
+		done[r.Name] = true
+	}
+	population := c.Survivor.Query().Where(survivor.SettlementID(settlementID))
+	total, err := population.Clone().Count(ctx)
+	if err != nil {
+		return err
+	}
+	living, err := population.Clone().
+		Where(survivor.StatusNotIn(survivor.StatusDead, survivor.StatusCeasedToExist)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	died, err := population.Clone().Where(survivor.StatusEQ(survivor.StatusDead)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	innovations, err := c.Innovation.Query().Where(innovation.SettlementID(settlementID)).Count(ctx)
+	if err != nil {
+		return err
+	}
+	met := map[milestone.Name]bool{
+		milestone.NameFirstChildBorn:  childBorn,
+		milestone.NameFirstDeath:      died,
+		milestone.NamePopulation15:    living >= 15,
+		milestone.NameFiveInnovations: innovations >= 5,
+		milestone.NamePopulation0:     total > 0 && living == 0,
+	}
+	year := -1
+	for _, m := range milestoneStories {
+		if done[m.name] || !met[m.name] {
+			continue
+		}
+		if year < 0 {
+			s, err := c.Settlement.Get(ctx, settlementID)
+			if err != nil {
+				return err
+			}
+			year = s.CurrentYear
+		}
+		err := c.Milestone.Create().
+			SetSettlementID(settlementID).
+			SetName(m.name).
+			SetYear(year).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = c.TimelineEvent.Create().
+			SetSettlementID(settlementID).
+			SetYear(year).
+			SetType(timelineevent.TypeStoryEvent).
+			SetName(m.story).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
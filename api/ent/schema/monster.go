@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Monster is a quarry or nemesis from the catalog, with the number of levels
+// it can be fought at.
+type Monster struct {
+	ent.Schema
+}
+
+// Fields of the Monster.
+func (Monster) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+		field.Enum("kind").Values("quarry", "nemesis"),
+		field.Int("levels").Validate(schematype.Range(1, 4)),
+	}
+}
+
+// Policy of the Monster makes it part of the read-only catalog.
+func (Monster) Policy() ent.Policy {
+	return catalogPolicy()
+}
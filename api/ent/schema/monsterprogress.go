@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// MonsterProgress is a settlement's progress against one monster: the
+// lantern year it was unlocked and every level defeated since.
+type MonsterProgress struct {
+	ent.Schema
+}
+
+// Fields of the MonsterProgress.
+func (MonsterProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("unlocked_year"),
+		field.JSON("defeats", []schematype.Defeat{}).
+			Optional().
+			Annotations(entgql.Type("[Defeat!]")),
+		field.Int("settlement_id").Immutable(),
+		field.Int("monster_id").Immutable(),
+	}
+}
+
+// Edges of the MonsterProgress.
+func (MonsterProgress) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("monsters").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+		edge.To("monster", Monster.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("monster_id"),
+	}
+}
+
+func (MonsterProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "monster_id").Unique(),
+	}
+}
+
+// Policy of the MonsterProgress shares the settlement's progress with its
+// members and lets editors record it.
+func (MonsterProgress) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfMonsterProgressEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterMonsterProgressMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// Principle records the settlement's choice for one of the four principles,
+// such as Cannibalize or Graves for Death. Each principle is chosen once.
+type Principle struct {
+	ent.Schema
+}
+
+// Fields of the Principle.
+func (Principle) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("name").Values("new_life", "death", "society", "conviction").Immutable(),
+		field.String("choice").Validate(schematype.Length(1, 50)).MaxLen(50).Immutable(),
+		field.Int("survival_limit_bonus").Validate(schematype.Range(0, 10)).Default(0).Immutable(),
+		field.Int("departing_survival_bonus").Validate(schematype.Range(0, 10)).Default(0).Immutable(),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the Principle.
+func (Principle) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("principles").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (Principle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "name").Unique(),
+	}
+}
+
+func (Principle) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Mutations(entgql.MutationCreate()),
+	}
+}
+
+// Policy of the Principle shares the settlement's principles with its members
+// and lets editors choose them.
+func (Principle) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPrincipleEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterPrincipleMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
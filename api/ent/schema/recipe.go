@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// Recipe is a catalog entry for a piece of gear a location crafts and the
+// resources crafting it consumes.
+type Recipe struct {
+	ent.Schema
+}
+
+// Fields of the Recipe.
+func (Recipe) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Unique().Annotations(entgql.OrderField("NAME")),
+		field.Strings("keywords").Optional(),
+		field.JSON("costs", []schematype.ResourceCost{}).
+			Annotations(entgql.Type("[ResourceCost!]")),
+		field.Int("location_id"),
+	}
+}
+
+// Edges of the Recipe.
+func (Recipe) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("recipes").
+			Unique().
+			Required().
+			Field("location_id"),
+	}
+}
+
+// Policy of the Recipe makes it part of the read-only catalog.
+func (Recipe) Policy() ent.Policy {
+	return catalogPolicy()
+}
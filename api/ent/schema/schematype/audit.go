@@ -0,0 +1,10 @@
+// Package schematype holds Go types stored in JSON fields of the ent schemas.
+package schematype
+
+// FieldChange is the value of one field or edge before and after a mutation.
+// Edges record the ids that were removed and added.
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
@@ -0,0 +1,9 @@
+package schematype
+
+// ResourceCost is one resource a recipe consumes: quantity resources named
+// Name, or when Name is empty, quantity resources of any name carrying Keyword.
+type ResourceCost struct {
+	Name     string `json:"name,omitempty"`
+	Keyword  string `json:"keyword,omitempty"`
+	Quantity int    `json:"quantity"`
+}
@@ -0,0 +1,8 @@
+package schematype
+
+// Defeat is a monster level a settlement defeated and the lantern year it
+// happened in.
+type Defeat struct {
+	Level int `json:"level"`
+	Year  int `json:"year"`
+}
@@ -0,0 +1,74 @@
+package schematype
+
+import "fmt"
+
+// Rule names the bound a LimitError reports.
+type Rule string
+
+const (
+	RuleMin       Rule = "min"
+	RuleMax       Rule = "max"
+	RuleMinLength Rule = "minLength"
+	RuleMaxLength Rule = "maxLength"
+)
+
+// LimitError is returned by the validators below when a value is outside its
+// field's bounds. Unlike ent's built-in validators it carries the limit, so
+// clients can tell the user what is allowed.
+type LimitError struct {
+	Rule  Rule
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	switch e.Rule {
+	case RuleMin:
+		return fmt.Sprintf("must be at least %d", e.Limit)
+	case RuleMax:
+		return fmt.Sprintf("must be at most %d", e.Limit)
+	case RuleMinLength:
+		if e.Limit == 1 {
+			return "must not be empty"
+		}
+		return fmt.Sprintf("must be at least %d characters long", e.Limit)
+	default:
+		return fmt.Sprintf("must be at most %d characters long", e.Limit)
+	}
+}
+
+// Min validates that an int is at least min.
+func Min(min int) func(int) error {
+	return func(v int) error {
+		if v < min {
+			return &LimitError{Rule: RuleMin, Limit: min}
+		}
+		return nil
+	}
+}
+
+// Range validates that an int is between min and max, inclusive.
+func Range(min, max int) func(int) error {
+	return func(v int) error {
+		if v < min {
+			return &LimitError{Rule: RuleMin, Limit: min}
+		}
+		if v > max {
+			return &LimitError{Rule: RuleMax, Limit: max}
+		}
+		return nil
+	}
+}
+
+// Length validates that a string is between min and max bytes long, the way
+// ent's MaxLen counts. Keep MaxLen on the field as well so the column is sized.
+func Length(min, max int) func(string) error {
+	return func(v string) error {
+		if len(v) < min {
+			return &LimitError{Rule: RuleMinLength, Limit: min}
+		}
+		if len(v) > max {
+			return &LimitError{Rule: RuleMaxLength, Limit: max}
+		}
+		return nil
+	}
+}
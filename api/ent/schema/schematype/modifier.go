@@ -0,0 +1,20 @@
+package schematype
+
+// Stats a StatModifier can change.
+const (
+	StatMovement = "movement"
+	StatAccuracy = "accuracy"
+	StatStrength = "strength"
+	StatEvasion  = "evasion"
+	StatLuck     = "luck"
+	StatSpeed    = "speed"
+)
+
+// StatModifier is a change an ability or impairment makes to one of a
+// survivor's stats. Modifiers with a Condition only apply while it holds, so
+// they are listed but left out of the survivor's effective stats.
+type StatModifier struct {
+	Stat      string `json:"stat"`
+	Amount    int    `json:"amount"`
+	Condition string `json:"condition,omitempty"`
+}
@@ -1,11 +1,21 @@
 package schema
 
 import (
+	"context"
+
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/catalog"
+	gen "github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/hook"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
 )
 
 // Settlement holds the schema definition for the Settlement entity.
@@ -13,15 +23,23 @@ type Settlement struct {
 	ent.Schema
 }
 
+// Mixin of the Settlement.
+func (Settlement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Settlement.
 func (Settlement) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("owner").NotEmpty().Annotations(entgql.OrderField("OWNER")),
-		field.String("name").MaxLen(50).NotEmpty().Annotations(entgql.OrderField("NAME")),
-		field.Int("survivalLimit").Min(0).Default(0).Annotations(entgql.OrderField("SURVIVAL_LIMIT")),
-		field.Int("departingSurvival").Min(0).Default(0).Annotations(entgql.OrderField("DEPARTING_SURVIVAL")),
-		field.Int("collectiveCognition").Min(0).Max(50).Default(0).Annotations(entgql.OrderField("COLLECTIVE_COGNITION")),
-		field.Int("currentYear").Min(0).Max(35).Default(0).Annotations(entgql.OrderField("CURRENT_YEAR")),
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Annotations(entgql.OrderField("NAME")),
+		field.Int("survivalLimit").Validate(schematype.Min(0)).Default(0).Annotations(entgql.OrderField("SURVIVAL_LIMIT")),
+		field.Int("departingSurvival").Validate(schematype.Min(0)).Default(0).Annotations(entgql.OrderField("DEPARTING_SURVIVAL")),
+		field.Int("collectiveCognition").Validate(schematype.Range(0, 50)).Default(0).Annotations(entgql.OrderField("COLLECTIVE_COGNITION")),
+		field.Int("currentYear").Validate(schematype.Range(0, 35)).Default(0).Annotations(entgql.OrderField("CURRENT_YEAR")),
+		field.String("share_token_hash").Optional().Nillable().Unique().Sensitive().Annotations(entgql.Skip()),
 	}
 }
 
@@ -29,11 +47,95 @@ func (Settlement) Fields() []ent.Field {
 func (Settlement) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("population", Survivor.Type),
+		edge.To("members", Membership.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("invites", Invite.Type).
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		edge.To("audit_log", AuditLog.Type).
+			Annotations(entgql.Skip(entgql.SkipAll)),
+		edge.To("timeline", TimelineEvent.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("storage", StorageItem.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("innovations", Innovation.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("principles", Principle.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("milestones", Milestone.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("locations", Location.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("monsters", MonsterProgress.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// Hooks of the Settlement.
+func (Settlement) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(addOwnerMembership, ent.OpCreate),
+		hook.On(buildLanternHoard, ent.OpCreate),
+		audit,
+		settlementMilestones,
 	}
 }
 
+// addOwnerMembership records the creator as the settlement's first owner.
+func addOwnerMembership(next ent.Mutator) ent.Mutator {
+	return hook.SettlementFunc(func(ctx context.Context, m *gen.SettlementMutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		s := v.(*gen.Settlement)
+		err = m.Client().Membership.Create().
+			SetSettlementID(s.ID).
+			SetUserID(s.Owner).
+			SetRole(membership.RoleOwner).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+}
+
+// buildLanternHoard builds the Lantern Hoard of a new settlement, every
+// settlement is founded around one. Nothing is built before the catalog is seeded.
+func buildLanternHoard(next ent.Mutator) ent.Mutator {
+	return hook.SettlementFunc(func(ctx context.Context, m *gen.SettlementMutation) (ent.Value, error) {
+		hoard, err := m.Client().Location.Query().
+			Where(location.Name(catalog.LanternHoard)).
+			OnlyID(rule.Bypass(ctx))
+		switch {
+		case gen.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		default:
+			m.AddLocationIDs(hoard)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
 func (Settlement) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 	}
 }
+
+// Policy of the Settlement restricts access to the settlement's members.
+func (Settlement) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfSettlementEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterSettlementMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
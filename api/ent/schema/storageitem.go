@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// StorageItem is a line item in a settlement's storage: a stack of one
+// resource or piece of gear. Quantities change through addToStorage and
+// removeFromStorage, which never let the stock go negative.
+type StorageItem struct {
+	ent.Schema
+}
+
+// Fields of the StorageItem.
+func (StorageItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Immutable().Annotations(entgql.OrderField("NAME")),
+		field.Enum("category").
+			Values("basic_resource", "monster_resource", "strange_resource", "vermin_resource", "gear").
+			Immutable().
+			Annotations(entgql.OrderField("CATEGORY")),
+		field.String("source_monster").Validate(schematype.Length(0, 50)).MaxLen(50).Optional(),
+		field.Strings("keywords").Optional(),
+		field.Int("quantity").Validate(schematype.Min(0)).Default(0).Annotations(entgql.OrderField("QUANTITY")),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the StorageItem.
+func (StorageItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("storage").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (StorageItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "category", "name").Unique(),
+	}
+}
+
+// Policy of the StorageItem shares the storage with the settlement's members
+// and lets editors change it.
+func (StorageItem) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStorageEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterStorageMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
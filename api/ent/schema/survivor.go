@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
 )
 
 // Survivor holds the schema definition for the Survivor entity.
@@ -13,27 +16,35 @@ type Survivor struct {
 	ent.Schema
 }
 
+// Mixin of the Survivor.
+func (Survivor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 func (Survivor) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name").MaxLen(50).MinLen(1).Annotations(entgql.OrderField("NAME")),
-		field.Int("born").NonNegative().Default(0).Max(35).Annotations(entgql.OrderField("BORN")),
+		field.String("name").Validate(schematype.Length(1, 50)).MaxLen(50).Annotations(entgql.OrderField("NAME")),
+		field.Int("born").Validate(schematype.Range(0, 35)).Default(0).Annotations(entgql.OrderField("BORN")),
 		field.Enum("gender").NamedValues("male", "M", "female", "F").Default("F").Annotations(entgql.OrderField("GENDER")),
-		field.Int("huntxp").NonNegative().Max(16).Default(0).Annotations(entgql.OrderField("HUNTXP")),
-		field.Int("survival").NonNegative().Max(50).Default(0).Annotations(entgql.OrderField("SURVVAL")),
-		field.Int("movement").Min(-20).Max(20).Default(5).Annotations(entgql.OrderField("MOVEMENT")),
-		field.Int("accuracy").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("ACCURACY")),
-		field.Int("strength").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("STRENGTH")),
-		field.Int("evasion").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("EVASION")),
-		field.Int("luck").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("LUCK")),
-		field.Int("speed").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("SPEED")),
-		field.Int("systemicpressure").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("SYSTEMICPRESSURE")),
-		field.Int("torment").Min(-20).Max(20).Default(0).Annotations(entgql.OrderField("TORMENT")),
-		field.Int("insanity").Min(0).Default(0).Annotations(entgql.OrderField("INSANITY")),
-		field.Int("lumi").Min(0).Default(0).Annotations(entgql.OrderField("LUMI")),
-		field.Int("courage").Min(0).Max(9).Default(0).Annotations(entgql.OrderField("CURRENCY")),
-		field.Int("understanding").Min(0).Max(9).Default(0).Annotations(entgql.OrderField("UNDERSTANDING")),
+		field.Int("huntxp").Validate(schematype.Range(0, 16)).Default(0).Annotations(entgql.OrderField("HUNTXP")),
+		field.Int("survival").Validate(schematype.Range(0, 50)).Default(0).Annotations(entgql.OrderField("SURVVAL")),
+		field.Int("movement").Validate(schematype.Range(-20, 20)).Default(5).Annotations(entgql.OrderField("MOVEMENT")),
+		field.Int("accuracy").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("ACCURACY")),
+		field.Int("strength").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("STRENGTH")),
+		field.Int("evasion").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("EVASION")),
+		field.Int("luck").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("LUCK")),
+		field.Int("speed").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("SPEED")),
+		field.Int("systemicpressure").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("SYSTEMICPRESSURE")),
+		field.Int("torment").Validate(schematype.Range(-20, 20)).Default(0).Annotations(entgql.OrderField("TORMENT")),
+		field.Int("insanity").Validate(schematype.Min(0)).Default(0).Annotations(entgql.OrderField("INSANITY")),
+		field.Int("lumi").Validate(schematype.Min(0)).Default(0).Annotations(entgql.OrderField("LUMI")),
+		field.Int("courage").Validate(schematype.Range(0, 9)).Default(0).Annotations(entgql.OrderField("CURRENCY")),
+		field.Int("understanding").Validate(schematype.Range(0, 9)).Default(0).Annotations(entgql.OrderField("UNDERSTANDING")),
 		field.Enum("status").Values("alive", "dead", "ceased_to_exist", "retired", "skip_hunt").Default("alive").Annotations(entgql.OrderField("STATUS")),
 		field.Int("status_change_year").Default(0).Annotations(entgql.OrderField("STATUS_CHANGE_YEAR")),
+		field.Bool("cannot_use_fighting_arts").Default(false),
 		field.Int("settlement_id").Optional().Annotations(entgql.OrderField("SETTLEMENTID")),
 	}
 }
@@ -43,6 +54,23 @@ func (Survivor) Edges() []ent.Edge {
 		edge.From("settlement", Settlement.Type).
 			Ref("population").
 			Unique().Field("settlement_id"),
+		edge.To("fighting_arts", FightingArt.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("secret_fighting_arts", FightingArt.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("disorders", Disorder.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		edge.To("abilities", Ability.Type).
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+	}
+}
+
+// Hooks of the Survivor.
+func (Survivor) Hooks() []ent.Hook {
+	return []ent.Hook{
+		audit,
+		survivorMilestones,
+		limitCards,
 	}
 }
 
@@ -51,3 +79,19 @@ func (Survivor) Annotations() []schema.Annotation {
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 	}
 }
+
+// Policy of the Survivor restricts access to members of the survivor's settlement.
+func (Survivor) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfSurvivorEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterSurvivorMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// TimelineEvent is an event planned on a settlement's timeline for a lantern
+// year. Events are checked off once they have been played.
+type TimelineEvent struct {
+	ent.Schema
+}
+
+// Fields of the TimelineEvent.
+func (TimelineEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("year").Validate(schematype.Range(0, 35)).Annotations(entgql.OrderField("YEAR")),
+		field.Enum("type").
+			Values("story_event", "settlement_event", "showdown", "nemesis_encounter", "special_showdown").
+			Annotations(entgql.OrderField("TYPE")),
+		field.String("name").Validate(schematype.Length(1, 100)).MaxLen(100).Annotations(entgql.OrderField("NAME")),
+		field.Bool("completed").Default(false),
+		field.Int("settlement_id").Immutable(),
+	}
+}
+
+// Edges of the TimelineEvent.
+func (TimelineEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("settlement", Settlement.Type).
+			Ref("timeline").
+			Unique().
+			Required().
+			Immutable().
+			Field("settlement_id"),
+	}
+}
+
+func (TimelineEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("settlement_id", "year"),
+	}
+}
+
+func (TimelineEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Mutations(entgql.MutationCreate()),
+	}
+}
+
+// Policy of the TimelineEvent shares the timeline with the settlement's members
+// and lets editors change it.
+func (TimelineEvent) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfTimelineEditor(),
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.DenyIfNoViewer(),
+			rule.FilterTimelineMember(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
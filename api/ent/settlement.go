@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -16,6 +17,8 @@ type Settlement struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Owner holds the value of the "owner" field.
 	Owner string `json:"owner,omitempty"`
 	// Name holds the value of the "name" field.
@@ -28,6 +31,8 @@ type Settlement struct {
 	CollectiveCognition int `json:"collectiveCognition,omitempty"`
 	// CurrentYear holds the value of the "currentYear" field.
 	CurrentYear int `json:"currentYear,omitempty"`
+	// ShareTokenHash holds the value of the "share_token_hash" field.
+	ShareTokenHash *string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SettlementQuery when eager-loading is set.
 	Edges        SettlementEdges `json:"edges"`
@@ -38,13 +43,43 @@ type Settlement struct {
 type SettlementEdges struct {
 	// Population holds the value of the population edge.
 	Population []*Survivor `json:"population,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*Membership `json:"members,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// AuditLog holds the value of the audit_log edge.
+	AuditLog []*AuditLog `json:"audit_log,omitempty"`
+	// Timeline holds the value of the timeline edge.
+	Timeline []*TimelineEvent `json:"timeline,omitempty"`
+	// Storage holds the value of the storage edge.
+	Storage []*StorageItem `json:"storage,omitempty"`
+	// Innovations holds the value of the innovations edge.
+	Innovations []*Innovation `json:"innovations,omitempty"`
+	// Principles holds the value of the principles edge.
+	Principles []*Principle `json:"principles,omitempty"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*Milestone `json:"milestones,omitempty"`
+	// Locations holds the value of the locations edge.
+	Locations []*Location `json:"locations,omitempty"`
+	// Monsters holds the value of the monsters edge.
+	Monsters []*MonsterProgress `json:"monsters,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [11]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [9]map[string]int
 
-	namedPopulation map[string][]*Survivor
+	namedPopulation  map[string][]*Survivor
+	namedMembers     map[string][]*Membership
+	namedInvites     map[string][]*Invite
+	namedAuditLog    map[string][]*AuditLog
+	namedTimeline    map[string][]*TimelineEvent
+	namedStorage     map[string][]*StorageItem
+	namedInnovations map[string][]*Innovation
+	namedPrinciples  map[string][]*Principle
+	namedMilestones  map[string][]*Milestone
+	namedLocations   map[string][]*Location
+	namedMonsters    map[string][]*MonsterProgress
 }
 
 // PopulationOrErr returns the Population value or an error if the edge
@@ -56,6 +91,96 @@ func (e SettlementEdges) PopulationOrErr() ([]*Survivor, error) {
 	return nil, &NotLoadedError{edge: "population"}
 }
 
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) MembersOrErr() ([]*Membership, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// AuditLogOrErr returns the AuditLog value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) AuditLogOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[3] {
+		return e.AuditLog, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_log"}
+}
+
+// TimelineOrErr returns the Timeline value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) TimelineOrErr() ([]*TimelineEvent, error) {
+	if e.loadedTypes[4] {
+		return e.Timeline, nil
+	}
+	return nil, &NotLoadedError{edge: "timeline"}
+}
+
+// StorageOrErr returns the Storage value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) StorageOrErr() ([]*StorageItem, error) {
+	if e.loadedTypes[5] {
+		return e.Storage, nil
+	}
+	return nil, &NotLoadedError{edge: "storage"}
+}
+
+// InnovationsOrErr returns the Innovations value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) InnovationsOrErr() ([]*Innovation, error) {
+	if e.loadedTypes[6] {
+		return e.Innovations, nil
+	}
+	return nil, &NotLoadedError{edge: "innovations"}
+}
+
+// PrinciplesOrErr returns the Principles value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) PrinciplesOrErr() ([]*Principle, error) {
+	if e.loadedTypes[7] {
+		return e.Principles, nil
+	}
+	return nil, &NotLoadedError{edge: "principles"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[8] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// LocationsOrErr returns the Locations value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) LocationsOrErr() ([]*Location, error) {
+	if e.loadedTypes[9] {
+		return e.Locations, nil
+	}
+	return nil, &NotLoadedError{edge: "locations"}
+}
+
+// MonstersOrErr returns the Monsters value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettlementEdges) MonstersOrErr() ([]*MonsterProgress, error) {
+	if e.loadedTypes[10] {
+		return e.Monsters, nil
+	}
+	return nil, &NotLoadedError{edge: "monsters"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Settlement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -63,8 +188,10 @@ func (*Settlement) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case settlement.FieldID, settlement.FieldSurvivalLimit, settlement.FieldDepartingSurvival, settlement.FieldCollectiveCognition, settlement.FieldCurrentYear:
 			values[i] = new(sql.NullInt64)
-		case settlement.FieldOwner, settlement.FieldName:
+		case settlement.FieldOwner, settlement.FieldName, settlement.FieldShareTokenHash:
 			values[i] = new(sql.NullString)
+		case settlement.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -86,6 +213,13 @@ func (s *Settlement) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			s.ID = int(value.Int64)
+		case settlement.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				s.DeletedAt = new(time.Time)
+				*s.DeletedAt = value.Time
+			}
 		case settlement.FieldOwner:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field owner", values[i])
@@ -122,6 +256,13 @@ func (s *Settlement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.CurrentYear = int(value.Int64)
 			}
+		case settlement.FieldShareTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_token_hash", values[i])
+			} else if value.Valid {
+				s.ShareTokenHash = new(string)
+				*s.ShareTokenHash = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +281,56 @@ func (s *Settlement) QueryPopulation() *SurvivorQuery {
 	return NewSettlementClient(s.config).QueryPopulation(s)
 }
 
+// QueryMembers queries the "members" edge of the Settlement entity.
+func (s *Settlement) QueryMembers() *MembershipQuery {
+	return NewSettlementClient(s.config).QueryMembers(s)
+}
+
+// QueryInvites queries the "invites" edge of the Settlement entity.
+func (s *Settlement) QueryInvites() *InviteQuery {
+	return NewSettlementClient(s.config).QueryInvites(s)
+}
+
+// QueryAuditLog queries the "audit_log" edge of the Settlement entity.
+func (s *Settlement) QueryAuditLog() *AuditLogQuery {
+	return NewSettlementClient(s.config).QueryAuditLog(s)
+}
+
+// QueryTimeline queries the "timeline" edge of the Settlement entity.
+func (s *Settlement) QueryTimeline() *TimelineEventQuery {
+	return NewSettlementClient(s.config).QueryTimeline(s)
+}
+
+// QueryStorage queries the "storage" edge of the Settlement entity.
+func (s *Settlement) QueryStorage() *StorageItemQuery {
+	return NewSettlementClient(s.config).QueryStorage(s)
+}
+
+// QueryInnovations queries the "innovations" edge of the Settlement entity.
+func (s *Settlement) QueryInnovations() *InnovationQuery {
+	return NewSettlementClient(s.config).QueryInnovations(s)
+}
+
+// QueryPrinciples queries the "principles" edge of the Settlement entity.
+func (s *Settlement) QueryPrinciples() *PrincipleQuery {
+	return NewSettlementClient(s.config).QueryPrinciples(s)
+}
+
+// QueryMilestones queries the "milestones" edge of the Settlement entity.
+func (s *Settlement) QueryMilestones() *MilestoneQuery {
+	return NewSettlementClient(s.config).QueryMilestones(s)
+}
+
+// QueryLocations queries the "locations" edge of the Settlement entity.
+func (s *Settlement) QueryLocations() *LocationQuery {
+	return NewSettlementClient(s.config).QueryLocations(s)
+}
+
+// QueryMonsters queries the "monsters" edge of the Settlement entity.
+func (s *Settlement) QueryMonsters() *MonsterProgressQuery {
+	return NewSettlementClient(s.config).QueryMonsters(s)
+}
+
 // Update returns a builder for updating this Settlement.
 // Note that you need to call Settlement.Unwrap() before calling this method if this Settlement
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -163,6 +354,11 @@ func (s *Settlement) String() string {
 	var builder strings.Builder
 	builder.WriteString("Settlement(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	if v := s.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("owner=")
 	builder.WriteString(s.Owner)
 	builder.WriteString(", ")
@@ -180,6 +376,8 @@ func (s *Settlement) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("currentYear=")
 	builder.WriteString(fmt.Sprintf("%v", s.CurrentYear))
+	builder.WriteString(", ")
+	builder.WriteString("share_token_hash=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -208,5 +406,245 @@ func (s *Settlement) appendNamedPopulation(name string, edges ...*Survivor) {
 	}
 }
 
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedMembers(name string) ([]*Membership, error) {
+	if s.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedMembers(name string, edges ...*Membership) {
+	if s.Edges.namedMembers == nil {
+		s.Edges.namedMembers = make(map[string][]*Membership)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedMembers[name] = []*Membership{}
+	} else {
+		s.Edges.namedMembers[name] = append(s.Edges.namedMembers[name], edges...)
+	}
+}
+
+// NamedInvites returns the Invites named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedInvites(name string) ([]*Invite, error) {
+	if s.Edges.namedInvites == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedInvites[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedInvites(name string, edges ...*Invite) {
+	if s.Edges.namedInvites == nil {
+		s.Edges.namedInvites = make(map[string][]*Invite)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedInvites[name] = []*Invite{}
+	} else {
+		s.Edges.namedInvites[name] = append(s.Edges.namedInvites[name], edges...)
+	}
+}
+
+// NamedAuditLog returns the AuditLog named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedAuditLog(name string) ([]*AuditLog, error) {
+	if s.Edges.namedAuditLog == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedAuditLog[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedAuditLog(name string, edges ...*AuditLog) {
+	if s.Edges.namedAuditLog == nil {
+		s.Edges.namedAuditLog = make(map[string][]*AuditLog)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedAuditLog[name] = []*AuditLog{}
+	} else {
+		s.Edges.namedAuditLog[name] = append(s.Edges.namedAuditLog[name], edges...)
+	}
+}
+
+// NamedTimeline returns the Timeline named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedTimeline(name string) ([]*TimelineEvent, error) {
+	if s.Edges.namedTimeline == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedTimeline[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedTimeline(name string, edges ...*TimelineEvent) {
+	if s.Edges.namedTimeline == nil {
+		s.Edges.namedTimeline = make(map[string][]*TimelineEvent)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedTimeline[name] = []*TimelineEvent{}
+	} else {
+		s.Edges.namedTimeline[name] = append(s.Edges.namedTimeline[name], edges...)
+	}
+}
+
+// NamedStorage returns the Storage named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedStorage(name string) ([]*StorageItem, error) {
+	if s.Edges.namedStorage == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedStorage[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedStorage(name string, edges ...*StorageItem) {
+	if s.Edges.namedStorage == nil {
+		s.Edges.namedStorage = make(map[string][]*StorageItem)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedStorage[name] = []*StorageItem{}
+	} else {
+		s.Edges.namedStorage[name] = append(s.Edges.namedStorage[name], edges...)
+	}
+}
+
+// NamedInnovations returns the Innovations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedInnovations(name string) ([]*Innovation, error) {
+	if s.Edges.namedInnovations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedInnovations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedInnovations(name string, edges ...*Innovation) {
+	if s.Edges.namedInnovations == nil {
+		s.Edges.namedInnovations = make(map[string][]*Innovation)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedInnovations[name] = []*Innovation{}
+	} else {
+		s.Edges.namedInnovations[name] = append(s.Edges.namedInnovations[name], edges...)
+	}
+}
+
+// NamedPrinciples returns the Principles named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedPrinciples(name string) ([]*Principle, error) {
+	if s.Edges.namedPrinciples == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedPrinciples[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedPrinciples(name string, edges ...*Principle) {
+	if s.Edges.namedPrinciples == nil {
+		s.Edges.namedPrinciples = make(map[string][]*Principle)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedPrinciples[name] = []*Principle{}
+	} else {
+		s.Edges.namedPrinciples[name] = append(s.Edges.namedPrinciples[name], edges...)
+	}
+}
+
+// NamedMilestones returns the Milestones named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedMilestones(name string) ([]*Milestone, error) {
+	if s.Edges.namedMilestones == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedMilestones[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedMilestones(name string, edges ...*Milestone) {
+	if s.Edges.namedMilestones == nil {
+		s.Edges.namedMilestones = make(map[string][]*Milestone)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedMilestones[name] = []*Milestone{}
+	} else {
+		s.Edges.namedMilestones[name] = append(s.Edges.namedMilestones[name], edges...)
+	}
+}
+
+// NamedLocations returns the Locations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedLocations(name string) ([]*Location, error) {
+	if s.Edges.namedLocations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedLocations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedLocations(name string, edges ...*Location) {
+	if s.Edges.namedLocations == nil {
+		s.Edges.namedLocations = make(map[string][]*Location)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedLocations[name] = []*Location{}
+	} else {
+		s.Edges.namedLocations[name] = append(s.Edges.namedLocations[name], edges...)
+	}
+}
+
+// NamedMonsters returns the Monsters named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Settlement) NamedMonsters(name string) ([]*MonsterProgress, error) {
+	if s.Edges.namedMonsters == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedMonsters[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Settlement) appendNamedMonsters(name string, edges ...*MonsterProgress) {
+	if s.Edges.namedMonsters == nil {
+		s.Edges.namedMonsters = make(map[string][]*MonsterProgress)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedMonsters[name] = []*MonsterProgress{}
+	} else {
+		s.Edges.namedMonsters[name] = append(s.Edges.namedMonsters[name], edges...)
+	}
+}
+
 // Settlements is a parsable slice of Settlement.
 type Settlements []*Settlement
@@ -3,6 +3,7 @@
 package settlement
 
 import (
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +13,8 @@ const (
 	Label = "settlement"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldOwner holds the string denoting the owner field in the database.
 	FieldOwner = "owner"
 	// FieldName holds the string denoting the name field in the database.
@@ -24,8 +27,30 @@ const (
 	FieldCollectiveCognition = "collective_cognition"
 	// FieldCurrentYear holds the string denoting the currentyear field in the database.
 	FieldCurrentYear = "current_year"
+	// FieldShareTokenHash holds the string denoting the share_token_hash field in the database.
+	FieldShareTokenHash = "share_token_hash"
 	// EdgePopulation holds the string denoting the population edge name in mutations.
 	EdgePopulation = "population"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeAuditLog holds the string denoting the audit_log edge name in mutations.
+	EdgeAuditLog = "audit_log"
+	// EdgeTimeline holds the string denoting the timeline edge name in mutations.
+	EdgeTimeline = "timeline"
+	// EdgeStorage holds the string denoting the storage edge name in mutations.
+	EdgeStorage = "storage"
+	// EdgeInnovations holds the string denoting the innovations edge name in mutations.
+	EdgeInnovations = "innovations"
+	// EdgePrinciples holds the string denoting the principles edge name in mutations.
+	EdgePrinciples = "principles"
+	// EdgeMilestones holds the string denoting the milestones edge name in mutations.
+	EdgeMilestones = "milestones"
+	// EdgeLocations holds the string denoting the locations edge name in mutations.
+	EdgeLocations = "locations"
+	// EdgeMonsters holds the string denoting the monsters edge name in mutations.
+	EdgeMonsters = "monsters"
 	// Table holds the table name of the settlement in the database.
 	Table = "settlements"
 	// PopulationTable is the table that holds the population relation/edge.
@@ -35,19 +60,95 @@ const (
 	PopulationInverseTable = "survivors"
 	// PopulationColumn is the table column denoting the population relation/edge.
 	PopulationColumn = "settlement_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "memberships"
+	// MembersInverseTable is the table name for the Membership entity.
+	// It exists in this package in order to avoid circular dependency with the "membership" package.
+	MembersInverseTable = "memberships"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "settlement_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "invites"
+	// InvitesInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InvitesInverseTable = "invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "settlement_id"
+	// AuditLogTable is the table that holds the audit_log relation/edge.
+	AuditLogTable = "audit_logs"
+	// AuditLogInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogInverseTable = "audit_logs"
+	// AuditLogColumn is the table column denoting the audit_log relation/edge.
+	AuditLogColumn = "settlement_id"
+	// TimelineTable is the table that holds the timeline relation/edge.
+	TimelineTable = "timeline_events"
+	// TimelineInverseTable is the table name for the TimelineEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "timelineevent" package.
+	TimelineInverseTable = "timeline_events"
+	// TimelineColumn is the table column denoting the timeline relation/edge.
+	TimelineColumn = "settlement_id"
+	// StorageTable is the table that holds the storage relation/edge.
+	StorageTable = "storage_items"
+	// StorageInverseTable is the table name for the StorageItem entity.
+	// It exists in this package in order to avoid circular dependency with the "storageitem" package.
+	StorageInverseTable = "storage_items"
+	// StorageColumn is the table column denoting the storage relation/edge.
+	StorageColumn = "settlement_id"
+	// InnovationsTable is the table that holds the innovations relation/edge.
+	InnovationsTable = "innovations"
+	// InnovationsInverseTable is the table name for the Innovation entity.
+	// It exists in this package in order to avoid circular dependency with the "innovation" package.
+	InnovationsInverseTable = "innovations"
+	// InnovationsColumn is the table column denoting the innovations relation/edge.
+	InnovationsColumn = "settlement_id"
+	// PrinciplesTable is the table that holds the principles relation/edge.
+	PrinciplesTable = "principles"
+	// PrinciplesInverseTable is the table name for the Principle entity.
+	// It exists in this package in order to avoid circular dependency with the "principle" package.
+	PrinciplesInverseTable = "principles"
+	// PrinciplesColumn is the table column denoting the principles relation/edge.
+	PrinciplesColumn = "settlement_id"
+	// MilestonesTable is the table that holds the milestones relation/edge.
+	MilestonesTable = "milestones"
+	// MilestonesInverseTable is the table name for the Milestone entity.
+	// It exists in this package in order to avoid circular dependency with the "milestone" package.
+	MilestonesInverseTable = "milestones"
+	// MilestonesColumn is the table column denoting the milestones relation/edge.
+	MilestonesColumn = "settlement_id"
+	// LocationsTable is the table that holds the locations relation/edge. The primary key declared below.
+	LocationsTable = "settlement_locations"
+	// LocationsInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationsInverseTable = "locations"
+	// MonstersTable is the table that holds the monsters relation/edge.
+	MonstersTable = "monster_progresses"
+	// MonstersInverseTable is the table name for the MonsterProgress entity.
+	// It exists in this package in order to avoid circular dependency with the "monsterprogress" package.
+	MonstersInverseTable = "monster_progresses"
+	// MonstersColumn is the table column denoting the monsters relation/edge.
+	MonstersColumn = "settlement_id"
 )
 
 // Columns holds all SQL columns for settlement fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldOwner,
 	FieldName,
 	FieldSurvivalLimit,
 	FieldDepartingSurvival,
 	FieldCollectiveCognition,
 	FieldCurrentYear,
+	FieldShareTokenHash,
 }
 
+var (
+	// LocationsPrimaryKey and LocationsColumn2 are the table columns denoting the
+	// primary key for the locations relation (M2M).
+	LocationsPrimaryKey = []string{"settlement_id", "location_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -58,7 +159,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
 var (
+	Hooks        [6]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
 	OwnerValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
@@ -89,6 +198,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByOwner orders the results by the owner field.
 func ByOwner(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOwner, opts...).ToFunc()
@@ -119,6 +233,11 @@ func ByCurrentYear(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrentYear, opts...).ToFunc()
 }
 
+// ByShareTokenHash orders the results by the share_token_hash field.
+func ByShareTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareTokenHash, opts...).ToFunc()
+}
+
 // ByPopulationCount orders the results by population count.
 func ByPopulationCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -132,6 +251,146 @@ func ByPopulation(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPopulationStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuditLogCount orders the results by audit_log count.
+func ByAuditLogCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditLogStep(), opts...)
+	}
+}
+
+// ByAuditLog orders the results by audit_log terms.
+func ByAuditLog(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditLogStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTimelineCount orders the results by timeline count.
+func ByTimelineCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTimelineStep(), opts...)
+	}
+}
+
+// ByTimeline orders the results by timeline terms.
+func ByTimeline(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTimelineStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStorageCount orders the results by storage count.
+func ByStorageCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStorageStep(), opts...)
+	}
+}
+
+// ByStorage orders the results by storage terms.
+func ByStorage(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStorageStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInnovationsCount orders the results by innovations count.
+func ByInnovationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInnovationsStep(), opts...)
+	}
+}
+
+// ByInnovations orders the results by innovations terms.
+func ByInnovations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInnovationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPrinciplesCount orders the results by principles count.
+func ByPrinciplesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrinciplesStep(), opts...)
+	}
+}
+
+// ByPrinciples orders the results by principles terms.
+func ByPrinciples(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrinciplesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMilestonesCount orders the results by milestones count.
+func ByMilestonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMilestonesStep(), opts...)
+	}
+}
+
+// ByMilestones orders the results by milestones terms.
+func ByMilestones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLocationsCount orders the results by locations count.
+func ByLocationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLocationsStep(), opts...)
+	}
+}
+
+// ByLocations orders the results by locations terms.
+func ByLocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMonstersCount orders the results by monsters count.
+func ByMonstersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMonstersStep(), opts...)
+	}
+}
+
+// ByMonsters orders the results by monsters terms.
+func ByMonsters(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMonstersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPopulationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -139,3 +398,73 @@ func newPopulationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PopulationTable, PopulationColumn),
 	)
 }
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
+func newAuditLogStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditLogInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditLogTable, AuditLogColumn),
+	)
+}
+func newTimelineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TimelineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TimelineTable, TimelineColumn),
+	)
+}
+func newStorageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StorageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StorageTable, StorageColumn),
+	)
+}
+func newInnovationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InnovationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InnovationsTable, InnovationsColumn),
+	)
+}
+func newPrinciplesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrinciplesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrinciplesTable, PrinciplesColumn),
+	)
+}
+func newMilestonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestonesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+	)
+}
+func newLocationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, LocationsTable, LocationsPrimaryKey...),
+	)
+}
+func newMonstersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MonstersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MonstersTable, MonstersColumn),
+	)
+}
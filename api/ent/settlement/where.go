@@ -3,6 +3,8 @@
 package settlement
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/failuretoload/datamonster/ent/predicate"
@@ -53,6 +55,11 @@ func IDLTE(id int) predicate.Settlement {
 	return predicate.Settlement(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
 func Owner(v string) predicate.Settlement {
 	return predicate.Settlement(sql.FieldEQ(FieldOwner, v))
@@ -83,6 +90,61 @@ func CurrentYear(v int) predicate.Settlement {
 	return predicate.Settlement(sql.FieldEQ(FieldCurrentYear, v))
 }
 
+// ShareTokenHash applies equality check predicate on the "share_token_hash" field. It's identical to ShareTokenHashEQ.
+func ShareTokenHash(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldShareTokenHash, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // OwnerEQ applies the EQ predicate on the "owner" field.
 func OwnerEQ(v string) predicate.Settlement {
 	return predicate.Settlement(sql.FieldEQ(FieldOwner, v))
@@ -373,6 +435,81 @@ func CurrentYearLTE(v int) predicate.Settlement {
 	return predicate.Settlement(sql.FieldLTE(FieldCurrentYear, v))
 }
 
+// ShareTokenHashEQ applies the EQ predicate on the "share_token_hash" field.
+func ShareTokenHashEQ(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashNEQ applies the NEQ predicate on the "share_token_hash" field.
+func ShareTokenHashNEQ(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashIn applies the In predicate on the "share_token_hash" field.
+func ShareTokenHashIn(vs ...string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldShareTokenHash, vs...))
+}
+
+// ShareTokenHashNotIn applies the NotIn predicate on the "share_token_hash" field.
+func ShareTokenHashNotIn(vs ...string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldShareTokenHash, vs...))
+}
+
+// ShareTokenHashGT applies the GT predicate on the "share_token_hash" field.
+func ShareTokenHashGT(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashGTE applies the GTE predicate on the "share_token_hash" field.
+func ShareTokenHashGTE(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashLT applies the LT predicate on the "share_token_hash" field.
+func ShareTokenHashLT(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashLTE applies the LTE predicate on the "share_token_hash" field.
+func ShareTokenHashLTE(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashContains applies the Contains predicate on the "share_token_hash" field.
+func ShareTokenHashContains(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldContains(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashHasPrefix applies the HasPrefix predicate on the "share_token_hash" field.
+func ShareTokenHashHasPrefix(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldHasPrefix(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashHasSuffix applies the HasSuffix predicate on the "share_token_hash" field.
+func ShareTokenHashHasSuffix(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldHasSuffix(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashIsNil applies the IsNil predicate on the "share_token_hash" field.
+func ShareTokenHashIsNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldIsNull(FieldShareTokenHash))
+}
+
+// ShareTokenHashNotNil applies the NotNil predicate on the "share_token_hash" field.
+func ShareTokenHashNotNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotNull(FieldShareTokenHash))
+}
+
+// ShareTokenHashEqualFold applies the EqualFold predicate on the "share_token_hash" field.
+func ShareTokenHashEqualFold(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEqualFold(FieldShareTokenHash, v))
+}
+
+// ShareTokenHashContainsFold applies the ContainsFold predicate on the "share_token_hash" field.
+func ShareTokenHashContainsFold(v string) predicate.Settlement {
+	return predicate.Settlement(sql.FieldContainsFold(FieldShareTokenHash, v))
+}
+
 // HasPopulation applies the HasEdge predicate on the "population" edge.
 func HasPopulation() predicate.Settlement {
 	return predicate.Settlement(func(s *sql.Selector) {
@@ -396,6 +533,236 @@ func HasPopulationWith(preds ...predicate.Survivor) predicate.Settlement {
 	})
 }
 
+// HasMembers applies the HasEdge predicate on the "members" edge.
+func HasMembers() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembersWith applies the HasEdge predicate on the "members" edge with a given conditions (other predicates).
+func HasMembersWith(preds ...predicate.Membership) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvites applies the HasEdge predicate on the "invites" edge.
+func HasInvites() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitesWith applies the HasEdge predicate on the "invites" edge with a given conditions (other predicates).
+func HasInvitesWith(preds ...predicate.Invite) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuditLog applies the HasEdge predicate on the "audit_log" edge.
+func HasAuditLog() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditLogTable, AuditLogColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditLogWith applies the HasEdge predicate on the "audit_log" edge with a given conditions (other predicates).
+func HasAuditLogWith(preds ...predicate.AuditLog) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newAuditLogStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTimeline applies the HasEdge predicate on the "timeline" edge.
+func HasTimeline() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TimelineTable, TimelineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTimelineWith applies the HasEdge predicate on the "timeline" edge with a given conditions (other predicates).
+func HasTimelineWith(preds ...predicate.TimelineEvent) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newTimelineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStorage applies the HasEdge predicate on the "storage" edge.
+func HasStorage() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StorageTable, StorageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStorageWith applies the HasEdge predicate on the "storage" edge with a given conditions (other predicates).
+func HasStorageWith(preds ...predicate.StorageItem) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newStorageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInnovations applies the HasEdge predicate on the "innovations" edge.
+func HasInnovations() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InnovationsTable, InnovationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInnovationsWith applies the HasEdge predicate on the "innovations" edge with a given conditions (other predicates).
+func HasInnovationsWith(preds ...predicate.Innovation) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newInnovationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrinciples applies the HasEdge predicate on the "principles" edge.
+func HasPrinciples() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrinciplesTable, PrinciplesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrinciplesWith applies the HasEdge predicate on the "principles" edge with a given conditions (other predicates).
+func HasPrinciplesWith(preds ...predicate.Principle) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newPrinciplesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestones applies the HasEdge predicate on the "milestones" edge.
+func HasMilestones() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestonesWith applies the HasEdge predicate on the "milestones" edge with a given conditions (other predicates).
+func HasMilestonesWith(preds ...predicate.Milestone) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newMilestonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocations applies the HasEdge predicate on the "locations" edge.
+func HasLocations() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, LocationsTable, LocationsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationsWith applies the HasEdge predicate on the "locations" edge with a given conditions (other predicates).
+func HasLocationsWith(preds ...predicate.Location) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newLocationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMonsters applies the HasEdge predicate on the "monsters" edge.
+func HasMonsters() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MonstersTable, MonstersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMonstersWith applies the HasEdge predicate on the "monsters" edge with a given conditions (other predicates).
+func HasMonstersWith(preds ...predicate.MonsterProgress) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newMonstersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Settlement) predicate.Settlement {
 	return predicate.Settlement(sql.AndPredicates(predicates...))
@@ -6,11 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 // SettlementCreate is the builder for creating a Settlement entity.
@@ -20,6 +31,20 @@ type SettlementCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *SettlementCreate) SetDeletedAt(t time.Time) *SettlementCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *SettlementCreate) SetNillableDeletedAt(t *time.Time) *SettlementCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
 // SetOwner sets the "owner" field.
 func (sc *SettlementCreate) SetOwner(s string) *SettlementCreate {
 	sc.mutation.SetOwner(s)
@@ -88,6 +113,20 @@ func (sc *SettlementCreate) SetNillableCurrentYear(i *int) *SettlementCreate {
 	return sc
 }
 
+// SetShareTokenHash sets the "share_token_hash" field.
+func (sc *SettlementCreate) SetShareTokenHash(s string) *SettlementCreate {
+	sc.mutation.SetShareTokenHash(s)
+	return sc
+}
+
+// SetNillableShareTokenHash sets the "share_token_hash" field if the given value is not nil.
+func (sc *SettlementCreate) SetNillableShareTokenHash(s *string) *SettlementCreate {
+	if s != nil {
+		sc.SetShareTokenHash(*s)
+	}
+	return sc
+}
+
 // AddPopulationIDs adds the "population" edge to the Survivor entity by IDs.
 func (sc *SettlementCreate) AddPopulationIDs(ids ...int) *SettlementCreate {
 	sc.mutation.AddPopulationIDs(ids...)
@@ -103,6 +142,156 @@ func (sc *SettlementCreate) AddPopulation(s ...*Survivor) *SettlementCreate {
 	return sc.AddPopulationIDs(ids...)
 }
 
+// AddMemberIDs adds the "members" edge to the Membership entity by IDs.
+func (sc *SettlementCreate) AddMemberIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddMemberIDs(ids...)
+	return sc
+}
+
+// AddMembers adds the "members" edges to the Membership entity.
+func (sc *SettlementCreate) AddMembers(m ...*Membership) *SettlementCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return sc.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (sc *SettlementCreate) AddInviteIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddInviteIDs(ids...)
+	return sc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (sc *SettlementCreate) AddInvites(i ...*Invite) *SettlementCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return sc.AddInviteIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_log" edge to the AuditLog entity by IDs.
+func (sc *SettlementCreate) AddAuditLogIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddAuditLogIDs(ids...)
+	return sc
+}
+
+// AddAuditLog adds the "audit_log" edges to the AuditLog entity.
+func (sc *SettlementCreate) AddAuditLog(a ...*AuditLog) *SettlementCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sc.AddAuditLogIDs(ids...)
+}
+
+// AddTimelineIDs adds the "timeline" edge to the TimelineEvent entity by IDs.
+func (sc *SettlementCreate) AddTimelineIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddTimelineIDs(ids...)
+	return sc
+}
+
+// AddTimeline adds the "timeline" edges to the TimelineEvent entity.
+func (sc *SettlementCreate) AddTimeline(t ...*TimelineEvent) *SettlementCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sc.AddTimelineIDs(ids...)
+}
+
+// AddStorageIDs adds the "storage" edge to the StorageItem entity by IDs.
+func (sc *SettlementCreate) AddStorageIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddStorageIDs(ids...)
+	return sc
+}
+
+// AddStorage adds the "storage" edges to the StorageItem entity.
+func (sc *SettlementCreate) AddStorage(s ...*StorageItem) *SettlementCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddStorageIDs(ids...)
+}
+
+// AddInnovationIDs adds the "innovations" edge to the Innovation entity by IDs.
+func (sc *SettlementCreate) AddInnovationIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddInnovationIDs(ids...)
+	return sc
+}
+
+// AddInnovations adds the "innovations" edges to the Innovation entity.
+func (sc *SettlementCreate) AddInnovations(i ...*Innovation) *SettlementCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return sc.AddInnovationIDs(ids...)
+}
+
+// AddPrincipleIDs adds the "principles" edge to the Principle entity by IDs.
+func (sc *SettlementCreate) AddPrincipleIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddPrincipleIDs(ids...)
+	return sc
+}
+
+// AddPrinciples adds the "principles" edges to the Principle entity.
+func (sc *SettlementCreate) AddPrinciples(p ...*Principle) *SettlementCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return sc.AddPrincipleIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (sc *SettlementCreate) AddMilestoneIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddMilestoneIDs(ids...)
+	return sc
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (sc *SettlementCreate) AddMilestones(m ...*Milestone) *SettlementCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return sc.AddMilestoneIDs(ids...)
+}
+
+// AddLocationIDs adds the "locations" edge to the Location entity by IDs.
+func (sc *SettlementCreate) AddLocationIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddLocationIDs(ids...)
+	return sc
+}
+
+// AddLocations adds the "locations" edges to the Location entity.
+func (sc *SettlementCreate) AddLocations(l ...*Location) *SettlementCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return sc.AddLocationIDs(ids...)
+}
+
+// AddMonsterIDs adds the "monsters" edge to the MonsterProgress entity by IDs.
+func (sc *SettlementCreate) AddMonsterIDs(ids ...int) *SettlementCreate {
+	sc.mutation.AddMonsterIDs(ids...)
+	return sc
+}
+
+// AddMonsters adds the "monsters" edges to the MonsterProgress entity.
+func (sc *SettlementCreate) AddMonsters(m ...*MonsterProgress) *SettlementCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return sc.AddMonsterIDs(ids...)
+}
+
 // Mutation returns the SettlementMutation object of the builder.
 func (sc *SettlementCreate) Mutation() *SettlementMutation {
 	return sc.mutation
@@ -110,7 +299,9 @@ func (sc *SettlementCreate) Mutation() *SettlementMutation {
 
 // Save creates the Settlement in the database.
 func (sc *SettlementCreate) Save(ctx context.Context) (*Settlement, error) {
-	sc.defaults()
+	if err := sc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -137,7 +328,7 @@ func (sc *SettlementCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (sc *SettlementCreate) defaults() {
+func (sc *SettlementCreate) defaults() error {
 	if _, ok := sc.mutation.SurvivalLimit(); !ok {
 		v := settlement.DefaultSurvivalLimit
 		sc.mutation.SetSurvivalLimit(v)
@@ -154,6 +345,7 @@ func (sc *SettlementCreate) defaults() {
 		v := settlement.DefaultCurrentYear
 		sc.mutation.SetCurrentYear(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -232,6 +424,10 @@ func (sc *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
 		_node = &Settlement{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(settlement.Table, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
 	)
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.SetField(settlement.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := sc.mutation.Owner(); ok {
 		_spec.SetField(settlement.FieldOwner, field.TypeString, value)
 		_node.Owner = value
@@ -256,6 +452,10 @@ func (sc *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
 		_spec.SetField(settlement.FieldCurrentYear, field.TypeInt, value)
 		_node.CurrentYear = value
 	}
+	if value, ok := sc.mutation.ShareTokenHash(); ok {
+		_spec.SetField(settlement.FieldShareTokenHash, field.TypeString, value)
+		_node.ShareTokenHash = &value
+	}
 	if nodes := sc.mutation.PopulationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -272,6 +472,166 @@ func (sc *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.AuditLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.TimelineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.InnovationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.PrinciplesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.LocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.MonstersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
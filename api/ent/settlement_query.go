@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -12,22 +13,52 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
 	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 // SettlementQuery is the builder for querying Settlement entities.
 type SettlementQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []settlement.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Settlement
-	withPopulation      *SurvivorQuery
-	modifiers           []func(*sql.Selector)
-	loadTotal           []func(context.Context, []*Settlement) error
-	withNamedPopulation map[string]*SurvivorQuery
+	ctx                  *QueryContext
+	order                []settlement.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Settlement
+	withPopulation       *SurvivorQuery
+	withMembers          *MembershipQuery
+	withInvites          *InviteQuery
+	withAuditLog         *AuditLogQuery
+	withTimeline         *TimelineEventQuery
+	withStorage          *StorageItemQuery
+	withInnovations      *InnovationQuery
+	withPrinciples       *PrincipleQuery
+	withMilestones       *MilestoneQuery
+	withLocations        *LocationQuery
+	withMonsters         *MonsterProgressQuery
+	modifiers            []func(*sql.Selector)
+	loadTotal            []func(context.Context, []*Settlement) error
+	withNamedPopulation  map[string]*SurvivorQuery
+	withNamedMembers     map[string]*MembershipQuery
+	withNamedInvites     map[string]*InviteQuery
+	withNamedAuditLog    map[string]*AuditLogQuery
+	withNamedTimeline    map[string]*TimelineEventQuery
+	withNamedStorage     map[string]*StorageItemQuery
+	withNamedInnovations map[string]*InnovationQuery
+	withNamedPrinciples  map[string]*PrincipleQuery
+	withNamedMilestones  map[string]*MilestoneQuery
+	withNamedLocations   map[string]*LocationQuery
+	withNamedMonsters    map[string]*MonsterProgressQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,6 +117,226 @@ func (sq *SettlementQuery) QueryPopulation() *SurvivorQuery {
 	return query
 }
 
+// QueryMembers chains the current query on the "members" edge.
+func (sq *SettlementQuery) QueryMembers() *MembershipQuery {
+	query := (&MembershipClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(membership.Table, membership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MembersTable, settlement.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (sq *SettlementQuery) QueryInvites() *InviteQuery {
+	query := (&InviteClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.InvitesTable, settlement.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuditLog chains the current query on the "audit_log" edge.
+func (sq *SettlementQuery) QueryAuditLog() *AuditLogQuery {
+	query := (&AuditLogClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.AuditLogTable, settlement.AuditLogColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTimeline chains the current query on the "timeline" edge.
+func (sq *SettlementQuery) QueryTimeline() *TimelineEventQuery {
+	query := (&TimelineEventClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.TimelineTable, settlement.TimelineColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStorage chains the current query on the "storage" edge.
+func (sq *SettlementQuery) QueryStorage() *StorageItemQuery {
+	query := (&StorageItemClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(storageitem.Table, storageitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.StorageTable, settlement.StorageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInnovations chains the current query on the "innovations" edge.
+func (sq *SettlementQuery) QueryInnovations() *InnovationQuery {
+	query := (&InnovationClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(innovation.Table, innovation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.InnovationsTable, settlement.InnovationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrinciples chains the current query on the "principles" edge.
+func (sq *SettlementQuery) QueryPrinciples() *PrincipleQuery {
+	query := (&PrincipleClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(principle.Table, principle.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.PrinciplesTable, settlement.PrinciplesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestones chains the current query on the "milestones" edge.
+func (sq *SettlementQuery) QueryMilestones() *MilestoneQuery {
+	query := (&MilestoneClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MilestonesTable, settlement.MilestonesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLocations chains the current query on the "locations" edge.
+func (sq *SettlementQuery) QueryLocations() *LocationQuery {
+	query := (&LocationClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, settlement.LocationsTable, settlement.LocationsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMonsters chains the current query on the "monsters" edge.
+func (sq *SettlementQuery) QueryMonsters() *MonsterProgressQuery {
+	query := (&MonsterProgressClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(settlement.Table, settlement.FieldID, selector),
+			sqlgraph.To(monsterprogress.Table, monsterprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, settlement.MonstersTable, settlement.MonstersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Settlement entity from the query.
 // Returns a *NotFoundError when no Settlement was found.
 func (sq *SettlementQuery) First(ctx context.Context) (*Settlement, error) {
@@ -273,12 +524,22 @@ func (sq *SettlementQuery) Clone() *SettlementQuery {
 		return nil
 	}
 	return &SettlementQuery{
-		config:         sq.config,
-		ctx:            sq.ctx.Clone(),
-		order:          append([]settlement.OrderOption{}, sq.order...),
-		inters:         append([]Interceptor{}, sq.inters...),
-		predicates:     append([]predicate.Settlement{}, sq.predicates...),
-		withPopulation: sq.withPopulation.Clone(),
+		config:          sq.config,
+		ctx:             sq.ctx.Clone(),
+		order:           append([]settlement.OrderOption{}, sq.order...),
+		inters:          append([]Interceptor{}, sq.inters...),
+		predicates:      append([]predicate.Settlement{}, sq.predicates...),
+		withPopulation:  sq.withPopulation.Clone(),
+		withMembers:     sq.withMembers.Clone(),
+		withInvites:     sq.withInvites.Clone(),
+		withAuditLog:    sq.withAuditLog.Clone(),
+		withTimeline:    sq.withTimeline.Clone(),
+		withStorage:     sq.withStorage.Clone(),
+		withInnovations: sq.withInnovations.Clone(),
+		withPrinciples:  sq.withPrinciples.Clone(),
+		withMilestones:  sq.withMilestones.Clone(),
+		withLocations:   sq.withLocations.Clone(),
+		withMonsters:    sq.withMonsters.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -296,18 +557,128 @@ func (sq *SettlementQuery) WithPopulation(opts ...func(*SurvivorQuery)) *Settlem
 	return sq
 }
 
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithMembers(opts ...func(*MembershipQuery)) *SettlementQuery {
+	query := (&MembershipClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withMembers = query
+	return sq
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithInvites(opts ...func(*InviteQuery)) *SettlementQuery {
+	query := (&InviteClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withInvites = query
+	return sq
+}
+
+// WithAuditLog tells the query-builder to eager-load the nodes that are connected to
+// the "audit_log" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithAuditLog(opts ...func(*AuditLogQuery)) *SettlementQuery {
+	query := (&AuditLogClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withAuditLog = query
+	return sq
+}
+
+// WithTimeline tells the query-builder to eager-load the nodes that are connected to
+// the "timeline" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithTimeline(opts ...func(*TimelineEventQuery)) *SettlementQuery {
+	query := (&TimelineEventClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withTimeline = query
+	return sq
+}
+
+// WithStorage tells the query-builder to eager-load the nodes that are connected to
+// the "storage" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithStorage(opts ...func(*StorageItemQuery)) *SettlementQuery {
+	query := (&StorageItemClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withStorage = query
+	return sq
+}
+
+// WithInnovations tells the query-builder to eager-load the nodes that are connected to
+// the "innovations" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithInnovations(opts ...func(*InnovationQuery)) *SettlementQuery {
+	query := (&InnovationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withInnovations = query
+	return sq
+}
+
+// WithPrinciples tells the query-builder to eager-load the nodes that are connected to
+// the "principles" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithPrinciples(opts ...func(*PrincipleQuery)) *SettlementQuery {
+	query := (&PrincipleClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withPrinciples = query
+	return sq
+}
+
+// WithMilestones tells the query-builder to eager-load the nodes that are connected to
+// the "milestones" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithMilestones(opts ...func(*MilestoneQuery)) *SettlementQuery {
+	query := (&MilestoneClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withMilestones = query
+	return sq
+}
+
+// WithLocations tells the query-builder to eager-load the nodes that are connected to
+// the "locations" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithLocations(opts ...func(*LocationQuery)) *SettlementQuery {
+	query := (&LocationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withLocations = query
+	return sq
+}
+
+// WithMonsters tells the query-builder to eager-load the nodes that are connected to
+// the "monsters" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithMonsters(opts ...func(*MonsterProgressQuery)) *SettlementQuery {
+	query := (&MonsterProgressClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withMonsters = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Owner string `json:"owner,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Settlement.Query().
-//		GroupBy(settlement.FieldOwner).
+//		GroupBy(settlement.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (sq *SettlementQuery) GroupBy(field string, fields ...string) *SettlementGroupBy {
@@ -325,11 +696,11 @@ func (sq *SettlementQuery) GroupBy(field string, fields ...string) *SettlementGr
 // Example:
 //
 //	var v []struct {
-//		Owner string `json:"owner,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Settlement.Query().
-//		Select(settlement.FieldOwner).
+//		Select(settlement.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (sq *SettlementQuery) Select(fields ...string) *SettlementSelect {
 	sq.ctx.Fields = append(sq.ctx.Fields, fields...)
@@ -367,6 +738,12 @@ func (sq *SettlementQuery) prepareQuery(ctx context.Context) error {
 		}
 		sq.sql = prev
 	}
+	if settlement.Policy == nil {
+		return errors.New("ent: uninitialized settlement.Policy (forgotten import ent/runtime?)")
+	}
+	if err := settlement.Policy.EvalQuery(ctx, sq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -374,8 +751,18 @@ func (sq *SettlementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S
 	var (
 		nodes       = []*Settlement{}
 		_spec       = sq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [11]bool{
 			sq.withPopulation != nil,
+			sq.withMembers != nil,
+			sq.withInvites != nil,
+			sq.withAuditLog != nil,
+			sq.withTimeline != nil,
+			sq.withStorage != nil,
+			sq.withInnovations != nil,
+			sq.withPrinciples != nil,
+			sq.withMilestones != nil,
+			sq.withLocations != nil,
+			sq.withMonsters != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -406,35 +793,175 @@ func (sq *SettlementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S
 			return nil, err
 		}
 	}
-	for name, query := range sq.withNamedPopulation {
-		if err := sq.loadPopulation(ctx, query, nodes,
-			func(n *Settlement) { n.appendNamedPopulation(name) },
-			func(n *Settlement, e *Survivor) { n.appendNamedPopulation(name, e) }); err != nil {
+	if query := sq.withMembers; query != nil {
+		if err := sq.loadMembers(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Members = []*Membership{} },
+			func(n *Settlement, e *Membership) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
 			return nil, err
 		}
 	}
-	for i := range sq.loadTotal {
-		if err := sq.loadTotal[i](ctx, nodes); err != nil {
+	if query := sq.withInvites; query != nil {
+		if err := sq.loadInvites(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Invites = []*Invite{} },
+			func(n *Settlement, e *Invite) { n.Edges.Invites = append(n.Edges.Invites, e) }); err != nil {
 			return nil, err
 		}
 	}
-	return nodes, nil
-}
-
-func (sq *SettlementQuery) loadPopulation(ctx context.Context, query *SurvivorQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Survivor)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*Settlement)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
-		if init != nil {
-			init(nodes[i])
+	if query := sq.withAuditLog; query != nil {
+		if err := sq.loadAuditLog(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.AuditLog = []*AuditLog{} },
+			func(n *Settlement, e *AuditLog) { n.Edges.AuditLog = append(n.Edges.AuditLog, e) }); err != nil {
+			return nil, err
 		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(survivor.FieldSettlementID)
+	if query := sq.withTimeline; query != nil {
+		if err := sq.loadTimeline(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Timeline = []*TimelineEvent{} },
+			func(n *Settlement, e *TimelineEvent) { n.Edges.Timeline = append(n.Edges.Timeline, e) }); err != nil {
+			return nil, err
+		}
 	}
-	query.Where(predicate.Survivor(func(s *sql.Selector) {
+	if query := sq.withStorage; query != nil {
+		if err := sq.loadStorage(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Storage = []*StorageItem{} },
+			func(n *Settlement, e *StorageItem) { n.Edges.Storage = append(n.Edges.Storage, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withInnovations; query != nil {
+		if err := sq.loadInnovations(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Innovations = []*Innovation{} },
+			func(n *Settlement, e *Innovation) { n.Edges.Innovations = append(n.Edges.Innovations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withPrinciples; query != nil {
+		if err := sq.loadPrinciples(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Principles = []*Principle{} },
+			func(n *Settlement, e *Principle) { n.Edges.Principles = append(n.Edges.Principles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withMilestones; query != nil {
+		if err := sq.loadMilestones(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Milestones = []*Milestone{} },
+			func(n *Settlement, e *Milestone) { n.Edges.Milestones = append(n.Edges.Milestones, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withLocations; query != nil {
+		if err := sq.loadLocations(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Locations = []*Location{} },
+			func(n *Settlement, e *Location) { n.Edges.Locations = append(n.Edges.Locations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withMonsters; query != nil {
+		if err := sq.loadMonsters(ctx, query, nodes,
+			func(n *Settlement) { n.Edges.Monsters = []*MonsterProgress{} },
+			func(n *Settlement, e *MonsterProgress) { n.Edges.Monsters = append(n.Edges.Monsters, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedPopulation {
+		if err := sq.loadPopulation(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedPopulation(name) },
+			func(n *Settlement, e *Survivor) { n.appendNamedPopulation(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedMembers {
+		if err := sq.loadMembers(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedMembers(name) },
+			func(n *Settlement, e *Membership) { n.appendNamedMembers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedInvites {
+		if err := sq.loadInvites(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedInvites(name) },
+			func(n *Settlement, e *Invite) { n.appendNamedInvites(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedAuditLog {
+		if err := sq.loadAuditLog(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedAuditLog(name) },
+			func(n *Settlement, e *AuditLog) { n.appendNamedAuditLog(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedTimeline {
+		if err := sq.loadTimeline(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedTimeline(name) },
+			func(n *Settlement, e *TimelineEvent) { n.appendNamedTimeline(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedStorage {
+		if err := sq.loadStorage(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedStorage(name) },
+			func(n *Settlement, e *StorageItem) { n.appendNamedStorage(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedInnovations {
+		if err := sq.loadInnovations(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedInnovations(name) },
+			func(n *Settlement, e *Innovation) { n.appendNamedInnovations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedPrinciples {
+		if err := sq.loadPrinciples(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedPrinciples(name) },
+			func(n *Settlement, e *Principle) { n.appendNamedPrinciples(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedMilestones {
+		if err := sq.loadMilestones(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedMilestones(name) },
+			func(n *Settlement, e *Milestone) { n.appendNamedMilestones(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedLocations {
+		if err := sq.loadLocations(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedLocations(name) },
+			func(n *Settlement, e *Location) { n.appendNamedLocations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedMonsters {
+		if err := sq.loadMonsters(ctx, query, nodes,
+			func(n *Settlement) { n.appendNamedMonsters(name) },
+			func(n *Settlement, e *MonsterProgress) { n.appendNamedMonsters(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range sq.loadTotal {
+		if err := sq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sq *SettlementQuery) loadPopulation(ctx context.Context, query *SurvivorQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Survivor)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(survivor.FieldSettlementID)
+	}
+	query.Where(predicate.Survivor(func(s *sql.Selector) {
 		s.Where(sql.InValues(s.C(settlement.PopulationColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
@@ -451,6 +978,340 @@ func (sq *SettlementQuery) loadPopulation(ctx context.Context, query *SurvivorQu
 	}
 	return nil
 }
+func (sq *SettlementQuery) loadMembers(ctx context.Context, query *MembershipQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Membership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(membership.FieldSettlementID)
+	}
+	query.Where(predicate.Membership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadInvites(ctx context.Context, query *InviteQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Invite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invite.FieldSettlementID)
+	}
+	query.Where(predicate.Invite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.InvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadAuditLog(ctx context.Context, query *AuditLogQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *AuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(auditlog.FieldSettlementID)
+	}
+	query.Where(predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.AuditLogColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "settlement_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadTimeline(ctx context.Context, query *TimelineEventQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *TimelineEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(timelineevent.FieldSettlementID)
+	}
+	query.Where(predicate.TimelineEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.TimelineColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadStorage(ctx context.Context, query *StorageItemQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *StorageItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(storageitem.FieldSettlementID)
+	}
+	query.Where(predicate.StorageItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.StorageColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadInnovations(ctx context.Context, query *InnovationQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Innovation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(innovation.FieldSettlementID)
+	}
+	query.Where(predicate.Innovation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.InnovationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadPrinciples(ctx context.Context, query *PrincipleQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Principle)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(principle.FieldSettlementID)
+	}
+	query.Where(predicate.Principle(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.PrinciplesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadMilestones(ctx context.Context, query *MilestoneQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Milestone)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(milestone.FieldSettlementID)
+	}
+	query.Where(predicate.Milestone(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.MilestonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadLocations(ctx context.Context, query *LocationQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *Location)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Settlement)
+	nids := make(map[int]map[*Settlement]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(settlement.LocationsTable)
+		s.Join(joinT).On(s.C(location.FieldID), joinT.C(settlement.LocationsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(settlement.LocationsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(settlement.LocationsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Settlement]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Location](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "locations" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (sq *SettlementQuery) loadMonsters(ctx context.Context, query *MonsterProgressQuery, nodes []*Settlement, init func(*Settlement), assign func(*Settlement, *MonsterProgress)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Settlement)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(monsterprogress.FieldSettlementID)
+	}
+	query.Where(predicate.MonsterProgress(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(settlement.MonstersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SettlementID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "settlement_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SettlementQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -550,6 +1411,146 @@ func (sq *SettlementQuery) WithNamedPopulation(name string, opts ...func(*Surviv
 	return sq
 }
 
+// WithNamedMembers tells the query-builder to eager-load the nodes that are connected to the "members"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedMembers(name string, opts ...func(*MembershipQuery)) *SettlementQuery {
+	query := (&MembershipClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedMembers == nil {
+		sq.withNamedMembers = make(map[string]*MembershipQuery)
+	}
+	sq.withNamedMembers[name] = query
+	return sq
+}
+
+// WithNamedInvites tells the query-builder to eager-load the nodes that are connected to the "invites"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedInvites(name string, opts ...func(*InviteQuery)) *SettlementQuery {
+	query := (&InviteClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedInvites == nil {
+		sq.withNamedInvites = make(map[string]*InviteQuery)
+	}
+	sq.withNamedInvites[name] = query
+	return sq
+}
+
+// WithNamedAuditLog tells the query-builder to eager-load the nodes that are connected to the "audit_log"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedAuditLog(name string, opts ...func(*AuditLogQuery)) *SettlementQuery {
+	query := (&AuditLogClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedAuditLog == nil {
+		sq.withNamedAuditLog = make(map[string]*AuditLogQuery)
+	}
+	sq.withNamedAuditLog[name] = query
+	return sq
+}
+
+// WithNamedTimeline tells the query-builder to eager-load the nodes that are connected to the "timeline"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedTimeline(name string, opts ...func(*TimelineEventQuery)) *SettlementQuery {
+	query := (&TimelineEventClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedTimeline == nil {
+		sq.withNamedTimeline = make(map[string]*TimelineEventQuery)
+	}
+	sq.withNamedTimeline[name] = query
+	return sq
+}
+
+// WithNamedStorage tells the query-builder to eager-load the nodes that are connected to the "storage"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedStorage(name string, opts ...func(*StorageItemQuery)) *SettlementQuery {
+	query := (&StorageItemClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedStorage == nil {
+		sq.withNamedStorage = make(map[string]*StorageItemQuery)
+	}
+	sq.withNamedStorage[name] = query
+	return sq
+}
+
+// WithNamedInnovations tells the query-builder to eager-load the nodes that are connected to the "innovations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedInnovations(name string, opts ...func(*InnovationQuery)) *SettlementQuery {
+	query := (&InnovationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedInnovations == nil {
+		sq.withNamedInnovations = make(map[string]*InnovationQuery)
+	}
+	sq.withNamedInnovations[name] = query
+	return sq
+}
+
+// WithNamedPrinciples tells the query-builder to eager-load the nodes that are connected to the "principles"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedPrinciples(name string, opts ...func(*PrincipleQuery)) *SettlementQuery {
+	query := (&PrincipleClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedPrinciples == nil {
+		sq.withNamedPrinciples = make(map[string]*PrincipleQuery)
+	}
+	sq.withNamedPrinciples[name] = query
+	return sq
+}
+
+// WithNamedMilestones tells the query-builder to eager-load the nodes that are connected to the "milestones"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedMilestones(name string, opts ...func(*MilestoneQuery)) *SettlementQuery {
+	query := (&MilestoneClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedMilestones == nil {
+		sq.withNamedMilestones = make(map[string]*MilestoneQuery)
+	}
+	sq.withNamedMilestones[name] = query
+	return sq
+}
+
+// WithNamedLocations tells the query-builder to eager-load the nodes that are connected to the "locations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedLocations(name string, opts ...func(*LocationQuery)) *SettlementQuery {
+	query := (&LocationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedLocations == nil {
+		sq.withNamedLocations = make(map[string]*LocationQuery)
+	}
+	sq.withNamedLocations[name] = query
+	return sq
+}
+
+// WithNamedMonsters tells the query-builder to eager-load the nodes that are connected to the "monsters"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SettlementQuery) WithNamedMonsters(name string, opts ...func(*MonsterProgressQuery)) *SettlementQuery {
+	query := (&MonsterProgressClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedMonsters == nil {
+		sq.withNamedMonsters = make(map[string]*MonsterProgressQuery)
+	}
+	sq.withNamedMonsters[name] = query
+	return sq
+}
+
 // SettlementGroupBy is the group-by builder for Settlement entities.
 type SettlementGroupBy struct {
 	selector
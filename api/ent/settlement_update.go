@@ -6,13 +6,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
 	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
 )
 
 // SettlementUpdate is the builder for updating Settlement entities.
@@ -28,6 +39,26 @@ func (su *SettlementUpdate) Where(ps ...predicate.Settlement) *SettlementUpdate
 	return su
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (su *SettlementUpdate) SetDeletedAt(t time.Time) *SettlementUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *SettlementUpdate) SetNillableDeletedAt(t *time.Time) *SettlementUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *SettlementUpdate) ClearDeletedAt() *SettlementUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
 // SetOwner sets the "owner" field.
 func (su *SettlementUpdate) SetOwner(s string) *SettlementUpdate {
 	su.mutation.SetOwner(s)
@@ -140,6 +171,26 @@ func (su *SettlementUpdate) AddCurrentYear(i int) *SettlementUpdate {
 	return su
 }
 
+// SetShareTokenHash sets the "share_token_hash" field.
+func (su *SettlementUpdate) SetShareTokenHash(s string) *SettlementUpdate {
+	su.mutation.SetShareTokenHash(s)
+	return su
+}
+
+// SetNillableShareTokenHash sets the "share_token_hash" field if the given value is not nil.
+func (su *SettlementUpdate) SetNillableShareTokenHash(s *string) *SettlementUpdate {
+	if s != nil {
+		su.SetShareTokenHash(*s)
+	}
+	return su
+}
+
+// ClearShareTokenHash clears the value of the "share_token_hash" field.
+func (su *SettlementUpdate) ClearShareTokenHash() *SettlementUpdate {
+	su.mutation.ClearShareTokenHash()
+	return su
+}
+
 // AddPopulationIDs adds the "population" edge to the Survivor entity by IDs.
 func (su *SettlementUpdate) AddPopulationIDs(ids ...int) *SettlementUpdate {
 	su.mutation.AddPopulationIDs(ids...)
@@ -155,6 +206,156 @@ func (su *SettlementUpdate) AddPopulation(s ...*Survivor) *SettlementUpdate {
 	return su.AddPopulationIDs(ids...)
 }
 
+// AddMemberIDs adds the "members" edge to the Membership entity by IDs.
+func (su *SettlementUpdate) AddMemberIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddMemberIDs(ids...)
+	return su
+}
+
+// AddMembers adds the "members" edges to the Membership entity.
+func (su *SettlementUpdate) AddMembers(m ...*Membership) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (su *SettlementUpdate) AddInviteIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddInviteIDs(ids...)
+	return su
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (su *SettlementUpdate) AddInvites(i ...*Invite) *SettlementUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return su.AddInviteIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_log" edge to the AuditLog entity by IDs.
+func (su *SettlementUpdate) AddAuditLogIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddAuditLogIDs(ids...)
+	return su
+}
+
+// AddAuditLog adds the "audit_log" edges to the AuditLog entity.
+func (su *SettlementUpdate) AddAuditLog(a ...*AuditLog) *SettlementUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.AddAuditLogIDs(ids...)
+}
+
+// AddTimelineIDs adds the "timeline" edge to the TimelineEvent entity by IDs.
+func (su *SettlementUpdate) AddTimelineIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddTimelineIDs(ids...)
+	return su
+}
+
+// AddTimeline adds the "timeline" edges to the TimelineEvent entity.
+func (su *SettlementUpdate) AddTimeline(t ...*TimelineEvent) *SettlementUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.AddTimelineIDs(ids...)
+}
+
+// AddStorageIDs adds the "storage" edge to the StorageItem entity by IDs.
+func (su *SettlementUpdate) AddStorageIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddStorageIDs(ids...)
+	return su
+}
+
+// AddStorage adds the "storage" edges to the StorageItem entity.
+func (su *SettlementUpdate) AddStorage(s ...*StorageItem) *SettlementUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddStorageIDs(ids...)
+}
+
+// AddInnovationIDs adds the "innovations" edge to the Innovation entity by IDs.
+func (su *SettlementUpdate) AddInnovationIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddInnovationIDs(ids...)
+	return su
+}
+
+// AddInnovations adds the "innovations" edges to the Innovation entity.
+func (su *SettlementUpdate) AddInnovations(i ...*Innovation) *SettlementUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return su.AddInnovationIDs(ids...)
+}
+
+// AddPrincipleIDs adds the "principles" edge to the Principle entity by IDs.
+func (su *SettlementUpdate) AddPrincipleIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddPrincipleIDs(ids...)
+	return su
+}
+
+// AddPrinciples adds the "principles" edges to the Principle entity.
+func (su *SettlementUpdate) AddPrinciples(p ...*Principle) *SettlementUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.AddPrincipleIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (su *SettlementUpdate) AddMilestoneIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddMilestoneIDs(ids...)
+	return su
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (su *SettlementUpdate) AddMilestones(m ...*Milestone) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.AddMilestoneIDs(ids...)
+}
+
+// AddLocationIDs adds the "locations" edge to the Location entity by IDs.
+func (su *SettlementUpdate) AddLocationIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddLocationIDs(ids...)
+	return su
+}
+
+// AddLocations adds the "locations" edges to the Location entity.
+func (su *SettlementUpdate) AddLocations(l ...*Location) *SettlementUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return su.AddLocationIDs(ids...)
+}
+
+// AddMonsterIDs adds the "monsters" edge to the MonsterProgress entity by IDs.
+func (su *SettlementUpdate) AddMonsterIDs(ids ...int) *SettlementUpdate {
+	su.mutation.AddMonsterIDs(ids...)
+	return su
+}
+
+// AddMonsters adds the "monsters" edges to the MonsterProgress entity.
+func (su *SettlementUpdate) AddMonsters(m ...*MonsterProgress) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.AddMonsterIDs(ids...)
+}
+
 // Mutation returns the SettlementMutation object of the builder.
 func (su *SettlementUpdate) Mutation() *SettlementMutation {
 	return su.mutation
@@ -181,6 +382,216 @@ func (su *SettlementUpdate) RemovePopulation(s ...*Survivor) *SettlementUpdate {
 	return su.RemovePopulationIDs(ids...)
 }
 
+// ClearMembers clears all "members" edges to the Membership entity.
+func (su *SettlementUpdate) ClearMembers() *SettlementUpdate {
+	su.mutation.ClearMembers()
+	return su
+}
+
+// RemoveMemberIDs removes the "members" edge to Membership entities by IDs.
+func (su *SettlementUpdate) RemoveMemberIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveMemberIDs(ids...)
+	return su
+}
+
+// RemoveMembers removes "members" edges to Membership entities.
+func (su *SettlementUpdate) RemoveMembers(m ...*Membership) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.RemoveMemberIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (su *SettlementUpdate) ClearInvites() *SettlementUpdate {
+	su.mutation.ClearInvites()
+	return su
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (su *SettlementUpdate) RemoveInviteIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveInviteIDs(ids...)
+	return su
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (su *SettlementUpdate) RemoveInvites(i ...*Invite) *SettlementUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return su.RemoveInviteIDs(ids...)
+}
+
+// ClearAuditLog clears all "audit_log" edges to the AuditLog entity.
+func (su *SettlementUpdate) ClearAuditLog() *SettlementUpdate {
+	su.mutation.ClearAuditLog()
+	return su
+}
+
+// RemoveAuditLogIDs removes the "audit_log" edge to AuditLog entities by IDs.
+func (su *SettlementUpdate) RemoveAuditLogIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveAuditLogIDs(ids...)
+	return su
+}
+
+// RemoveAuditLog removes "audit_log" edges to AuditLog entities.
+func (su *SettlementUpdate) RemoveAuditLog(a ...*AuditLog) *SettlementUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.RemoveAuditLogIDs(ids...)
+}
+
+// ClearTimeline clears all "timeline" edges to the TimelineEvent entity.
+func (su *SettlementUpdate) ClearTimeline() *SettlementUpdate {
+	su.mutation.ClearTimeline()
+	return su
+}
+
+// RemoveTimelineIDs removes the "timeline" edge to TimelineEvent entities by IDs.
+func (su *SettlementUpdate) RemoveTimelineIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveTimelineIDs(ids...)
+	return su
+}
+
+// RemoveTimeline removes "timeline" edges to TimelineEvent entities.
+func (su *SettlementUpdate) RemoveTimeline(t ...*TimelineEvent) *SettlementUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.RemoveTimelineIDs(ids...)
+}
+
+// ClearStorage clears all "storage" edges to the StorageItem entity.
+func (su *SettlementUpdate) ClearStorage() *SettlementUpdate {
+	su.mutation.ClearStorage()
+	return su
+}
+
+// RemoveStorageIDs removes the "storage" edge to StorageItem entities by IDs.
+func (su *SettlementUpdate) RemoveStorageIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveStorageIDs(ids...)
+	return su
+}
+
+// RemoveStorage removes "storage" edges to StorageItem entities.
+func (su *SettlementUpdate) RemoveStorage(s ...*StorageItem) *SettlementUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveStorageIDs(ids...)
+}
+
+// ClearInnovations clears all "innovations" edges to the Innovation entity.
+func (su *SettlementUpdate) ClearInnovations() *SettlementUpdate {
+	su.mutation.ClearInnovations()
+	return su
+}
+
+// RemoveInnovationIDs removes the "innovations" edge to Innovation entities by IDs.
+func (su *SettlementUpdate) RemoveInnovationIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveInnovationIDs(ids...)
+	return su
+}
+
+// RemoveInnovations removes "innovations" edges to Innovation entities.
+func (su *SettlementUpdate) RemoveInnovations(i ...*Innovation) *SettlementUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return su.RemoveInnovationIDs(ids...)
+}
+
+// ClearPrinciples clears all "principles" edges to the Principle entity.
+func (su *SettlementUpdate) ClearPrinciples() *SettlementUpdate {
+	su.mutation.ClearPrinciples()
+	return su
+}
+
+// RemovePrincipleIDs removes the "principles" edge to Principle entities by IDs.
+func (su *SettlementUpdate) RemovePrincipleIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemovePrincipleIDs(ids...)
+	return su
+}
+
+// RemovePrinciples removes "principles" edges to Principle entities.
+func (su *SettlementUpdate) RemovePrinciples(p ...*Principle) *SettlementUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return su.RemovePrincipleIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (su *SettlementUpdate) ClearMilestones() *SettlementUpdate {
+	su.mutation.ClearMilestones()
+	return su
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (su *SettlementUpdate) RemoveMilestoneIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveMilestoneIDs(ids...)
+	return su
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (su *SettlementUpdate) RemoveMilestones(m ...*Milestone) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.RemoveMilestoneIDs(ids...)
+}
+
+// ClearLocations clears all "locations" edges to the Location entity.
+func (su *SettlementUpdate) ClearLocations() *SettlementUpdate {
+	su.mutation.ClearLocations()
+	return su
+}
+
+// RemoveLocationIDs removes the "locations" edge to Location entities by IDs.
+func (su *SettlementUpdate) RemoveLocationIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveLocationIDs(ids...)
+	return su
+}
+
+// RemoveLocations removes "locations" edges to Location entities.
+func (su *SettlementUpdate) RemoveLocations(l ...*Location) *SettlementUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return su.RemoveLocationIDs(ids...)
+}
+
+// ClearMonsters clears all "monsters" edges to the MonsterProgress entity.
+func (su *SettlementUpdate) ClearMonsters() *SettlementUpdate {
+	su.mutation.ClearMonsters()
+	return su
+}
+
+// RemoveMonsterIDs removes the "monsters" edge to MonsterProgress entities by IDs.
+func (su *SettlementUpdate) RemoveMonsterIDs(ids ...int) *SettlementUpdate {
+	su.mutation.RemoveMonsterIDs(ids...)
+	return su
+}
+
+// RemoveMonsters removes "monsters" edges to MonsterProgress entities.
+func (su *SettlementUpdate) RemoveMonsters(m ...*MonsterProgress) *SettlementUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return su.RemoveMonsterIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SettlementUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -255,6 +666,12 @@ func (su *SettlementUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.SetField(settlement.FieldDeletedAt, field.TypeTime, value)
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.ClearField(settlement.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := su.mutation.Owner(); ok {
 		_spec.SetField(settlement.FieldOwner, field.TypeString, value)
 	}
@@ -285,6 +702,12 @@ func (su *SettlementUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.AddedCurrentYear(); ok {
 		_spec.AddField(settlement.FieldCurrentYear, field.TypeInt, value)
 	}
+	if value, ok := su.mutation.ShareTokenHash(); ok {
+		_spec.SetField(settlement.FieldShareTokenHash, field.TypeString, value)
+	}
+	if su.mutation.ShareTokenHashCleared() {
+		_spec.ClearField(settlement.FieldShareTokenHash, field.TypeString)
+	}
 	if su.mutation.PopulationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -330,56 +753,526 @@ func (su *SettlementUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{settlement.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if su.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	su.mutation.done = true
-	return n, nil
-}
-
-// SettlementUpdateOne is the builder for updating a single Settlement entity.
-type SettlementUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *SettlementMutation
-}
-
-// SetOwner sets the "owner" field.
-func (suo *SettlementUpdateOne) SetOwner(s string) *SettlementUpdateOne {
-	suo.mutation.SetOwner(s)
-	return suo
-}
-
-// SetNillableOwner sets the "owner" field if the given value is not nil.
-func (suo *SettlementUpdateOne) SetNillableOwner(s *string) *SettlementUpdateOne {
-	if s != nil {
-		suo.SetOwner(*s)
+	if nodes := su.mutation.RemovedMembersIDs(); len(nodes) > 0 && !su.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return suo
-}
-
-// SetName sets the "name" field.
-func (suo *SettlementUpdateOne) SetName(s string) *SettlementUpdateOne {
-	suo.mutation.SetName(s)
-	return suo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (suo *SettlementUpdateOne) SetNillableName(s *string) *SettlementUpdateOne {
-	if s != nil {
-		suo.SetName(*s)
+	if nodes := su.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return suo
-}
-
-// SetSurvivalLimit sets the "survivalLimit" field.
-func (suo *SettlementUpdateOne) SetSurvivalLimit(i int) *SettlementUpdateOne {
+	if su.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !su.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.AuditLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedAuditLogIDs(); len(nodes) > 0 && !su.mutation.AuditLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AuditLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.TimelineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedTimelineIDs(); len(nodes) > 0 && !su.mutation.TimelineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TimelineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedStorageIDs(); len(nodes) > 0 && !su.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.InnovationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedInnovationsIDs(); len(nodes) > 0 && !su.mutation.InnovationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.InnovationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.PrinciplesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedPrinciplesIDs(); len(nodes) > 0 && !su.mutation.PrinciplesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PrinciplesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !su.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedLocationsIDs(); len(nodes) > 0 && !su.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.LocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.MonstersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedMonstersIDs(); len(nodes) > 0 && !su.mutation.MonstersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.MonstersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SettlementUpdateOne is the builder for updating a single Settlement entity.
+type SettlementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *SettlementUpdateOne) SetDeletedAt(t time.Time) *SettlementUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableDeletedAt(t *time.Time) *SettlementUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *SettlementUpdateOne) ClearDeletedAt() *SettlementUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
+// SetOwner sets the "owner" field.
+func (suo *SettlementUpdateOne) SetOwner(s string) *SettlementUpdateOne {
+	suo.mutation.SetOwner(s)
+	return suo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableOwner(s *string) *SettlementUpdateOne {
+	if s != nil {
+		suo.SetOwner(*s)
+	}
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *SettlementUpdateOne) SetName(s string) *SettlementUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableName(s *string) *SettlementUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetSurvivalLimit sets the "survivalLimit" field.
+func (suo *SettlementUpdateOne) SetSurvivalLimit(i int) *SettlementUpdateOne {
 	suo.mutation.ResetSurvivalLimit()
 	suo.mutation.SetSurvivalLimit(i)
 	return suo
@@ -448,59 +1341,439 @@ func (suo *SettlementUpdateOne) SetCurrentYear(i int) *SettlementUpdateOne {
 	return suo
 }
 
-// SetNillableCurrentYear sets the "currentYear" field if the given value is not nil.
-func (suo *SettlementUpdateOne) SetNillableCurrentYear(i *int) *SettlementUpdateOne {
-	if i != nil {
-		suo.SetCurrentYear(*i)
+// SetNillableCurrentYear sets the "currentYear" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableCurrentYear(i *int) *SettlementUpdateOne {
+	if i != nil {
+		suo.SetCurrentYear(*i)
+	}
+	return suo
+}
+
+// AddCurrentYear adds i to the "currentYear" field.
+func (suo *SettlementUpdateOne) AddCurrentYear(i int) *SettlementUpdateOne {
+	suo.mutation.AddCurrentYear(i)
+	return suo
+}
+
+// SetShareTokenHash sets the "share_token_hash" field.
+func (suo *SettlementUpdateOne) SetShareTokenHash(s string) *SettlementUpdateOne {
+	suo.mutation.SetShareTokenHash(s)
+	return suo
+}
+
+// SetNillableShareTokenHash sets the "share_token_hash" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableShareTokenHash(s *string) *SettlementUpdateOne {
+	if s != nil {
+		suo.SetShareTokenHash(*s)
+	}
+	return suo
+}
+
+// ClearShareTokenHash clears the value of the "share_token_hash" field.
+func (suo *SettlementUpdateOne) ClearShareTokenHash() *SettlementUpdateOne {
+	suo.mutation.ClearShareTokenHash()
+	return suo
+}
+
+// AddPopulationIDs adds the "population" edge to the Survivor entity by IDs.
+func (suo *SettlementUpdateOne) AddPopulationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddPopulationIDs(ids...)
+	return suo
+}
+
+// AddPopulation adds the "population" edges to the Survivor entity.
+func (suo *SettlementUpdateOne) AddPopulation(s ...*Survivor) *SettlementUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddPopulationIDs(ids...)
+}
+
+// AddMemberIDs adds the "members" edge to the Membership entity by IDs.
+func (suo *SettlementUpdateOne) AddMemberIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddMemberIDs(ids...)
+	return suo
+}
+
+// AddMembers adds the "members" edges to the Membership entity.
+func (suo *SettlementUpdateOne) AddMembers(m ...*Membership) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (suo *SettlementUpdateOne) AddInviteIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddInviteIDs(ids...)
+	return suo
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (suo *SettlementUpdateOne) AddInvites(i ...*Invite) *SettlementUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return suo.AddInviteIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_log" edge to the AuditLog entity by IDs.
+func (suo *SettlementUpdateOne) AddAuditLogIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddAuditLogIDs(ids...)
+	return suo
+}
+
+// AddAuditLog adds the "audit_log" edges to the AuditLog entity.
+func (suo *SettlementUpdateOne) AddAuditLog(a ...*AuditLog) *SettlementUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.AddAuditLogIDs(ids...)
+}
+
+// AddTimelineIDs adds the "timeline" edge to the TimelineEvent entity by IDs.
+func (suo *SettlementUpdateOne) AddTimelineIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddTimelineIDs(ids...)
+	return suo
+}
+
+// AddTimeline adds the "timeline" edges to the TimelineEvent entity.
+func (suo *SettlementUpdateOne) AddTimeline(t ...*TimelineEvent) *SettlementUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.AddTimelineIDs(ids...)
+}
+
+// AddStorageIDs adds the "storage" edge to the StorageItem entity by IDs.
+func (suo *SettlementUpdateOne) AddStorageIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddStorageIDs(ids...)
+	return suo
+}
+
+// AddStorage adds the "storage" edges to the StorageItem entity.
+func (suo *SettlementUpdateOne) AddStorage(s ...*StorageItem) *SettlementUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddStorageIDs(ids...)
+}
+
+// AddInnovationIDs adds the "innovations" edge to the Innovation entity by IDs.
+func (suo *SettlementUpdateOne) AddInnovationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddInnovationIDs(ids...)
+	return suo
+}
+
+// AddInnovations adds the "innovations" edges to the Innovation entity.
+func (suo *SettlementUpdateOne) AddInnovations(i ...*Innovation) *SettlementUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return suo.AddInnovationIDs(ids...)
+}
+
+// AddPrincipleIDs adds the "principles" edge to the Principle entity by IDs.
+func (suo *SettlementUpdateOne) AddPrincipleIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddPrincipleIDs(ids...)
+	return suo
+}
+
+// AddPrinciples adds the "principles" edges to the Principle entity.
+func (suo *SettlementUpdateOne) AddPrinciples(p ...*Principle) *SettlementUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.AddPrincipleIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (suo *SettlementUpdateOne) AddMilestoneIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddMilestoneIDs(ids...)
+	return suo
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (suo *SettlementUpdateOne) AddMilestones(m ...*Milestone) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.AddMilestoneIDs(ids...)
+}
+
+// AddLocationIDs adds the "locations" edge to the Location entity by IDs.
+func (suo *SettlementUpdateOne) AddLocationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddLocationIDs(ids...)
+	return suo
+}
+
+// AddLocations adds the "locations" edges to the Location entity.
+func (suo *SettlementUpdateOne) AddLocations(l ...*Location) *SettlementUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return suo.AddLocationIDs(ids...)
+}
+
+// AddMonsterIDs adds the "monsters" edge to the MonsterProgress entity by IDs.
+func (suo *SettlementUpdateOne) AddMonsterIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.AddMonsterIDs(ids...)
+	return suo
+}
+
+// AddMonsters adds the "monsters" edges to the MonsterProgress entity.
+func (suo *SettlementUpdateOne) AddMonsters(m ...*MonsterProgress) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.AddMonsterIDs(ids...)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (suo *SettlementUpdateOne) Mutation() *SettlementMutation {
+	return suo.mutation
+}
+
+// ClearPopulation clears all "population" edges to the Survivor entity.
+func (suo *SettlementUpdateOne) ClearPopulation() *SettlementUpdateOne {
+	suo.mutation.ClearPopulation()
+	return suo
+}
+
+// RemovePopulationIDs removes the "population" edge to Survivor entities by IDs.
+func (suo *SettlementUpdateOne) RemovePopulationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemovePopulationIDs(ids...)
+	return suo
+}
+
+// RemovePopulation removes "population" edges to Survivor entities.
+func (suo *SettlementUpdateOne) RemovePopulation(s ...*Survivor) *SettlementUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemovePopulationIDs(ids...)
+}
+
+// ClearMembers clears all "members" edges to the Membership entity.
+func (suo *SettlementUpdateOne) ClearMembers() *SettlementUpdateOne {
+	suo.mutation.ClearMembers()
+	return suo
+}
+
+// RemoveMemberIDs removes the "members" edge to Membership entities by IDs.
+func (suo *SettlementUpdateOne) RemoveMemberIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveMemberIDs(ids...)
+	return suo
+}
+
+// RemoveMembers removes "members" edges to Membership entities.
+func (suo *SettlementUpdateOne) RemoveMembers(m ...*Membership) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return suo.RemoveMemberIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (suo *SettlementUpdateOne) ClearInvites() *SettlementUpdateOne {
+	suo.mutation.ClearInvites()
+	return suo
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (suo *SettlementUpdateOne) RemoveInviteIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveInviteIDs(ids...)
+	return suo
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (suo *SettlementUpdateOne) RemoveInvites(i ...*Invite) *SettlementUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return suo.RemoveInviteIDs(ids...)
+}
+
+// ClearAuditLog clears all "audit_log" edges to the AuditLog entity.
+func (suo *SettlementUpdateOne) ClearAuditLog() *SettlementUpdateOne {
+	suo.mutation.ClearAuditLog()
+	return suo
+}
+
+// RemoveAuditLogIDs removes the "audit_log" edge to AuditLog entities by IDs.
+func (suo *SettlementUpdateOne) RemoveAuditLogIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveAuditLogIDs(ids...)
+	return suo
+}
+
+// RemoveAuditLog removes "audit_log" edges to AuditLog entities.
+func (suo *SettlementUpdateOne) RemoveAuditLog(a ...*AuditLog) *SettlementUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.RemoveAuditLogIDs(ids...)
+}
+
+// ClearTimeline clears all "timeline" edges to the TimelineEvent entity.
+func (suo *SettlementUpdateOne) ClearTimeline() *SettlementUpdateOne {
+	suo.mutation.ClearTimeline()
+	return suo
+}
+
+// RemoveTimelineIDs removes the "timeline" edge to TimelineEvent entities by IDs.
+func (suo *SettlementUpdateOne) RemoveTimelineIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveTimelineIDs(ids...)
+	return suo
+}
+
+// RemoveTimeline removes "timeline" edges to TimelineEvent entities.
+func (suo *SettlementUpdateOne) RemoveTimeline(t ...*TimelineEvent) *SettlementUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.RemoveTimelineIDs(ids...)
+}
+
+// ClearStorage clears all "storage" edges to the StorageItem entity.
+func (suo *SettlementUpdateOne) ClearStorage() *SettlementUpdateOne {
+	suo.mutation.ClearStorage()
+	return suo
+}
+
+// RemoveStorageIDs removes the "storage" edge to StorageItem entities by IDs.
+func (suo *SettlementUpdateOne) RemoveStorageIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveStorageIDs(ids...)
+	return suo
+}
+
+// RemoveStorage removes "storage" edges to StorageItem entities.
+func (suo *SettlementUpdateOne) RemoveStorage(s ...*StorageItem) *SettlementUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveStorageIDs(ids...)
+}
+
+// ClearInnovations clears all "innovations" edges to the Innovation entity.
+func (suo *SettlementUpdateOne) ClearInnovations() *SettlementUpdateOne {
+	suo.mutation.ClearInnovations()
+	return suo
+}
+
+// RemoveInnovationIDs removes the "innovations" edge to Innovation entities by IDs.
+func (suo *SettlementUpdateOne) RemoveInnovationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveInnovationIDs(ids...)
+	return suo
+}
+
+// RemoveInnovations removes "innovations" edges to Innovation entities.
+func (suo *SettlementUpdateOne) RemoveInnovations(i ...*Innovation) *SettlementUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return suo.RemoveInnovationIDs(ids...)
+}
+
+// ClearPrinciples clears all "principles" edges to the Principle entity.
+func (suo *SettlementUpdateOne) ClearPrinciples() *SettlementUpdateOne {
+	suo.mutation.ClearPrinciples()
+	return suo
+}
+
+// RemovePrincipleIDs removes the "principles" edge to Principle entities by IDs.
+func (suo *SettlementUpdateOne) RemovePrincipleIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemovePrincipleIDs(ids...)
+	return suo
+}
+
+// RemovePrinciples removes "principles" edges to Principle entities.
+func (suo *SettlementUpdateOne) RemovePrinciples(p ...*Principle) *SettlementUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return suo.RemovePrincipleIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (suo *SettlementUpdateOne) ClearMilestones() *SettlementUpdateOne {
+	suo.mutation.ClearMilestones()
+	return suo
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (suo *SettlementUpdateOne) RemoveMilestoneIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveMilestoneIDs(ids...)
+	return suo
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (suo *SettlementUpdateOne) RemoveMilestones(m ...*Milestone) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return suo
+	return suo.RemoveMilestoneIDs(ids...)
 }
 
-// AddCurrentYear adds i to the "currentYear" field.
-func (suo *SettlementUpdateOne) AddCurrentYear(i int) *SettlementUpdateOne {
-	suo.mutation.AddCurrentYear(i)
+// ClearLocations clears all "locations" edges to the Location entity.
+func (suo *SettlementUpdateOne) ClearLocations() *SettlementUpdateOne {
+	suo.mutation.ClearLocations()
 	return suo
 }
 
-// AddPopulationIDs adds the "population" edge to the Survivor entity by IDs.
-func (suo *SettlementUpdateOne) AddPopulationIDs(ids ...int) *SettlementUpdateOne {
-	suo.mutation.AddPopulationIDs(ids...)
+// RemoveLocationIDs removes the "locations" edge to Location entities by IDs.
+func (suo *SettlementUpdateOne) RemoveLocationIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveLocationIDs(ids...)
 	return suo
 }
 
-// AddPopulation adds the "population" edges to the Survivor entity.
-func (suo *SettlementUpdateOne) AddPopulation(s ...*Survivor) *SettlementUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveLocations removes "locations" edges to Location entities.
+func (suo *SettlementUpdateOne) RemoveLocations(l ...*Location) *SettlementUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
 	}
-	return suo.AddPopulationIDs(ids...)
-}
-
-// Mutation returns the SettlementMutation object of the builder.
-func (suo *SettlementUpdateOne) Mutation() *SettlementMutation {
-	return suo.mutation
+	return suo.RemoveLocationIDs(ids...)
 }
 
-// ClearPopulation clears all "population" edges to the Survivor entity.
-func (suo *SettlementUpdateOne) ClearPopulation() *SettlementUpdateOne {
-	suo.mutation.ClearPopulation()
+// ClearMonsters clears all "monsters" edges to the MonsterProgress entity.
+func (suo *SettlementUpdateOne) ClearMonsters() *SettlementUpdateOne {
+	suo.mutation.ClearMonsters()
 	return suo
 }
 
-// RemovePopulationIDs removes the "population" edge to Survivor entities by IDs.
-func (suo *SettlementUpdateOne) RemovePopulationIDs(ids ...int) *SettlementUpdateOne {
-	suo.mutation.RemovePopulationIDs(ids...)
+// RemoveMonsterIDs removes the "monsters" edge to MonsterProgress entities by IDs.
+func (suo *SettlementUpdateOne) RemoveMonsterIDs(ids ...int) *SettlementUpdateOne {
+	suo.mutation.RemoveMonsterIDs(ids...)
 	return suo
 }
 
-// RemovePopulation removes "population" edges to Survivor entities.
-func (suo *SettlementUpdateOne) RemovePopulation(s ...*Survivor) *SettlementUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveMonsters removes "monsters" edges to MonsterProgress entities.
+func (suo *SettlementUpdateOne) RemoveMonsters(m ...*MonsterProgress) *SettlementUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
 	}
-	return suo.RemovePopulationIDs(ids...)
+	return suo.RemoveMonsterIDs(ids...)
 }
 
 // Where appends a list predicates to the SettlementUpdate builder.
@@ -550,115 +1823,577 @@ func (suo *SettlementUpdateOne) check() error {
 			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "Settlement.owner": %w`, err)}
 		}
 	}
-	if v, ok := suo.mutation.Name(); ok {
-		if err := settlement.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Settlement.name": %w`, err)}
+	if v, ok := suo.mutation.Name(); ok {
+		if err := settlement.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Settlement.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.SurvivalLimit(); ok {
+		if err := settlement.SurvivalLimitValidator(v); err != nil {
+			return &ValidationError{Name: "survivalLimit", err: fmt.Errorf(`ent: validator failed for field "Settlement.survivalLimit": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.DepartingSurvival(); ok {
+		if err := settlement.DepartingSurvivalValidator(v); err != nil {
+			return &ValidationError{Name: "departingSurvival", err: fmt.Errorf(`ent: validator failed for field "Settlement.departingSurvival": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.CollectiveCognition(); ok {
+		if err := settlement.CollectiveCognitionValidator(v); err != nil {
+			return &ValidationError{Name: "collectiveCognition", err: fmt.Errorf(`ent: validator failed for field "Settlement.collectiveCognition": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.CurrentYear(); ok {
+		if err := settlement.CurrentYearValidator(v); err != nil {
+			return &ValidationError{Name: "currentYear", err: fmt.Errorf(`ent: validator failed for field "Settlement.currentYear": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Settlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlement.FieldID)
+		for _, f := range fields {
+			if !settlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.SetField(settlement.FieldDeletedAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.ClearField(settlement.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.Owner(); ok {
+		_spec.SetField(settlement.FieldOwner, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(settlement.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.SurvivalLimit(); ok {
+		_spec.SetField(settlement.FieldSurvivalLimit, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedSurvivalLimit(); ok {
+		_spec.AddField(settlement.FieldSurvivalLimit, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.DepartingSurvival(); ok {
+		_spec.SetField(settlement.FieldDepartingSurvival, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDepartingSurvival(); ok {
+		_spec.AddField(settlement.FieldDepartingSurvival, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.CollectiveCognition(); ok {
+		_spec.SetField(settlement.FieldCollectiveCognition, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedCollectiveCognition(); ok {
+		_spec.AddField(settlement.FieldCollectiveCognition, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.CurrentYear(); ok {
+		_spec.SetField(settlement.FieldCurrentYear, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedCurrentYear(); ok {
+		_spec.AddField(settlement.FieldCurrentYear, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.ShareTokenHash(); ok {
+		_spec.SetField(settlement.FieldShareTokenHash, field.TypeString, value)
+	}
+	if suo.mutation.ShareTokenHashCleared() {
+		_spec.ClearField(settlement.FieldShareTokenHash, field.TypeString)
+	}
+	if suo.mutation.PopulationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PopulationTable,
+			Columns: []string{settlement.PopulationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedPopulationIDs(); len(nodes) > 0 && !suo.mutation.PopulationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PopulationTable,
+			Columns: []string{settlement.PopulationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PopulationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PopulationTable,
+			Columns: []string{settlement.PopulationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !suo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MembersTable,
+			Columns: []string{settlement.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(membership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !suo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InvitesTable,
+			Columns: []string{settlement.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.AuditLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedAuditLogIDs(); len(nodes) > 0 && !suo.mutation.AuditLogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AuditLogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.AuditLogTable,
+			Columns: []string{settlement.AuditLogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := suo.mutation.SurvivalLimit(); ok {
-		if err := settlement.SurvivalLimitValidator(v); err != nil {
-			return &ValidationError{Name: "survivalLimit", err: fmt.Errorf(`ent: validator failed for field "Settlement.survivalLimit": %w`, err)}
+	if suo.mutation.TimelineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := suo.mutation.DepartingSurvival(); ok {
-		if err := settlement.DepartingSurvivalValidator(v); err != nil {
-			return &ValidationError{Name: "departingSurvival", err: fmt.Errorf(`ent: validator failed for field "Settlement.departingSurvival": %w`, err)}
+	if nodes := suo.mutation.RemovedTimelineIDs(); len(nodes) > 0 && !suo.mutation.TimelineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := suo.mutation.CollectiveCognition(); ok {
-		if err := settlement.CollectiveCognitionValidator(v); err != nil {
-			return &ValidationError{Name: "collectiveCognition", err: fmt.Errorf(`ent: validator failed for field "Settlement.collectiveCognition": %w`, err)}
+	if nodes := suo.mutation.TimelineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.TimelineTable,
+			Columns: []string{settlement.TimelineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := suo.mutation.CurrentYear(); ok {
-		if err := settlement.CurrentYearValidator(v); err != nil {
-			return &ValidationError{Name: "currentYear", err: fmt.Errorf(`ent: validator failed for field "Settlement.currentYear": %w`, err)}
+	if suo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (suo *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement, err error) {
-	if err := suo.check(); err != nil {
-		return _node, err
+	if nodes := suo.mutation.RemovedStorageIDs(); len(nodes) > 0 && !suo.mutation.StorageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
-	id, ok := suo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Settlement.id" for update`)}
+	if nodes := suo.mutation.StorageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.StorageTable,
+			Columns: []string{settlement.StorageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := suo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, settlement.FieldID)
-		for _, f := range fields {
-			if !settlement.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != settlement.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if suo.mutation.InnovationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := suo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := suo.mutation.RemovedInnovationsIDs(); len(nodes) > 0 && !suo.mutation.InnovationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.Owner(); ok {
-		_spec.SetField(settlement.FieldOwner, field.TypeString, value)
+	if nodes := suo.mutation.InnovationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.InnovationsTable,
+			Columns: []string{settlement.InnovationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(innovation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := suo.mutation.Name(); ok {
-		_spec.SetField(settlement.FieldName, field.TypeString, value)
+	if suo.mutation.PrinciplesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.SurvivalLimit(); ok {
-		_spec.SetField(settlement.FieldSurvivalLimit, field.TypeInt, value)
+	if nodes := suo.mutation.RemovedPrinciplesIDs(); len(nodes) > 0 && !suo.mutation.PrinciplesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.AddedSurvivalLimit(); ok {
-		_spec.AddField(settlement.FieldSurvivalLimit, field.TypeInt, value)
+	if nodes := suo.mutation.PrinciplesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.PrinciplesTable,
+			Columns: []string{settlement.PrinciplesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(principle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := suo.mutation.DepartingSurvival(); ok {
-		_spec.SetField(settlement.FieldDepartingSurvival, field.TypeInt, value)
+	if suo.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.AddedDepartingSurvival(); ok {
-		_spec.AddField(settlement.FieldDepartingSurvival, field.TypeInt, value)
+	if nodes := suo.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !suo.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.CollectiveCognition(); ok {
-		_spec.SetField(settlement.FieldCollectiveCognition, field.TypeInt, value)
+	if nodes := suo.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settlement.MilestonesTable,
+			Columns: []string{settlement.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := suo.mutation.AddedCollectiveCognition(); ok {
-		_spec.AddField(settlement.FieldCollectiveCognition, field.TypeInt, value)
+	if suo.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.CurrentYear(); ok {
-		_spec.SetField(settlement.FieldCurrentYear, field.TypeInt, value)
+	if nodes := suo.mutation.RemovedLocationsIDs(); len(nodes) > 0 && !suo.mutation.LocationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := suo.mutation.AddedCurrentYear(); ok {
-		_spec.AddField(settlement.FieldCurrentYear, field.TypeInt, value)
+	if nodes := suo.mutation.LocationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   settlement.LocationsTable,
+			Columns: settlement.LocationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if suo.mutation.PopulationCleared() {
+	if suo.mutation.MonstersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   settlement.PopulationTable,
-			Columns: []string{settlement.PopulationColumn},
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := suo.mutation.RemovedPopulationIDs(); len(nodes) > 0 && !suo.mutation.PopulationCleared() {
+	if nodes := suo.mutation.RemovedMonstersIDs(); len(nodes) > 0 && !suo.mutation.MonstersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   settlement.PopulationTable,
-			Columns: []string{settlement.PopulationColumn},
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -666,15 +2401,15 @@ func (suo *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement,
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := suo.mutation.PopulationIDs(); len(nodes) > 0 {
+	if nodes := suo.mutation.MonstersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   settlement.PopulationTable,
-			Columns: []string{settlement.PopulationColumn},
+			Table:   settlement.MonstersTable,
+			Columns: []string{settlement.MonstersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(monsterprogress.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
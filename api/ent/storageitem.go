@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+)
+
+// StorageItem is the model entity for the StorageItem schema.
+type StorageItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category storageitem.Category `json:"category,omitempty"`
+	// SourceMonster holds the value of the "source_monster" field.
+	SourceMonster string `json:"source_monster,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StorageItemQuery when eager-loading is set.
+	Edges        StorageItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StorageItemEdges holds the relations/edges for other nodes in the graph.
+type StorageItemEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StorageItemEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StorageItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case storageitem.FieldKeywords:
+			values[i] = new([]byte)
+		case storageitem.FieldID, storageitem.FieldQuantity, storageitem.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case storageitem.FieldName, storageitem.FieldCategory, storageitem.FieldSourceMonster:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StorageItem fields.
+func (si *StorageItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case storageitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			si.ID = int(value.Int64)
+		case storageitem.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				si.Name = value.String
+			}
+		case storageitem.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				si.Category = storageitem.Category(value.String)
+			}
+		case storageitem.FieldSourceMonster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_monster", values[i])
+			} else if value.Valid {
+				si.SourceMonster = value.String
+			}
+		case storageitem.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &si.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case storageitem.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				si.Quantity = int(value.Int64)
+			}
+		case storageitem.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				si.SettlementID = int(value.Int64)
+			}
+		default:
+			si.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StorageItem.
+// This includes values selected through modifiers, order, etc.
+func (si *StorageItem) Value(name string) (ent.Value, error) {
+	return si.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the StorageItem entity.
+func (si *StorageItem) QuerySettlement() *SettlementQuery {
+	return NewStorageItemClient(si.config).QuerySettlement(si)
+}
+
+// Update returns a builder for updating this StorageItem.
+// Note that you need to call StorageItem.Unwrap() before calling this method if this StorageItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *StorageItem) Update() *StorageItemUpdateOne {
+	return NewStorageItemClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the StorageItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *StorageItem) Unwrap() *StorageItem {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StorageItem is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *StorageItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("StorageItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("name=")
+	builder.WriteString(si.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", si.Category))
+	builder.WriteString(", ")
+	builder.WriteString("source_monster=")
+	builder.WriteString(si.SourceMonster)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", si.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", si.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StorageItems is a parsable slice of StorageItem.
+type StorageItems []*StorageItem
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package storageitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/failuretoload/datamonster/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldName, v))
+}
+
+// SourceMonster applies equality check predicate on the "source_monster" field. It's identical to SourceMonsterEQ.
+func SourceMonster(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldSourceMonster, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
+func SettlementID(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// SourceMonsterEQ applies the EQ predicate on the "source_monster" field.
+func SourceMonsterEQ(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldSourceMonster, v))
+}
+
+// SourceMonsterNEQ applies the NEQ predicate on the "source_monster" field.
+func SourceMonsterNEQ(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldSourceMonster, v))
+}
+
+// SourceMonsterIn applies the In predicate on the "source_monster" field.
+func SourceMonsterIn(vs ...string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldSourceMonster, vs...))
+}
+
+// SourceMonsterNotIn applies the NotIn predicate on the "source_monster" field.
+func SourceMonsterNotIn(vs ...string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldSourceMonster, vs...))
+}
+
+// SourceMonsterGT applies the GT predicate on the "source_monster" field.
+func SourceMonsterGT(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGT(FieldSourceMonster, v))
+}
+
+// SourceMonsterGTE applies the GTE predicate on the "source_monster" field.
+func SourceMonsterGTE(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGTE(FieldSourceMonster, v))
+}
+
+// SourceMonsterLT applies the LT predicate on the "source_monster" field.
+func SourceMonsterLT(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLT(FieldSourceMonster, v))
+}
+
+// SourceMonsterLTE applies the LTE predicate on the "source_monster" field.
+func SourceMonsterLTE(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLTE(FieldSourceMonster, v))
+}
+
+// SourceMonsterContains applies the Contains predicate on the "source_monster" field.
+func SourceMonsterContains(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldContains(FieldSourceMonster, v))
+}
+
+// SourceMonsterHasPrefix applies the HasPrefix predicate on the "source_monster" field.
+func SourceMonsterHasPrefix(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldHasPrefix(FieldSourceMonster, v))
+}
+
+// SourceMonsterHasSuffix applies the HasSuffix predicate on the "source_monster" field.
+func SourceMonsterHasSuffix(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldHasSuffix(FieldSourceMonster, v))
+}
+
+// SourceMonsterIsNil applies the IsNil predicate on the "source_monster" field.
+func SourceMonsterIsNil() predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIsNull(FieldSourceMonster))
+}
+
+// SourceMonsterNotNil applies the NotNil predicate on the "source_monster" field.
+func SourceMonsterNotNil() predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotNull(FieldSourceMonster))
+}
+
+// SourceMonsterEqualFold applies the EqualFold predicate on the "source_monster" field.
+func SourceMonsterEqualFold(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEqualFold(FieldSourceMonster, v))
+}
+
+// SourceMonsterContainsFold applies the ContainsFold predicate on the "source_monster" field.
+func SourceMonsterContainsFold(v string) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldContainsFold(FieldSourceMonster, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotNull(FieldKeywords))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldLTE(FieldQuantity, v))
+}
+
+// SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
+func SettlementIDEQ(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldEQ(FieldSettlementID, v))
+}
+
+// SettlementIDNEQ applies the NEQ predicate on the "settlement_id" field.
+func SettlementIDNEQ(v int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNEQ(FieldSettlementID, v))
+}
+
+// SettlementIDIn applies the In predicate on the "settlement_id" field.
+func SettlementIDIn(vs ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldIn(FieldSettlementID, vs...))
+}
+
+// SettlementIDNotIn applies the NotIn predicate on the "settlement_id" field.
+func SettlementIDNotIn(vs ...int) predicate.StorageItem {
+	return predicate.StorageItem(sql.FieldNotIn(FieldSettlementID, vs...))
+}
+
+// HasSettlement applies the HasEdge predicate on the "settlement" edge.
+func HasSettlement() predicate.StorageItem {
+	return predicate.StorageItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSettlementWith applies the HasEdge predicate on the "settlement" edge with a given conditions (other predicates).
+func HasSettlementWith(preds ...predicate.Settlement) predicate.StorageItem {
+	return predicate.StorageItem(func(s *sql.Selector) {
+		step := newSettlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StorageItem) predicate.StorageItem {
+	return predicate.StorageItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StorageItem) predicate.StorageItem {
+	return predicate.StorageItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StorageItem) predicate.StorageItem {
+	return predicate.StorageItem(sql.NotPredicates(p))
+}
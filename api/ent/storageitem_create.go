@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+)
+
+// StorageItemCreate is the builder for creating a StorageItem entity.
+type StorageItemCreate struct {
+	config
+	mutation *StorageItemMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sic *StorageItemCreate) SetName(s string) *StorageItemCreate {
+	sic.mutation.SetName(s)
+	return sic
+}
+
+// SetCategory sets the "category" field.
+func (sic *StorageItemCreate) SetCategory(s storageitem.Category) *StorageItemCreate {
+	sic.mutation.SetCategory(s)
+	return sic
+}
+
+// SetSourceMonster sets the "source_monster" field.
+func (sic *StorageItemCreate) SetSourceMonster(s string) *StorageItemCreate {
+	sic.mutation.SetSourceMonster(s)
+	return sic
+}
+
+// SetNillableSourceMonster sets the "source_monster" field if the given value is not nil.
+func (sic *StorageItemCreate) SetNillableSourceMonster(s *string) *StorageItemCreate {
+	if s != nil {
+		sic.SetSourceMonster(*s)
+	}
+	return sic
+}
+
+// SetKeywords sets the "keywords" field.
+func (sic *StorageItemCreate) SetKeywords(s []string) *StorageItemCreate {
+	sic.mutation.SetKeywords(s)
+	return sic
+}
+
+// SetQuantity sets the "quantity" field.
+func (sic *StorageItemCreate) SetQuantity(i int) *StorageItemCreate {
+	sic.mutation.SetQuantity(i)
+	return sic
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (sic *StorageItemCreate) SetNillableQuantity(i *int) *StorageItemCreate {
+	if i != nil {
+		sic.SetQuantity(*i)
+	}
+	return sic
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (sic *StorageItemCreate) SetSettlementID(i int) *StorageItemCreate {
+	sic.mutation.SetSettlementID(i)
+	return sic
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (sic *StorageItemCreate) SetSettlement(s *Settlement) *StorageItemCreate {
+	return sic.SetSettlementID(s.ID)
+}
+
+// Mutation returns the StorageItemMutation object of the builder.
+func (sic *StorageItemCreate) Mutation() *StorageItemMutation {
+	return sic.mutation
+}
+
+// Save creates the StorageItem in the database.
+func (sic *StorageItemCreate) Save(ctx context.Context) (*StorageItem, error) {
+	if err := sic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *StorageItemCreate) SaveX(ctx context.Context) *StorageItem {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *StorageItemCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *StorageItemCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *StorageItemCreate) defaults() error {
+	if _, ok := sic.mutation.Quantity(); !ok {
+		v := storageitem.DefaultQuantity
+		sic.mutation.SetQuantity(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *StorageItemCreate) check() error {
+	if _, ok := sic.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "StorageItem.name"`)}
+	}
+	if v, ok := sic.mutation.Name(); ok {
+		if err := storageitem.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StorageItem.name": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "StorageItem.category"`)}
+	}
+	if v, ok := sic.mutation.Category(); ok {
+		if err := storageitem.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "StorageItem.category": %w`, err)}
+		}
+	}
+	if v, ok := sic.mutation.SourceMonster(); ok {
+		if err := storageitem.SourceMonsterValidator(v); err != nil {
+			return &ValidationError{Name: "source_monster", err: fmt.Errorf(`ent: validator failed for field "StorageItem.source_monster": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StorageItem.quantity"`)}
+	}
+	if v, ok := sic.mutation.Quantity(); ok {
+		if err := storageitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "StorageItem.quantity": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "StorageItem.settlement_id"`)}
+	}
+	if len(sic.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "StorageItem.settlement"`)}
+	}
+	return nil
+}
+
+func (sic *StorageItemCreate) sqlSave(ctx context.Context) (*StorageItem, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *StorageItemCreate) createSpec() (*StorageItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageItem{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(storageitem.Table, sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt))
+	)
+	if value, ok := sic.mutation.Name(); ok {
+		_spec.SetField(storageitem.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sic.mutation.Category(); ok {
+		_spec.SetField(storageitem.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := sic.mutation.SourceMonster(); ok {
+		_spec.SetField(storageitem.FieldSourceMonster, field.TypeString, value)
+		_node.SourceMonster = value
+	}
+	if value, ok := sic.mutation.Keywords(); ok {
+		_spec.SetField(storageitem.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := sic.mutation.Quantity(); ok {
+		_spec.SetField(storageitem.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if nodes := sic.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   storageitem.SettlementTable,
+			Columns: []string{storageitem.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StorageItemCreateBulk is the builder for creating many StorageItem entities in bulk.
+type StorageItemCreateBulk struct {
+	config
+	err      error
+	builders []*StorageItemCreate
+}
+
+// Save creates the StorageItem entities in the database.
+func (sicb *StorageItemCreateBulk) Save(ctx context.Context) ([]*StorageItem, error) {
+	if sicb.err != nil {
+		return nil, sicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*StorageItem, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *StorageItemCreateBulk) SaveX(ctx context.Context) []*StorageItem {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *StorageItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *StorageItemCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
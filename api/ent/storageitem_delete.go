@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+)
+
+// StorageItemDelete is the builder for deleting a StorageItem entity.
+type StorageItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *StorageItemMutation
+}
+
+// Where appends a list predicates to the StorageItemDelete builder.
+func (sid *StorageItemDelete) Where(ps ...predicate.StorageItem) *StorageItemDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *StorageItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sid.sqlExec, sid.mutation, sid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *StorageItemDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *StorageItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(storageitem.Table, sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt))
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sid.mutation.done = true
+	return affected, err
+}
+
+// StorageItemDeleteOne is the builder for deleting a single StorageItem entity.
+type StorageItemDeleteOne struct {
+	sid *StorageItemDelete
+}
+
+// Where appends a list predicates to the StorageItemDelete builder.
+func (sido *StorageItemDeleteOne) Where(ps ...predicate.StorageItem) *StorageItemDeleteOne {
+	sido.sid.mutation.Where(ps...)
+	return sido
+}
+
+// Exec executes the deletion query.
+func (sido *StorageItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{storageitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *StorageItemDeleteOne) ExecX(ctx context.Context) {
+	if err := sido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+)
+
+// StorageItemQuery is the builder for querying StorageItem entities.
+type StorageItemQuery struct {
+	config
+	ctx            *QueryContext
+	order          []storageitem.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.StorageItem
+	withSettlement *SettlementQuery
+	modifiers      []func(*sql.Selector)
+	loadTotal      []func(context.Context, []*StorageItem) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StorageItemQuery builder.
+func (siq *StorageItemQuery) Where(ps ...predicate.StorageItem) *StorageItemQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *StorageItemQuery) Limit(limit int) *StorageItemQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *StorageItemQuery) Offset(offset int) *StorageItemQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *StorageItemQuery) Unique(unique bool) *StorageItemQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *StorageItemQuery) Order(o ...storageitem.OrderOption) *StorageItemQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// QuerySettlement chains the current query on the "settlement" edge.
+func (siq *StorageItemQuery) QuerySettlement() *SettlementQuery {
+	query := (&SettlementClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storageitem.Table, storageitem.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, storageitem.SettlementTable, storageitem.SettlementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StorageItem entity from the query.
+// Returns a *NotFoundError when no StorageItem was found.
+func (siq *StorageItemQuery) First(ctx context.Context) (*StorageItem, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{storageitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *StorageItemQuery) FirstX(ctx context.Context) *StorageItem {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StorageItem ID from the query.
+// Returns a *NotFoundError when no StorageItem ID was found.
+func (siq *StorageItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{storageitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *StorageItemQuery) FirstIDX(ctx context.Context) int {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StorageItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StorageItem entity is found.
+// Returns a *NotFoundError when no StorageItem entities are found.
+func (siq *StorageItemQuery) Only(ctx context.Context) (*StorageItem, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{storageitem.Label}
+	default:
+		return nil, &NotSingularError{storageitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *StorageItemQuery) OnlyX(ctx context.Context) *StorageItem {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StorageItem ID in the query.
+// Returns a *NotSingularError when more than one StorageItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *StorageItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{storageitem.Label}
+	default:
+		err = &NotSingularError{storageitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *StorageItemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StorageItems.
+func (siq *StorageItemQuery) All(ctx context.Context) ([]*StorageItem, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryAll)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StorageItem, *StorageItemQuery]()
+	return withInterceptors[[]*StorageItem](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *StorageItemQuery) AllX(ctx context.Context) []*StorageItem {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StorageItem IDs.
+func (siq *StorageItemQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryIDs)
+	if err = siq.Select(storageitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *StorageItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *StorageItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryCount)
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*StorageItemQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *StorageItemQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *StorageItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, ent.OpQueryExist)
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *StorageItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StorageItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *StorageItemQuery) Clone() *StorageItemQuery {
+	if siq == nil {
+		return nil
+	}
+	return &StorageItemQuery{
+		config:         siq.config,
+		ctx:            siq.ctx.Clone(),
+		order:          append([]storageitem.OrderOption{}, siq.order...),
+		inters:         append([]Interceptor{}, siq.inters...),
+		predicates:     append([]predicate.StorageItem{}, siq.predicates...),
+		withSettlement: siq.withSettlement.Clone(),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// WithSettlement tells the query-builder to eager-load the nodes that are connected to
+// the "settlement" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *StorageItemQuery) WithSettlement(opts ...func(*SettlementQuery)) *StorageItemQuery {
+	query := (&SettlementClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withSettlement = query
+	return siq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StorageItem.Query().
+//		GroupBy(storageitem.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *StorageItemQuery) GroupBy(field string, fields ...string) *StorageItemGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StorageItemGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = storageitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.StorageItem.Query().
+//		Select(storageitem.FieldName).
+//		Scan(ctx, &v)
+func (siq *StorageItemQuery) Select(fields ...string) *StorageItemSelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &StorageItemSelect{StorageItemQuery: siq}
+	sbuild.label = storageitem.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StorageItemSelect configured with the given aggregations.
+func (siq *StorageItemQuery) Aggregate(fns ...AggregateFunc) *StorageItemSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *StorageItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !storageitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	if storageitem.Policy == nil {
+		return errors.New("ent: uninitialized storageitem.Policy (forgotten import ent/runtime?)")
+	}
+	if err := storageitem.Policy.EvalQuery(ctx, siq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (siq *StorageItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StorageItem, error) {
+	var (
+		nodes       = []*StorageItem{}
+		_spec       = siq.querySpec()
+		loadedTypes = [1]bool{
+			siq.withSettlement != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StorageItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StorageItem{config: siq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := siq.withSettlement; query != nil {
+		if err := siq.loadSettlement(ctx, query, nodes, nil,
+			func(n *StorageItem, e *Settlement) { n.Edges.Settlement = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range siq.loadTotal {
+		if err := siq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (siq *StorageItemQuery) loadSettlement(ctx context.Context, query *SettlementQuery, nodes []*StorageItem, init func(*StorageItem), assign func(*StorageItem, *Settlement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StorageItem)
+	for i := range nodes {
+		fk := nodes[i].SettlementID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(settlement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "settlement_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (siq *StorageItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *StorageItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(storageitem.Table, storageitem.Columns, sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageitem.FieldID)
+		for i := range fields {
+			if fields[i] != storageitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if siq.withSettlement != nil {
+			_spec.Node.AddColumnOnce(storageitem.FieldSettlementID)
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *StorageItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(storageitem.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = storageitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StorageItemGroupBy is the group-by builder for StorageItem entities.
+type StorageItemGroupBy struct {
+	selector
+	build *StorageItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *StorageItemGroupBy) Aggregate(fns ...AggregateFunc) *StorageItemGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *StorageItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, ent.OpQueryGroupBy)
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StorageItemQuery, *StorageItemGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *StorageItemGroupBy) sqlScan(ctx context.Context, root *StorageItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StorageItemSelect is the builder for selecting fields of StorageItem entities.
+type StorageItemSelect struct {
+	*StorageItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *StorageItemSelect) Aggregate(fns ...AggregateFunc) *StorageItemSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *StorageItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, ent.OpQuerySelect)
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StorageItemQuery, *StorageItemSelect](ctx, sis.StorageItemQuery, sis, sis.inters, v)
+}
+
+func (sis *StorageItemSelect) sqlScan(ctx context.Context, root *StorageItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
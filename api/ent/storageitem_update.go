@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+)
+
+// StorageItemUpdate is the builder for updating StorageItem entities.
+type StorageItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StorageItemMutation
+}
+
+// Where appends a list predicates to the StorageItemUpdate builder.
+func (siu *StorageItemUpdate) Where(ps ...predicate.StorageItem) *StorageItemUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetSourceMonster sets the "source_monster" field.
+func (siu *StorageItemUpdate) SetSourceMonster(s string) *StorageItemUpdate {
+	siu.mutation.SetSourceMonster(s)
+	return siu
+}
+
+// SetNillableSourceMonster sets the "source_monster" field if the given value is not nil.
+func (siu *StorageItemUpdate) SetNillableSourceMonster(s *string) *StorageItemUpdate {
+	if s != nil {
+		siu.SetSourceMonster(*s)
+	}
+	return siu
+}
+
+// ClearSourceMonster clears the value of the "source_monster" field.
+func (siu *StorageItemUpdate) ClearSourceMonster() *StorageItemUpdate {
+	siu.mutation.ClearSourceMonster()
+	return siu
+}
+
+// SetKeywords sets the "keywords" field.
+func (siu *StorageItemUpdate) SetKeywords(s []string) *StorageItemUpdate {
+	siu.mutation.SetKeywords(s)
+	return siu
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (siu *StorageItemUpdate) AppendKeywords(s []string) *StorageItemUpdate {
+	siu.mutation.AppendKeywords(s)
+	return siu
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (siu *StorageItemUpdate) ClearKeywords() *StorageItemUpdate {
+	siu.mutation.ClearKeywords()
+	return siu
+}
+
+// SetQuantity sets the "quantity" field.
+func (siu *StorageItemUpdate) SetQuantity(i int) *StorageItemUpdate {
+	siu.mutation.ResetQuantity()
+	siu.mutation.SetQuantity(i)
+	return siu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (siu *StorageItemUpdate) SetNillableQuantity(i *int) *StorageItemUpdate {
+	if i != nil {
+		siu.SetQuantity(*i)
+	}
+	return siu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (siu *StorageItemUpdate) AddQuantity(i int) *StorageItemUpdate {
+	siu.mutation.AddQuantity(i)
+	return siu
+}
+
+// Mutation returns the StorageItemMutation object of the builder.
+func (siu *StorageItemUpdate) Mutation() *StorageItemMutation {
+	return siu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *StorageItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *StorageItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *StorageItemUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *StorageItemUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *StorageItemUpdate) check() error {
+	if v, ok := siu.mutation.SourceMonster(); ok {
+		if err := storageitem.SourceMonsterValidator(v); err != nil {
+			return &ValidationError{Name: "source_monster", err: fmt.Errorf(`ent: validator failed for field "StorageItem.source_monster": %w`, err)}
+		}
+	}
+	if v, ok := siu.mutation.Quantity(); ok {
+		if err := storageitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "StorageItem.quantity": %w`, err)}
+		}
+	}
+	if siu.mutation.SettlementCleared() && len(siu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StorageItem.settlement"`)
+	}
+	return nil
+}
+
+func (siu *StorageItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storageitem.Table, storageitem.Columns, sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.SourceMonster(); ok {
+		_spec.SetField(storageitem.FieldSourceMonster, field.TypeString, value)
+	}
+	if siu.mutation.SourceMonsterCleared() {
+		_spec.ClearField(storageitem.FieldSourceMonster, field.TypeString)
+	}
+	if value, ok := siu.mutation.Keywords(); ok {
+		_spec.SetField(storageitem.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := siu.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, storageitem.FieldKeywords, value)
+		})
+	}
+	if siu.mutation.KeywordsCleared() {
+		_spec.ClearField(storageitem.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := siu.mutation.Quantity(); ok {
+		_spec.SetField(storageitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := siu.mutation.AddedQuantity(); ok {
+		_spec.AddField(storageitem.FieldQuantity, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// StorageItemUpdateOne is the builder for updating a single StorageItem entity.
+type StorageItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StorageItemMutation
+}
+
+// SetSourceMonster sets the "source_monster" field.
+func (siuo *StorageItemUpdateOne) SetSourceMonster(s string) *StorageItemUpdateOne {
+	siuo.mutation.SetSourceMonster(s)
+	return siuo
+}
+
+// SetNillableSourceMonster sets the "source_monster" field if the given value is not nil.
+func (siuo *StorageItemUpdateOne) SetNillableSourceMonster(s *string) *StorageItemUpdateOne {
+	if s != nil {
+		siuo.SetSourceMonster(*s)
+	}
+	return siuo
+}
+
+// ClearSourceMonster clears the value of the "source_monster" field.
+func (siuo *StorageItemUpdateOne) ClearSourceMonster() *StorageItemUpdateOne {
+	siuo.mutation.ClearSourceMonster()
+	return siuo
+}
+
+// SetKeywords sets the "keywords" field.
+func (siuo *StorageItemUpdateOne) SetKeywords(s []string) *StorageItemUpdateOne {
+	siuo.mutation.SetKeywords(s)
+	return siuo
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (siuo *StorageItemUpdateOne) AppendKeywords(s []string) *StorageItemUpdateOne {
+	siuo.mutation.AppendKeywords(s)
+	return siuo
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (siuo *StorageItemUpdateOne) ClearKeywords() *StorageItemUpdateOne {
+	siuo.mutation.ClearKeywords()
+	return siuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (siuo *StorageItemUpdateOne) SetQuantity(i int) *StorageItemUpdateOne {
+	siuo.mutation.ResetQuantity()
+	siuo.mutation.SetQuantity(i)
+	return siuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (siuo *StorageItemUpdateOne) SetNillableQuantity(i *int) *StorageItemUpdateOne {
+	if i != nil {
+		siuo.SetQuantity(*i)
+	}
+	return siuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (siuo *StorageItemUpdateOne) AddQuantity(i int) *StorageItemUpdateOne {
+	siuo.mutation.AddQuantity(i)
+	return siuo
+}
+
+// Mutation returns the StorageItemMutation object of the builder.
+func (siuo *StorageItemUpdateOne) Mutation() *StorageItemMutation {
+	return siuo.mutation
+}
+
+// Where appends a list predicates to the StorageItemUpdate builder.
+func (siuo *StorageItemUpdateOne) Where(ps ...predicate.StorageItem) *StorageItemUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *StorageItemUpdateOne) Select(field string, fields ...string) *StorageItemUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated StorageItem entity.
+func (siuo *StorageItemUpdateOne) Save(ctx context.Context) (*StorageItem, error) {
+	return withHooks(ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *StorageItemUpdateOne) SaveX(ctx context.Context) *StorageItem {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *StorageItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *StorageItemUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *StorageItemUpdateOne) check() error {
+	if v, ok := siuo.mutation.SourceMonster(); ok {
+		if err := storageitem.SourceMonsterValidator(v); err != nil {
+			return &ValidationError{Name: "source_monster", err: fmt.Errorf(`ent: validator failed for field "StorageItem.source_monster": %w`, err)}
+		}
+	}
+	if v, ok := siuo.mutation.Quantity(); ok {
+		if err := storageitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "StorageItem.quantity": %w`, err)}
+		}
+	}
+	if siuo.mutation.SettlementCleared() && len(siuo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StorageItem.settlement"`)
+	}
+	return nil
+}
+
+func (siuo *StorageItemUpdateOne) sqlSave(ctx context.Context) (_node *StorageItem, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(storageitem.Table, storageitem.Columns, sqlgraph.NewFieldSpec(storageitem.FieldID, field.TypeInt))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StorageItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageitem.FieldID)
+		for _, f := range fields {
+			if !storageitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != storageitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.SourceMonster(); ok {
+		_spec.SetField(storageitem.FieldSourceMonster, field.TypeString, value)
+	}
+	if siuo.mutation.SourceMonsterCleared() {
+		_spec.ClearField(storageitem.FieldSourceMonster, field.TypeString)
+	}
+	if value, ok := siuo.mutation.Keywords(); ok {
+		_spec.SetField(storageitem.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := siuo.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, storageitem.FieldKeywords, value)
+		})
+	}
+	if siuo.mutation.KeywordsCleared() {
+		_spec.ClearField(storageitem.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := siuo.mutation.Quantity(); ok {
+		_spec.SetField(storageitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := siuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(storageitem.FieldQuantity, field.TypeInt, value)
+	}
+	_node = &StorageItem{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
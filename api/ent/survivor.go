@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,6 +18,8 @@ type Survivor struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Born holds the value of the "born" field.
@@ -55,6 +58,8 @@ type Survivor struct {
 	Status survivor.Status `json:"status,omitempty"`
 	// StatusChangeYear holds the value of the "status_change_year" field.
 	StatusChangeYear int `json:"status_change_year,omitempty"`
+	// CannotUseFightingArts holds the value of the "cannot_use_fighting_arts" field.
+	CannotUseFightingArts bool `json:"cannot_use_fighting_arts,omitempty"`
 	// SettlementID holds the value of the "settlement_id" field.
 	SettlementID int `json:"settlement_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -67,11 +72,24 @@ type Survivor struct {
 type SurvivorEdges struct {
 	// Settlement holds the value of the settlement edge.
 	Settlement *Settlement `json:"settlement,omitempty"`
+	// FightingArts holds the value of the fighting_arts edge.
+	FightingArts []*FightingArt `json:"fighting_arts,omitempty"`
+	// SecretFightingArts holds the value of the secret_fighting_arts edge.
+	SecretFightingArts []*FightingArt `json:"secret_fighting_arts,omitempty"`
+	// Disorders holds the value of the disorders edge.
+	Disorders []*Disorder `json:"disorders,omitempty"`
+	// Abilities holds the value of the abilities edge.
+	Abilities []*Ability `json:"abilities,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [5]map[string]int
+
+	namedFightingArts       map[string][]*FightingArt
+	namedSecretFightingArts map[string][]*FightingArt
+	namedDisorders          map[string][]*Disorder
+	namedAbilities          map[string][]*Ability
 }
 
 // SettlementOrErr returns the Settlement value or an error if the edge
@@ -85,15 +103,55 @@ func (e SurvivorEdges) SettlementOrErr() (*Settlement, error) {
 	return nil, &NotLoadedError{edge: "settlement"}
 }
 
+// FightingArtsOrErr returns the FightingArts value or an error if the edge
+// was not loaded in eager-loading.
+func (e SurvivorEdges) FightingArtsOrErr() ([]*FightingArt, error) {
+	if e.loadedTypes[1] {
+		return e.FightingArts, nil
+	}
+	return nil, &NotLoadedError{edge: "fighting_arts"}
+}
+
+// SecretFightingArtsOrErr returns the SecretFightingArts value or an error if the edge
+// was not loaded in eager-loading.
+func (e SurvivorEdges) SecretFightingArtsOrErr() ([]*FightingArt, error) {
+	if e.loadedTypes[2] {
+		return e.SecretFightingArts, nil
+	}
+	return nil, &NotLoadedError{edge: "secret_fighting_arts"}
+}
+
+// DisordersOrErr returns the Disorders value or an error if the edge
+// was not loaded in eager-loading.
+func (e SurvivorEdges) DisordersOrErr() ([]*Disorder, error) {
+	if e.loadedTypes[3] {
+		return e.Disorders, nil
+	}
+	return nil, &NotLoadedError{edge: "disorders"}
+}
+
+// AbilitiesOrErr returns the Abilities value or an error if the edge
+// was not loaded in eager-loading.
+func (e SurvivorEdges) AbilitiesOrErr() ([]*Ability, error) {
+	if e.loadedTypes[4] {
+		return e.Abilities, nil
+	}
+	return nil, &NotLoadedError{edge: "abilities"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Survivor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case survivor.FieldCannotUseFightingArts:
+			values[i] = new(sql.NullBool)
 		case survivor.FieldID, survivor.FieldBorn, survivor.FieldHuntxp, survivor.FieldSurvival, survivor.FieldMovement, survivor.FieldAccuracy, survivor.FieldStrength, survivor.FieldEvasion, survivor.FieldLuck, survivor.FieldSpeed, survivor.FieldSystemicpressure, survivor.FieldTorment, survivor.FieldInsanity, survivor.FieldLumi, survivor.FieldCourage, survivor.FieldUnderstanding, survivor.FieldStatusChangeYear, survivor.FieldSettlementID:
 			values[i] = new(sql.NullInt64)
 		case survivor.FieldName, survivor.FieldGender, survivor.FieldStatus:
 			values[i] = new(sql.NullString)
+		case survivor.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -115,6 +173,13 @@ func (s *Survivor) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			s.ID = int(value.Int64)
+		case survivor.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				s.DeletedAt = new(time.Time)
+				*s.DeletedAt = value.Time
+			}
 		case survivor.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -229,6 +294,12 @@ func (s *Survivor) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.StatusChangeYear = int(value.Int64)
 			}
+		case survivor.FieldCannotUseFightingArts:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cannot_use_fighting_arts", values[i])
+			} else if value.Valid {
+				s.CannotUseFightingArts = value.Bool
+			}
 		case survivor.FieldSettlementID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
@@ -253,6 +324,26 @@ func (s *Survivor) QuerySettlement() *SettlementQuery {
 	return NewSurvivorClient(s.config).QuerySettlement(s)
 }
 
+// QueryFightingArts queries the "fighting_arts" edge of the Survivor entity.
+func (s *Survivor) QueryFightingArts() *FightingArtQuery {
+	return NewSurvivorClient(s.config).QueryFightingArts(s)
+}
+
+// QuerySecretFightingArts queries the "secret_fighting_arts" edge of the Survivor entity.
+func (s *Survivor) QuerySecretFightingArts() *FightingArtQuery {
+	return NewSurvivorClient(s.config).QuerySecretFightingArts(s)
+}
+
+// QueryDisorders queries the "disorders" edge of the Survivor entity.
+func (s *Survivor) QueryDisorders() *DisorderQuery {
+	return NewSurvivorClient(s.config).QueryDisorders(s)
+}
+
+// QueryAbilities queries the "abilities" edge of the Survivor entity.
+func (s *Survivor) QueryAbilities() *AbilityQuery {
+	return NewSurvivorClient(s.config).QueryAbilities(s)
+}
+
 // Update returns a builder for updating this Survivor.
 // Note that you need to call Survivor.Unwrap() before calling this method if this Survivor
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -276,6 +367,11 @@ func (s *Survivor) String() string {
 	var builder strings.Builder
 	builder.WriteString("Survivor(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	if v := s.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(s.Name)
 	builder.WriteString(", ")
@@ -333,11 +429,110 @@ func (s *Survivor) String() string {
 	builder.WriteString("status_change_year=")
 	builder.WriteString(fmt.Sprintf("%v", s.StatusChangeYear))
 	builder.WriteString(", ")
+	builder.WriteString("cannot_use_fighting_arts=")
+	builder.WriteString(fmt.Sprintf("%v", s.CannotUseFightingArts))
+	builder.WriteString(", ")
 	builder.WriteString("settlement_id=")
 	builder.WriteString(fmt.Sprintf("%v", s.SettlementID))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedFightingArts returns the FightingArts named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Survivor) NamedFightingArts(name string) ([]*FightingArt, error) {
+	if s.Edges.namedFightingArts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedFightingArts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Survivor) appendNamedFightingArts(name string, edges ...*FightingArt) {
+	if s.Edges.namedFightingArts == nil {
+		s.Edges.namedFightingArts = make(map[string][]*FightingArt)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedFightingArts[name] = []*FightingArt{}
+	} else {
+		s.Edges.namedFightingArts[name] = append(s.Edges.namedFightingArts[name], edges...)
+	}
+}
+
+// NamedSecretFightingArts returns the SecretFightingArts named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Survivor) NamedSecretFightingArts(name string) ([]*FightingArt, error) {
+	if s.Edges.namedSecretFightingArts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedSecretFightingArts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Survivor) appendNamedSecretFightingArts(name string, edges ...*FightingArt) {
+	if s.Edges.namedSecretFightingArts == nil {
+		s.Edges.namedSecretFightingArts = make(map[string][]*FightingArt)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedSecretFightingArts[name] = []*FightingArt{}
+	} else {
+		s.Edges.namedSecretFightingArts[name] = append(s.Edges.namedSecretFightingArts[name], edges...)
+	}
+}
+
+// NamedDisorders returns the Disorders named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Survivor) NamedDisorders(name string) ([]*Disorder, error) {
+	if s.Edges.namedDisorders == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedDisorders[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Survivor) appendNamedDisorders(name string, edges ...*Disorder) {
+	if s.Edges.namedDisorders == nil {
+		s.Edges.namedDisorders = make(map[string][]*Disorder)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedDisorders[name] = []*Disorder{}
+	} else {
+		s.Edges.namedDisorders[name] = append(s.Edges.namedDisorders[name], edges...)
+	}
+}
+
+// NamedAbilities returns the Abilities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (s *Survivor) NamedAbilities(name string) ([]*Ability, error) {
+	if s.Edges.namedAbilities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := s.Edges.namedAbilities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (s *Survivor) appendNamedAbilities(name string, edges ...*Ability) {
+	if s.Edges.namedAbilities == nil {
+		s.Edges.namedAbilities = make(map[string][]*Ability)
+	}
+	if len(edges) == 0 {
+		s.Edges.namedAbilities[name] = []*Ability{}
+	} else {
+		s.Edges.namedAbilities[name] = append(s.Edges.namedAbilities[name], edges...)
+	}
+}
+
 // Survivors is a parsable slice of Survivor.
 type Survivors []*Survivor
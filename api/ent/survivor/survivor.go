@@ -7,6 +7,7 @@ import (
 	"io"
 	"strconv"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,6 +17,8 @@ const (
 	Label = "survivor"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldBorn holds the string denoting the born field in the database.
@@ -54,10 +57,20 @@ const (
 	FieldStatus = "status"
 	// FieldStatusChangeYear holds the string denoting the status_change_year field in the database.
 	FieldStatusChangeYear = "status_change_year"
+	// FieldCannotUseFightingArts holds the string denoting the cannot_use_fighting_arts field in the database.
+	FieldCannotUseFightingArts = "cannot_use_fighting_arts"
 	// FieldSettlementID holds the string denoting the settlement_id field in the database.
 	FieldSettlementID = "settlement_id"
 	// EdgeSettlement holds the string denoting the settlement edge name in mutations.
 	EdgeSettlement = "settlement"
+	// EdgeFightingArts holds the string denoting the fighting_arts edge name in mutations.
+	EdgeFightingArts = "fighting_arts"
+	// EdgeSecretFightingArts holds the string denoting the secret_fighting_arts edge name in mutations.
+	EdgeSecretFightingArts = "secret_fighting_arts"
+	// EdgeDisorders holds the string denoting the disorders edge name in mutations.
+	EdgeDisorders = "disorders"
+	// EdgeAbilities holds the string denoting the abilities edge name in mutations.
+	EdgeAbilities = "abilities"
 	// Table holds the table name of the survivor in the database.
 	Table = "survivors"
 	// SettlementTable is the table that holds the settlement relation/edge.
@@ -67,11 +80,32 @@ const (
 	SettlementInverseTable = "settlements"
 	// SettlementColumn is the table column denoting the settlement relation/edge.
 	SettlementColumn = "settlement_id"
+	// FightingArtsTable is the table that holds the fighting_arts relation/edge. The primary key declared below.
+	FightingArtsTable = "survivor_fighting_arts"
+	// FightingArtsInverseTable is the table name for the FightingArt entity.
+	// It exists in this package in order to avoid circular dependency with the "fightingart" package.
+	FightingArtsInverseTable = "fighting_arts"
+	// SecretFightingArtsTable is the table that holds the secret_fighting_arts relation/edge. The primary key declared below.
+	SecretFightingArtsTable = "survivor_secret_fighting_arts"
+	// SecretFightingArtsInverseTable is the table name for the FightingArt entity.
+	// It exists in this package in order to avoid circular dependency with the "fightingart" package.
+	SecretFightingArtsInverseTable = "fighting_arts"
+	// DisordersTable is the table that holds the disorders relation/edge. The primary key declared below.
+	DisordersTable = "survivor_disorders"
+	// DisordersInverseTable is the table name for the Disorder entity.
+	// It exists in this package in order to avoid circular dependency with the "disorder" package.
+	DisordersInverseTable = "disorders"
+	// AbilitiesTable is the table that holds the abilities relation/edge. The primary key declared below.
+	AbilitiesTable = "survivor_abilities"
+	// AbilitiesInverseTable is the table name for the Ability entity.
+	// It exists in this package in order to avoid circular dependency with the "ability" package.
+	AbilitiesInverseTable = "abilities"
 )
 
 // Columns holds all SQL columns for survivor fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldName,
 	FieldBorn,
 	FieldGender,
@@ -91,9 +125,25 @@ var Columns = []string{
 	FieldUnderstanding,
 	FieldStatus,
 	FieldStatusChangeYear,
+	FieldCannotUseFightingArts,
 	FieldSettlementID,
 }
 
+var (
+	// FightingArtsPrimaryKey and FightingArtsColumn2 are the table columns denoting the
+	// primary key for the fighting_arts relation (M2M).
+	FightingArtsPrimaryKey = []string{"survivor_id", "fighting_art_id"}
+	// SecretFightingArtsPrimaryKey and SecretFightingArtsColumn2 are the table columns denoting the
+	// primary key for the secret_fighting_arts relation (M2M).
+	SecretFightingArtsPrimaryKey = []string{"survivor_id", "fighting_art_id"}
+	// DisordersPrimaryKey and DisordersColumn2 are the table columns denoting the
+	// primary key for the disorders relation (M2M).
+	DisordersPrimaryKey = []string{"survivor_id", "disorder_id"}
+	// AbilitiesPrimaryKey and AbilitiesColumn2 are the table columns denoting the
+	// primary key for the abilities relation (M2M).
+	AbilitiesPrimaryKey = []string{"survivor_id", "ability_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -104,7 +154,15 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/failuretoload/datamonster/ent/runtime"
 var (
+	Hooks        [5]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultBorn holds the default value on creation for the "born" field.
@@ -169,6 +227,8 @@ var (
 	UnderstandingValidator func(int) error
 	// DefaultStatusChangeYear holds the default value on creation for the "status_change_year" field.
 	DefaultStatusChangeYear int
+	// DefaultCannotUseFightingArts holds the default value on creation for the "cannot_use_fighting_arts" field.
+	DefaultCannotUseFightingArts bool
 )
 
 // Gender defines the type for the "gender" enum field.
@@ -234,6 +294,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -329,6 +394,11 @@ func ByStatusChangeYear(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatusChangeYear, opts...).ToFunc()
 }
 
+// ByCannotUseFightingArts orders the results by the cannot_use_fighting_arts field.
+func ByCannotUseFightingArts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCannotUseFightingArts, opts...).ToFunc()
+}
+
 // BySettlementID orders the results by the settlement_id field.
 func BySettlementID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSettlementID, opts...).ToFunc()
@@ -340,6 +410,62 @@ func BySettlementField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSettlementStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFightingArtsCount orders the results by fighting_arts count.
+func ByFightingArtsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFightingArtsStep(), opts...)
+	}
+}
+
+// ByFightingArts orders the results by fighting_arts terms.
+func ByFightingArts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFightingArtsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySecretFightingArtsCount orders the results by secret_fighting_arts count.
+func BySecretFightingArtsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecretFightingArtsStep(), opts...)
+	}
+}
+
+// BySecretFightingArts orders the results by secret_fighting_arts terms.
+func BySecretFightingArts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecretFightingArtsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDisordersCount orders the results by disorders count.
+func ByDisordersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisordersStep(), opts...)
+	}
+}
+
+// ByDisorders orders the results by disorders terms.
+func ByDisorders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisordersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAbilitiesCount orders the results by abilities count.
+func ByAbilitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAbilitiesStep(), opts...)
+	}
+}
+
+// ByAbilities orders the results by abilities terms.
+func ByAbilities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAbilitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSettlementStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -347,6 +473,34 @@ func newSettlementStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, SettlementTable, SettlementColumn),
 	)
 }
+func newFightingArtsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FightingArtsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, FightingArtsTable, FightingArtsPrimaryKey...),
+	)
+}
+func newSecretFightingArtsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecretFightingArtsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, SecretFightingArtsTable, SecretFightingArtsPrimaryKey...),
+	)
+}
+func newDisordersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisordersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, DisordersTable, DisordersPrimaryKey...),
+	)
+}
+func newAbilitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AbilitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, AbilitiesTable, AbilitiesPrimaryKey...),
+	)
+}
 
 // MarshalGQL implements graphql.Marshaler interface.
 func (e Gender) MarshalGQL(w io.Writer) {
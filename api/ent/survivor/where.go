@@ -3,6 +3,8 @@
 package survivor
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/failuretoload/datamonster/ent/predicate"
@@ -53,6 +55,11 @@ func IDLTE(id int) predicate.Survivor {
 	return predicate.Survivor(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Survivor {
 	return predicate.Survivor(sql.FieldEQ(FieldName, v))
@@ -138,11 +145,66 @@ func StatusChangeYear(v int) predicate.Survivor {
 	return predicate.Survivor(sql.FieldEQ(FieldStatusChangeYear, v))
 }
 
+// CannotUseFightingArts applies equality check predicate on the "cannot_use_fighting_arts" field. It's identical to CannotUseFightingArtsEQ.
+func CannotUseFightingArts(v bool) predicate.Survivor {
+	return predicate.Survivor(sql.FieldEQ(FieldCannotUseFightingArts, v))
+}
+
 // SettlementID applies equality check predicate on the "settlement_id" field. It's identical to SettlementIDEQ.
 func SettlementID(v int) predicate.Survivor {
 	return predicate.Survivor(sql.FieldEQ(FieldSettlementID, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Survivor {
+	return predicate.Survivor(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Survivor {
+	return predicate.Survivor(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Survivor {
+	return predicate.Survivor(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Survivor {
 	return predicate.Survivor(sql.FieldEQ(FieldName, v))
@@ -888,6 +950,16 @@ func StatusChangeYearLTE(v int) predicate.Survivor {
 	return predicate.Survivor(sql.FieldLTE(FieldStatusChangeYear, v))
 }
 
+// CannotUseFightingArtsEQ applies the EQ predicate on the "cannot_use_fighting_arts" field.
+func CannotUseFightingArtsEQ(v bool) predicate.Survivor {
+	return predicate.Survivor(sql.FieldEQ(FieldCannotUseFightingArts, v))
+}
+
+// CannotUseFightingArtsNEQ applies the NEQ predicate on the "cannot_use_fighting_arts" field.
+func CannotUseFightingArtsNEQ(v bool) predicate.Survivor {
+	return predicate.Survivor(sql.FieldNEQ(FieldCannotUseFightingArts, v))
+}
+
 // SettlementIDEQ applies the EQ predicate on the "settlement_id" field.
 func SettlementIDEQ(v int) predicate.Survivor {
 	return predicate.Survivor(sql.FieldEQ(FieldSettlementID, v))
@@ -941,6 +1013,98 @@ func HasSettlementWith(preds ...predicate.Settlement) predicate.Survivor {
 	})
 }
 
+// HasFightingArts applies the HasEdge predicate on the "fighting_arts" edge.
+func HasFightingArts() predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, FightingArtsTable, FightingArtsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFightingArtsWith applies the HasEdge predicate on the "fighting_arts" edge with a given conditions (other predicates).
+func HasFightingArtsWith(preds ...predicate.FightingArt) predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := newFightingArtsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSecretFightingArts applies the HasEdge predicate on the "secret_fighting_arts" edge.
+func HasSecretFightingArts() predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, SecretFightingArtsTable, SecretFightingArtsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSecretFightingArtsWith applies the HasEdge predicate on the "secret_fighting_arts" edge with a given conditions (other predicates).
+func HasSecretFightingArtsWith(preds ...predicate.FightingArt) predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := newSecretFightingArtsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDisorders applies the HasEdge predicate on the "disorders" edge.
+func HasDisorders() predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, DisordersTable, DisordersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDisordersWith applies the HasEdge predicate on the "disorders" edge with a given conditions (other predicates).
+func HasDisordersWith(preds ...predicate.Disorder) predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := newDisordersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAbilities applies the HasEdge predicate on the "abilities" edge.
+func HasAbilities() predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AbilitiesTable, AbilitiesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAbilitiesWith applies the HasEdge predicate on the "abilities" edge with a given conditions (other predicates).
+func HasAbilitiesWith(preds ...predicate.Ability) predicate.Survivor {
+	return predicate.Survivor(func(s *sql.Selector) {
+		step := newAbilitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Survivor) predicate.Survivor {
 	return predicate.Survivor(sql.AndPredicates(predicates...))
@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
 	"github.com/failuretoload/datamonster/ent/settlement"
 	"github.com/failuretoload/datamonster/ent/survivor"
 )
@@ -20,6 +24,20 @@ type SurvivorCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *SurvivorCreate) SetDeletedAt(t time.Time) *SurvivorCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *SurvivorCreate) SetNillableDeletedAt(t *time.Time) *SurvivorCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
 // SetName sets the "name" field.
 func (sc *SurvivorCreate) SetName(s string) *SurvivorCreate {
 	sc.mutation.SetName(s)
@@ -278,6 +296,20 @@ func (sc *SurvivorCreate) SetNillableStatusChangeYear(i *int) *SurvivorCreate {
 	return sc
 }
 
+// SetCannotUseFightingArts sets the "cannot_use_fighting_arts" field.
+func (sc *SurvivorCreate) SetCannotUseFightingArts(b bool) *SurvivorCreate {
+	sc.mutation.SetCannotUseFightingArts(b)
+	return sc
+}
+
+// SetNillableCannotUseFightingArts sets the "cannot_use_fighting_arts" field if the given value is not nil.
+func (sc *SurvivorCreate) SetNillableCannotUseFightingArts(b *bool) *SurvivorCreate {
+	if b != nil {
+		sc.SetCannotUseFightingArts(*b)
+	}
+	return sc
+}
+
 // SetSettlementID sets the "settlement_id" field.
 func (sc *SurvivorCreate) SetSettlementID(i int) *SurvivorCreate {
 	sc.mutation.SetSettlementID(i)
@@ -297,6 +329,66 @@ func (sc *SurvivorCreate) SetSettlement(s *Settlement) *SurvivorCreate {
 	return sc.SetSettlementID(s.ID)
 }
 
+// AddFightingArtIDs adds the "fighting_arts" edge to the FightingArt entity by IDs.
+func (sc *SurvivorCreate) AddFightingArtIDs(ids ...int) *SurvivorCreate {
+	sc.mutation.AddFightingArtIDs(ids...)
+	return sc
+}
+
+// AddFightingArts adds the "fighting_arts" edges to the FightingArt entity.
+func (sc *SurvivorCreate) AddFightingArts(f ...*FightingArt) *SurvivorCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return sc.AddFightingArtIDs(ids...)
+}
+
+// AddSecretFightingArtIDs adds the "secret_fighting_arts" edge to the FightingArt entity by IDs.
+func (sc *SurvivorCreate) AddSecretFightingArtIDs(ids ...int) *SurvivorCreate {
+	sc.mutation.AddSecretFightingArtIDs(ids...)
+	return sc
+}
+
+// AddSecretFightingArts adds the "secret_fighting_arts" edges to the FightingArt entity.
+func (sc *SurvivorCreate) AddSecretFightingArts(f ...*FightingArt) *SurvivorCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return sc.AddSecretFightingArtIDs(ids...)
+}
+
+// AddDisorderIDs adds the "disorders" edge to the Disorder entity by IDs.
+func (sc *SurvivorCreate) AddDisorderIDs(ids ...int) *SurvivorCreate {
+	sc.mutation.AddDisorderIDs(ids...)
+	return sc
+}
+
+// AddDisorders adds the "disorders" edges to the Disorder entity.
+func (sc *SurvivorCreate) AddDisorders(d ...*Disorder) *SurvivorCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return sc.AddDisorderIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the Ability entity by IDs.
+func (sc *SurvivorCreate) AddAbilityIDs(ids ...int) *SurvivorCreate {
+	sc.mutation.AddAbilityIDs(ids...)
+	return sc
+}
+
+// AddAbilities adds the "abilities" edges to the Ability entity.
+func (sc *SurvivorCreate) AddAbilities(a ...*Ability) *SurvivorCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sc.AddAbilityIDs(ids...)
+}
+
 // Mutation returns the SurvivorMutation object of the builder.
 func (sc *SurvivorCreate) Mutation() *SurvivorMutation {
 	return sc.mutation
@@ -304,7 +396,9 @@ func (sc *SurvivorCreate) Mutation() *SurvivorMutation {
 
 // Save creates the Survivor in the database.
 func (sc *SurvivorCreate) Save(ctx context.Context) (*Survivor, error) {
-	sc.defaults()
+	if err := sc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
 }
 
@@ -331,7 +425,7 @@ func (sc *SurvivorCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (sc *SurvivorCreate) defaults() {
+func (sc *SurvivorCreate) defaults() error {
 	if _, ok := sc.mutation.Born(); !ok {
 		v := survivor.DefaultBorn
 		sc.mutation.SetBorn(v)
@@ -404,6 +498,11 @@ func (sc *SurvivorCreate) defaults() {
 		v := survivor.DefaultStatusChangeYear
 		sc.mutation.SetStatusChangeYear(v)
 	}
+	if _, ok := sc.mutation.CannotUseFightingArts(); !ok {
+		v := survivor.DefaultCannotUseFightingArts
+		sc.mutation.SetCannotUseFightingArts(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -555,6 +654,9 @@ func (sc *SurvivorCreate) check() error {
 	if _, ok := sc.mutation.StatusChangeYear(); !ok {
 		return &ValidationError{Name: "status_change_year", err: errors.New(`ent: missing required field "Survivor.status_change_year"`)}
 	}
+	if _, ok := sc.mutation.CannotUseFightingArts(); !ok {
+		return &ValidationError{Name: "cannot_use_fighting_arts", err: errors.New(`ent: missing required field "Survivor.cannot_use_fighting_arts"`)}
+	}
 	return nil
 }
 
@@ -581,6 +683,10 @@ func (sc *SurvivorCreate) createSpec() (*Survivor, *sqlgraph.CreateSpec) {
 		_node = &Survivor{config: sc.config}
 		_spec = sqlgraph.NewCreateSpec(survivor.Table, sqlgraph.NewFieldSpec(survivor.FieldID, field.TypeInt))
 	)
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.SetField(survivor.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := sc.mutation.Name(); ok {
 		_spec.SetField(survivor.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -657,6 +763,10 @@ func (sc *SurvivorCreate) createSpec() (*Survivor, *sqlgraph.CreateSpec) {
 		_spec.SetField(survivor.FieldStatusChangeYear, field.TypeInt, value)
 		_node.StatusChangeYear = value
 	}
+	if value, ok := sc.mutation.CannotUseFightingArts(); ok {
+		_spec.SetField(survivor.FieldCannotUseFightingArts, field.TypeBool, value)
+		_node.CannotUseFightingArts = value
+	}
 	if nodes := sc.mutation.SettlementIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -674,6 +784,70 @@ func (sc *SurvivorCreate) createSpec() (*Survivor, *sqlgraph.CreateSpec) {
 		_node.SettlementID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.FightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.SecretFightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.DisordersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
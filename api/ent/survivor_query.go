@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
@@ -11,6 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
 	"github.com/failuretoload/datamonster/ent/predicate"
 	"github.com/failuretoload/datamonster/ent/settlement"
 	"github.com/failuretoload/datamonster/ent/survivor"
@@ -19,13 +24,21 @@ import (
 // SurvivorQuery is the builder for querying Survivor entities.
 type SurvivorQuery struct {
 	config
-	ctx            *QueryContext
-	order          []survivor.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Survivor
-	withSettlement *SettlementQuery
-	modifiers      []func(*sql.Selector)
-	loadTotal      []func(context.Context, []*Survivor) error
+	ctx                         *QueryContext
+	order                       []survivor.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.Survivor
+	withSettlement              *SettlementQuery
+	withFightingArts            *FightingArtQuery
+	withSecretFightingArts      *FightingArtQuery
+	withDisorders               *DisorderQuery
+	withAbilities               *AbilityQuery
+	modifiers                   []func(*sql.Selector)
+	loadTotal                   []func(context.Context, []*Survivor) error
+	withNamedFightingArts       map[string]*FightingArtQuery
+	withNamedSecretFightingArts map[string]*FightingArtQuery
+	withNamedDisorders          map[string]*DisorderQuery
+	withNamedAbilities          map[string]*AbilityQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +97,94 @@ func (sq *SurvivorQuery) QuerySettlement() *SettlementQuery {
 	return query
 }
 
+// QueryFightingArts chains the current query on the "fighting_arts" edge.
+func (sq *SurvivorQuery) QueryFightingArts() *FightingArtQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, selector),
+			sqlgraph.To(fightingart.Table, fightingart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.FightingArtsTable, survivor.FightingArtsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySecretFightingArts chains the current query on the "secret_fighting_arts" edge.
+func (sq *SurvivorQuery) QuerySecretFightingArts() *FightingArtQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, selector),
+			sqlgraph.To(fightingart.Table, fightingart.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.SecretFightingArtsTable, survivor.SecretFightingArtsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDisorders chains the current query on the "disorders" edge.
+func (sq *SurvivorQuery) QueryDisorders() *DisorderQuery {
+	query := (&DisorderClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, selector),
+			sqlgraph.To(disorder.Table, disorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.DisordersTable, survivor.DisordersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAbilities chains the current query on the "abilities" edge.
+func (sq *SurvivorQuery) QueryAbilities() *AbilityQuery {
+	query := (&AbilityClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(survivor.Table, survivor.FieldID, selector),
+			sqlgraph.To(ability.Table, ability.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, survivor.AbilitiesTable, survivor.AbilitiesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Survivor entity from the query.
 // Returns a *NotFoundError when no Survivor was found.
 func (sq *SurvivorQuery) First(ctx context.Context) (*Survivor, error) {
@@ -271,12 +372,16 @@ func (sq *SurvivorQuery) Clone() *SurvivorQuery {
 		return nil
 	}
 	return &SurvivorQuery{
-		config:         sq.config,
-		ctx:            sq.ctx.Clone(),
-		order:          append([]survivor.OrderOption{}, sq.order...),
-		inters:         append([]Interceptor{}, sq.inters...),
-		predicates:     append([]predicate.Survivor{}, sq.predicates...),
-		withSettlement: sq.withSettlement.Clone(),
+		config:                 sq.config,
+		ctx:                    sq.ctx.Clone(),
+		order:                  append([]survivor.OrderOption{}, sq.order...),
+		inters:                 append([]Interceptor{}, sq.inters...),
+		predicates:             append([]predicate.Survivor{}, sq.predicates...),
+		withSettlement:         sq.withSettlement.Clone(),
+		withFightingArts:       sq.withFightingArts.Clone(),
+		withSecretFightingArts: sq.withSecretFightingArts.Clone(),
+		withDisorders:          sq.withDisorders.Clone(),
+		withAbilities:          sq.withAbilities.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -294,18 +399,62 @@ func (sq *SurvivorQuery) WithSettlement(opts ...func(*SettlementQuery)) *Survivo
 	return sq
 }
 
+// WithFightingArts tells the query-builder to eager-load the nodes that are connected to
+// the "fighting_arts" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithFightingArts(opts ...func(*FightingArtQuery)) *SurvivorQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withFightingArts = query
+	return sq
+}
+
+// WithSecretFightingArts tells the query-builder to eager-load the nodes that are connected to
+// the "secret_fighting_arts" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithSecretFightingArts(opts ...func(*FightingArtQuery)) *SurvivorQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withSecretFightingArts = query
+	return sq
+}
+
+// WithDisorders tells the query-builder to eager-load the nodes that are connected to
+// the "disorders" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithDisorders(opts ...func(*DisorderQuery)) *SurvivorQuery {
+	query := (&DisorderClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withDisorders = query
+	return sq
+}
+
+// WithAbilities tells the query-builder to eager-load the nodes that are connected to
+// the "abilities" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithAbilities(opts ...func(*AbilityQuery)) *SurvivorQuery {
+	query := (&AbilityClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withAbilities = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Survivor.Query().
-//		GroupBy(survivor.FieldName).
+//		GroupBy(survivor.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (sq *SurvivorQuery) GroupBy(field string, fields ...string) *SurvivorGroupBy {
@@ -323,11 +472,11 @@ func (sq *SurvivorQuery) GroupBy(field string, fields ...string) *SurvivorGroupB
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Survivor.Query().
-//		Select(survivor.FieldName).
+//		Select(survivor.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (sq *SurvivorQuery) Select(fields ...string) *SurvivorSelect {
 	sq.ctx.Fields = append(sq.ctx.Fields, fields...)
@@ -365,6 +514,12 @@ func (sq *SurvivorQuery) prepareQuery(ctx context.Context) error {
 		}
 		sq.sql = prev
 	}
+	if survivor.Policy == nil {
+		return errors.New("ent: uninitialized survivor.Policy (forgotten import ent/runtime?)")
+	}
+	if err := survivor.Policy.EvalQuery(ctx, sq); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -372,8 +527,12 @@ func (sq *SurvivorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sur
 	var (
 		nodes       = []*Survivor{}
 		_spec       = sq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			sq.withSettlement != nil,
+			sq.withFightingArts != nil,
+			sq.withSecretFightingArts != nil,
+			sq.withDisorders != nil,
+			sq.withAbilities != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -403,6 +562,62 @@ func (sq *SurvivorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Sur
 			return nil, err
 		}
 	}
+	if query := sq.withFightingArts; query != nil {
+		if err := sq.loadFightingArts(ctx, query, nodes,
+			func(n *Survivor) { n.Edges.FightingArts = []*FightingArt{} },
+			func(n *Survivor, e *FightingArt) { n.Edges.FightingArts = append(n.Edges.FightingArts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withSecretFightingArts; query != nil {
+		if err := sq.loadSecretFightingArts(ctx, query, nodes,
+			func(n *Survivor) { n.Edges.SecretFightingArts = []*FightingArt{} },
+			func(n *Survivor, e *FightingArt) { n.Edges.SecretFightingArts = append(n.Edges.SecretFightingArts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withDisorders; query != nil {
+		if err := sq.loadDisorders(ctx, query, nodes,
+			func(n *Survivor) { n.Edges.Disorders = []*Disorder{} },
+			func(n *Survivor, e *Disorder) { n.Edges.Disorders = append(n.Edges.Disorders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withAbilities; query != nil {
+		if err := sq.loadAbilities(ctx, query, nodes,
+			func(n *Survivor) { n.Edges.Abilities = []*Ability{} },
+			func(n *Survivor, e *Ability) { n.Edges.Abilities = append(n.Edges.Abilities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedFightingArts {
+		if err := sq.loadFightingArts(ctx, query, nodes,
+			func(n *Survivor) { n.appendNamedFightingArts(name) },
+			func(n *Survivor, e *FightingArt) { n.appendNamedFightingArts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedSecretFightingArts {
+		if err := sq.loadSecretFightingArts(ctx, query, nodes,
+			func(n *Survivor) { n.appendNamedSecretFightingArts(name) },
+			func(n *Survivor, e *FightingArt) { n.appendNamedSecretFightingArts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedDisorders {
+		if err := sq.loadDisorders(ctx, query, nodes,
+			func(n *Survivor) { n.appendNamedDisorders(name) },
+			func(n *Survivor, e *Disorder) { n.appendNamedDisorders(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range sq.withNamedAbilities {
+		if err := sq.loadAbilities(ctx, query, nodes,
+			func(n *Survivor) { n.appendNamedAbilities(name) },
+			func(n *Survivor, e *Ability) { n.appendNamedAbilities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range sq.loadTotal {
 		if err := sq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -440,6 +655,250 @@ func (sq *SurvivorQuery) loadSettlement(ctx context.Context, query *SettlementQu
 	}
 	return nil
 }
+func (sq *SurvivorQuery) loadFightingArts(ctx context.Context, query *FightingArtQuery, nodes []*Survivor, init func(*Survivor), assign func(*Survivor, *FightingArt)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Survivor)
+	nids := make(map[int]map[*Survivor]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(survivor.FightingArtsTable)
+		s.Join(joinT).On(s.C(fightingart.FieldID), joinT.C(survivor.FightingArtsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(survivor.FightingArtsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(survivor.FightingArtsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Survivor]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*FightingArt](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "fighting_arts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (sq *SurvivorQuery) loadSecretFightingArts(ctx context.Context, query *FightingArtQuery, nodes []*Survivor, init func(*Survivor), assign func(*Survivor, *FightingArt)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Survivor)
+	nids := make(map[int]map[*Survivor]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(survivor.SecretFightingArtsTable)
+		s.Join(joinT).On(s.C(fightingart.FieldID), joinT.C(survivor.SecretFightingArtsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(survivor.SecretFightingArtsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(survivor.SecretFightingArtsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Survivor]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*FightingArt](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "secret_fighting_arts" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (sq *SurvivorQuery) loadDisorders(ctx context.Context, query *DisorderQuery, nodes []*Survivor, init func(*Survivor), assign func(*Survivor, *Disorder)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Survivor)
+	nids := make(map[int]map[*Survivor]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(survivor.DisordersTable)
+		s.Join(joinT).On(s.C(disorder.FieldID), joinT.C(survivor.DisordersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(survivor.DisordersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(survivor.DisordersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Survivor]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Disorder](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "disorders" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (sq *SurvivorQuery) loadAbilities(ctx context.Context, query *AbilityQuery, nodes []*Survivor, init func(*Survivor), assign func(*Survivor, *Ability)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Survivor)
+	nids := make(map[int]map[*Survivor]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(survivor.AbilitiesTable)
+		s.Join(joinT).On(s.C(ability.FieldID), joinT.C(survivor.AbilitiesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(survivor.AbilitiesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(survivor.AbilitiesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Survivor]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Ability](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "abilities" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (sq *SurvivorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -528,6 +987,62 @@ func (sq *SurvivorQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedFightingArts tells the query-builder to eager-load the nodes that are connected to the "fighting_arts"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithNamedFightingArts(name string, opts ...func(*FightingArtQuery)) *SurvivorQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedFightingArts == nil {
+		sq.withNamedFightingArts = make(map[string]*FightingArtQuery)
+	}
+	sq.withNamedFightingArts[name] = query
+	return sq
+}
+
+// WithNamedSecretFightingArts tells the query-builder to eager-load the nodes that are connected to the "secret_fighting_arts"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithNamedSecretFightingArts(name string, opts ...func(*FightingArtQuery)) *SurvivorQuery {
+	query := (&FightingArtClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedSecretFightingArts == nil {
+		sq.withNamedSecretFightingArts = make(map[string]*FightingArtQuery)
+	}
+	sq.withNamedSecretFightingArts[name] = query
+	return sq
+}
+
+// WithNamedDisorders tells the query-builder to eager-load the nodes that are connected to the "disorders"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithNamedDisorders(name string, opts ...func(*DisorderQuery)) *SurvivorQuery {
+	query := (&DisorderClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedDisorders == nil {
+		sq.withNamedDisorders = make(map[string]*DisorderQuery)
+	}
+	sq.withNamedDisorders[name] = query
+	return sq
+}
+
+// WithNamedAbilities tells the query-builder to eager-load the nodes that are connected to the "abilities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (sq *SurvivorQuery) WithNamedAbilities(name string, opts ...func(*AbilityQuery)) *SurvivorQuery {
+	query := (&AbilityClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if sq.withNamedAbilities == nil {
+		sq.withNamedAbilities = make(map[string]*AbilityQuery)
+	}
+	sq.withNamedAbilities[name] = query
+	return sq
+}
+
 // SurvivorGroupBy is the group-by builder for Survivor entities.
 type SurvivorGroupBy struct {
 	selector
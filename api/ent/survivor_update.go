@@ -6,10 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
 	"github.com/failuretoload/datamonster/ent/predicate"
 	"github.com/failuretoload/datamonster/ent/settlement"
 	"github.com/failuretoload/datamonster/ent/survivor"
@@ -28,6 +32,26 @@ func (su *SurvivorUpdate) Where(ps ...predicate.Survivor) *SurvivorUpdate {
 	return su
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (su *SurvivorUpdate) SetDeletedAt(t time.Time) *SurvivorUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *SurvivorUpdate) SetNillableDeletedAt(t *time.Time) *SurvivorUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *SurvivorUpdate) ClearDeletedAt() *SurvivorUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
 // SetName sets the "name" field.
 func (su *SurvivorUpdate) SetName(s string) *SurvivorUpdate {
 	su.mutation.SetName(s)
@@ -406,6 +430,20 @@ func (su *SurvivorUpdate) AddStatusChangeYear(i int) *SurvivorUpdate {
 	return su
 }
 
+// SetCannotUseFightingArts sets the "cannot_use_fighting_arts" field.
+func (su *SurvivorUpdate) SetCannotUseFightingArts(b bool) *SurvivorUpdate {
+	su.mutation.SetCannotUseFightingArts(b)
+	return su
+}
+
+// SetNillableCannotUseFightingArts sets the "cannot_use_fighting_arts" field if the given value is not nil.
+func (su *SurvivorUpdate) SetNillableCannotUseFightingArts(b *bool) *SurvivorUpdate {
+	if b != nil {
+		su.SetCannotUseFightingArts(*b)
+	}
+	return su
+}
+
 // SetSettlementID sets the "settlement_id" field.
 func (su *SurvivorUpdate) SetSettlementID(i int) *SurvivorUpdate {
 	su.mutation.SetSettlementID(i)
@@ -431,6 +469,66 @@ func (su *SurvivorUpdate) SetSettlement(s *Settlement) *SurvivorUpdate {
 	return su.SetSettlementID(s.ID)
 }
 
+// AddFightingArtIDs adds the "fighting_arts" edge to the FightingArt entity by IDs.
+func (su *SurvivorUpdate) AddFightingArtIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.AddFightingArtIDs(ids...)
+	return su
+}
+
+// AddFightingArts adds the "fighting_arts" edges to the FightingArt entity.
+func (su *SurvivorUpdate) AddFightingArts(f ...*FightingArt) *SurvivorUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.AddFightingArtIDs(ids...)
+}
+
+// AddSecretFightingArtIDs adds the "secret_fighting_arts" edge to the FightingArt entity by IDs.
+func (su *SurvivorUpdate) AddSecretFightingArtIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.AddSecretFightingArtIDs(ids...)
+	return su
+}
+
+// AddSecretFightingArts adds the "secret_fighting_arts" edges to the FightingArt entity.
+func (su *SurvivorUpdate) AddSecretFightingArts(f ...*FightingArt) *SurvivorUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.AddSecretFightingArtIDs(ids...)
+}
+
+// AddDisorderIDs adds the "disorders" edge to the Disorder entity by IDs.
+func (su *SurvivorUpdate) AddDisorderIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.AddDisorderIDs(ids...)
+	return su
+}
+
+// AddDisorders adds the "disorders" edges to the Disorder entity.
+func (su *SurvivorUpdate) AddDisorders(d ...*Disorder) *SurvivorUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.AddDisorderIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the Ability entity by IDs.
+func (su *SurvivorUpdate) AddAbilityIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.AddAbilityIDs(ids...)
+	return su
+}
+
+// AddAbilities adds the "abilities" edges to the Ability entity.
+func (su *SurvivorUpdate) AddAbilities(a ...*Ability) *SurvivorUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.AddAbilityIDs(ids...)
+}
+
 // Mutation returns the SurvivorMutation object of the builder.
 func (su *SurvivorUpdate) Mutation() *SurvivorMutation {
 	return su.mutation
@@ -442,6 +540,90 @@ func (su *SurvivorUpdate) ClearSettlement() *SurvivorUpdate {
 	return su
 }
 
+// ClearFightingArts clears all "fighting_arts" edges to the FightingArt entity.
+func (su *SurvivorUpdate) ClearFightingArts() *SurvivorUpdate {
+	su.mutation.ClearFightingArts()
+	return su
+}
+
+// RemoveFightingArtIDs removes the "fighting_arts" edge to FightingArt entities by IDs.
+func (su *SurvivorUpdate) RemoveFightingArtIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.RemoveFightingArtIDs(ids...)
+	return su
+}
+
+// RemoveFightingArts removes "fighting_arts" edges to FightingArt entities.
+func (su *SurvivorUpdate) RemoveFightingArts(f ...*FightingArt) *SurvivorUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.RemoveFightingArtIDs(ids...)
+}
+
+// ClearSecretFightingArts clears all "secret_fighting_arts" edges to the FightingArt entity.
+func (su *SurvivorUpdate) ClearSecretFightingArts() *SurvivorUpdate {
+	su.mutation.ClearSecretFightingArts()
+	return su
+}
+
+// RemoveSecretFightingArtIDs removes the "secret_fighting_arts" edge to FightingArt entities by IDs.
+func (su *SurvivorUpdate) RemoveSecretFightingArtIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.RemoveSecretFightingArtIDs(ids...)
+	return su
+}
+
+// RemoveSecretFightingArts removes "secret_fighting_arts" edges to FightingArt entities.
+func (su *SurvivorUpdate) RemoveSecretFightingArts(f ...*FightingArt) *SurvivorUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return su.RemoveSecretFightingArtIDs(ids...)
+}
+
+// ClearDisorders clears all "disorders" edges to the Disorder entity.
+func (su *SurvivorUpdate) ClearDisorders() *SurvivorUpdate {
+	su.mutation.ClearDisorders()
+	return su
+}
+
+// RemoveDisorderIDs removes the "disorders" edge to Disorder entities by IDs.
+func (su *SurvivorUpdate) RemoveDisorderIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.RemoveDisorderIDs(ids...)
+	return su
+}
+
+// RemoveDisorders removes "disorders" edges to Disorder entities.
+func (su *SurvivorUpdate) RemoveDisorders(d ...*Disorder) *SurvivorUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return su.RemoveDisorderIDs(ids...)
+}
+
+// ClearAbilities clears all "abilities" edges to the Ability entity.
+func (su *SurvivorUpdate) ClearAbilities() *SurvivorUpdate {
+	su.mutation.ClearAbilities()
+	return su
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to Ability entities by IDs.
+func (su *SurvivorUpdate) RemoveAbilityIDs(ids ...int) *SurvivorUpdate {
+	su.mutation.RemoveAbilityIDs(ids...)
+	return su
+}
+
+// RemoveAbilities removes "abilities" edges to Ability entities.
+func (su *SurvivorUpdate) RemoveAbilities(a ...*Ability) *SurvivorUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.RemoveAbilityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SurvivorUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -576,6 +758,12 @@ func (su *SurvivorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.SetField(survivor.FieldDeletedAt, field.TypeTime, value)
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.ClearField(survivor.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := su.mutation.Name(); ok {
 		_spec.SetField(survivor.FieldName, field.TypeString, value)
 	}
@@ -681,6 +869,9 @@ func (su *SurvivorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.AddedStatusChangeYear(); ok {
 		_spec.AddField(survivor.FieldStatusChangeYear, field.TypeInt, value)
 	}
+	if value, ok := su.mutation.CannotUseFightingArts(); ok {
+		_spec.SetField(survivor.FieldCannotUseFightingArts, field.TypeBool, value)
+	}
 	if su.mutation.SettlementCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -710,6 +901,186 @@ func (su *SurvivorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.FightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedFightingArtsIDs(); len(nodes) > 0 && !su.mutation.FightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.SecretFightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedSecretFightingArtsIDs(); len(nodes) > 0 && !su.mutation.SecretFightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SecretFightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.DisordersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedDisordersIDs(); len(nodes) > 0 && !su.mutation.DisordersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.DisordersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedAbilitiesIDs(); len(nodes) > 0 && !su.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{survivor.Label}
@@ -730,6 +1101,26 @@ type SurvivorUpdateOne struct {
 	mutation *SurvivorMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *SurvivorUpdateOne) SetDeletedAt(t time.Time) *SurvivorUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *SurvivorUpdateOne) SetNillableDeletedAt(t *time.Time) *SurvivorUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *SurvivorUpdateOne) ClearDeletedAt() *SurvivorUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
 // SetName sets the "name" field.
 func (suo *SurvivorUpdateOne) SetName(s string) *SurvivorUpdateOne {
 	suo.mutation.SetName(s)
@@ -1108,6 +1499,20 @@ func (suo *SurvivorUpdateOne) AddStatusChangeYear(i int) *SurvivorUpdateOne {
 	return suo
 }
 
+// SetCannotUseFightingArts sets the "cannot_use_fighting_arts" field.
+func (suo *SurvivorUpdateOne) SetCannotUseFightingArts(b bool) *SurvivorUpdateOne {
+	suo.mutation.SetCannotUseFightingArts(b)
+	return suo
+}
+
+// SetNillableCannotUseFightingArts sets the "cannot_use_fighting_arts" field if the given value is not nil.
+func (suo *SurvivorUpdateOne) SetNillableCannotUseFightingArts(b *bool) *SurvivorUpdateOne {
+	if b != nil {
+		suo.SetCannotUseFightingArts(*b)
+	}
+	return suo
+}
+
 // SetSettlementID sets the "settlement_id" field.
 func (suo *SurvivorUpdateOne) SetSettlementID(i int) *SurvivorUpdateOne {
 	suo.mutation.SetSettlementID(i)
@@ -1133,6 +1538,66 @@ func (suo *SurvivorUpdateOne) SetSettlement(s *Settlement) *SurvivorUpdateOne {
 	return suo.SetSettlementID(s.ID)
 }
 
+// AddFightingArtIDs adds the "fighting_arts" edge to the FightingArt entity by IDs.
+func (suo *SurvivorUpdateOne) AddFightingArtIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.AddFightingArtIDs(ids...)
+	return suo
+}
+
+// AddFightingArts adds the "fighting_arts" edges to the FightingArt entity.
+func (suo *SurvivorUpdateOne) AddFightingArts(f ...*FightingArt) *SurvivorUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.AddFightingArtIDs(ids...)
+}
+
+// AddSecretFightingArtIDs adds the "secret_fighting_arts" edge to the FightingArt entity by IDs.
+func (suo *SurvivorUpdateOne) AddSecretFightingArtIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.AddSecretFightingArtIDs(ids...)
+	return suo
+}
+
+// AddSecretFightingArts adds the "secret_fighting_arts" edges to the FightingArt entity.
+func (suo *SurvivorUpdateOne) AddSecretFightingArts(f ...*FightingArt) *SurvivorUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.AddSecretFightingArtIDs(ids...)
+}
+
+// AddDisorderIDs adds the "disorders" edge to the Disorder entity by IDs.
+func (suo *SurvivorUpdateOne) AddDisorderIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.AddDisorderIDs(ids...)
+	return suo
+}
+
+// AddDisorders adds the "disorders" edges to the Disorder entity.
+func (suo *SurvivorUpdateOne) AddDisorders(d ...*Disorder) *SurvivorUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.AddDisorderIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the Ability entity by IDs.
+func (suo *SurvivorUpdateOne) AddAbilityIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.AddAbilityIDs(ids...)
+	return suo
+}
+
+// AddAbilities adds the "abilities" edges to the Ability entity.
+func (suo *SurvivorUpdateOne) AddAbilities(a ...*Ability) *SurvivorUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.AddAbilityIDs(ids...)
+}
+
 // Mutation returns the SurvivorMutation object of the builder.
 func (suo *SurvivorUpdateOne) Mutation() *SurvivorMutation {
 	return suo.mutation
@@ -1144,6 +1609,90 @@ func (suo *SurvivorUpdateOne) ClearSettlement() *SurvivorUpdateOne {
 	return suo
 }
 
+// ClearFightingArts clears all "fighting_arts" edges to the FightingArt entity.
+func (suo *SurvivorUpdateOne) ClearFightingArts() *SurvivorUpdateOne {
+	suo.mutation.ClearFightingArts()
+	return suo
+}
+
+// RemoveFightingArtIDs removes the "fighting_arts" edge to FightingArt entities by IDs.
+func (suo *SurvivorUpdateOne) RemoveFightingArtIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.RemoveFightingArtIDs(ids...)
+	return suo
+}
+
+// RemoveFightingArts removes "fighting_arts" edges to FightingArt entities.
+func (suo *SurvivorUpdateOne) RemoveFightingArts(f ...*FightingArt) *SurvivorUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.RemoveFightingArtIDs(ids...)
+}
+
+// ClearSecretFightingArts clears all "secret_fighting_arts" edges to the FightingArt entity.
+func (suo *SurvivorUpdateOne) ClearSecretFightingArts() *SurvivorUpdateOne {
+	suo.mutation.ClearSecretFightingArts()
+	return suo
+}
+
+// RemoveSecretFightingArtIDs removes the "secret_fighting_arts" edge to FightingArt entities by IDs.
+func (suo *SurvivorUpdateOne) RemoveSecretFightingArtIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.RemoveSecretFightingArtIDs(ids...)
+	return suo
+}
+
+// RemoveSecretFightingArts removes "secret_fighting_arts" edges to FightingArt entities.
+func (suo *SurvivorUpdateOne) RemoveSecretFightingArts(f ...*FightingArt) *SurvivorUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return suo.RemoveSecretFightingArtIDs(ids...)
+}
+
+// ClearDisorders clears all "disorders" edges to the Disorder entity.
+func (suo *SurvivorUpdateOne) ClearDisorders() *SurvivorUpdateOne {
+	suo.mutation.ClearDisorders()
+	return suo
+}
+
+// RemoveDisorderIDs removes the "disorders" edge to Disorder entities by IDs.
+func (suo *SurvivorUpdateOne) RemoveDisorderIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.RemoveDisorderIDs(ids...)
+	return suo
+}
+
+// RemoveDisorders removes "disorders" edges to Disorder entities.
+func (suo *SurvivorUpdateOne) RemoveDisorders(d ...*Disorder) *SurvivorUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return suo.RemoveDisorderIDs(ids...)
+}
+
+// ClearAbilities clears all "abilities" edges to the Ability entity.
+func (suo *SurvivorUpdateOne) ClearAbilities() *SurvivorUpdateOne {
+	suo.mutation.ClearAbilities()
+	return suo
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to Ability entities by IDs.
+func (suo *SurvivorUpdateOne) RemoveAbilityIDs(ids ...int) *SurvivorUpdateOne {
+	suo.mutation.RemoveAbilityIDs(ids...)
+	return suo
+}
+
+// RemoveAbilities removes "abilities" edges to Ability entities.
+func (suo *SurvivorUpdateOne) RemoveAbilities(a ...*Ability) *SurvivorUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.RemoveAbilityIDs(ids...)
+}
+
 // Where appends a list predicates to the SurvivorUpdate builder.
 func (suo *SurvivorUpdateOne) Where(ps ...predicate.Survivor) *SurvivorUpdateOne {
 	suo.mutation.Where(ps...)
@@ -1308,6 +1857,12 @@ func (suo *SurvivorUpdateOne) sqlSave(ctx context.Context) (_node *Survivor, err
 			}
 		}
 	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.SetField(survivor.FieldDeletedAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.ClearField(survivor.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := suo.mutation.Name(); ok {
 		_spec.SetField(survivor.FieldName, field.TypeString, value)
 	}
@@ -1413,6 +1968,9 @@ func (suo *SurvivorUpdateOne) sqlSave(ctx context.Context) (_node *Survivor, err
 	if value, ok := suo.mutation.AddedStatusChangeYear(); ok {
 		_spec.AddField(survivor.FieldStatusChangeYear, field.TypeInt, value)
 	}
+	if value, ok := suo.mutation.CannotUseFightingArts(); ok {
+		_spec.SetField(survivor.FieldCannotUseFightingArts, field.TypeBool, value)
+	}
 	if suo.mutation.SettlementCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1442,6 +2000,186 @@ func (suo *SurvivorUpdateOne) sqlSave(ctx context.Context) (_node *Survivor, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.FightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedFightingArtsIDs(); len(nodes) > 0 && !suo.mutation.FightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.FightingArtsTable,
+			Columns: survivor.FightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.SecretFightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedSecretFightingArtsIDs(); len(nodes) > 0 && !suo.mutation.SecretFightingArtsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SecretFightingArtsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.SecretFightingArtsTable,
+			Columns: survivor.SecretFightingArtsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fightingart.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.DisordersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedDisordersIDs(); len(nodes) > 0 && !suo.mutation.DisordersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.DisordersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.DisordersTable,
+			Columns: survivor.DisordersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disorder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedAbilitiesIDs(); len(nodes) > 0 && !suo.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   survivor.AbilitiesTable,
+			Columns: survivor.AbilitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ability.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Survivor{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
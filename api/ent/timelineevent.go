@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TimelineEvent is the model entity for the TimelineEvent schema.
+type TimelineEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Year holds the value of the "year" field.
+	Year int `json:"year,omitempty"`
+	// Type holds the value of the "type" field.
+	Type timelineevent.Type `json:"type,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Completed holds the value of the "completed" field.
+	Completed bool `json:"completed,omitempty"`
+	// SettlementID holds the value of the "settlement_id" field.
+	SettlementID int `json:"settlement_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TimelineEventQuery when eager-loading is set.
+	Edges        TimelineEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TimelineEventEdges holds the relations/edges for other nodes in the graph.
+type TimelineEventEdges struct {
+	// Settlement holds the value of the settlement edge.
+	Settlement *Settlement `json:"settlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// SettlementOrErr returns the Settlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TimelineEventEdges) SettlementOrErr() (*Settlement, error) {
+	if e.Settlement != nil {
+		return e.Settlement, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: settlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "settlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TimelineEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case timelineevent.FieldCompleted:
+			values[i] = new(sql.NullBool)
+		case timelineevent.FieldID, timelineevent.FieldYear, timelineevent.FieldSettlementID:
+			values[i] = new(sql.NullInt64)
+		case timelineevent.FieldType, timelineevent.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TimelineEvent fields.
+func (te *TimelineEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case timelineevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			te.ID = int(value.Int64)
+		case timelineevent.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				te.Year = int(value.Int64)
+			}
+		case timelineevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				te.Type = timelineevent.Type(value.String)
+			}
+		case timelineevent.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				te.Name = value.String
+			}
+		case timelineevent.FieldCompleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field completed", values[i])
+			} else if value.Valid {
+				te.Completed = value.Bool
+			}
+		case timelineevent.FieldSettlementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field settlement_id", values[i])
+			} else if value.Valid {
+				te.SettlementID = int(value.Int64)
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TimelineEvent.
+// This includes values selected through modifiers, order, etc.
+func (te *TimelineEvent) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// QuerySettlement queries the "settlement" edge of the TimelineEvent entity.
+func (te *TimelineEvent) QuerySettlement() *SettlementQuery {
+	return NewTimelineEventClient(te.config).QuerySettlement(te)
+}
+
+// Update returns a builder for updating this TimelineEvent.
+// Note that you need to call TimelineEvent.Unwrap() before calling this method if this TimelineEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TimelineEvent) Update() *TimelineEventUpdateOne {
+	return NewTimelineEventClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TimelineEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TimelineEvent) Unwrap() *TimelineEvent {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TimelineEvent is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TimelineEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("TimelineEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", te.Year))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", te.Type))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(te.Name)
+	builder.WriteString(", ")
+	builder.WriteString("completed=")
+	builder.WriteString(fmt.Sprintf("%v", te.Completed))
+	builder.WriteString(", ")
+	builder.WriteString("settlement_id=")
+	builder.WriteString(fmt.Sprintf("%v", te.SettlementID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TimelineEvents is a parsable slice of TimelineEvent.
+type TimelineEvents []*TimelineEvent
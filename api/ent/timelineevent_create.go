@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TimelineEventCreate is the builder for creating a TimelineEvent entity.
+type TimelineEventCreate struct {
+	config
+	mutation *TimelineEventMutation
+	hooks    []Hook
+}
+
+// SetYear sets the "year" field.
+func (tec *TimelineEventCreate) SetYear(i int) *TimelineEventCreate {
+	tec.mutation.SetYear(i)
+	return tec
+}
+
+// SetType sets the "type" field.
+func (tec *TimelineEventCreate) SetType(t timelineevent.Type) *TimelineEventCreate {
+	tec.mutation.SetType(t)
+	return tec
+}
+
+// SetName sets the "name" field.
+func (tec *TimelineEventCreate) SetName(s string) *TimelineEventCreate {
+	tec.mutation.SetName(s)
+	return tec
+}
+
+// SetCompleted sets the "completed" field.
+func (tec *TimelineEventCreate) SetCompleted(b bool) *TimelineEventCreate {
+	tec.mutation.SetCompleted(b)
+	return tec
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (tec *TimelineEventCreate) SetNillableCompleted(b *bool) *TimelineEventCreate {
+	if b != nil {
+		tec.SetCompleted(*b)
+	}
+	return tec
+}
+
+// SetSettlementID sets the "settlement_id" field.
+func (tec *TimelineEventCreate) SetSettlementID(i int) *TimelineEventCreate {
+	tec.mutation.SetSettlementID(i)
+	return tec
+}
+
+// SetSettlement sets the "settlement" edge to the Settlement entity.
+func (tec *TimelineEventCreate) SetSettlement(s *Settlement) *TimelineEventCreate {
+	return tec.SetSettlementID(s.ID)
+}
+
+// Mutation returns the TimelineEventMutation object of the builder.
+func (tec *TimelineEventCreate) Mutation() *TimelineEventMutation {
+	return tec.mutation
+}
+
+// Save creates the TimelineEvent in the database.
+func (tec *TimelineEventCreate) Save(ctx context.Context) (*TimelineEvent, error) {
+	if err := tec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TimelineEventCreate) SaveX(ctx context.Context) *TimelineEvent {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TimelineEventCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TimelineEventCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tec *TimelineEventCreate) defaults() error {
+	if _, ok := tec.mutation.Completed(); !ok {
+		v := timelineevent.DefaultCompleted
+		tec.mutation.SetCompleted(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TimelineEventCreate) check() error {
+	if _, ok := tec.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "TimelineEvent.year"`)}
+	}
+	if v, ok := tec.mutation.Year(); ok {
+		if err := timelineevent.YearValidator(v); err != nil {
+			return &ValidationError{Name: "year", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.year": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TimelineEvent.type"`)}
+	}
+	if v, ok := tec.mutation.GetType(); ok {
+		if err := timelineevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TimelineEvent.name"`)}
+	}
+	if v, ok := tec.mutation.Name(); ok {
+		if err := timelineevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.name": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Completed(); !ok {
+		return &ValidationError{Name: "completed", err: errors.New(`ent: missing required field "TimelineEvent.completed"`)}
+	}
+	if _, ok := tec.mutation.SettlementID(); !ok {
+		return &ValidationError{Name: "settlement_id", err: errors.New(`ent: missing required field "TimelineEvent.settlement_id"`)}
+	}
+	if len(tec.mutation.SettlementIDs()) == 0 {
+		return &ValidationError{Name: "settlement", err: errors.New(`ent: missing required edge "TimelineEvent.settlement"`)}
+	}
+	return nil
+}
+
+func (tec *TimelineEventCreate) sqlSave(ctx context.Context) (*TimelineEvent, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TimelineEventCreate) createSpec() (*TimelineEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TimelineEvent{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(timelineevent.Table, sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt))
+	)
+	if value, ok := tec.mutation.Year(); ok {
+		_spec.SetField(timelineevent.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := tec.mutation.GetType(); ok {
+		_spec.SetField(timelineevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := tec.mutation.Name(); ok {
+		_spec.SetField(timelineevent.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tec.mutation.Completed(); ok {
+		_spec.SetField(timelineevent.FieldCompleted, field.TypeBool, value)
+		_node.Completed = value
+	}
+	if nodes := tec.mutation.SettlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   timelineevent.SettlementTable,
+			Columns: []string{timelineevent.SettlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SettlementID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TimelineEventCreateBulk is the builder for creating many TimelineEvent entities in bulk.
+type TimelineEventCreateBulk struct {
+	config
+	err      error
+	builders []*TimelineEventCreate
+}
+
+// Save creates the TimelineEvent entities in the database.
+func (tecb *TimelineEventCreateBulk) Save(ctx context.Context) ([]*TimelineEvent, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TimelineEvent, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TimelineEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TimelineEventCreateBulk) SaveX(ctx context.Context) []*TimelineEvent {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TimelineEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TimelineEventCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TimelineEventDelete is the builder for deleting a TimelineEvent entity.
+type TimelineEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *TimelineEventMutation
+}
+
+// Where appends a list predicates to the TimelineEventDelete builder.
+func (ted *TimelineEventDelete) Where(ps ...predicate.TimelineEvent) *TimelineEventDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TimelineEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ted.sqlExec, ted.mutation, ted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TimelineEventDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TimelineEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(timelineevent.Table, sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt))
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ted.mutation.done = true
+	return affected, err
+}
+
+// TimelineEventDeleteOne is the builder for deleting a single TimelineEvent entity.
+type TimelineEventDeleteOne struct {
+	ted *TimelineEventDelete
+}
+
+// Where appends a list predicates to the TimelineEventDelete builder.
+func (tedo *TimelineEventDeleteOne) Where(ps ...predicate.TimelineEvent) *TimelineEventDeleteOne {
+	tedo.ted.mutation.Where(ps...)
+	return tedo
+}
+
+// Exec executes the deletion query.
+func (tedo *TimelineEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{timelineevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TimelineEventDeleteOne) ExecX(ctx context.Context) {
+	if err := tedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
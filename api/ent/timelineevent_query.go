@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TimelineEventQuery is the builder for querying TimelineEvent entities.
+type TimelineEventQuery struct {
+	config
+	ctx            *QueryContext
+	order          []timelineevent.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TimelineEvent
+	withSettlement *SettlementQuery
+	modifiers      []func(*sql.Selector)
+	loadTotal      []func(context.Context, []*TimelineEvent) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TimelineEventQuery builder.
+func (teq *TimelineEventQuery) Where(ps ...predicate.TimelineEvent) *TimelineEventQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit the number of records to be returned by this query.
+func (teq *TimelineEventQuery) Limit(limit int) *TimelineEventQuery {
+	teq.ctx.Limit = &limit
+	return teq
+}
+
+// Offset to start from.
+func (teq *TimelineEventQuery) Offset(offset int) *TimelineEventQuery {
+	teq.ctx.Offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TimelineEventQuery) Unique(unique bool) *TimelineEventQuery {
+	teq.ctx.Unique = &unique
+	return teq
+}
+
+// Order specifies how the records should be ordered.
+func (teq *TimelineEventQuery) Order(o ...timelineevent.OrderOption) *TimelineEventQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// QuerySettlement chains the current query on the "settlement" edge.
+func (teq *TimelineEventQuery) QuerySettlement() *SettlementQuery {
+	query := (&SettlementClient{config: teq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := teq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(timelineevent.Table, timelineevent.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, timelineevent.SettlementTable, timelineevent.SettlementColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(teq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TimelineEvent entity from the query.
+// Returns a *NotFoundError when no TimelineEvent was found.
+func (teq *TimelineEventQuery) First(ctx context.Context) (*TimelineEvent, error) {
+	nodes, err := teq.Limit(1).All(setContextOp(ctx, teq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{timelineevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TimelineEventQuery) FirstX(ctx context.Context) *TimelineEvent {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TimelineEvent ID from the query.
+// Returns a *NotFoundError when no TimelineEvent ID was found.
+func (teq *TimelineEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(1).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{timelineevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TimelineEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TimelineEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TimelineEvent entity is found.
+// Returns a *NotFoundError when no TimelineEvent entities are found.
+func (teq *TimelineEventQuery) Only(ctx context.Context) (*TimelineEvent, error) {
+	nodes, err := teq.Limit(2).All(setContextOp(ctx, teq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{timelineevent.Label}
+	default:
+		return nil, &NotSingularError{timelineevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TimelineEventQuery) OnlyX(ctx context.Context) *TimelineEvent {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TimelineEvent ID in the query.
+// Returns a *NotSingularError when more than one TimelineEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TimelineEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = teq.Limit(2).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{timelineevent.Label}
+	default:
+		err = &NotSingularError{timelineevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TimelineEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TimelineEvents.
+func (teq *TimelineEventQuery) All(ctx context.Context) ([]*TimelineEvent, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryAll)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TimelineEvent, *TimelineEventQuery]()
+	return withInterceptors[[]*TimelineEvent](ctx, teq, qr, teq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TimelineEventQuery) AllX(ctx context.Context) []*TimelineEvent {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TimelineEvent IDs.
+func (teq *TimelineEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if teq.ctx.Unique == nil && teq.path != nil {
+		teq.Unique(true)
+	}
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryIDs)
+	if err = teq.Select(timelineevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TimelineEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TimelineEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryCount)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, teq, querierCount[*TimelineEventQuery](), teq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TimelineEventQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TimelineEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryExist)
+	switch _, err := teq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TimelineEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TimelineEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TimelineEventQuery) Clone() *TimelineEventQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TimelineEventQuery{
+		config:         teq.config,
+		ctx:            teq.ctx.Clone(),
+		order:          append([]timelineevent.OrderOption{}, teq.order...),
+		inters:         append([]Interceptor{}, teq.inters...),
+		predicates:     append([]predicate.TimelineEvent{}, teq.predicates...),
+		withSettlement: teq.withSettlement.Clone(),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// WithSettlement tells the query-builder to eager-load the nodes that are connected to
+// the "settlement" edge. The optional arguments are used to configure the query builder of the edge.
+func (teq *TimelineEventQuery) WithSettlement(opts ...func(*SettlementQuery)) *TimelineEventQuery {
+	query := (&SettlementClient{config: teq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	teq.withSettlement = query
+	return teq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Year int `json:"year,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TimelineEvent.Query().
+//		GroupBy(timelineevent.FieldYear).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (teq *TimelineEventQuery) GroupBy(field string, fields ...string) *TimelineEventGroupBy {
+	teq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TimelineEventGroupBy{build: teq}
+	grbuild.flds = &teq.ctx.Fields
+	grbuild.label = timelineevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Year int `json:"year,omitempty"`
+//	}
+//
+//	client.TimelineEvent.Query().
+//		Select(timelineevent.FieldYear).
+//		Scan(ctx, &v)
+func (teq *TimelineEventQuery) Select(fields ...string) *TimelineEventSelect {
+	teq.ctx.Fields = append(teq.ctx.Fields, fields...)
+	sbuild := &TimelineEventSelect{TimelineEventQuery: teq}
+	sbuild.label = timelineevent.Label
+	sbuild.flds, sbuild.scan = &teq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TimelineEventSelect configured with the given aggregations.
+func (teq *TimelineEventQuery) Aggregate(fns ...AggregateFunc) *TimelineEventSelect {
+	return teq.Select().Aggregate(fns...)
+}
+
+func (teq *TimelineEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range teq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, teq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range teq.ctx.Fields {
+		if !timelineevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	if timelineevent.Policy == nil {
+		return errors.New("ent: uninitialized timelineevent.Policy (forgotten import ent/runtime?)")
+	}
+	if err := timelineevent.Policy.EvalQuery(ctx, teq); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (teq *TimelineEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TimelineEvent, error) {
+	var (
+		nodes       = []*TimelineEvent{}
+		_spec       = teq.querySpec()
+		loadedTypes = [1]bool{
+			teq.withSettlement != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TimelineEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TimelineEvent{config: teq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(teq.modifiers) > 0 {
+		_spec.Modifiers = teq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := teq.withSettlement; query != nil {
+		if err := teq.loadSettlement(ctx, query, nodes, nil,
+			func(n *TimelineEvent, e *Settlement) { n.Edges.Settlement = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range teq.loadTotal {
+		if err := teq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (teq *TimelineEventQuery) loadSettlement(ctx context.Context, query *SettlementQuery, nodes []*TimelineEvent, init func(*TimelineEvent), assign func(*TimelineEvent, *Settlement)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TimelineEvent)
+	for i := range nodes {
+		fk := nodes[i].SettlementID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(settlement.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "settlement_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (teq *TimelineEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	if len(teq.modifiers) > 0 {
+		_spec.Modifiers = teq.modifiers
+	}
+	_spec.Node.Columns = teq.ctx.Fields
+	if len(teq.ctx.Fields) > 0 {
+		_spec.Unique = teq.ctx.Unique != nil && *teq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TimelineEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(timelineevent.Table, timelineevent.Columns, sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt))
+	_spec.From = teq.sql
+	if unique := teq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if teq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := teq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timelineevent.FieldID)
+		for i := range fields {
+			if fields[i] != timelineevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if teq.withSettlement != nil {
+			_spec.Node.AddColumnOnce(timelineevent.FieldSettlementID)
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TimelineEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(timelineevent.Table)
+	columns := teq.ctx.Fields
+	if len(columns) == 0 {
+		columns = timelineevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.ctx.Unique != nil && *teq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TimelineEventGroupBy is the group-by builder for TimelineEvent entities.
+type TimelineEventGroupBy struct {
+	selector
+	build *TimelineEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TimelineEventGroupBy) Aggregate(fns ...AggregateFunc) *TimelineEventGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tegb *TimelineEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TimelineEventQuery, *TimelineEventGroupBy](ctx, tegb.build, tegb, tegb.build.inters, v)
+}
+
+func (tegb *TimelineEventGroupBy) sqlScan(ctx context.Context, root *TimelineEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tegb.flds)+len(tegb.fns))
+		for _, f := range *tegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TimelineEventSelect is the builder for selecting fields of TimelineEvent entities.
+type TimelineEventSelect struct {
+	*TimelineEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tes *TimelineEventSelect) Aggregate(fns ...AggregateFunc) *TimelineEventSelect {
+	tes.fns = append(tes.fns, fns...)
+	return tes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TimelineEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tes.ctx, ent.OpQuerySelect)
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TimelineEventQuery, *TimelineEventSelect](ctx, tes.TimelineEventQuery, tes, tes.inters, v)
+}
+
+func (tes *TimelineEventSelect) sqlScan(ctx context.Context, root *TimelineEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tes.fns))
+	for _, fn := range tes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
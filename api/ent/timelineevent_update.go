@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TimelineEventUpdate is the builder for updating TimelineEvent entities.
+type TimelineEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TimelineEventMutation
+}
+
+// Where appends a list predicates to the TimelineEventUpdate builder.
+func (teu *TimelineEventUpdate) Where(ps ...predicate.TimelineEvent) *TimelineEventUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetYear sets the "year" field.
+func (teu *TimelineEventUpdate) SetYear(i int) *TimelineEventUpdate {
+	teu.mutation.ResetYear()
+	teu.mutation.SetYear(i)
+	return teu
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (teu *TimelineEventUpdate) SetNillableYear(i *int) *TimelineEventUpdate {
+	if i != nil {
+		teu.SetYear(*i)
+	}
+	return teu
+}
+
+// AddYear adds i to the "year" field.
+func (teu *TimelineEventUpdate) AddYear(i int) *TimelineEventUpdate {
+	teu.mutation.AddYear(i)
+	return teu
+}
+
+// SetType sets the "type" field.
+func (teu *TimelineEventUpdate) SetType(t timelineevent.Type) *TimelineEventUpdate {
+	teu.mutation.SetType(t)
+	return teu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (teu *TimelineEventUpdate) SetNillableType(t *timelineevent.Type) *TimelineEventUpdate {
+	if t != nil {
+		teu.SetType(*t)
+	}
+	return teu
+}
+
+// SetName sets the "name" field.
+func (teu *TimelineEventUpdate) SetName(s string) *TimelineEventUpdate {
+	teu.mutation.SetName(s)
+	return teu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (teu *TimelineEventUpdate) SetNillableName(s *string) *TimelineEventUpdate {
+	if s != nil {
+		teu.SetName(*s)
+	}
+	return teu
+}
+
+// SetCompleted sets the "completed" field.
+func (teu *TimelineEventUpdate) SetCompleted(b bool) *TimelineEventUpdate {
+	teu.mutation.SetCompleted(b)
+	return teu
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (teu *TimelineEventUpdate) SetNillableCompleted(b *bool) *TimelineEventUpdate {
+	if b != nil {
+		teu.SetCompleted(*b)
+	}
+	return teu
+}
+
+// Mutation returns the TimelineEventMutation object of the builder.
+func (teu *TimelineEventUpdate) Mutation() *TimelineEventMutation {
+	return teu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TimelineEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TimelineEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TimelineEventUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TimelineEventUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TimelineEventUpdate) check() error {
+	if v, ok := teu.mutation.Year(); ok {
+		if err := timelineevent.YearValidator(v); err != nil {
+			return &ValidationError{Name: "year", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.year": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.GetType(); ok {
+		if err := timelineevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.Name(); ok {
+		if err := timelineevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.name": %w`, err)}
+		}
+	}
+	if teu.mutation.SettlementCleared() && len(teu.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TimelineEvent.settlement"`)
+	}
+	return nil
+}
+
+func (teu *TimelineEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := teu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(timelineevent.Table, timelineevent.Columns, sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.Year(); ok {
+		_spec.SetField(timelineevent.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.AddedYear(); ok {
+		_spec.AddField(timelineevent.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.GetType(); ok {
+		_spec.SetField(timelineevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := teu.mutation.Name(); ok {
+		_spec.SetField(timelineevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.Completed(); ok {
+		_spec.SetField(timelineevent.FieldCompleted, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelineevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TimelineEventUpdateOne is the builder for updating a single TimelineEvent entity.
+type TimelineEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TimelineEventMutation
+}
+
+// SetYear sets the "year" field.
+func (teuo *TimelineEventUpdateOne) SetYear(i int) *TimelineEventUpdateOne {
+	teuo.mutation.ResetYear()
+	teuo.mutation.SetYear(i)
+	return teuo
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (teuo *TimelineEventUpdateOne) SetNillableYear(i *int) *TimelineEventUpdateOne {
+	if i != nil {
+		teuo.SetYear(*i)
+	}
+	return teuo
+}
+
+// AddYear adds i to the "year" field.
+func (teuo *TimelineEventUpdateOne) AddYear(i int) *TimelineEventUpdateOne {
+	teuo.mutation.AddYear(i)
+	return teuo
+}
+
+// SetType sets the "type" field.
+func (teuo *TimelineEventUpdateOne) SetType(t timelineevent.Type) *TimelineEventUpdateOne {
+	teuo.mutation.SetType(t)
+	return teuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (teuo *TimelineEventUpdateOne) SetNillableType(t *timelineevent.Type) *TimelineEventUpdateOne {
+	if t != nil {
+		teuo.SetType(*t)
+	}
+	return teuo
+}
+
+// SetName sets the "name" field.
+func (teuo *TimelineEventUpdateOne) SetName(s string) *TimelineEventUpdateOne {
+	teuo.mutation.SetName(s)
+	return teuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (teuo *TimelineEventUpdateOne) SetNillableName(s *string) *TimelineEventUpdateOne {
+	if s != nil {
+		teuo.SetName(*s)
+	}
+	return teuo
+}
+
+// SetCompleted sets the "completed" field.
+func (teuo *TimelineEventUpdateOne) SetCompleted(b bool) *TimelineEventUpdateOne {
+	teuo.mutation.SetCompleted(b)
+	return teuo
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (teuo *TimelineEventUpdateOne) SetNillableCompleted(b *bool) *TimelineEventUpdateOne {
+	if b != nil {
+		teuo.SetCompleted(*b)
+	}
+	return teuo
+}
+
+// Mutation returns the TimelineEventMutation object of the builder.
+func (teuo *TimelineEventUpdateOne) Mutation() *TimelineEventMutation {
+	return teuo.mutation
+}
+
+// Where appends a list predicates to the TimelineEventUpdate builder.
+func (teuo *TimelineEventUpdateOne) Where(ps ...predicate.TimelineEvent) *TimelineEventUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TimelineEventUpdateOne) Select(field string, fields ...string) *TimelineEventUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TimelineEvent entity.
+func (teuo *TimelineEventUpdateOne) Save(ctx context.Context) (*TimelineEvent, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TimelineEventUpdateOne) SaveX(ctx context.Context) *TimelineEvent {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TimelineEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TimelineEventUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TimelineEventUpdateOne) check() error {
+	if v, ok := teuo.mutation.Year(); ok {
+		if err := timelineevent.YearValidator(v); err != nil {
+			return &ValidationError{Name: "year", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.year": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.GetType(); ok {
+		if err := timelineevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.Name(); ok {
+		if err := timelineevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.name": %w`, err)}
+		}
+	}
+	if teuo.mutation.SettlementCleared() && len(teuo.mutation.SettlementIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TimelineEvent.settlement"`)
+	}
+	return nil
+}
+
+func (teuo *TimelineEventUpdateOne) sqlSave(ctx context.Context) (_node *TimelineEvent, err error) {
+	if err := teuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(timelineevent.Table, timelineevent.Columns, sqlgraph.NewFieldSpec(timelineevent.FieldID, field.TypeInt))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TimelineEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, timelineevent.FieldID)
+		for _, f := range fields {
+			if !timelineevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != timelineevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.Year(); ok {
+		_spec.SetField(timelineevent.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.AddedYear(); ok {
+		_spec.AddField(timelineevent.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.GetType(); ok {
+		_spec.SetField(timelineevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := teuo.mutation.Name(); ok {
+		_spec.SetField(timelineevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.Completed(); ok {
+		_spec.SetField(timelineevent.FieldCompleted, field.TypeBool, value)
+	}
+	_node = &TimelineEvent{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{timelineevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
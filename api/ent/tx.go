@@ -12,10 +12,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Ability is the client for interacting with the Ability builders.
+	Ability *AbilityClient
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Disorder is the client for interacting with the Disorder builders.
+	Disorder *DisorderClient
+	// FightingArt is the client for interacting with the FightingArt builders.
+	FightingArt *FightingArtClient
+	// Innovation is the client for interacting with the Innovation builders.
+	Innovation *InnovationClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// Membership is the client for interacting with the Membership builders.
+	Membership *MembershipClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Monster is the client for interacting with the Monster builders.
+	Monster *MonsterClient
+	// MonsterProgress is the client for interacting with the MonsterProgress builders.
+	MonsterProgress *MonsterProgressClient
+	// Principle is the client for interacting with the Principle builders.
+	Principle *PrincipleClient
+	// Recipe is the client for interacting with the Recipe builders.
+	Recipe *RecipeClient
 	// Settlement is the client for interacting with the Settlement builders.
 	Settlement *SettlementClient
+	// StorageItem is the client for interacting with the StorageItem builders.
+	StorageItem *StorageItemClient
 	// Survivor is the client for interacting with the Survivor builders.
 	Survivor *SurvivorClient
+	// TimelineEvent is the client for interacting with the TimelineEvent builders.
+	TimelineEvent *TimelineEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +179,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Ability = NewAbilityClient(tx.config)
+	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Disorder = NewDisorderClient(tx.config)
+	tx.FightingArt = NewFightingArtClient(tx.config)
+	tx.Innovation = NewInnovationClient(tx.config)
+	tx.Invite = NewInviteClient(tx.config)
+	tx.Location = NewLocationClient(tx.config)
+	tx.Membership = NewMembershipClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.Monster = NewMonsterClient(tx.config)
+	tx.MonsterProgress = NewMonsterProgressClient(tx.config)
+	tx.Principle = NewPrincipleClient(tx.config)
+	tx.Recipe = NewRecipeClient(tx.config)
 	tx.Settlement = NewSettlementClient(tx.config)
+	tx.StorageItem = NewStorageItemClient(tx.config)
 	tx.Survivor = NewSurvivorClient(tx.config)
+	tx.TimelineEvent = NewTimelineEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Settlement.QueryXXX(), the query will be executed
+// applies a query, for example: Ability.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
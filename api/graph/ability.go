@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// grantAbility gives the survivor the ability or impairment abilityID.
+func grantAbility(ctx context.Context, c *ent.Client, survivorID, abilityID int) (*ent.Survivor, error) {
+	a, err := c.Ability.Get(ctx, abilityID)
+	if err != nil {
+		return nil, err
+	}
+	held, err := hasAbility(ctx, c, survivorID, abilityID)
+	if err != nil {
+		return nil, err
+	}
+	if held {
+		return nil, apperr.Conflict(fmt.Sprintf("the survivor already has %s", a.Name))
+	}
+	return c.Survivor.UpdateOneID(survivorID).AddAbilityIDs(abilityID).Save(ctx)
+}
+
+// removeAbility takes the ability or impairment abilityID from the survivor.
+func removeAbility(ctx context.Context, c *ent.Client, survivorID, abilityID int) (*ent.Survivor, error) {
+	held, err := hasAbility(ctx, c, survivorID, abilityID)
+	if err != nil {
+		return nil, err
+	}
+	if !held {
+		return nil, apperr.BadUserInput("the survivor doesn't have that ability or impairment")
+	}
+	return c.Survivor.UpdateOneID(survivorID).RemoveAbilityIDs(abilityID).Save(ctx)
+}
+
+func hasAbility(ctx context.Context, c *ent.Client, survivorID, abilityID int) (bool, error) {
+	return c.Survivor.Query().
+		Where(survivor.ID(survivorID), survivor.HasAbilitiesWith(ability.ID(abilityID))).
+		Exist(ctx)
+}
+
+// effectiveStats adds the unconditional modifiers of the survivor's abilities
+// and impairments to their base stats.
+func effectiveStats(ctx context.Context, s *ent.Survivor) (*model.SurvivorStats, error) {
+	abilities, err := s.Abilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats := &model.SurvivorStats{
+		Movement: s.Movement,
+		Accuracy: s.Accuracy,
+		Strength: s.Strength,
+		Evasion:  s.Evasion,
+		Luck:     s.Luck,
+		Speed:    s.Speed,
+	}
+	for _, a := range abilities {
+		for _, m := range a.Modifiers {
+			if m.Condition != "" {
+				continue
+			}
+			switch m.Stat {
+			case schematype.StatMovement:
+				stats.Movement += m.Amount
+			case schematype.StatAccuracy:
+				stats.Accuracy += m.Amount
+			case schematype.StatStrength:
+				stats.Strength += m.Amount
+			case schematype.StatEvasion:
+				stats.Evasion += m.Amount
+			case schematype.StatLuck:
+				stats.Luck += m.Amount
+			case schematype.StatSpeed:
+				stats.Speed += m.Amount
+			}
+		}
+	}
+	return stats, nil
+}
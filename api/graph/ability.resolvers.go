@@ -0,0 +1,48 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// GrantAbility is the resolver for the grantAbility field.
+func (r *mutationResolver) GrantAbility(ctx context.Context, survivorID int, abilityID int) (*ent.Survivor, error) {
+	return grantAbility(ctx, ent.FromContext(ctx), survivorID, abilityID)
+}
+
+// RemoveAbility is the resolver for the removeAbility field.
+func (r *mutationResolver) RemoveAbility(ctx context.Context, survivorID int, abilityID int) (*ent.Survivor, error) {
+	return removeAbility(ctx, ent.FromContext(ctx), survivorID, abilityID)
+}
+
+// Abilities is the resolver for the abilities field.
+func (r *queryResolver) Abilities(ctx context.Context, kind *ability.Kind) ([]*ent.Ability, error) {
+	query := r.client.Ability.Query()
+	if kind != nil {
+		query.Where(ability.KindEQ(*kind))
+	}
+	return query.Order(ability.ByKind(), ability.ByName()).All(ctx)
+}
+
+// Condition is the resolver for the condition field.
+func (r *statModifierResolver) Condition(ctx context.Context, obj *schematype.StatModifier) (*string, error) {
+	return nonEmpty(obj.Condition), nil
+}
+
+// EffectiveStats is the resolver for the effectiveStats field.
+func (r *survivorResolver) EffectiveStats(ctx context.Context, obj *ent.Survivor) (*model.SurvivorStats, error) {
+	return effectiveStats(ctx, obj)
+}
+
+// StatModifier returns StatModifierResolver implementation.
+func (r *Resolver) StatModifier() StatModifierResolver { return &statModifierResolver{r} }
+
+type statModifierResolver struct{ *Resolver }
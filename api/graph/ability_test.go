@@ -0,0 +1,101 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const grantAbility = `mutation($v: ID!, $c: ID!) {
+	grantAbility(survivorID: $v, abilityID: $c) { strength movement effectiveStats { movement accuracy strength } }
+}`
+
+type stats struct{ Movement, Accuracy, Strength int }
+
+type catalogAbility struct {
+	ID   int `json:"id,string"`
+	Name string
+	Kind string
+}
+
+// abilities returns the catalog's abilities and impairments by name.
+func (a *api) abilities() map[string]catalogAbility {
+	a.t.Helper()
+	var out struct{ Abilities []catalogAbility }
+	a.run("alice", `{ abilities { id name kind } }`, nil, &out)
+	abilities := map[string]catalogAbility{}
+	for _, c := range out.Abilities {
+		abilities[c.Name] = c
+	}
+	return abilities
+}
+
+func TestAbilities(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	abilities := a.abilities()
+
+	// Seeding again at the next startup doesn't duplicate the catalog.
+	a.seedCatalog()
+	if again := a.abilities(); len(again) == 0 || len(again) != len(abilities) {
+		t.Fatalf("%d abilities after seeding twice, want %d", len(again), len(abilities))
+	}
+	var impairments struct{ Abilities []catalogAbility }
+	a.run("alice", `{ abilities(kind: impairment) { name kind } }`, nil, &impairments)
+	for _, c := range impairments.Abilities {
+		if c.Kind != "impairment" {
+			t.Errorf("%s is a %s", c.Name, c.Kind)
+		}
+	}
+	if len(impairments.Abilities) == 0 || len(impairments.Abilities) == len(abilities) {
+		t.Errorf("%d of %d abilities are impairments", len(impairments.Abilities), len(abilities))
+	}
+}
+
+func TestEffectiveStats(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	abilities := a.abilities()
+	var created struct {
+		CreateSettlement struct {
+			ID         int `json:"id,string"`
+			Population []struct {
+				ID int `json:"id,string"`
+			}
+		}
+	}
+	a.run("alice", `mutation { createSettlement(input: {owner: "alice", name: "Lantern Hollow", createSurvivors: [{name: "Zachary", strength: 2}]}) {
+		id population { id }
+	} }`, nil, &created)
+	a.addCollaborator("alice", created.CreateSettlement.ID, "bob", "viewer")
+	grant := func(name string) map[string]any {
+		return map[string]any{"v": created.CreateSettlement.Population[0].ID, "c": abilities[name].ID}
+	}
+
+	var out struct {
+		GrantAbility struct {
+			Strength, Movement int
+			EffectiveStats     stats
+		}
+	}
+	// Bitter Frenzy only adds strength while frenzied.
+	a.run("alice", grantAbility, grant("Bitter Frenzy"), &out)
+	if got := out.GrantAbility.EffectiveStats; got != (stats{Movement: 5, Strength: 2}) {
+		t.Fatalf("with Bitter Frenzy %+v", got)
+	}
+	a.run("alice", grantAbility, grant("Broken Arm"), nil)
+	a.run("alice", grantAbility, grant("Dismembered Leg"), &out)
+	if got := out.GrantAbility; got.EffectiveStats != (stats{Movement: 3, Accuracy: -1, Strength: 1}) || got.Strength != 2 || got.Movement != 5 {
+		t.Fatalf("with a Broken Arm and a Dismembered Leg %+v", got)
+	}
+	a.reject("alice", grantAbility, grant("Dismembered Leg"), "CONFLICT")
+	a.reject("bob", grantAbility, grant("Blind"), "FORBIDDEN")
+
+	remove := `mutation($v: ID!, $c: ID!) { removeAbility(survivorID: $v, abilityID: $c) { effectiveStats { movement accuracy strength } } }`
+	var removed struct {
+		RemoveAbility struct{ EffectiveStats stats }
+	}
+	a.run("alice", remove, grant("Broken Arm"), &removed)
+	if got := removed.RemoveAbility.EffectiveStats; got != (stats{Movement: 3, Strength: 2}) {
+		t.Fatalf("after the Broken Arm healed %+v", got)
+	}
+	a.reject("alice", remove, grant("Broken Arm"), "BAD_USER_INPUT")
+}
@@ -0,0 +1,68 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/auth"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/graph/model"
+	"github.com/failuretoload/datamonster/secret"
+)
+
+// CreateAccessToken is the resolver for the createAccessToken field.
+func (r *mutationResolver) CreateAccessToken(ctx context.Context, name string, scope accesstoken.Scope, expiresAt time.Time) (*model.CreateAccessTokenPayload, error) {
+	userID, err := ownerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if auth.ViaAccessToken(ctx) {
+		return nil, errAccessTokenCaller
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, apperr.BadUserInput("expiresAt must be in the future")
+	}
+	if lifetime := config.AccessTokenMaxLifetime(); expiresAt.After(time.Now().Add(lifetime)) {
+		return nil, apperr.BadUserInput(fmt.Sprintf("expiresAt can be at most %g days away", lifetime.Hours()/24))
+	}
+	token, hash, err := secret.New(auth.AccessTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	pat, err := ent.FromContext(ctx).AccessToken.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetScope(scope).
+		SetTokenHash(hash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &model.CreateAccessTokenPayload{Token: token, AccessToken: pat}, nil
+}
+
+// RevokeAccessToken is the resolver for the revokeAccessToken field.
+func (r *mutationResolver) RevokeAccessToken(ctx context.Context, id int) (*ent.AccessToken, error) {
+	if auth.ViaAccessToken(ctx) {
+		return nil, errAccessTokenCaller
+	}
+	return ent.FromContext(ctx).AccessToken.UpdateOneID(id).SetRevokedAt(time.Now()).Save(ctx)
+}
+
+// AccessTokens is the resolver for the accessTokens field.
+func (r *queryResolver) AccessTokens(ctx context.Context) ([]*ent.AccessToken, error) {
+	if _, err := ownerFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return r.client.AccessToken.Query().Order(accesstoken.ByCreatedAt(sql.OrderDesc())).All(ctx)
+}
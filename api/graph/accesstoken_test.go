@@ -0,0 +1,66 @@
+package graph_test
+
+import (
+	"testing"
+	"time"
+)
+
+const createAccessToken = `mutation($name: String!, $scope: AccessTokenScope!, $expiresAt: Time!) {
+	createAccessToken(name: $name, scope: $scope, expiresAt: $expiresAt) { token accessToken { id } }
+}`
+
+type accessTokenPayload struct {
+	CreateAccessToken struct {
+		Token       string
+		AccessToken struct {
+			ID int `json:"id,string"`
+		}
+	}
+}
+
+func (a *api) createAccessToken(token, name, scope string) accessTokenPayload {
+	a.t.Helper()
+	var out accessTokenPayload
+	vars := map[string]any{"name": name, "scope": scope, "expiresAt": time.Now().Add(24 * time.Hour)}
+	a.run(token, createAccessToken, vars, &out)
+	return out
+}
+
+func TestAccessTokens(t *testing.T) {
+	a := newAPI(t)
+	ro := a.createAccessToken("alice", "read only", "read").CreateAccessToken.Token
+	rw := a.createAccessToken("alice", "scripts", "read_write")
+
+	a.run(ro, `{ settlements { id } }`, nil, nil)
+	a.reject(ro, `mutation { createSettlement(input: {owner: "alice", name: "Lantern Hollow"}) { id } }`, nil, "FORBIDDEN")
+	a.run(rw.CreateAccessToken.Token, `mutation { createSettlement(input: {owner: "alice", name: "Lantern Hollow"}) { id } }`, nil, nil)
+
+	var tokens struct {
+		AccessTokens []struct{ Name string }
+	}
+	a.run("bob", `{ accessTokens { name } }`, nil, &tokens)
+	if len(tokens.AccessTokens) != 0 {
+		t.Fatalf("bob sees %d of alice's tokens", len(tokens.AccessTokens))
+	}
+	revoke := `mutation($id: ID!) { revokeAccessToken(id: $id) { id } }`
+	vars := map[string]any{"id": rw.CreateAccessToken.AccessToken.ID}
+	a.reject("bob", revoke, vars, "FORBIDDEN")
+	a.run("alice", revoke, vars, nil)
+	a.reject(rw.CreateAccessToken.Token, `{ settlements { id } }`, nil, "UNAUTHENTICATED")
+}
+
+// TestAccessTokensCantMintTokens checks that a leaked access token can't be
+// used to create long lived tokens or revoke others.
+func TestAccessTokensCantMintTokens(t *testing.T) {
+	a := newAPI(t)
+	rw := a.createAccessToken("alice", "scripts", "read_write")
+	other := a.createAccessToken("alice", "backup", "read")
+
+	vars := map[string]any{"name": "minted", "scope": "read_write", "expiresAt": time.Now().Add(time.Hour)}
+	a.reject(rw.CreateAccessToken.Token, createAccessToken, vars, "FORBIDDEN")
+	a.reject(rw.CreateAccessToken.Token, `mutation($id: ID!) { revokeAccessToken(id: $id) { id } }`,
+		map[string]any{"id": other.CreateAccessToken.AccessToken.ID}, "FORBIDDEN")
+
+	vars["expiresAt"] = time.Now().AddDate(1, 0, 0)
+	a.reject("alice", createAccessToken, vars, "BAD_USER_INPUT")
+}
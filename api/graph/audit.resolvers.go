@@ -0,0 +1,25 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+)
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, settlementID int, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int) (*ent.AuditLogConnection, error) {
+	return r.client.AuditLog.Query().
+		Where(auditlog.SettlementID(settlementID)).
+		Paginate(ctx, after, first, before, last,
+			ent.WithAuditLogOrder(&ent.AuditLogOrder{
+				Direction: entgql.OrderDirectionDesc,
+				Field:     ent.AuditLogOrderFieldCreatedAt,
+			}),
+		)
+}
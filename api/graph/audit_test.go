@@ -0,0 +1,123 @@
+package graph_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/secret"
+)
+
+const auditLog = `query($s: ID!) {
+	auditLog(settlementID: $s) { totalCount edges { node { actor operation entityType changes { field before after } } } }
+}`
+
+type auditPage struct {
+	AuditLog struct {
+		TotalCount int
+		Edges      []struct {
+			Node struct {
+				Actor      string
+				Operation  string
+				EntityType string
+				Changes    []struct {
+					Field  string
+					Before any
+					After  any
+				}
+			}
+		}
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+	vars := map[string]any{"s": settlementID, "v": survivors[0]}
+	a.run("alice", `mutation($s: ID!) { updateSettlement(id: $s, input: {survivallimit: 3}) { id } }`, vars, nil)
+	a.run("alice", `mutation($v: ID!) { updateSurvivor(id: $v, input: {name: "Zed"}) { id } }`, vars, nil)
+
+	var page auditPage
+	a.run("bob", auditLog, vars, &page)
+	latest := page.AuditLog.Edges[0].Node
+	if latest.Actor != "alice" || latest.Operation != "update" || latest.EntityType != "Survivor" {
+		t.Fatalf("latest entry %+v", latest)
+	}
+	if c := latest.Changes[0]; c.Field != "name" || c.Before != "Zachary" || c.After != "Zed" {
+		t.Fatalf("latest change %+v", c)
+	}
+	var stranger auditPage
+	a.run("carol", auditLog, vars, &stranger)
+	if stranger.AuditLog.TotalCount != 0 {
+		t.Fatalf("carol sees %d entries", stranger.AuditLog.TotalCount)
+	}
+
+	// Entries can't be removed, even by the owner.
+	if _, err := a.client.AuditLog.Delete().Exec(viewer("alice")); err == nil {
+		t.Fatal("alice deleted audit log entries")
+	}
+}
+
+// TestAuditLogSensitiveFields checks that the hash behind a spectator link
+// doesn't end up in the audit log, which every member can read.
+func TestAuditLogSensitiveFields(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	vars := map[string]any{"s": settlementID}
+	var shared struct{ ShareSettlement string }
+	a.run("alice", `mutation($s: ID!) { shareSettlement(settlementID: $s) }`, vars, &shared)
+	a.run("alice", `mutation($s: ID!) { unshareSettlement(settlementID: $s) }`, vars, nil)
+
+	entries := a.client.AuditLog.Query().Where(auditlog.SettlementID(settlementID)).AllX(bypass())
+	logged := 0
+	for _, entry := range entries {
+		for _, c := range entry.Changes {
+			if c.Field != "share_token_hash" {
+				continue
+			}
+			logged++
+			if c.Before != nil || c.After != nil {
+				t.Errorf("share token hash logged with values %+v", c)
+			}
+		}
+	}
+	if logged != 2 {
+		t.Errorf("share token changes logged %d times, want 2", logged)
+	}
+	res := a.post("alice", auditLog, vars)
+	if len(res.Errors) > 0 || strings.Contains(string(res.Data), secret.Hash(shared.ShareSettlement)) {
+		t.Fatalf("audit log: %+v %s", res.Errors, res.Data)
+	}
+}
+
+// TestAuditLogSoftDeletes checks that moving records to the trash and back is
+// logged as deletes and restores rather than plain updates.
+func TestAuditLogSoftDeletes(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	vars := map[string]any{"s": settlementID, "v": survivors[0], "name": "Lantern Hollow"}
+	a.run("alice", `mutation($v: ID!) { deleteSurvivor(id: $v) }`, vars, nil)
+	a.run("alice", `mutation($v: ID!) { restoreSurvivor(id: $v) { id } }`, vars, nil)
+	a.run("alice", deleteSettlement, vars, nil)
+
+	operations := func(entityType string, id int) []auditlog.Operation {
+		var ops []auditlog.Operation
+		entries := a.client.AuditLog.Query().
+			Where(auditlog.EntityType(entityType), auditlog.EntityID(id)).
+			Order(auditlog.ByID()).
+			AllX(bypass())
+		for _, entry := range entries {
+			ops = append(ops, entry.Operation)
+		}
+		return ops
+	}
+	want := []auditlog.Operation{auditlog.OperationCreate, auditlog.OperationDelete, auditlog.OperationRestore, auditlog.OperationDelete}
+	if got := operations("Survivor", survivors[0]); !slices.Equal(got, want) {
+		t.Errorf("survivor operations %v, want %v", got, want)
+	}
+	if got := operations("Settlement", settlementID); len(got) == 0 || got[len(got)-1] != auditlog.OperationDelete {
+		t.Errorf("settlement operations %v", got)
+	}
+}
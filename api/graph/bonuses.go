@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/principle"
+)
+
+// survivalBonus is what a settlement's innovations and principles add to its
+// survival limit and to the survival survivors depart with.
+type survivalBonus struct {
+	limit     int
+	departing int
+}
+
+// survivalBonuses loads the settlement's bonuses once for all the fields
+// derived from them.
+func survivalBonuses(ctx context.Context, s *ent.Settlement) (survivalBonus, error) {
+	return memoized(ctx, "survivalBonuses", s.ID, func(ctx context.Context) (survivalBonus, error) {
+		return loadSurvivalBonuses(ctx, s)
+	})
+}
+
+func loadSurvivalBonuses(ctx context.Context, s *ent.Settlement) (survivalBonus, error) {
+	var bonus survivalBonus
+	innovations, err := s.QueryInnovations().
+		Select(innovation.FieldSurvivalLimitBonus, innovation.FieldDepartingSurvivalBonus).
+		All(ctx)
+	if err != nil {
+		return bonus, err
+	}
+	for _, i := range innovations {
+		bonus.limit += i.SurvivalLimitBonus
+		bonus.departing += i.DepartingSurvivalBonus
+	}
+	principles, err := s.QueryPrinciples().
+		Select(principle.FieldSurvivalLimitBonus, principle.FieldDepartingSurvivalBonus).
+		All(ctx)
+	if err != nil {
+		return bonus, err
+	}
+	for _, p := range principles {
+		bonus.limit += p.SurvivalLimitBonus
+		bonus.departing += p.DepartingSurvivalBonus
+	}
+	return bonus, nil
+}
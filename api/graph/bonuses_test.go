@@ -0,0 +1,47 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+func TestSurvivalBonuses(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.createSettlement("alice", "Dusk")
+	vars := map[string]any{"s": settlementID}
+	a.run("alice", `mutation($s: ID!) { innovate(input: {settlementID: $s, name: "Language", survivalLimitBonus: 1}) { id } }`, vars, nil)
+	a.run("alice", `mutation($s: ID!) { choosePrinciple(input: {settlementID: $s, name: death, choice: "Graves", departingSurvivalBonus: 1}) { id } }`, vars, nil)
+
+	var out struct {
+		Settlement struct{ EffectiveSurvivalLimit, EffectiveDepartingSurvival int }
+	}
+	n := a.queries("innovations", func() {
+		a.run("alice", `query($s: ID!) { settlement(id: $s) { effectiveSurvivalLimit effectiveDepartingSurvival } }`, vars, &out)
+	})
+	if out.Settlement.EffectiveSurvivalLimit != 1 || out.Settlement.EffectiveDepartingSurvival != 1 {
+		t.Errorf("effective survival %+v", out.Settlement)
+	}
+	if n != 1 {
+		t.Errorf("innovations loaded %d times, want once", n)
+	}
+	n = a.queries("principles", func() {
+		a.run("alice", `{ settlements { effectiveSurvivalLimit effectiveDepartingSurvival } }`, nil, nil)
+	})
+	if n != 2 {
+		t.Errorf("principles loaded %d times for 2 settlements", n)
+	}
+
+	// Each mutation sees the bonuses of the ones before it.
+	var innovated struct {
+		First, Second struct {
+			Settlement struct{ EffectiveSurvivalLimit int }
+		}
+	}
+	a.run("alice", `mutation($s: ID!) {
+		first: innovate(input: {settlementID: $s, name: "Ammonia", survivalLimitBonus: 1}) { settlement { effectiveSurvivalLimit } }
+		second: innovate(input: {settlementID: $s, name: "Paint", survivalLimitBonus: 1}) { settlement { effectiveSurvivalLimit } }
+	}`, vars, &innovated)
+	if innovated.First.Settlement.EffectiveSurvivalLimit != 2 || innovated.Second.Settlement.EffectiveSurvivalLimit != 3 {
+		t.Errorf("survival limits after innovating %+v", innovated)
+	}
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+// changeFightingArts removes the fighting art removeID and grants grantID in
+// one update, so the card limit is checked against the result. Either id may
+// be 0. Secret fighting arts go through their own edge.
+func changeFightingArts(ctx context.Context, c *ent.Client, survivorID, removeID, grantID int) (*ent.Survivor, error) {
+	update := c.Survivor.UpdateOneID(survivorID)
+	if removeID != 0 {
+		held, err := c.Survivor.Query().
+			Where(survivor.ID(survivorID), survivor.Or(
+				survivor.HasFightingArtsWith(fightingart.ID(removeID)),
+				survivor.HasSecretFightingArtsWith(fightingart.ID(removeID)),
+			)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			return nil, apperr.BadUserInput("the survivor doesn't have that fighting art")
+		}
+		update.RemoveFightingArtIDs(removeID).RemoveSecretFightingArtIDs(removeID)
+	}
+	if grantID != 0 {
+		art, err := c.FightingArt.Get(ctx, grantID)
+		if err != nil {
+			return nil, err
+		}
+		held, err := c.Survivor.Query().
+			Where(survivor.ID(survivorID), survivor.Or(
+				survivor.HasFightingArtsWith(fightingart.ID(grantID)),
+				survivor.HasSecretFightingArtsWith(fightingart.ID(grantID)),
+			)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			return nil, apperr.Conflict(fmt.Sprintf("the survivor already has %s", art.Name))
+		}
+		if art.Secret {
+			update.AddSecretFightingArtIDs(grantID)
+		} else {
+			update.AddFightingArtIDs(grantID)
+		}
+	}
+	return update.Save(ctx)
+}
+
+// changeDisorders removes the disorder removeID and grants grantID in one
+// update, so the card limit is checked against the result. Either id may be 0.
+func changeDisorders(ctx context.Context, c *ent.Client, survivorID, removeID, grantID int) (*ent.Survivor, error) {
+	update := c.Survivor.UpdateOneID(survivorID)
+	if removeID != 0 {
+		held, err := c.Survivor.Query().
+			Where(survivor.ID(survivorID), survivor.HasDisordersWith(disorder.ID(removeID))).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			return nil, apperr.BadUserInput("the survivor doesn't have that disorder")
+		}
+		update.RemoveDisorderIDs(removeID)
+	}
+	if grantID != 0 {
+		d, err := c.Disorder.Get(ctx, grantID)
+		if err != nil {
+			return nil, err
+		}
+		held, err := c.Survivor.Query().
+			Where(survivor.ID(survivorID), survivor.HasDisordersWith(disorder.ID(grantID))).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if held {
+			return nil, apperr.Conflict(fmt.Sprintf("the survivor already has %s", d.Name))
+		}
+		update.AddDisorderIDs(grantID)
+	}
+	return update.Save(ctx)
+}
@@ -0,0 +1,53 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/disorder"
+	"github.com/failuretoload/datamonster/ent/fightingart"
+)
+
+// GrantFightingArt is the resolver for the grantFightingArt field.
+func (r *mutationResolver) GrantFightingArt(ctx context.Context, survivorID int, fightingArtID int) (*ent.Survivor, error) {
+	return changeFightingArts(ctx, ent.FromContext(ctx), survivorID, 0, fightingArtID)
+}
+
+// RemoveFightingArt is the resolver for the removeFightingArt field.
+func (r *mutationResolver) RemoveFightingArt(ctx context.Context, survivorID int, fightingArtID int) (*ent.Survivor, error) {
+	return changeFightingArts(ctx, ent.FromContext(ctx), survivorID, fightingArtID, 0)
+}
+
+// SwapFightingArt is the resolver for the swapFightingArt field.
+func (r *mutationResolver) SwapFightingArt(ctx context.Context, survivorID int, removeID int, grantID int) (*ent.Survivor, error) {
+	return changeFightingArts(ctx, ent.FromContext(ctx), survivorID, removeID, grantID)
+}
+
+// GrantDisorder is the resolver for the grantDisorder field.
+func (r *mutationResolver) GrantDisorder(ctx context.Context, survivorID int, disorderID int) (*ent.Survivor, error) {
+	return changeDisorders(ctx, ent.FromContext(ctx), survivorID, 0, disorderID)
+}
+
+// RemoveDisorder is the resolver for the removeDisorder field.
+func (r *mutationResolver) RemoveDisorder(ctx context.Context, survivorID int, disorderID int) (*ent.Survivor, error) {
+	return changeDisorders(ctx, ent.FromContext(ctx), survivorID, disorderID, 0)
+}
+
+// SwapDisorder is the resolver for the swapDisorder field.
+func (r *mutationResolver) SwapDisorder(ctx context.Context, survivorID int, removeID int, grantID int) (*ent.Survivor, error) {
+	return changeDisorders(ctx, ent.FromContext(ctx), survivorID, removeID, grantID)
+}
+
+// FightingArts is the resolver for the fightingArts field.
+func (r *queryResolver) FightingArts(ctx context.Context) ([]*ent.FightingArt, error) {
+	return r.client.FightingArt.Query().Order(fightingart.BySecret(), fightingart.ByName()).All(ctx)
+}
+
+// Disorders is the resolver for the disorders field.
+func (r *queryResolver) Disorders(ctx context.Context) ([]*ent.Disorder, error) {
+	return r.client.Disorder.Query().Order(disorder.ByName()).All(ctx)
+}
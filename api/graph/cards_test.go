@@ -0,0 +1,141 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+)
+
+const grantFightingArt = `mutation($v: ID!, $c: ID!) {
+	grantFightingArt(survivorID: $v, fightingArtID: $c) { fightingArts { name } secretFightingArts { name } }
+}`
+
+const swapFightingArt = `mutation($v: ID!, $r: ID!, $c: ID!) {
+	swapFightingArt(survivorID: $v, removeID: $r, grantID: $c) { fightingArts { name } secretFightingArts { name } }
+}`
+
+type card struct {
+	ID     int `json:"id,string"`
+	Name   string
+	Secret bool
+}
+
+type heldCards struct {
+	FightingArts, SecretFightingArts, Disorders []struct{ Name string }
+}
+
+// names returns the names of the held cards, sorted.
+func (h heldCards) names() (arts, secret, disorders []string) {
+	for _, c := range h.FightingArts {
+		arts = append(arts, c.Name)
+	}
+	for _, c := range h.SecretFightingArts {
+		secret = append(secret, c.Name)
+	}
+	for _, c := range h.Disorders {
+		disorders = append(disorders, c.Name)
+	}
+	slices.Sort(arts)
+	slices.Sort(secret)
+	slices.Sort(disorders)
+	return arts, secret, disorders
+}
+
+// sorted returns the names of cards, sorted to compare against what a
+// survivor holds.
+func sorted(cards ...card) []string {
+	names := make([]string, len(cards))
+	for i, c := range cards {
+		names[i] = c.Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+// cards returns the catalog's fighting arts, secret fighting arts and disorders.
+func (a *api) cards() (arts, secret, disorders []card) {
+	a.t.Helper()
+	var out struct {
+		FightingArts []card
+		Disorders    []card
+	}
+	a.run("alice", `{ fightingArts { id name secret } disorders { id name } }`, nil, &out)
+	for _, c := range out.FightingArts {
+		if c.Secret {
+			secret = append(secret, c)
+		} else {
+			arts = append(arts, c)
+		}
+	}
+	return arts, secret, out.Disorders
+}
+
+func TestFightingArts(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+	arts, secret, _ := a.cards()
+	grant := func(c card) map[string]any { return map[string]any{"v": survivors[0], "c": c.ID} }
+
+	var out struct{ GrantFightingArt heldCards }
+	a.run("alice", grantFightingArt, grant(arts[0]), nil)
+	a.reject("alice", grantFightingArt, grant(arts[0]), "CONFLICT")
+	a.run("alice", grantFightingArt, grant(secret[0]), nil)
+	a.run("alice", grantFightingArt, grant(arts[1]), &out)
+	held, heldSecret, _ := out.GrantFightingArt.names()
+	if !slices.Equal(held, sorted(arts[0], arts[1])) || !slices.Equal(heldSecret, sorted(secret[0])) {
+		t.Fatalf("fighting arts %v, secret %v", held, heldSecret)
+	}
+
+	// Secret fighting arts count towards the limit of three.
+	a.reject("alice", grantFightingArt, grant(arts[2]), "BAD_USER_INPUT")
+	a.reject("bob", grantFightingArt, grant(arts[2]), "FORBIDDEN")
+
+	// A swap works at the limit, but only for an art the survivor has.
+	var swapped struct{ SwapFightingArt heldCards }
+	vars := map[string]any{"v": survivors[0], "r": arts[0].ID, "c": secret[1].ID}
+	a.run("alice", swapFightingArt, vars, &swapped)
+	held, heldSecret, _ = swapped.SwapFightingArt.names()
+	if !slices.Equal(held, sorted(arts[1])) || !slices.Equal(heldSecret, sorted(secret[0], secret[1])) {
+		t.Fatalf("after the swap: fighting arts %v, secret %v", held, heldSecret)
+	}
+	vars["c"] = arts[3].ID
+	a.reject("alice", swapFightingArt, vars, "BAD_USER_INPUT")
+
+	var removed struct{ RemoveFightingArt heldCards }
+	a.run("alice", `mutation($v: ID!, $c: ID!) {
+		removeFightingArt(survivorID: $v, fightingArtID: $c) { fightingArts { name } secretFightingArts { name } }
+	}`, grant(secret[0]), &removed)
+	if _, heldSecret, _ = removed.RemoveFightingArt.names(); !slices.Equal(heldSecret, sorted(secret[1])) {
+		t.Fatalf("secret fighting arts after removal %v", heldSecret)
+	}
+
+	var updated struct {
+		UpdateSurvivor struct{ CannotUseFightingArts bool }
+	}
+	a.run("alice", `mutation($v: ID!) { updateSurvivor(id: $v, input: {cannotUseFightingArts: true}) { cannotUseFightingArts } }`,
+		map[string]any{"v": survivors[0]}, &updated)
+	if !updated.UpdateSurvivor.CannotUseFightingArts {
+		t.Fatal("the survivor can still use fighting arts")
+	}
+}
+
+func TestDisorders(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	_, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	_, _, disorders := a.cards()
+	grant := `mutation($v: ID!, $c: ID!) { grantDisorder(survivorID: $v, disorderID: $c) { disorders { name } } }`
+	for _, d := range disorders[:3] {
+		a.run("alice", grant, map[string]any{"v": survivors[0], "c": d.ID}, nil)
+	}
+	a.reject("alice", grant, map[string]any{"v": survivors[0], "c": disorders[3].ID}, "BAD_USER_INPUT")
+
+	var out struct{ SwapDisorder heldCards }
+	a.run("alice", `mutation($v: ID!, $r: ID!, $c: ID!) {
+		swapDisorder(survivorID: $v, removeID: $r, grantID: $c) { disorders { name } }
+	}`, map[string]any{"v": survivors[0], "r": disorders[0].ID, "c": disorders[5].ID}, &out)
+	if _, _, held := out.SwapDisorder.names(); !slices.Equal(held, sorted(disorders[1], disorders[2], disorders[5])) {
+		t.Fatalf("disorders after the swap %v", held)
+	}
+}
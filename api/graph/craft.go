@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// draw is the quantity a recipe takes from one storage line item.
+type draw struct {
+	itemID   int
+	quantity int
+}
+
+// shortfall is a recipe cost storage can't pay in full.
+type shortfall struct {
+	Name      string `json:"name,omitempty"`
+	Keyword   string `json:"keyword,omitempty"`
+	Required  int    `json:"required"`
+	Available int    `json:"available"`
+}
+
+// craftGear draws the recipe's costs from the settlement's storage and stocks
+// the crafted gear. It runs in the mutation's transaction, so a draw that
+// fails leaves storage untouched.
+func craftGear(ctx context.Context, c *ent.Client, settlementID, recipeID int) (*ent.StorageItem, error) {
+	r, err := c.Recipe.Get(ctx, recipeID)
+	if err != nil {
+		return nil, err
+	}
+	built, err := c.Settlement.Query().
+		Where(settlement.ID(settlementID), settlement.HasLocationsWith(location.ID(r.LocationID))).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !built {
+		l, err := c.Location.Get(ctx, r.LocationID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, apperr.BadUserInput(fmt.Sprintf("%s can only be crafted once the %s is built", r.Name, l.Name))
+	}
+	resources, err := c.StorageItem.Query().
+		Where(storageitem.SettlementID(settlementID), storageitem.CategoryNEQ(storageitem.CategoryGear)).
+		Order(storageitem.ByCategory(), storageitem.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	draws, shortfalls := allocateCosts(r.Costs, resources)
+	if len(shortfalls) > 0 {
+		return nil, resourceShortfall(r.Name, shortfalls)
+	}
+	for _, d := range draws {
+		if _, err := drawFromStorage(ctx, c, d.itemID, d.quantity); err != nil {
+			return nil, err
+		}
+	}
+	return addToStorage(ctx, c, model.AddToStorageInput{
+		SettlementID: settlementID,
+		Name:         r.Name,
+		Category:     storageitem.CategoryGear,
+		Keywords:     r.Keywords,
+		Quantity:     1,
+	})
+}
+
+// allocateCosts picks the resources that pay for costs. Costs naming a
+// resource are paid first so keyword costs can't use up a resource a named
+// cost needs. Keyword costs spend resources in storage order. Costs that
+// can't be paid in full are returned as shortfalls.
+func allocateCosts(costs []schematype.ResourceCost, resources []*ent.StorageItem) ([]draw, []shortfall) {
+	ordered := slices.Clone(costs)
+	slices.SortStableFunc(ordered, func(a, b schematype.ResourceCost) int {
+		switch {
+		case a.Name != "" && b.Name == "":
+			return -1
+		case a.Name == "" && b.Name != "":
+			return 1
+		}
+		return 0
+	})
+	left := make(map[int]int, len(resources))
+	for _, item := range resources {
+		left[item.ID] = item.Quantity
+	}
+	drawn := map[int]int{}
+	var shortfalls []shortfall
+	for _, cost := range ordered {
+		need := cost.Quantity
+		for _, item := range resources {
+			if need == 0 {
+				break
+			}
+			if !pays(cost, item) || left[item.ID] == 0 {
+				continue
+			}
+			take := min(need, left[item.ID])
+			left[item.ID] -= take
+			drawn[item.ID] += take
+			need -= take
+		}
+		if need > 0 {
+			shortfalls = append(shortfalls, shortfall{
+				Name:      cost.Name,
+				Keyword:   cost.Keyword,
+				Required:  cost.Quantity,
+				Available: cost.Quantity - need,
+			})
+		}
+	}
+	var draws []draw
+	for _, item := range resources {
+		if quantity := drawn[item.ID]; quantity > 0 {
+			draws = append(draws, draw{itemID: item.ID, quantity: quantity})
+		}
+	}
+	return draws, shortfalls
+}
+
+// pays reports whether item can pay for cost.
+func pays(cost schematype.ResourceCost, item *ent.StorageItem) bool {
+	if cost.Name != "" {
+		return strings.EqualFold(cost.Name, item.Name)
+	}
+	return slices.ContainsFunc(item.Keywords, func(keyword string) bool {
+		return strings.EqualFold(keyword, cost.Keyword)
+	})
+}
+
+// resourceShortfall reports every resource storage is missing to craft gear.
+func resourceShortfall(gear string, shortfalls []shortfall) error {
+	missing := make([]string, len(shortfalls))
+	for i, s := range shortfalls {
+		resource := s.Name
+		if resource == "" {
+			resource = s.Keyword
+		}
+		missing[i] = fmt.Sprintf("%d %s", s.Required-s.Available, resource)
+	}
+	err := apperr.InsufficientStock(fmt.Sprintf("crafting %s needs %s more", gear, strings.Join(missing, ", ")))
+	err.Extensions = map[string]any{"shortfalls": shortfalls}
+	return err
+}
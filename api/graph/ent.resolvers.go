@@ -12,17 +12,32 @@ import (
 
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id int) (ent.Noder, error) {
+	if _, err := ownerFromContext(ctx); err != nil {
+		return nil, err
+	}
 	return r.client.Noder(ctx, id)
 }
 
 // Nodes is the resolver for the nodes field.
 func (r *queryResolver) Nodes(ctx context.Context, ids []int) ([]ent.Noder, error) {
+	if _, err := ownerFromContext(ctx); err != nil {
+		return nil, err
+	}
 	return r.client.Noders(ctx, ids)
 }
 
+// MonsterProgress returns MonsterProgressResolver implementation.
+func (r *Resolver) MonsterProgress() MonsterProgressResolver { return &monsterProgressResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Settlement returns SettlementResolver implementation.
+func (r *Resolver) Settlement() SettlementResolver { return &settlementResolver{r} }
+
+// Survivor returns SurvivorResolver implementation.
+func (r *Resolver) Survivor() SurvivorResolver { return &survivorResolver{r} }
+
 // CreateSettlementInput returns CreateSettlementInputResolver implementation.
 func (r *Resolver) CreateSettlementInput() CreateSettlementInputResolver {
 	return &createSettlementInputResolver{r}
@@ -33,6 +48,9 @@ func (r *Resolver) UpdateSettlementInput() UpdateSettlementInputResolver {
 	return &updateSettlementInputResolver{r}
 }
 
+type monsterProgressResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type settlementResolver struct{ *Resolver }
+type survivorResolver struct{ *Resolver }
 type createSettlementInputResolver struct{ *Resolver }
 type updateSettlementInputResolver struct{ *Resolver }
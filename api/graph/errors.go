@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter tags errors from the apperr taxonomy, including privacy
+// denials and ent's validation errors, with a stable extensions code so
+// clients can tell them apart from other failures. Invalid fields also report
+// the path of the input that was rejected, so forms can highlight it.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	appErr, ok := apperr.From(err)
+	if !ok {
+		return gqlErr
+	}
+	coded := appErr.GQLError()
+	coded.Path = gqlErr.Path
+	coded.Locations = gqlErr.Locations
+	if appErr.Code == apperr.CodeInvalidField {
+		if path := inputPath(ctx, appErr); path != nil {
+			coded.Extensions["path"] = path
+		}
+	}
+	return coded
+}
+
+// inputPath finds the argument of the current field that set the invalid
+// field, such as [input createSurvivors 1 born]. When the limit is known it
+// picks the value that broke it, otherwise the first input with the name.
+func inputPath(ctx context.Context, e *apperr.Error) []any {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return nil
+	}
+	field, _ := e.Extensions["field"].(string)
+	rule, _ := e.Extensions["rule"].(schematype.Rule)
+	limit, hasLimit := e.Extensions["limit"].(int)
+	match := func(value any) bool {
+		return !hasLimit || violates(value, rule, limit)
+	}
+	vars := graphql.GetOperationContext(ctx).Variables
+	for _, arg := range fc.Field.Arguments {
+		value, err := arg.Value.Value(vars)
+		if err != nil {
+			continue
+		}
+		if path := findInput(value, inputName(field), match); path != nil {
+			return append([]any{arg.Name}, path...)
+		}
+	}
+	return nil
+}
+
+func findInput(value any, name string, match func(any) bool) []any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if inputName(key) == name && match(child) {
+				return []any{key}
+			}
+		}
+		for key, child := range v {
+			if path := findInput(child, name, match); path != nil {
+				return append([]any{key}, path...)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if path := findInput(child, name, match); path != nil {
+				return append([]any{i}, path...)
+			}
+		}
+	}
+	return nil
+}
+
+// inputName normalizes field names, entgql renames survivalLimit to
+// survivallimit and status_change_year to statusChangeYear.
+func inputName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func violates(value any, rule schematype.Rule, limit int) bool {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return err == nil && violates(n, rule, limit)
+	case int64:
+		return (rule == schematype.RuleMin && v < int64(limit)) || (rule == schematype.RuleMax && v > int64(limit))
+	case string:
+		return (rule == schematype.RuleMinLength && len(v) < limit) || (rule == schematype.RuleMaxLength && len(v) > limit)
+	}
+	return false
+}
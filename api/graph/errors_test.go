@@ -0,0 +1,56 @@
+package graph_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuthStatus(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+
+	res := a.reject("", `{ settlements { id } }`, nil, "UNAUTHENTICATED")
+	if res.Status != http.StatusUnauthorized {
+		t.Errorf("anonymous query: status %d, want 401", res.Status)
+	}
+	res = a.reject("bob", `mutation($s: ID!) { updateSettlement(id: $s, input: {name: "Taken"}) { id } }`, map[string]any{"s": settlementID}, "FORBIDDEN")
+	if res.Status != http.StatusForbidden {
+		t.Errorf("foreign mutation: status %d, want 403", res.Status)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"{ settlements { id } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer nobody")
+	rec := httptest.NewRecorder()
+	a.h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized || !strings.Contains(rec.Body.String(), `"UNAUTHENTICATED"`) {
+		t.Errorf("invalid token: %d %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("invalid token: content type %q", ct)
+	}
+}
+
+// TestAnonymousStatus checks that callers without credentials get a 401 for
+// anything but the public fields, however the fields are selected.
+func TestAnonymousStatus(t *testing.T) {
+	a := newAPI(t)
+	a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	for _, query := range []string{
+		`{ publicSettlement(token: "dms_unknown") { name } settlements { name } }`,
+		`query { ...Settlements } fragment Settlements on Query { settlements { name } }`,
+		`{ ... on Query { survivors { name } } }`,
+		`mutation { createSettlement(input: {owner: "alice", name: "Dusk"}) { id } }`,
+	} {
+		res := a.reject("", query, nil, "UNAUTHENTICATED")
+		if res.Status != http.StatusUnauthorized || strings.Contains(string(res.Data), "Lantern Hollow") {
+			t.Errorf("%s: status %d, data %s", query, res.Status, res.Data)
+		}
+	}
+	res := a.reject("", `{ __typename publicSettlement(token: "dms_unknown") { name } }`, nil, "NOT_FOUND")
+	if res.Status == http.StatusUnauthorized {
+		t.Error("public field: status 401")
+	}
+}
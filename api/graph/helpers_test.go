@@ -0,0 +1,223 @@
+package graph_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/auth"
+	"github.com/failuretoload/datamonster/catalog"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/enttest"
+	"github.com/failuretoload/datamonster/ent/migrate"
+	_ "github.com/failuretoload/datamonster/ent/runtime"
+	"github.com/failuretoload/datamonster/graph"
+	"github.com/failuretoload/datamonster/rule"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var databases atomic.Int64
+
+// api serves the GraphQL schema over an in-memory database. The static tokens
+// "alice", "bob" and "carol" sign in as the users of the same name.
+type api struct {
+	t      *testing.T
+	client *ent.Client
+	srv    *handler.Server
+	h      http.Handler
+
+	mu         sync.Mutex
+	recording  bool
+	statements []string
+}
+
+func newAPI(t *testing.T) *api {
+	t.Helper()
+	a := &api{t: t}
+	dsn := fmt.Sprintf("file:graph%d?mode=memory&cache=shared&_fk=1", databases.Add(1))
+	drv, err := sql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.client = enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(dialect.DebugWithContext(drv, a.record))),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	t.Cleanup(func() { a.client.Close() })
+	a.srv = handler.NewDefaultServer(graph.NewSchema(a.client))
+	a.srv.Use(entgql.Transactioner{TxOpener: a.client})
+	a.srv.AroundRootFields(graph.Memoize)
+	a.srv.SetErrorPresenter(graph.ErrorPresenter)
+	a.srv.AroundResponses(apperr.AroundResponses)
+	a.srv.AroundOperations(auth.AllowAnonymous(graph.PublicFields...))
+	a.srv.AroundOperations(auth.EnforceScopes)
+	users := auth.NewStatic(map[string]string{"alice": "alice", "bob": "bob", "carol": "carol"})
+	authenticator := auth.NewAccessTokens(a.client, users)
+	a.h = apperr.Status(auth.Middleware(authenticator)(a.srv))
+	return a
+}
+
+func (a *api) record(_ context.Context, v ...any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.recording {
+		a.statements = append(a.statements, fmt.Sprint(v...))
+	}
+}
+
+// recorded runs f and returns the SQL statements it ran.
+func (a *api) recorded(f func()) []string {
+	a.t.Helper()
+	a.mu.Lock()
+	a.recording, a.statements = true, nil
+	a.mu.Unlock()
+	f()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.recording = false
+	return a.statements
+}
+
+// queries runs f and returns how many of the SQL statements it ran read from table.
+func (a *api) queries(table string, f func()) int {
+	a.t.Helper()
+	n := 0
+	for _, s := range a.recorded(f) {
+		if strings.Contains(s, "FROM `"+table+"`") {
+			n++
+		}
+	}
+	return n
+}
+
+type gqlError struct {
+	Message    string         `json:"message"`
+	Extensions map[string]any `json:"extensions"`
+}
+
+type gqlResponse struct {
+	Status int             `json:"-"`
+	Data   json.RawMessage `json:"data"`
+	Errors []gqlError      `json:"errors"`
+}
+
+// post sends a GraphQL request as token, or anonymously when token is empty.
+func (a *api) post(token, query string, vars map[string]any) gqlResponse {
+	a.t.Helper()
+	body, err := json.Marshal(map[string]any{"query": query, "variables": vars})
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	a.h.ServeHTTP(rec, req)
+	res := gqlResponse{Status: rec.Code}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		a.t.Fatalf("decoding %q: %v", rec.Body.String(), err)
+	}
+	return res
+}
+
+// run sends a request that must succeed and decodes its data into out.
+func (a *api) run(token, query string, vars map[string]any, out any) {
+	a.t.Helper()
+	res := a.post(token, query, vars)
+	if len(res.Errors) > 0 {
+		a.t.Fatalf("%s: unexpected errors %+v", firstLine(query), res.Errors)
+	}
+	if out != nil {
+		if err := json.Unmarshal(res.Data, out); err != nil {
+			a.t.Fatal(err)
+		}
+	}
+}
+
+// reject sends a request that must fail with code.
+func (a *api) reject(token, query string, vars map[string]any, code string) gqlResponse {
+	a.t.Helper()
+	res := a.post(token, query, vars)
+	if len(res.Errors) == 0 {
+		a.t.Fatalf("%s: expected %s, got %s", firstLine(query), code, res.Data)
+	}
+	if got := res.Errors[0].Extensions["code"]; got != code {
+		a.t.Fatalf("%s: expected %s, got %v: %s", firstLine(query), code, got, res.Errors[0].Message)
+	}
+	return res
+}
+
+// createSettlement creates a settlement owned by token with the named
+// survivors and returns the ids of both.
+func (a *api) createSettlement(token, name string, survivors ...string) (int, []int) {
+	a.t.Helper()
+	input := make([]map[string]any, len(survivors))
+	for i, s := range survivors {
+		input[i] = map[string]any{"name": s}
+	}
+	var out struct {
+		CreateSettlement struct {
+			ID         int `json:"id,string"`
+			Population []struct {
+				ID int `json:"id,string"`
+			}
+		}
+	}
+	a.run(token, `mutation($name: String!, $owner: String!, $survivors: [CreateSurvivorInput!]) {
+		createSettlement(input: {name: $name, owner: $owner, createSurvivors: $survivors}) { id population { id } }
+	}`, map[string]any{"name": name, "owner": token, "survivors": input}, &out)
+	ids := make([]int, len(out.CreateSettlement.Population))
+	for i, s := range out.CreateSettlement.Population {
+		ids[i] = s.ID
+	}
+	return out.CreateSettlement.ID, ids
+}
+
+// addCollaborator gives user a role in the settlement, on behalf of its owner.
+func (a *api) addCollaborator(owner string, settlementID int, user, role string) {
+	a.t.Helper()
+	a.run(owner, `mutation($s: ID!, $u: String!, $r: MembershipRole!) {
+		addCollaborator(settlementID: $s, userID: $u, role: $r) { id }
+	}`, map[string]any{"s": settlementID, "u": user, "r": role}, nil)
+}
+
+// seedCatalog writes the game's reference data, as the server does at startup.
+func (a *api) seedCatalog() {
+	a.t.Helper()
+	if err := catalog.Seed(context.Background(), a.client); err != nil {
+		a.t.Fatal(err)
+	}
+}
+
+// viewer is a context signed in as userID, for using the ent client directly.
+func viewer(userID string) context.Context {
+	return context.WithValue(context.Background(), config.UserIDKey, userID)
+}
+
+// bypass is a context that skips the privacy rules, for checking the database
+// behind the API's back.
+func bypass() context.Context {
+	return rule.Bypass(context.Background())
+}
+
+func firstLine(query string) string {
+	query = strings.TrimSpace(query)
+	if i := strings.IndexByte(query, '\n'); i >= 0 {
+		return query[:i]
+	}
+	return query
+}
@@ -0,0 +1,57 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/principle"
+)
+
+// Innovate is the resolver for the innovate field.
+func (r *mutationResolver) Innovate(ctx context.Context, input ent.CreateInnovationInput) (*ent.Innovation, error) {
+	return ent.FromContext(ctx).Innovation.Create().SetInput(input).Save(ctx)
+}
+
+// RemoveInnovation is the resolver for the removeInnovation field.
+func (r *mutationResolver) RemoveInnovation(ctx context.Context, id int) (*bool, error) {
+	return nil, ent.FromContext(ctx).Innovation.DeleteOneID(id).Exec(ctx)
+}
+
+// ChoosePrinciple is the resolver for the choosePrinciple field.
+func (r *mutationResolver) ChoosePrinciple(ctx context.Context, input ent.CreatePrincipleInput) (*ent.Principle, error) {
+	c := ent.FromContext(ctx)
+	chosen, err := c.Principle.Query().
+		Where(principle.SettlementID(input.SettlementID), principle.NameEQ(input.Name)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if chosen {
+		return nil, apperr.Conflict(fmt.Sprintf("the %s principle has already been chosen", input.Name))
+	}
+	return c.Principle.Create().SetInput(input).Save(ctx)
+}
+
+// EffectiveSurvivalLimit is the resolver for the effectiveSurvivalLimit field.
+func (r *settlementResolver) EffectiveSurvivalLimit(ctx context.Context, obj *ent.Settlement) (int, error) {
+	bonus, err := survivalBonuses(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	return obj.SurvivalLimit + bonus.limit, nil
+}
+
+// EffectiveDepartingSurvival is the resolver for the effectiveDepartingSurvival field.
+func (r *settlementResolver) EffectiveDepartingSurvival(ctx context.Context, obj *ent.Settlement) (int, error) {
+	bonus, err := survivalBonuses(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	return obj.DepartingSurvival + bonus.departing, nil
+}
@@ -0,0 +1,61 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const createInvite = `mutation($s: ID!, $role: MembershipRole!, $maxUses: Int) {
+	createInvite(settlementID: $s, role: $role, maxUses: $maxUses) { code invite { id } }
+}`
+
+const joinSettlement = `mutation($code: String!) { joinSettlement(code: $code) { id members { userID role } } }`
+
+type invitePayload struct {
+	CreateInvite struct {
+		Code   string
+		Invite struct {
+			ID int `json:"id,string"`
+		}
+	}
+}
+
+func TestInvites(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	vars := map[string]any{"s": settlementID, "role": "editor", "maxUses": 1}
+
+	a.reject("bob", createInvite, vars, "FORBIDDEN")
+	var inv invitePayload
+	a.run("alice", createInvite, vars, &inv)
+
+	var joined struct {
+		JoinSettlement struct {
+			ID      int `json:"id,string"`
+			Members []struct{ UserID, Role string }
+		}
+	}
+	a.run("bob", joinSettlement, map[string]any{"code": inv.CreateInvite.Code}, &joined)
+	if joined.JoinSettlement.ID != settlementID || len(joined.JoinSettlement.Members) != 2 {
+		t.Fatalf("bob joined %+v", joined.JoinSettlement)
+	}
+	a.reject("carol", joinSettlement, map[string]any{"code": inv.CreateInvite.Code}, "BAD_USER_INPUT")
+	a.reject("carol", joinSettlement, map[string]any{"code": "dmi_unknown"}, "BAD_USER_INPUT")
+
+	var invites struct {
+		Invites []struct{ Uses int }
+	}
+	// Invites are only listed to their owner.
+	a.run("bob", `query($s: ID!) { invites(settlementID: $s) { uses } }`, vars, &invites)
+	if len(invites.Invites) != 0 {
+		t.Fatalf("bob sees %d invites", len(invites.Invites))
+	}
+
+	a.run("alice", createInvite, map[string]any{"s": settlementID, "role": "viewer"}, &inv)
+	a.run("alice", `mutation($id: ID!) { revokeInvite(id: $id) { id } }`, map[string]any{"id": inv.CreateInvite.Invite.ID}, nil)
+	a.reject("carol", joinSettlement, map[string]any{"code": inv.CreateInvite.Code}, "BAD_USER_INPUT")
+
+	a.run("alice", `query($s: ID!) { invites(settlementID: $s) { uses } }`, vars, &invites)
+	if len(invites.Invites) != 2 {
+		t.Fatalf("alice sees %d invites, want 2", len(invites.Invites))
+	}
+}
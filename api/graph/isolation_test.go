@@ -0,0 +1,82 @@
+package graph_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestTenantIsolation walks every query that reaches settlement data and checks
+// that a user outside the settlement gets none of it back.
+func TestTenantIsolation(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary", "Lucie")
+	a.run("alice", `mutation($s: ID!) {
+		planTimelineEvent(input: {settlementID: $s, year: 1, type: story_event, name: "Returning Survivors"}) { id }
+		addToStorage(input: {settlementID: $s, name: "Broken Lantern", category: basic_resource, quantity: 2}) { id }
+	}`, map[string]any{"s": settlementID}, nil)
+	a.run("alice", `mutation($v: ID!) { deleteSurvivor(id: $v) }`, map[string]any{"v": survivors[1]}, nil)
+
+	var own struct {
+		Survivors []struct{ ID string }
+	}
+	a.run("alice", `{ survivors { id } }`, nil, &own)
+	if len(own.Survivors) != 1 {
+		t.Fatalf("alice sees %d survivors, want 1", len(own.Survivors))
+	}
+
+	vars := map[string]any{"s": settlementID, "v": survivors[0], "ids": []int{settlementID, survivors[0]}}
+	secrets := []string{"Lantern Hollow", "Zachary", "Lucie", "Returning Survivors", "Broken Lantern", strconv.Itoa(survivors[0])}
+	for _, query := range []string{
+		`{ settlements { id name } }`,
+		`query($s: ID!) { settlement(id: $s) { id name } }`,
+		`{ survivors { id name } }`,
+		`query($s: ID!) { survivors(filter: {settlementID: $s}) { id name } }`,
+		`query($v: ID!) { node(id: $v) { id ... on Survivor { name } } }`,
+		`query($s: ID!) { node(id: $s) { id ... on Settlement { name population { name } } } }`,
+		`query($ids: [ID!]!) { nodes(ids: $ids) { id ... on Survivor { name } ... on Settlement { name } } }`,
+		`query($s: ID!) { invites(settlementID: $s) { id } }`,
+		`query($s: ID!) { auditLog(settlementID: $s) { edges { node { entityID changes { after } } } } }`,
+		`query($s: ID!) { timeline(settlementID: $s) { name } }`,
+		`query($s: ID!) { storage(settlementID: $s) { name } }`,
+		`query($s: ID!) { trash(settlementID: $s) { settlement { name } survivors { name } } }`,
+		`query($s: ID!) { availableHunts(settlementID: $s) { monster { name } } }`,
+	} {
+		for _, user := range []string{"bob", ""} {
+			res := a.post(user, query, vars)
+			for _, secret := range secrets {
+				if strings.Contains(string(res.Data), secret) {
+					t.Errorf("%q as %q leaked %q: %s", query, user, secret, res.Data)
+				}
+			}
+		}
+	}
+}
+
+// TestForeignMutations checks that a user outside the settlement can't change
+// it or its survivors, nor move its survivors into their own settlement.
+func TestForeignMutations(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+	own, _ := a.createSettlement("bob", "Bob's Rest")
+	vars := map[string]any{"s": settlementID, "v": survivors[0], "own": own}
+	for _, query := range []string{
+		`mutation($s: ID!) { updateSettlement(id: $s, input: {name: "Taken"}) { id } }`,
+		`mutation($v: ID!) { updateSurvivor(id: $v, input: {name: "Taken"}) { id } }`,
+		`mutation($v: ID!) { deleteSurvivor(id: $v) }`,
+		`mutation($s: ID!) { createSurvivor(input: {name: "Stowaway", settlementID: $s}) { id } }`,
+		`mutation($own: ID!, $v: ID!) { updateSettlement(id: $own, input: {addPopulationIDs: [$v]}) { id } }`,
+	} {
+		a.reject("bob", query, vars, "FORBIDDEN")
+	}
+	var out struct {
+		Settlement struct {
+			Name       string
+			Population []struct{ Name string }
+		}
+	}
+	a.run("alice", `query($s: ID!) { settlement(id: $s) { name population { name } } }`, vars, &out)
+	if out.Settlement.Name != "Lantern Hollow" || len(out.Settlement.Population) != 1 || out.Settlement.Population[0].Name != "Zachary" {
+		t.Fatalf("settlement changed: %+v", out.Settlement)
+	}
+}
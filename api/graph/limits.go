@@ -0,0 +1,190 @@
+package graph
+
+import (
+	"context"
+	"log"
+	"math"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/ability"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	errQueryTooDeep    = "QUERY_TOO_DEEP"
+	errQueryTooComplex = "QUERY_TOO_COMPLEX"
+
+	// listSize is the number of elements assumed for lists that aren't paginated.
+	listSize = 20
+)
+
+// Limits rejects operations that nest deeper than MaxDepth or cost more than
+// MaxComplexity before any resolver runs, and logs the cost of every
+// operation. A zero limit disables the check.
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &Limits{}
+
+func (l *Limits) ExtensionName() string {
+	return "Limits"
+}
+
+func (l *Limits) Validate(schema graphql.ExecutableSchema) error {
+	l.es = schema
+	return nil
+}
+
+func (l *Limits) MutateOperationContext(ctx context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	op := oc.Doc.Operations.ForName(oc.OperationName)
+	if op == nil {
+		// Let the executor report the missing operation.
+		return nil
+	}
+	depth := selectionDepth(op.SelectionSet)
+	cost := complexity.Calculate(l.es, op, oc.Variables)
+	log.Printf("[%s] graphql %s %q depth %d cost %d", middleware.GetReqID(ctx), op.Operation, op.Name, depth, cost)
+
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, l.MaxDepth)
+		errcode.Set(err, errQueryTooDeep)
+		err.Extensions["depth"] = depth
+		err.Extensions["limit"] = l.MaxDepth
+		return err
+	}
+	if l.MaxComplexity > 0 && cost > l.MaxComplexity {
+		err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", cost, l.MaxComplexity)
+		errcode.Set(err, errQueryTooComplex)
+		err.Extensions["complexity"] = cost
+		err.Extensions["limit"] = l.MaxComplexity
+		return err
+	}
+	return nil
+}
+
+// selectionDepth is the number of nested fields below the selection set.
+// Introspection fields are left out, the introspection query nests deeply
+// but only reads the schema.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range set {
+		var d int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				d = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
+
+// complexityRoot prices the fields that return lists by the number of
+// elements they may return, so nested lists multiply instead of add.
+func complexityRoot() ComplexityRoot {
+	var c ComplexityRoot
+	c.Query.Settlements = listCost
+	c.Query.Survivors = func(childComplexity int, _ *ent.SurvivorWhereInput, _ *ent.SurvivorOrder) int {
+		return listCost(childComplexity)
+	}
+	c.Query.Nodes = func(childComplexity int, ids []int) int {
+		return multiply(len(ids), childComplexity)
+	}
+	c.Query.Invites = func(childComplexity int, _ int) int {
+		return listCost(childComplexity)
+	}
+	c.Query.AccessTokens = listCost
+	c.Query.AuditLog = func(childComplexity int, _ int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int) int {
+		return pageCost(childComplexity, first, last)
+	}
+	c.Query.Timeline = func(childComplexity int, _ int, _ *int, _ *int, _ *ent.TimelineEventWhereInput) int {
+		return listCost(childComplexity)
+	}
+	c.Query.Storage = func(childComplexity int, _ int, _ *storageitem.Category, _ *string, _ *ent.StorageItemWhereInput) int {
+		return listCost(childComplexity)
+	}
+	c.Query.AvailableHunts = func(childComplexity int, _ int) int {
+		return listCost(childComplexity)
+	}
+	c.Query.Abilities = func(childComplexity int, _ *ability.Kind) int {
+		return listCost(childComplexity)
+	}
+	c.Query.Locations = listCost
+	c.Query.Monsters = listCost
+	c.Query.FightingArts = listCost
+	c.Query.Disorders = listCost
+
+	c.Settlement.Population = listCost
+	c.Settlement.Members = listCost
+	c.Settlement.Timeline = listCost
+	c.Settlement.Storage = listCost
+	c.Settlement.Innovations = listCost
+	c.Settlement.Principles = listCost
+	c.Settlement.Milestones = listCost
+	c.Settlement.Locations = listCost
+	c.Settlement.Monsters = listCost
+	c.Settlement.DeathsByYear = listCost
+	c.Survivor.Abilities = listCost
+	c.Survivor.FightingArts = listCost
+	c.Survivor.SecretFightingArts = listCost
+	c.Survivor.Disorders = listCost
+	c.PublicSettlement.Population = listCost
+	c.PublicSettlement.Timeline = listCost
+	c.Trash.Survivors = listCost
+
+	c.Location.Recipes = listCost
+	c.Recipe.Costs = listCost
+	c.Ability.Modifiers = listCost
+	c.MonsterProgress.Defeats = listCost
+	c.AuditLog.Changes = listCost
+	return c
+}
+
+func listCost(childComplexity int) int {
+	return multiply(listSize, childComplexity)
+}
+
+// pageCost prices a connection by the page size the caller asked for.
+func pageCost(childComplexity int, first, last *int) int {
+	switch {
+	case first != nil:
+		return multiply(*first, childComplexity)
+	case last != nil:
+		return multiply(*last, childComplexity)
+	default:
+		return listCost(childComplexity)
+	}
+}
+
+// multiply saturates instead of overflowing, so a huge page size can't wrap
+// the cost around to a small number.
+func multiply(n, childComplexity int) int {
+	n = max(n, 1)
+	if childComplexity > 0 && n > math.MaxInt/childComplexity {
+		return math.MaxInt
+	}
+	return 1 + n*childComplexity
+}
@@ -0,0 +1,76 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/failuretoload/datamonster/graph"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestQueryLimits(t *testing.T) {
+	a := newAPI(t)
+	a.srv.Use(&graph.Limits{MaxDepth: 4, MaxComplexity: 500})
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow", "Zachary")
+
+	a.run("alice", `{ settlements { id population { id } } }`, nil, nil)
+	a.reject("alice", `{ settlements { population { settlement { population { id } } } } }`, nil, "QUERY_TOO_DEEP")
+	// 20 settlements of 20 survivors with 2 fields each.
+	res := a.reject("alice", `{ settlements { population { id name } } }`, nil, "QUERY_TOO_COMPLEX")
+	if got := res.Errors[0].Extensions["complexity"]; got != float64(1+20*(1+20*2)) {
+		t.Errorf("complexity %v", got)
+	}
+	a.reject("alice", `query($s: ID!) { auditLog(settlementID: $s, first: 1000000000000) { edges { node { id } } } }`,
+		map[string]any{"s": settlementID}, "QUERY_TOO_COMPLEX")
+	a.run("alice", `query($s: ID!) { auditLog(settlementID: $s, first: 5) { edges { node { id } } } }`,
+		map[string]any{"s": settlementID}, nil)
+	// Introspection reads the schema only and isn't held to the depth limit.
+	a.run("alice", `{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`, nil, nil)
+}
+
+// TestListCosts checks that every field returning a list of objects is priced
+// by the number of elements it may return, so new list fields can't slip past
+// the complexity limit at the cost of a single field.
+func TestListCosts(t *testing.T) {
+	es := graph.NewSchema(nil)
+	schema := es.Schema()
+	for _, typ := range schema.Types {
+		if typ.Kind != ast.Object || strings.HasPrefix(typ.Name, "__") || strings.HasSuffix(typ.Name, "Connection") {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if field.Type.Elem == nil || !schema.Types[field.Type.Name()].IsCompositeType() {
+				continue
+			}
+			args := map[string]any{}
+			for _, arg := range field.Arguments {
+				if arg.Type.NonNull {
+					args[arg.Name] = exampleValue(schema, arg.Type)
+				}
+			}
+			cost, ok := es.Complexity(typ.Name, field.Name, 1, args)
+			if !ok || cost <= 1 {
+				t.Errorf("%s.%s costs %d like a single object", typ.Name, field.Name, cost)
+			}
+		}
+	}
+}
+
+func exampleValue(schema *ast.Schema, typ *ast.Type) any {
+	if typ.Elem != nil {
+		return []any{}
+	}
+	switch def := schema.Types[typ.Name()]; def.Kind {
+	case ast.Enum:
+		return def.EnumValues[0].Name
+	case ast.InputObject:
+		return map[string]any{}
+	}
+	switch typ.Name() {
+	case "String":
+		return "x"
+	case "Boolean":
+		return true
+	}
+	return 1
+}
@@ -0,0 +1,61 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/catalog"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/location"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+)
+
+// BuildLocation is the resolver for the buildLocation field.
+func (r *mutationResolver) BuildLocation(ctx context.Context, settlementID int, locationID int) (*ent.Settlement, error) {
+	return ent.FromContext(ctx).Settlement.UpdateOneID(settlementID).AddLocationIDs(locationID).Save(ctx)
+}
+
+// DemolishLocation is the resolver for the demolishLocation field.
+func (r *mutationResolver) DemolishLocation(ctx context.Context, settlementID int, locationID int) (*ent.Settlement, error) {
+	c := ent.FromContext(ctx)
+	// Seeding rebuilds the Lantern Hoard of any settlement without one, so a
+	// demolished hoard would come back on the next start.
+	hoard, err := c.Location.Query().
+		Where(location.ID(locationID), location.Name(catalog.LanternHoard)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hoard {
+		return nil, errLanternHoard
+	}
+	return c.Settlement.UpdateOneID(settlementID).RemoveLocationIDs(locationID).Save(ctx)
+}
+
+// CraftGear is the resolver for the craftGear field.
+func (r *mutationResolver) CraftGear(ctx context.Context, settlementID int, recipeID int) (*ent.StorageItem, error) {
+	return craftGear(ctx, ent.FromContext(ctx), settlementID, recipeID)
+}
+
+// Locations is the resolver for the locations field.
+func (r *queryResolver) Locations(ctx context.Context) ([]*ent.Location, error) {
+	return r.client.Location.Query().Order(location.ByName()).All(ctx)
+}
+
+// Name is the resolver for the name field.
+func (r *resourceCostResolver) Name(ctx context.Context, obj *schematype.ResourceCost) (*string, error) {
+	return nonEmpty(obj.Name), nil
+}
+
+// Keyword is the resolver for the keyword field.
+func (r *resourceCostResolver) Keyword(ctx context.Context, obj *schematype.ResourceCost) (*string, error) {
+	return nonEmpty(obj.Keyword), nil
+}
+
+// ResourceCost returns ResourceCostResolver implementation.
+func (r *Resolver) ResourceCost() ResourceCostResolver { return &resourceCostResolver{r} }
+
+type resourceCostResolver struct{ *Resolver }
@@ -0,0 +1,111 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const settlementLocations = `query($s: ID!) { settlement(id: $s) { locations { name } } }`
+
+type builtLocations struct {
+	Settlement struct {
+		Locations []struct{ Name string }
+	}
+}
+
+func TestLanternHoard(t *testing.T) {
+	a := newAPI(t)
+	// Settlements founded before the catalog is seeded get their Lantern Hoard with it.
+	older, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.seedCatalog()
+	a.seedCatalog()
+	newer, _ := a.createSettlement("alice", "Dusk")
+
+	for _, id := range []int{older, newer} {
+		var built builtLocations
+		a.run("alice", settlementLocations, map[string]any{"s": id}, &built)
+		if l := built.Settlement.Locations; len(l) != 1 || l[0].Name != "Lantern Hoard" {
+			t.Errorf("settlement %d has %+v", id, l)
+		}
+	}
+
+	// The next seeding would only build it again.
+	var catalog struct {
+		Locations []struct {
+			ID   int `json:"id,string"`
+			Name string
+		}
+	}
+	a.run("alice", `{ locations { id name } }`, nil, &catalog)
+	vars := map[string]any{"s": newer}
+	for _, l := range catalog.Locations {
+		if l.Name == "Lantern Hoard" {
+			vars["l"] = l.ID
+		}
+	}
+	a.reject("alice", `mutation($s: ID!, $l: ID!) { demolishLocation(settlementID: $s, locationID: $l) { id } }`, vars, "BAD_USER_INPUT")
+	var built builtLocations
+	a.run("alice", settlementLocations, vars, &built)
+	if len(built.Settlement.Locations) != 1 {
+		t.Errorf("locations after demolishing the Lantern Hoard %+v", built.Settlement.Locations)
+	}
+}
+
+func TestCraftGear(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+
+	var catalog struct {
+		Locations []struct {
+			ID      int `json:"id,string"`
+			Name    string
+			Recipes []struct {
+				ID   int `json:"id,string"`
+				Name string
+			}
+		}
+	}
+	a.run("alice", `{ locations { id name recipes { id name } } }`, nil, &catalog)
+	vars := map[string]any{"s": settlementID}
+	for _, l := range catalog.Locations {
+		if l.Name != "Bone Smith" {
+			continue
+		}
+		vars["l"] = l.ID
+		for _, r := range l.Recipes {
+			if r.Name == "Bone Axe" {
+				vars["r"] = r.ID
+			}
+		}
+	}
+
+	craft := `mutation($s: ID!, $r: ID!) { craftGear(settlementID: $s, recipeID: $r) { name category quantity } }`
+	build := `mutation($s: ID!, $l: ID!) { buildLocation(settlementID: $s, locationID: $l) { id } }`
+	a.reject("alice", craft, vars, "BAD_USER_INPUT")
+	a.reject("bob", build, vars, "FORBIDDEN")
+	a.run("alice", build, vars, nil)
+
+	bone := map[string]any{"s": settlementID, "name": "Bone", "category": "basic_resource", "quantity": 1, "keywords": []string{"bone"}}
+	a.run("alice", addToStorage, bone, nil)
+	res := a.reject("alice", craft, vars, "INSUFFICIENT_STOCK")
+	if shortfalls, _ := res.Errors[0].Extensions["shortfalls"].([]any); len(shortfalls) != 1 {
+		t.Errorf("shortfalls %v", res.Errors[0].Extensions)
+	}
+	tail := map[string]any{"s": settlementID, "name": "Lion Tail", "category": "monster_resource", "quantity": 1, "keywords": []string{"organ", "hide"}}
+	a.run("alice", addToStorage, tail, nil)
+	a.run("alice", craft, vars, nil)
+
+	var stock storage
+	a.run("alice", `query($s: ID!) { storage(settlementID: $s) { name quantity } }`, vars, &stock)
+	if len(stock.Storage) != 1 || stock.Storage[0].Name != "Bone Axe" {
+		t.Errorf("storage after crafting %+v", stock.Storage)
+	}
+
+	a.run("alice", `mutation($s: ID!, $l: ID!) { demolishLocation(settlementID: $s, locationID: $l) { id } }`, vars, nil)
+	var built builtLocations
+	a.run("alice", settlementLocations, vars, &built)
+	if len(built.Settlement.Locations) != 1 {
+		t.Errorf("locations after demolishing %+v", built.Settlement.Locations)
+	}
+}
@@ -0,0 +1,41 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/membership"
+)
+
+// AddCollaborator is the resolver for the addCollaborator field.
+func (r *mutationResolver) AddCollaborator(ctx context.Context, settlementID int, userID string, role membership.Role) (*ent.Membership, error) {
+	return ent.FromContext(ctx).Membership.Create().
+		SetSettlementID(settlementID).
+		SetUserID(userID).
+		SetRole(role).
+		Save(ctx)
+}
+
+// UpdateCollaborator is the resolver for the updateCollaborator field.
+func (r *mutationResolver) UpdateCollaborator(ctx context.Context, settlementID int, userID string, role membership.Role) (*ent.Membership, error) {
+	c := ent.FromContext(ctx)
+	m, err := collaborator(ctx, c, settlementID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return c.Membership.UpdateOne(m).SetRole(role).Save(ctx)
+}
+
+// RemoveCollaborator is the resolver for the removeCollaborator field.
+func (r *mutationResolver) RemoveCollaborator(ctx context.Context, settlementID int, userID string) (*bool, error) {
+	c := ent.FromContext(ctx)
+	m, err := collaborator(ctx, c, settlementID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.Membership.DeleteOne(m).Exec(ctx)
+}
@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type memoKey struct{}
+
+// memo holds the values several resolvers derive their fields from, such as
+// the survival bonuses of a settlement, so they are loaded once.
+type memo struct {
+	mu      sync.Mutex
+	results map[memoEntry]*memoResult
+}
+
+type memoEntry struct {
+	name string
+	id   int
+}
+
+type memoResult struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+// Memoize is a gqlgen root field interceptor that shares memoized values
+// between the resolvers below a root field. Mutations run one root field after
+// another, so the values never outlive the mutation that could change them.
+func Memoize(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	return next(context.WithValue(ctx, memoKey{}, &memo{results: map[memoEntry]*memoResult{}}))
+}
+
+// memoized returns what load returns for the record with id, loading it once
+// per root field. Without Memoize it loads on every call.
+func memoized[T any](ctx context.Context, name string, id int, load func(context.Context) (T, error)) (T, error) {
+	m, ok := ctx.Value(memoKey{}).(*memo)
+	if !ok {
+		return load(ctx)
+	}
+	m.mu.Lock()
+	key := memoEntry{name: name, id: id}
+	result, ok := m.results[key]
+	if !ok {
+		result = &memoResult{}
+		m.results[key] = result
+	}
+	m.mu.Unlock()
+	result.once.Do(func() {
+		result.value, result.err = load(ctx)
+	})
+	value, _ := result.value.(T)
+	return value, result.err
+}
@@ -0,0 +1,80 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const milestones = `query($s: ID!) { settlement(id: $s) { milestones { name year } timeline { name year } } }`
+
+type settlementMilestones struct {
+	Settlement struct {
+		Milestones []struct {
+			Name string
+			Year int
+		}
+		Timeline []struct {
+			Name string
+			Year int
+		}
+	}
+}
+
+func (m settlementMilestones) reached() []string {
+	names := make([]string, len(m.Settlement.Milestones))
+	for i, r := range m.Settlement.Milestones {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func TestMilestones(t *testing.T) {
+	a := newAPI(t)
+	var created struct {
+		CreateSettlement struct {
+			ID         int `json:"id,string"`
+			Population []struct {
+				ID int `json:"id,string"`
+			}
+		}
+	}
+	a.run("alice", `mutation {
+		createSettlement(input: {owner: "alice", name: "Lantern Hollow", currentyear: 2, createSurvivors: [{name: "Zachary"}, {name: "Erza"}]}) { id population { id } }
+	}`, nil, &created)
+	vars := map[string]any{"s": created.CreateSettlement.ID}
+	var m settlementMilestones
+	a.run("alice", milestones, vars, &m)
+	if len(m.Settlement.Milestones) != 0 {
+		t.Fatalf("a new settlement reached %v", m.reached())
+	}
+
+	a.run("alice", createSurvivor, map[string]any{"i": map[string]any{"name": "Lucy", "born": 2, "settlementID": created.CreateSettlement.ID}}, nil)
+	for _, s := range created.CreateSettlement.Population {
+		a.run("alice", `mutation($v: ID!) { updateSurvivor(id: $v, input: {status: dead}) { id } }`, map[string]any{"v": s.ID}, nil)
+	}
+	a.run("alice", milestones, vars, &m)
+	if got := m.reached(); len(got) != 2 || got[0] != "first_child_born" || got[1] != "first_death" {
+		t.Fatalf("reached %v, want the first child and death", got)
+	}
+	if len(m.Settlement.Timeline) != 2 || m.Settlement.Timeline[1].Name != "Principle: Death" || m.Settlement.Timeline[1].Year != 2 {
+		t.Fatalf("timeline %+v", m.Settlement.Timeline)
+	}
+}
+
+// TestFirstChildBornWithSettlement checks that children among the founding
+// population of a settlement count as born to it.
+func TestFirstChildBornWithSettlement(t *testing.T) {
+	a := newAPI(t)
+	var created struct {
+		CreateSettlement struct {
+			ID int `json:"id,string"`
+		}
+	}
+	a.run("alice", `mutation {
+		createSettlement(input: {owner: "alice", name: "Lantern Hollow", currentyear: 3, createSurvivors: [{name: "Zachary"}, {name: "Lucy", born: 3}]}) { id }
+	}`, nil, &created)
+	var m settlementMilestones
+	a.run("alice", milestones, map[string]any{"s": created.CreateSettlement.ID}, &m)
+	if got := m.reached(); len(got) != 1 || got[0] != "first_child_born" || m.Settlement.Milestones[0].Year != 3 {
+		t.Fatalf("reached %+v, want the first child in year 3", m.Settlement.Milestones)
+	}
+}
@@ -0,0 +1,102 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+type AddToStorageInput struct {
+	SettlementID int                  `json:"settlementID"`
+	Name         string               `json:"name"`
+	Category     storageitem.Category `json:"category"`
+	// The monster a resource was harvested from. Only recorded for new line items.
+	SourceMonster *string `json:"sourceMonster,omitempty"`
+	// Keywords such as bone, hide or organ. Only recorded for new line items.
+	Keywords []string `json:"keywords,omitempty"`
+	Quantity int      `json:"quantity"`
+}
+
+type CreateAccessTokenPayload struct {
+	// The plain access token. It is only returned once, the server keeps a hash.
+	Token       string           `json:"token"`
+	AccessToken *ent.AccessToken `json:"accessToken"`
+}
+
+type CreateInvitePayload struct {
+	// The plain invite code. It is only returned once, the server keeps a hash.
+	Code   string      `json:"code"`
+	Invite *ent.Invite `json:"invite"`
+}
+
+// Hunt is a quarry the settlement can hunt and the levels it can be hunted at.
+type Hunt struct {
+	Monster *ent.Monster `json:"monster"`
+	Levels  []int        `json:"levels"`
+}
+
+// PublicSettlement is the read-only view of a settlement shared through a
+// spectator link. It leaves out ids and anything identifying its members.
+type PublicSettlement struct {
+	Name                string            `json:"name"`
+	SurvivalLimit       int               `json:"survivallimit"`
+	DepartingSurvival   int               `json:"departingsurvival"`
+	CollectiveCognition int               `json:"collectivecognition"`
+	CurrentYear         int               `json:"currentyear"`
+	Population          []*PublicSurvivor `json:"population"`
+	// The settlement's lantern years, in order.
+	Timeline []*PublicTimelineEvent `json:"timeline"`
+}
+
+type PublicSurvivor struct {
+	Name             string          `json:"name"`
+	Born             int             `json:"born"`
+	Gender           survivor.Gender `json:"gender"`
+	Huntxp           int             `json:"huntxp"`
+	Survival         int             `json:"survival"`
+	Movement         int             `json:"movement"`
+	Accuracy         int             `json:"accuracy"`
+	Strength         int             `json:"strength"`
+	Evasion          int             `json:"evasion"`
+	Luck             int             `json:"luck"`
+	Speed            int             `json:"speed"`
+	Insanity         int             `json:"insanity"`
+	Courage          int             `json:"courage"`
+	Understanding    int             `json:"understanding"`
+	Status           survivor.Status `json:"status"`
+	StatusChangeYear int             `json:"statusChangeYear"`
+}
+
+type PublicTimelineEvent struct {
+	Year      int                `json:"year"`
+	Type      timelineevent.Type `json:"type"`
+	Name      string             `json:"name"`
+	Completed bool               `json:"completed"`
+}
+
+// SurvivorStats are a survivor's stats with the modifiers of their abilities and
+// impairments applied.
+type SurvivorStats struct {
+	Movement int `json:"movement"`
+	Accuracy int `json:"accuracy"`
+	Strength int `json:"strength"`
+	Evasion  int `json:"evasion"`
+	Luck     int `json:"luck"`
+	Speed    int `json:"speed"`
+}
+
+// Trash holds a settlement's deleted records until the retention period purges them.
+type Trash struct {
+	// The settlement itself, when it was deleted.
+	Settlement *ent.Settlement `json:"settlement,omitempty"`
+	Survivors  []*ent.Survivor `json:"survivors"`
+}
+
+// YearCount is a number of survivors counted in one lantern year.
+type YearCount struct {
+	Year  int `json:"year"`
+	Count int `json:"count"`
+}
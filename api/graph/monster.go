@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/schema/schematype"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// unlockMonster returns the settlement's progress against the monster,
+// unlocking it in the settlement's current lantern year first if needed.
+func unlockMonster(ctx context.Context, c *ent.Client, settlementID, monsterID int) (*ent.MonsterProgress, error) {
+	progress, err := c.MonsterProgress.Query().
+		Where(monsterprogress.SettlementID(settlementID), monsterprogress.MonsterID(monsterID)).
+		Only(ctx)
+	if !ent.IsNotFound(err) {
+		return progress, err
+	}
+	s, err := c.Settlement.Get(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	return c.MonsterProgress.Create().
+		SetSettlementID(settlementID).
+		SetMonsterID(monsterID).
+		SetUnlockedYear(s.CurrentYear).
+		Save(ctx)
+}
+
+// recordDefeat appends a defeat to the settlement's progress against the
+// monster. year defaults to the settlement's current lantern year.
+func recordDefeat(ctx context.Context, c *ent.Client, settlementID, monsterID, level int, year *int) (*ent.MonsterProgress, error) {
+	m, err := c.Monster.Get(ctx, monsterID)
+	if err != nil {
+		return nil, err
+	}
+	if level < 1 || level > m.Levels {
+		return nil, apperr.BadUserInput(fmt.Sprintf("%s can only be fought at levels 1 to %d", m.Name, m.Levels))
+	}
+	progress, err := unlockMonster(ctx, c, settlementID, monsterID)
+	if err != nil {
+		return nil, err
+	}
+	defeat := schematype.Defeat{Level: level}
+	if year != nil {
+		if *year < 0 || *year > 35 {
+			return nil, apperr.BadUserInput("year must be a lantern year between 0 and 35")
+		}
+		defeat.Year = *year
+	} else {
+		s, err := c.Settlement.Get(ctx, settlementID)
+		if err != nil {
+			return nil, err
+		}
+		defeat.Year = s.CurrentYear
+	}
+	return c.MonsterProgress.UpdateOne(progress).AppendDefeats([]schematype.Defeat{defeat}).Save(ctx)
+}
+
+// defeatedLevels lists the levels defeated at least once, lowest first.
+func defeatedLevels(defeats []schematype.Defeat) []int {
+	levels := []int{}
+	for _, d := range defeats {
+		if !slices.Contains(levels, d.Level) {
+			levels = append(levels, d.Level)
+		}
+	}
+	slices.Sort(levels)
+	return levels
+}
+
+// availableHunts lists the quarries the settlement can hunt in its current
+// lantern year, with the levels they can be hunted at: any level up to one
+// above the highest level defeated by then. Quarries unlocked in a later year
+// are left out, and there is no hunt in a year that has a nemesis encounter or
+// special showdown on the timeline.
+func availableHunts(ctx context.Context, c *ent.Client, settlementID int) ([]*model.Hunt, error) {
+	s, err := c.Settlement.Get(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	hunts := []*model.Hunt{}
+	showdown, err := c.TimelineEvent.Query().
+		Where(
+			timelineevent.SettlementID(settlementID),
+			timelineevent.Year(s.CurrentYear),
+			timelineevent.TypeIn(timelineevent.TypeNemesisEncounter, timelineevent.TypeSpecialShowdown),
+		).
+		Exist(ctx)
+	if err != nil || showdown {
+		return hunts, err
+	}
+	progress, err := c.MonsterProgress.Query().
+		Where(
+			monsterprogress.SettlementID(settlementID),
+			monsterprogress.UnlockedYearLTE(s.CurrentYear),
+			monsterprogress.HasMonsterWith(monster.KindEQ(monster.KindQuarry)),
+		).
+		WithMonster().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range progress {
+		m := p.Edges.Monster
+		highest := 0
+		for _, d := range p.Defeats {
+			if d.Year <= s.CurrentYear {
+				highest = max(highest, d.Level)
+			}
+		}
+		levels := make([]int, 0, m.Levels)
+		for level := 1; level <= min(highest+1, m.Levels); level++ {
+			levels = append(levels, level)
+		}
+		hunts = append(hunts, &model.Hunt{Monster: m, Levels: levels})
+	}
+	slices.SortFunc(hunts, func(a, b *model.Hunt) int {
+		return cmp.Compare(a.Monster.Name, b.Monster.Name)
+	})
+	return hunts, nil
+}
@@ -0,0 +1,42 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/monster"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// DefeatedLevels is the resolver for the defeatedLevels field.
+func (r *monsterProgressResolver) DefeatedLevels(ctx context.Context, obj *ent.MonsterProgress) ([]int, error) {
+	return defeatedLevels(obj.Defeats), nil
+}
+
+// UnlockMonster is the resolver for the unlockMonster field.
+func (r *mutationResolver) UnlockMonster(ctx context.Context, settlementID int, monsterID int) (*ent.MonsterProgress, error) {
+	c := ent.FromContext(ctx)
+	if _, err := c.Monster.Get(ctx, monsterID); err != nil {
+		return nil, err
+	}
+	return unlockMonster(ctx, c, settlementID, monsterID)
+}
+
+// RecordDefeat is the resolver for the recordDefeat field.
+func (r *mutationResolver) RecordDefeat(ctx context.Context, settlementID int, monsterID int, level int, year *int) (*ent.MonsterProgress, error) {
+	return recordDefeat(ctx, ent.FromContext(ctx), settlementID, monsterID, level, year)
+}
+
+// Monsters is the resolver for the monsters field.
+func (r *queryResolver) Monsters(ctx context.Context) ([]*ent.Monster, error) {
+	return r.client.Monster.Query().Order(monster.ByKind(), monster.ByName()).All(ctx)
+}
+
+// AvailableHunts is the resolver for the availableHunts field.
+func (r *queryResolver) AvailableHunts(ctx context.Context, settlementID int) ([]*model.Hunt, error) {
+	return availableHunts(ctx, r.client, settlementID)
+}
@@ -0,0 +1,127 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+)
+
+const recordDefeat = `mutation($s: ID!, $m: ID!, $level: Int!, $year: Int) {
+	recordDefeat(settlementID: $s, monsterID: $m, level: $level, year: $year) { unlockedYear defeats { level year } defeatedLevels }
+}`
+
+const availableHunts = `query($s: ID!) { availableHunts(settlementID: $s) { monster { name } levels } }`
+
+type hunts struct {
+	AvailableHunts []struct {
+		Monster struct{ Name string }
+		Levels  []int
+	}
+}
+
+// monsters returns the catalog's monster ids by name.
+func (a *api) monsters() map[string]int {
+	a.t.Helper()
+	var out struct {
+		Monsters []struct {
+			ID   int `json:"id,string"`
+			Name string
+		}
+	}
+	a.run("alice", `{ monsters { id name } }`, nil, &out)
+	ids := map[string]int{}
+	for _, m := range out.Monsters {
+		ids[m.Name] = m.ID
+	}
+	return ids
+}
+
+// hunts returns the levels of each hunt available to the settlement by monster name.
+func (a *api) hunts(token string, settlementID int) map[string][]int {
+	a.t.Helper()
+	var out hunts
+	a.run(token, availableHunts, map[string]any{"s": settlementID}, &out)
+	levels := map[string][]int{}
+	for _, h := range out.AvailableHunts {
+		levels[h.Monster.Name] = h.Levels
+	}
+	return levels
+}
+
+func setYear(a *api, settlementID, year int) {
+	a.t.Helper()
+	a.run("alice", `mutation($s: ID!, $y: Int!) { updateSettlement(id: $s, input: {currentyear: $y}) { id } }`,
+		map[string]any{"s": settlementID, "y": year}, nil)
+}
+
+func TestRecordDefeat(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+	setYear(a, settlementID, 3)
+	ids := a.monsters()
+
+	var out struct {
+		RecordDefeat struct {
+			UnlockedYear   int
+			Defeats        []struct{ Level, Year int }
+			DefeatedLevels []int
+		}
+	}
+	vars := map[string]any{"s": settlementID, "m": ids["White Lion"], "level": 2, "year": 1}
+	a.run("alice", recordDefeat, vars, &out)
+	vars["level"], vars["year"] = 1, nil
+	a.run("alice", recordDefeat, vars, &out)
+	if d := out.RecordDefeat; d.UnlockedYear != 3 || len(d.Defeats) != 2 || d.Defeats[1].Year != 3 || !slices.Equal(d.DefeatedLevels, []int{1, 2}) {
+		t.Fatalf("White Lion progress %+v", d)
+	}
+
+	vars["level"] = 4
+	a.reject("alice", recordDefeat, vars, "BAD_USER_INPUT")
+	vars["level"], vars["year"] = 1, 36
+	a.reject("alice", recordDefeat, vars, "BAD_USER_INPUT")
+	vars["year"] = nil
+	a.reject("bob", recordDefeat, vars, "FORBIDDEN")
+}
+
+func TestAvailableHunts(t *testing.T) {
+	a := newAPI(t)
+	a.seedCatalog()
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	ids := a.monsters()
+	unlock := `mutation($s: ID!, $m: ID!) { unlockMonster(settlementID: $s, monsterID: $m) { id } }`
+	a.run("alice", unlock, map[string]any{"s": settlementID, "m": ids["White Lion"]}, nil)
+	a.run("alice", recordDefeat, map[string]any{"s": settlementID, "m": ids["Butcher"], "level": 1}, nil)
+	setYear(a, settlementID, 2)
+	a.run("alice", unlock, map[string]any{"s": settlementID, "m": ids["Screaming Antelope"]}, nil)
+	a.run("alice", recordDefeat, map[string]any{"s": settlementID, "m": ids["White Lion"], "level": 1, "year": 1}, nil)
+	a.run("alice", recordDefeat, map[string]any{"s": settlementID, "m": ids["White Lion"], "level": 2, "year": 4}, nil)
+
+	// Nemeses are fought through the timeline, not hunted.
+	got := a.hunts("alice", settlementID)
+	if len(got) != 2 || !slices.Equal(got["White Lion"], []int{1, 2}) || !slices.Equal(got["Screaming Antelope"], []int{1}) {
+		t.Fatalf("year 2 hunts %v", got)
+	}
+
+	// Back in year 0 the Screaming Antelope is still locked and no defeats count yet.
+	setYear(a, settlementID, 0)
+	if got := a.hunts("alice", settlementID); len(got) != 1 || !slices.Equal(got["White Lion"], []int{1}) {
+		t.Fatalf("year 0 hunts %v", got)
+	}
+	setYear(a, settlementID, 4)
+	if got := a.hunts("alice", settlementID); !slices.Equal(got["White Lion"], []int{1, 2, 3}) {
+		t.Fatalf("year 4 hunts %v", got)
+	}
+
+	a.planEvent("alice", settlementID, 4, "nemesis_encounter", "Butcher")
+	if got := a.hunts("alice", settlementID); len(got) != 0 {
+		t.Fatalf("hunts in a nemesis year %v", got)
+	}
+	a.planEvent("alice", settlementID, 5, "showdown", "White Lion")
+	setYear(a, settlementID, 5)
+	if got := a.hunts("alice", settlementID); len(got) != 2 {
+		t.Fatalf("year 5 hunts %v", got)
+	}
+
+	a.reject("carol", availableHunts, map[string]any{"s": settlementID}, "NOT_FOUND")
+}
@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// statusCounts counts the settlement's population by status in a single
+// GROUP BY query, run once for all the fields derived from it. The counts are
+// derived on every read, so correcting a survivor's status is reflected
+// immediately.
+func statusCounts(ctx context.Context, s *ent.Settlement) (map[survivor.Status]int, error) {
+	return memoized(ctx, "statusCounts", s.ID, func(ctx context.Context) (map[survivor.Status]int, error) {
+		return loadStatusCounts(ctx, s)
+	})
+}
+
+func loadStatusCounts(ctx context.Context, s *ent.Settlement) (map[survivor.Status]int, error) {
+	var rows []struct {
+		Status survivor.Status `json:"status"`
+		Count  int             `json:"count"`
+	}
+	err := s.QueryPopulation().
+		GroupBy(survivor.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[survivor.Status]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// deathsByYear counts the settlement's dead by the year their status changed.
+func deathsByYear(ctx context.Context, s *ent.Settlement) ([]*model.YearCount, error) {
+	var rows []struct {
+		Year  int `json:"status_change_year"`
+		Count int `json:"count"`
+	}
+	err := s.QueryPopulation().
+		Where(survivor.StatusEQ(survivor.StatusDead)).
+		GroupBy(survivor.FieldStatusChangeYear).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	years := make([]*model.YearCount, len(rows))
+	for i, row := range rows {
+		years[i] = &model.YearCount{Year: row.Year, Count: row.Count}
+	}
+	slices.SortFunc(years, func(a, b *model.YearCount) int {
+		return cmp.Compare(a.Year, b.Year)
+	})
+	return years, nil
+}
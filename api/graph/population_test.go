@@ -0,0 +1,89 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+)
+
+const populationCounts = `query($s: ID!) { settlement(id: $s) { deathCount populationCount retiredCount deathsByYear { year count } } }`
+
+type yearCount struct{ Year, Count int }
+
+type counts struct {
+	Settlement struct {
+		DeathCount, PopulationCount, RetiredCount int
+		DeathsByYear                              []yearCount
+	}
+}
+
+func TestPopulationCounts(t *testing.T) {
+	a := newAPI(t)
+	var created struct {
+		CreateSettlement struct {
+			ID         int `json:"id,string"`
+			Population []struct {
+				ID int `json:"id,string"`
+			}
+		}
+	}
+	a.run("alice", `mutation { createSettlement(input: {owner: "alice", name: "Lantern Hollow", createSurvivors: [
+		{name: "Allister"},
+		{name: "Brenna", status: dead, statusChangeYear: 2},
+		{name: "Cain", status: retired},
+		{name: "Dara", status: dead, statusChangeYear: 1},
+		{name: "Esme", status: dead, statusChangeYear: 2},
+	]}) { id population { id } } }`, nil, &created)
+	vars := map[string]any{"s": created.CreateSettlement.ID, "v": created.CreateSettlement.Population[1].ID}
+
+	var out counts
+	a.run("alice", populationCounts, vars, &out)
+	if s := out.Settlement; s.DeathCount != 3 || s.PopulationCount != 2 || s.RetiredCount != 1 ||
+		!slices.Equal(s.DeathsByYear, []yearCount{{1, 1}, {2, 2}}) {
+		t.Fatalf("counts %+v", s)
+	}
+
+	// Correcting a status is reflected right away.
+	a.run("alice", `mutation($v: ID!) { updateSurvivor(id: $v, input: {status: alive}) { id } }`, vars, nil)
+	a.run("alice", populationCounts, vars, &out)
+	if s := out.Settlement; s.DeathCount != 2 || s.PopulationCount != 3 || !slices.Equal(s.DeathsByYear, []yearCount{{1, 1}, {2, 1}}) {
+		t.Fatalf("counts after Brenna's return %+v", s)
+	}
+}
+
+// TestPopulationCountsQueries checks that the counts share one GROUP BY per
+// settlement.
+func TestPopulationCountsQueries(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow", "Allister", "Brenna")
+	a.createSettlement("alice", "Dusk", "Cain")
+
+	var out counts
+	n := a.queries("survivors", func() {
+		a.run("alice", `query($s: ID!) { settlement(id: $s) { deathCount populationCount retiredCount } }`,
+			map[string]any{"s": settlementID}, &out)
+	})
+	if out.Settlement.PopulationCount != 2 {
+		t.Errorf("counts %+v", out.Settlement)
+	}
+	if n != 1 {
+		t.Errorf("survivors counted %d times, want once", n)
+	}
+
+	var list struct {
+		Settlements []struct{ PopulationCount, DeathCount int }
+	}
+	n = a.queries("survivors", func() {
+		a.run("alice", `{ settlements { populationCount deathCount retiredCount } }`, nil, &list)
+	})
+	populations := []int{}
+	for _, s := range list.Settlements {
+		populations = append(populations, s.PopulationCount)
+	}
+	slices.Sort(populations)
+	if !slices.Equal(populations, []int{1, 2}) {
+		t.Errorf("populations %v", populations)
+	}
+	if n != 2 {
+		t.Errorf("survivors counted %d times for 2 settlements", n)
+	}
+}
@@ -0,0 +1,59 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+	"github.com/failuretoload/datamonster/graph/model"
+	"github.com/failuretoload/datamonster/rule"
+	"github.com/failuretoload/datamonster/secret"
+)
+
+// ShareSettlement is the resolver for the shareSettlement field.
+func (r *mutationResolver) ShareSettlement(ctx context.Context, settlementID int) (*string, error) {
+	token, hash, err := secret.New(sharePrefix)
+	if err != nil {
+		return nil, err
+	}
+	err = ent.FromContext(ctx).Settlement.UpdateOneID(settlementID).SetShareTokenHash(hash).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// UnshareSettlement is the resolver for the unshareSettlement field.
+func (r *mutationResolver) UnshareSettlement(ctx context.Context, settlementID int) (*bool, error) {
+	return nil, ent.FromContext(ctx).Settlement.UpdateOneID(settlementID).ClearShareTokenHash().Exec(ctx)
+}
+
+// PublicSettlement is the resolver for the publicSettlement field.
+func (r *queryResolver) PublicSettlement(ctx context.Context, token string) (*model.PublicSettlement, error) {
+	// Spectators aren't signed in, the token itself is the authorization.
+	bypass := rule.Bypass(ctx)
+	s, err := r.client.Settlement.Query().
+		Where(settlement.ShareTokenHash(secret.Hash(token))).
+		Only(bypass)
+	if ent.IsNotFound(err) {
+		return nil, errInvalidShare
+	}
+	if err != nil {
+		return nil, err
+	}
+	population, err := s.QueryPopulation().Order(survivor.ByName()).All(bypass)
+	if err != nil {
+		return nil, err
+	}
+	timeline, err := s.QueryTimeline().Order(timelineevent.ByYear(), timelineevent.ByID()).All(bypass)
+	if err != nil {
+		return nil, err
+	}
+	return publicSettlement(s, population, timeline), nil
+}
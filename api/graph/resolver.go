@@ -10,6 +10,7 @@ type Resolver struct{ client *ent.Client }
 // NewSchema creates a graphql executable schema.
 func NewSchema(client *ent.Client) graphql.ExecutableSchema {
 	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client},
+		Resolvers:  &Resolver{client},
+		Complexity: complexityRoot(),
 	})
 }
@@ -6,11 +6,16 @@ package graph
 
 import (
 	"context"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
-	"github.com/failuretoload/datamonster/config"
 	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/membership"
 	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/graph/model"
+	"github.com/failuretoload/datamonster/rule"
 )
 
 // CreateSettlement is the resolver for the createSettlement field.
@@ -23,17 +28,98 @@ func (r *mutationResolver) UpdateSettlement(ctx context.Context, id int, input e
 	return ent.FromContext(ctx).Settlement.UpdateOneID(id).SetInput(input).Save(ctx)
 }
 
-// Settlements is the resolver for settlements scoped by user
+// DeleteSettlement is the resolver for the deleteSettlement field.
+func (r *mutationResolver) DeleteSettlement(ctx context.Context, id int, confirmName string) (*bool, error) {
+	userID, err := ownerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := ent.FromContext(ctx)
+	s, err := c.Settlement.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Editors may trash survivors, so check the role before touching the
+	// population rather than leaving it to the settlement's own update.
+	if err := rule.CheckSettlementRole(ctx, c, userID, id, membership.RoleOwner); err != nil {
+		return nil, err
+	}
+	if s.Name != confirmName {
+		return nil, errUnconfirmedDelete
+	}
+	// The population shares the settlement's timestamp so restoring the
+	// settlement brings back exactly the survivors deleted with it. The
+	// settlement goes last, nothing in the trash can be edited.
+	now := time.Now()
+	err = c.Survivor.Update().
+		Where(survivor.SettlementID(id), survivor.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Invite.Update().
+		Where(invite.SettlementID(id), invite.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.Settlement.UpdateOneID(id).
+		SetDeletedAt(now).
+		ClearShareTokenHash().
+		Exec(ctx)
+}
+
+// Settlements is the resolver for every settlement the user is a member of
 func (r *queryResolver) Settlements(ctx context.Context) ([]*ent.Settlement, error) {
-	owner := ctx.Value(config.UserIDKey).(string)
-	query := r.client.Settlement.Query().Where(settlement.Owner(owner))
+	owner, err := ownerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := r.client.Settlement.Query().Where(rule.MemberOf(owner))
 	return query.Order(settlement.ByCurrentYear(sql.OrderDesc())).All(ctx)
 }
 
 // Settlement is the resolver for a single settlement, scoped to a user
 func (r *queryResolver) Settlement(ctx context.Context, id int) (*ent.Settlement, error) {
-	owner := ctx.Value(config.UserIDKey).(string)
-	return r.client.Settlement.Query().Where(settlement.ID(id)).Where(settlement.Owner(owner)).First(ctx)
+	owner, err := ownerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.client.Settlement.Query().Where(settlement.ID(id)).Where(rule.MemberOf(owner)).First(ctx)
+}
+
+// DeathCount is the resolver for the deathCount field.
+func (r *settlementResolver) DeathCount(ctx context.Context, obj *ent.Settlement) (int, error) {
+	counts, err := statusCounts(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	return counts[survivor.StatusDead], nil
+}
+
+// PopulationCount is the resolver for the populationCount field.
+func (r *settlementResolver) PopulationCount(ctx context.Context, obj *ent.Settlement) (int, error) {
+	counts, err := statusCounts(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	return counts[survivor.StatusAlive] + counts[survivor.StatusSkipHunt] + counts[survivor.StatusRetired], nil
+}
+
+// RetiredCount is the resolver for the retiredCount field.
+func (r *settlementResolver) RetiredCount(ctx context.Context, obj *ent.Settlement) (int, error) {
+	counts, err := statusCounts(ctx, obj)
+	if err != nil {
+		return 0, err
+	}
+	return counts[survivor.StatusRetired], nil
+}
+
+// DeathsByYear is the resolver for the deathsByYear field.
+func (r *settlementResolver) DeathsByYear(ctx context.Context, obj *ent.Settlement) ([]*model.YearCount, error) {
+	return deathsByYear(ctx, obj)
 }
 
 // CreateSurvivors is the resolver for the createSurvivors field.
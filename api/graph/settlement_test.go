@@ -0,0 +1,84 @@
+package graph_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/survivor"
+)
+
+const deleteSettlement = `mutation($s: ID!, $name: String!) { deleteSettlement(id: $s, confirmName: $name) }`
+
+func TestDeleteSettlement(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary", "Erza")
+	a.addCollaborator("alice", settlementID, "bob", "editor")
+	a.run("alice", createInvite, map[string]any{"s": settlementID, "role": "viewer"}, nil)
+	vars := map[string]any{"s": settlementID, "name": "Lantern Hollow", "v": survivors[0]}
+	a.run("alice", `mutation($v: ID!) { deleteSurvivor(id: $v) }`, vars, nil)
+
+	a.reject("bob", deleteSettlement, vars, "FORBIDDEN")
+	a.reject("alice", deleteSettlement, map[string]any{"s": settlementID, "name": "Dusk"}, "BAD_USER_INPUT")
+	a.run("alice", deleteSettlement, vars, nil)
+
+	var list struct {
+		Settlements []struct{ ID string }
+		Survivors   []struct{ ID string }
+	}
+	a.run("alice", `{ settlements { id } survivors { id } }`, nil, &list)
+	if len(list.Settlements) != 0 || len(list.Survivors) != 0 {
+		t.Fatalf("deleted settlement still listed %+v", list)
+	}
+	var page trashPage
+	a.run("alice", trash, vars, &page)
+	if page.Trash.Settlement == nil || len(page.Trash.Survivors) != 2 {
+		t.Fatalf("trash %+v", page.Trash)
+	}
+	if n := a.client.Invite.Query().Where(invite.RevokedAtIsNil()).CountX(bypass()); n != 0 {
+		t.Errorf("%d invites still open", n)
+	}
+	a.reject("bob", createSurvivor, map[string]any{"i": map[string]any{"name": "Lucy", "settlementID": settlementID}}, "FORBIDDEN")
+
+	// Restoring brings back the population deleted with the settlement, not
+	// the survivor deleted before it.
+	var restored struct {
+		RestoreSettlement struct {
+			Population []struct{ Name string }
+		}
+	}
+	a.run("alice", `mutation($s: ID!) { restoreSettlement(id: $s) { population { name } } }`, vars, &restored)
+	if pop := restored.RestoreSettlement.Population; len(pop) != 1 || pop[0].Name != "Erza" {
+		t.Fatalf("restored population %+v", pop)
+	}
+}
+
+// TestDeleteSettlementAsEditor checks that an editor's deleteSettlement is
+// turned away before it trashes the population or revokes anything, not just
+// rolled back.
+func TestDeleteSettlementAsEditor(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow", "Zachary", "Erza")
+	a.addCollaborator("alice", settlementID, "bob", "editor")
+	a.run("alice", createInvite, map[string]any{"s": settlementID, "role": "viewer"}, nil)
+	vars := map[string]any{"s": settlementID, "name": "Lantern Hollow"}
+	a.run("alice", `mutation($s: ID!) { shareSettlement(settlementID: $s) }`, vars, nil)
+
+	// The transaction would roll the changes back, but they shouldn't be
+	// made in the first place.
+	for _, s := range a.recorded(func() { a.reject("bob", deleteSettlement, vars, "FORBIDDEN") }) {
+		if strings.Contains(s, "UPDATE `") {
+			t.Errorf("the rejected delete ran %s", s)
+		}
+	}
+	if n := a.client.Survivor.Query().Where(survivor.DeletedAtNotNil()).CountX(bypass()); n != 0 {
+		t.Errorf("%d survivors trashed", n)
+	}
+	if n := a.client.Invite.Query().Where(invite.RevokedAtIsNil()).CountX(bypass()); n != 1 {
+		t.Errorf("%d invites open, want 1", n)
+	}
+	if !a.client.Settlement.Query().Where(settlement.ID(settlementID), settlement.ShareTokenHashNotNil(), settlement.DeletedAtIsNil()).ExistX(bypass()) {
+		t.Error("the settlement was trashed or unshared")
+	}
+}
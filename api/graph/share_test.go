@@ -0,0 +1,37 @@
+package graph_test
+
+import "testing"
+
+const publicSettlement = `query($token: String!) { publicSettlement(token: $token) { name population { name } timeline { year name } } }`
+
+func TestSpectatorLink(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow", "Zachary", "Lucie")
+	a.addCollaborator("alice", settlementID, "bob", "editor")
+	a.planEvent("alice", settlementID, 1, "story_event", "Returning Survivors")
+	vars := map[string]any{"s": settlementID}
+
+	a.reject("bob", `mutation($s: ID!) { shareSettlement(settlementID: $s) }`, vars, "FORBIDDEN")
+	var shared struct{ ShareSettlement string }
+	a.run("alice", `mutation($s: ID!) { shareSettlement(settlementID: $s) }`, vars, &shared)
+
+	var out struct {
+		PublicSettlement struct {
+			Name       string
+			Population []struct{ Name string }
+			Timeline   []struct {
+				Year int
+				Name string
+			}
+		}
+	}
+	a.run("", publicSettlement, map[string]any{"token": shared.ShareSettlement}, &out)
+	if out.PublicSettlement.Name != "Lantern Hollow" || len(out.PublicSettlement.Population) != 2 || len(out.PublicSettlement.Timeline) != 1 {
+		t.Fatalf("spectator sees %+v", out.PublicSettlement)
+	}
+	// The link only opens the public view.
+	a.reject("", `{ survivors { id } }`, nil, "UNAUTHENTICATED")
+
+	a.run("alice", `mutation($s: ID!) { unshareSettlement(settlementID: $s) }`, vars, nil)
+	a.reject("", publicSettlement, map[string]any{"token": shared.ShareSettlement}, "NOT_FOUND")
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+var errInvalidQuantity = apperr.BadUserInput("quantity must be at least 1")
+
+// addToStorage stacks input onto the settlement's matching line item. The
+// quantity is added in SQL, so concurrent additions don't overwrite each other.
+func addToStorage(ctx context.Context, c *ent.Client, input model.AddToStorageInput) (*ent.StorageItem, error) {
+	if input.Quantity < 1 {
+		return nil, errInvalidQuantity
+	}
+	item, err := c.StorageItem.Query().
+		Where(
+			storageitem.SettlementID(input.SettlementID),
+			storageitem.CategoryEQ(input.Category),
+			storageitem.Name(input.Name),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return c.StorageItem.Create().
+			SetSettlementID(input.SettlementID).
+			SetName(input.Name).
+			SetCategory(input.Category).
+			SetNillableSourceMonster(input.SourceMonster).
+			SetKeywords(input.Keywords).
+			SetQuantity(input.Quantity).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.StorageItem.UpdateOne(item).AddQuantity(input.Quantity).Save(ctx)
+}
+
+// drawFromStorage takes quantity from a line item. The update only matches
+// while enough is left, so the stock can't go negative under concurrent draws.
+// A line item that runs out is removed and returned with a zero quantity.
+func drawFromStorage(ctx context.Context, c *ent.Client, id, quantity int) (*ent.StorageItem, error) {
+	if quantity < 1 {
+		return nil, errInvalidQuantity
+	}
+	item, err := c.StorageItem.UpdateOneID(id).
+		Where(storageitem.QuantityGTE(quantity)).
+		AddQuantity(-quantity).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		stored, err := c.StorageItem.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return nil, insufficientStock(stored, quantity)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if item.Quantity == 0 {
+		if err := c.StorageItem.DeleteOne(item).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
+}
+
+// insufficientStock reports how much of the line item was asked for and how
+// much storage holds.
+func insufficientStock(item *ent.StorageItem, requested int) error {
+	err := apperr.InsufficientStock(fmt.Sprintf("storage holds %d %s, %d requested", item.Quantity, item.Name, requested))
+	err.Extensions = map[string]any{
+		"name":      item.Name,
+		"requested": requested,
+		"available": item.Quantity,
+	}
+	return err
+}
+
+// hasKeyword matches line items whose keywords include keyword.
+func hasKeyword(keyword string) predicate.StorageItem {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(storageitem.FieldKeywords), keyword))
+	}
+}
@@ -0,0 +1,42 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+// AddToStorage is the resolver for the addToStorage field.
+func (r *mutationResolver) AddToStorage(ctx context.Context, input model.AddToStorageInput) (*ent.StorageItem, error) {
+	return addToStorage(ctx, ent.FromContext(ctx), input)
+}
+
+// RemoveFromStorage is the resolver for the removeFromStorage field.
+func (r *mutationResolver) RemoveFromStorage(ctx context.Context, id int, quantity int) (*ent.StorageItem, error) {
+	return drawFromStorage(ctx, ent.FromContext(ctx), id, quantity)
+}
+
+// Storage is the resolver for the storage field.
+func (r *queryResolver) Storage(ctx context.Context, settlementID int, category *storageitem.Category, keyword *string, filter *ent.StorageItemWhereInput) ([]*ent.StorageItem, error) {
+	query := r.client.StorageItem.Query().Where(storageitem.SettlementID(settlementID))
+	if category != nil {
+		query.Where(storageitem.CategoryEQ(*category))
+	}
+	if keyword != nil {
+		query.Where(hasKeyword(*keyword))
+	}
+	if filter != nil {
+		var err error
+		query, err = filter.Filter(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return query.Order(storageitem.ByCategory(), storageitem.ByName()).All(ctx)
+}
@@ -0,0 +1,77 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const addToStorage = `mutation($s: ID!, $name: String!, $category: StorageItemCategory!, $quantity: Int!, $keywords: [String!]) {
+	addToStorage(input: {settlementID: $s, name: $name, category: $category, quantity: $quantity, keywords: $keywords}) { id quantity }
+}`
+
+const removeFromStorage = `mutation($i: ID!, $quantity: Int!) { removeFromStorage(id: $i, quantity: $quantity) { quantity } }`
+
+type storage struct {
+	Storage []struct {
+		Name     string
+		Quantity int
+	}
+}
+
+func TestStorage(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+
+	var added struct {
+		AddToStorage struct {
+			ID       int `json:"id,string"`
+			Quantity int
+		}
+	}
+	bone := map[string]any{"s": settlementID, "name": "Bone", "category": "basic_resource", "quantity": 2, "keywords": []string{"bone"}}
+	a.run("alice", addToStorage, bone, &added)
+	bone["quantity"] = 3
+	a.run("alice", addToStorage, bone, &added)
+	if added.AddToStorage.Quantity != 5 {
+		t.Fatalf("stacked quantity %d, want 5", added.AddToStorage.Quantity)
+	}
+	fur := map[string]any{"s": settlementID, "name": "White Fur", "category": "monster_resource", "quantity": 1, "keywords": []string{"hide"}}
+	a.run("alice", addToStorage, fur, nil)
+	bone["quantity"] = 0
+	a.reject("alice", addToStorage, bone, "BAD_USER_INPUT")
+	bone["quantity"] = 1
+	a.reject("bob", addToStorage, bone, "FORBIDDEN")
+
+	item := map[string]any{"i": added.AddToStorage.ID, "quantity": 9}
+	res := a.reject("alice", removeFromStorage, item, "INSUFFICIENT_STOCK")
+	if ext := res.Errors[0].Extensions; ext["available"] != 5.0 || ext["requested"] != 9.0 {
+		t.Errorf("insufficient stock extensions %v", ext)
+	}
+	item["quantity"] = 2
+	a.reject("bob", removeFromStorage, item, "FORBIDDEN")
+	a.run("alice", removeFromStorage, item, nil)
+
+	vars := map[string]any{"s": settlementID}
+	var hides, basic storage
+	a.run("bob", `query($s: ID!) { storage(settlementID: $s, keyword: "hide") { name quantity } }`, vars, &hides)
+	a.run("bob", `query($s: ID!) { storage(settlementID: $s, category: basic_resource) { name quantity } }`, vars, &basic)
+	if len(hides.Storage) != 1 || hides.Storage[0].Name != "White Fur" {
+		t.Errorf("hides %+v", hides.Storage)
+	}
+	if len(basic.Storage) != 1 || basic.Storage[0].Quantity != 3 {
+		t.Errorf("basic resources %+v", basic.Storage)
+	}
+
+	// Items drawn to zero leave storage.
+	item["quantity"] = 3
+	a.run("alice", removeFromStorage, item, nil)
+	var all storage
+	a.run("alice", `query($s: ID!) { storage(settlementID: $s) { name quantity } }`, vars, &all)
+	if len(all.Storage) != 1 || all.Storage[0].Name != "White Fur" {
+		t.Errorf("storage %+v", all.Storage)
+	}
+	a.run("carol", `query($s: ID!) { storage(settlementID: $s) { name quantity } }`, vars, &all)
+	if len(all.Storage) != 0 {
+		t.Errorf("carol sees %+v", all.Storage)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 
 	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/rule"
 )
 
 // CreateSurvivor is the resolver for the createSurvivor field.
@@ -27,8 +29,11 @@ func (r *mutationResolver) DeleteSurvivor(ctx context.Context, id int) (*bool, e
 
 // Survivors is the resolver for the survivors field.
 func (r *queryResolver) Survivors(ctx context.Context, filter *ent.SurvivorWhereInput, order *ent.SurvivorOrder) ([]*ent.Survivor, error) {
-	query := r.client.Survivor.Query()
-	var err error
+	owner, err := ownerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := r.client.Survivor.Query().Where(survivor.HasSettlementWith(rule.MemberOf(owner)))
 	if filter != nil {
 		query, err = filter.Filter(query)
 		if err != nil {
@@ -0,0 +1,51 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// PlanTimelineEvent is the resolver for the planTimelineEvent field.
+func (r *mutationResolver) PlanTimelineEvent(ctx context.Context, input ent.CreateTimelineEventInput) (*ent.TimelineEvent, error) {
+	return ent.FromContext(ctx).TimelineEvent.Create().SetInput(input).Save(ctx)
+}
+
+// CompleteTimelineEvent is the resolver for the completeTimelineEvent field.
+func (r *mutationResolver) CompleteTimelineEvent(ctx context.Context, id int, completed bool) (*ent.TimelineEvent, error) {
+	return ent.FromContext(ctx).TimelineEvent.UpdateOneID(id).SetCompleted(completed).Save(ctx)
+}
+
+// RescheduleTimelineEvent is the resolver for the rescheduleTimelineEvent field.
+func (r *mutationResolver) RescheduleTimelineEvent(ctx context.Context, id int, year int) (*ent.TimelineEvent, error) {
+	return ent.FromContext(ctx).TimelineEvent.UpdateOneID(id).SetYear(year).Save(ctx)
+}
+
+// DeleteTimelineEvent is the resolver for the deleteTimelineEvent field.
+func (r *mutationResolver) DeleteTimelineEvent(ctx context.Context, id int) (*bool, error) {
+	return nil, ent.FromContext(ctx).TimelineEvent.DeleteOneID(id).Exec(ctx)
+}
+
+// Timeline is the resolver for the timeline field.
+func (r *queryResolver) Timeline(ctx context.Context, settlementID int, fromYear *int, toYear *int, filter *ent.TimelineEventWhereInput) ([]*ent.TimelineEvent, error) {
+	query := r.client.TimelineEvent.Query().Where(timelineevent.SettlementID(settlementID))
+	if fromYear != nil {
+		query.Where(timelineevent.YearGTE(*fromYear))
+	}
+	if toYear != nil {
+		query.Where(timelineevent.YearLTE(*toYear))
+	}
+	if filter != nil {
+		var err error
+		query, err = filter.Filter(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return query.Order(timelineevent.ByYear(), timelineevent.ByID()).All(ctx)
+}
@@ -0,0 +1,67 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const planTimelineEvent = `mutation($s: ID!, $year: Int!, $type: TimelineEventType!, $name: String!) {
+	planTimelineEvent(input: {settlementID: $s, year: $year, type: $type, name: $name}) { id }
+}`
+
+// planEvent adds an event to the settlement's timeline and returns its id.
+func (a *api) planEvent(token string, settlementID, year int, typ, name string) int {
+	a.t.Helper()
+	var out struct {
+		PlanTimelineEvent struct {
+			ID int `json:"id,string"`
+		}
+	}
+	a.run(token, planTimelineEvent, map[string]any{"s": settlementID, "year": year, "type": typ, "name": name}, &out)
+	return out.PlanTimelineEvent.ID
+}
+
+type timeline struct {
+	Timeline []struct {
+		Name      string
+		Year      int
+		Completed bool
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.addCollaborator("alice", settlementID, "bob", "viewer")
+	returning := a.planEvent("alice", settlementID, 1, "story_event", "Returning Survivors")
+	a.planEvent("alice", settlementID, 5, "nemesis_encounter", "Butcher")
+
+	vars := map[string]any{"s": settlementID, "year": 40, "type": "showdown", "name": "White Lion", "e": returning}
+	a.reject("alice", planTimelineEvent, vars, "INVALID_FIELD")
+	vars["year"] = 2
+	a.reject("bob", planTimelineEvent, vars, "FORBIDDEN")
+	a.reject("carol", `mutation($e: ID!) { completeTimelineEvent(id: $e) { id } }`, vars, "FORBIDDEN")
+	a.run("alice", `mutation($e: ID!) { completeTimelineEvent(id: $e) { id } }`, vars, nil)
+	a.run("alice", `mutation($e: ID!) { rescheduleTimelineEvent(id: $e, year: 6) { id } }`, vars, nil)
+
+	var all timeline
+	a.run("bob", `query($s: ID!) { timeline(settlementID: $s) { name year completed } }`, vars, &all)
+	if len(all.Timeline) != 2 || all.Timeline[0].Name != "Butcher" || all.Timeline[1].Year != 6 || !all.Timeline[1].Completed {
+		t.Fatalf("timeline %+v", all.Timeline)
+	}
+	var upcoming timeline
+	a.run("bob", `query($s: ID!) { timeline(settlementID: $s, toYear: 5, filter: {completed: false}) { name year completed } }`, vars, &upcoming)
+	if len(upcoming.Timeline) != 1 || upcoming.Timeline[0].Name != "Butcher" {
+		t.Fatalf("upcoming %+v", upcoming.Timeline)
+	}
+	var stranger timeline
+	a.run("carol", `query($s: ID!) { timeline(settlementID: $s) { name } }`, vars, &stranger)
+	if len(stranger.Timeline) != 0 {
+		t.Fatalf("carol sees %+v", stranger.Timeline)
+	}
+
+	a.run("alice", `mutation($e: ID!) { deleteTimelineEvent(id: $e) }`, vars, nil)
+	a.run("alice", `query($s: ID!) { timeline(settlementID: $s) { name } }`, vars, &all)
+	if len(all.Timeline) != 1 {
+		t.Fatalf("timeline after delete %+v", all.Timeline)
+	}
+}
@@ -0,0 +1,71 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/schema"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/graph/model"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// RestoreSurvivor is the resolver for the restoreSurvivor field.
+func (r *mutationResolver) RestoreSurvivor(ctx context.Context, id int) (*ent.Survivor, error) {
+	return ent.FromContext(ctx).Survivor.UpdateOneID(id).ClearDeletedAt().Save(schema.SkipSoftDelete(ctx))
+}
+
+// RestoreSettlement is the resolver for the restoreSettlement field.
+func (r *mutationResolver) RestoreSettlement(ctx context.Context, id int) (*ent.Settlement, error) {
+	c := ent.FromContext(ctx)
+	ctx = schema.SkipSoftDelete(ctx)
+	s, err := c.Settlement.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	deletedAt := s.DeletedAt
+	s, err = c.Settlement.UpdateOne(s).ClearDeletedAt().Save(ctx)
+	if err != nil || deletedAt == nil {
+		return s, err
+	}
+	// deleteSettlement stamps the population with the settlement's deletion time.
+	err = c.Survivor.Update().
+		Where(survivor.SettlementID(id), survivor.DeletedAt(*deletedAt)).
+		ClearDeletedAt().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context, settlementID int) (*model.Trash, error) {
+	if _, err := ownerFromContext(ctx); err != nil {
+		return nil, err
+	}
+	ctx = schema.SkipSoftDelete(ctx)
+	s, err := r.client.Settlement.Get(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	// The privacy rules hide the population of a settlement in the trash,
+	// the caller is a member of it.
+	survivors, err := r.client.Survivor.Query().
+		Where(survivor.SettlementID(settlementID), survivor.DeletedAtNotNil()).
+		Order(survivor.ByDeletedAt(sql.OrderDesc())).
+		All(rule.Bypass(ctx))
+	if err != nil {
+		return nil, err
+	}
+	trash := &model.Trash{Survivors: survivors}
+	if s.DeletedAt != nil {
+		trash.Settlement = s
+	}
+	return trash, nil
+}
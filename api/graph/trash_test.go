@@ -0,0 +1,79 @@
+package graph_test
+
+import (
+	"testing"
+)
+
+const trash = `query($s: ID!) { trash(settlementID: $s) { settlement { id } survivors { name } } }`
+
+type trashPage struct {
+	Trash struct {
+		Settlement *struct {
+			ID int `json:"id,string"`
+		}
+		Survivors []struct{ Name string }
+	}
+}
+
+type population struct {
+	Settlement struct {
+		Population []struct{ Name string }
+	}
+}
+
+func TestTrashSurvivor(t *testing.T) {
+	a := newAPI(t)
+	settlementID, survivors := a.createSettlement("alice", "Lantern Hollow", "Zachary", "Erza")
+	a.addCollaborator("alice", settlementID, "bob", "editor")
+	vars := map[string]any{"s": settlementID, "v": survivors[0]}
+	populationQuery := `query($s: ID!) { settlement(id: $s) { population { name } } }`
+
+	a.run("alice", `mutation($v: ID!) { deleteSurvivor(id: $v) }`, vars, nil)
+	var pop population
+	a.run("alice", populationQuery, vars, &pop)
+	if len(pop.Settlement.Population) != 1 || pop.Settlement.Population[0].Name != "Erza" {
+		t.Fatalf("population after delete %+v", pop.Settlement.Population)
+	}
+	var page trashPage
+	a.run("bob", trash, vars, &page)
+	if page.Trash.Settlement != nil || len(page.Trash.Survivors) != 1 || page.Trash.Survivors[0].Name != "Zachary" {
+		t.Fatalf("trash %+v", page.Trash)
+	}
+
+	a.reject("carol", trash, vars, "NOT_FOUND")
+	a.reject("carol", `mutation($v: ID!) { restoreSurvivor(id: $v) { id } }`, vars, "FORBIDDEN")
+	a.run("bob", `mutation($v: ID!) { restoreSurvivor(id: $v) { id } }`, vars, nil)
+	a.run("alice", populationQuery, vars, &pop)
+	if len(pop.Settlement.Population) != 2 {
+		t.Fatalf("population after restore %+v", pop.Settlement.Population)
+	}
+}
+
+func TestTrashSettlement(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+	a.addCollaborator("alice", settlementID, "bob", "editor")
+	vars := map[string]any{"s": settlementID}
+	if err := a.client.Settlement.DeleteOneID(settlementID).Exec(viewer("alice")); err != nil {
+		t.Fatal(err)
+	}
+
+	var list struct{ Settlements []struct{ ID string } }
+	a.run("alice", `{ settlements { id } }`, nil, &list)
+	if len(list.Settlements) != 0 {
+		t.Fatalf("deleted settlement still listed %+v", list.Settlements)
+	}
+	var page trashPage
+	a.run("alice", trash, vars, &page)
+	if page.Trash.Settlement == nil || page.Trash.Settlement.ID != settlementID {
+		t.Fatalf("trash %+v", page.Trash)
+	}
+
+	restore := `mutation($s: ID!) { restoreSettlement(id: $s) { id } }`
+	a.reject("bob", restore, vars, "FORBIDDEN")
+	a.run("alice", restore, vars, nil)
+	a.run("alice", `{ settlements { id } }`, nil, &list)
+	if len(list.Settlements) != 1 {
+		t.Fatalf("restored settlement not listed %+v", list.Settlements)
+	}
+}
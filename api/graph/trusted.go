@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/failuretoload/datamonster/auth"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const errOperationNotTrusted = "OPERATION_NOT_TRUSTED"
+
+// untrustedFields are the root fields any caller may select in documents
+// outside the manifest: the public ones, and the ones users manage their
+// access tokens with, since access token callers may run any document.
+var untrustedFields = append([]string{"accessTokens", "createAccessToken", "revokeAccessToken"}, PublicFields...)
+
+// TrustedDocuments is the manifest of operations the server runs in trusted
+// documents mode. It maps the sha256 hash of each document to its text, the
+// format graphql-codegen writes with persistedDocuments enabled. The manifest
+// only covers the web client: callers with a personal access token, and
+// documents that only select untrustedFields, are let through.
+//
+// Register it as the cache of the persisted query extension so clients can
+// send hashes, and as an extension after it so any other document is rejected.
+type TrustedDocuments struct {
+	documents map[string]string
+}
+
+var _ interface {
+	graphql.Cache
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = &TrustedDocuments{}
+
+// LoadTrustedDocuments reads a manifest, checking that every hash matches its document.
+func LoadTrustedDocuments(path string) (*TrustedDocuments, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading trusted documents: %w", err)
+	}
+	documents := map[string]string{}
+	if err := json.Unmarshal(data, &documents); err != nil {
+		return nil, fmt.Errorf("parsing trusted documents %s: %w", path, err)
+	}
+	for hash, document := range documents {
+		if documentHash(document) != hash {
+			return nil, fmt.Errorf("trusted document %s doesn't match its sha256 hash", hash)
+		}
+	}
+	return &TrustedDocuments{documents: documents}, nil
+}
+
+func (t *TrustedDocuments) Get(_ context.Context, hash string) (any, bool) {
+	document, ok := t.documents[hash]
+	return document, ok
+}
+
+// Add ignores documents clients register, the manifest only changes on deploy.
+func (t *TrustedDocuments) Add(context.Context, string, any) {}
+
+func (t *TrustedDocuments) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+func (t *TrustedDocuments) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters rejects documents that aren't in the manifest.
+// Documents sent by hash were already filled in by the persisted query extension.
+func (t *TrustedDocuments) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	if _, ok := t.documents[documentHash(params.Query)]; ok {
+		return nil
+	}
+	if auth.ViaAccessToken(ctx) || untrusted(params.Query) {
+		return nil
+	}
+	err := gqlerror.Errorf("operation is not a trusted document")
+	errcode.Set(err, errOperationNotTrusted)
+	return err
+}
+
+// untrusted reports whether every operation in document only selects
+// untrustedFields at its root. Documents that don't parse are left to be
+// rejected.
+func untrusted(document string) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: document})
+	if err != nil || len(doc.Operations) == 0 {
+		return false
+	}
+	var allowed func(set ast.SelectionSet, spread map[string]bool) bool
+	allowed = func(set ast.SelectionSet, spread map[string]bool) bool {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				if sel.Name != "__typename" && !slices.Contains(untrustedFields, sel.Name) {
+					return false
+				}
+			case *ast.InlineFragment:
+				if !allowed(sel.SelectionSet, spread) {
+					return false
+				}
+			case *ast.FragmentSpread:
+				fragment := doc.Fragments.ForName(sel.Name)
+				if fragment == nil || spread[sel.Name] {
+					return false
+				}
+				spread[sel.Name] = true
+				if !allowed(fragment.SelectionSet, spread) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Subscription || !allowed(op.SelectionSet, map[string]bool{}) {
+			return false
+		}
+	}
+	return true
+}
+
+func documentHash(document string) string {
+	sum := sha256.Sum256([]byte(document))
+	return hex.EncodeToString(sum[:])
+}
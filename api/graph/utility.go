@@ -1,11 +1,45 @@
 package graph
 
 import (
+	"context"
+	"time"
+
 	"entgo.io/ent/dialect/sql"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/config"
 	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/membership"
 	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/graph/model"
+)
+
+const (
+	defaultInviteTTL = 7 * 24 * time.Hour
+	invitePrefix     = "dmi_"
+	sharePrefix      = "dms_"
+)
+
+// PublicFields are the root fields callers may use without signing in.
+var PublicFields = []string{"publicSettlement"}
+
+var (
+	errNoOwner           = apperr.Unauthenticated("user id missing from context")
+	errInvalidInvite     = apperr.BadUserInput("invite code is invalid, expired or used up")
+	errInvalidShare      = apperr.NotFound("spectator link is invalid or has been revoked")
+	errUnconfirmedDelete = apperr.BadUserInput("confirmName must match the settlement's name")
+	errAccessTokenCaller = apperr.Forbidden("access tokens can't be managed with an access token, sign in instead")
+	errLanternHoard      = apperr.BadUserInput("the Lantern Hoard can't be demolished, every settlement is founded around one")
 )
 
+// ownerFromContext returns the authenticated user that every query entry point is scoped to.
+func ownerFromContext(ctx context.Context) (string, error) {
+	owner, ok := config.UserID(ctx)
+	if !ok {
+		return "", errNoOwner
+	}
+	return owner, nil
+}
+
 func survivorOrderFunc(order *ent.SurvivorOrder) func(opts ...sql.OrderTermOption) survivor.OrderOption {
 	switch order.Field.String() {
 	case "BORN":
@@ -45,3 +79,61 @@ func survivorOrderFunc(order *ent.SurvivorOrder) func(opts ...sql.OrderTermOptio
 	}
 	return survivor.ByName
 }
+
+// nonEmpty returns nil for an empty string, so it is reported as null.
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// collaborator looks up userID's membership in the settlement.
+func collaborator(ctx context.Context, c *ent.Client, settlementID int, userID string) (*ent.Membership, error) {
+	return c.Membership.Query().
+		Where(membership.SettlementID(settlementID), membership.UserID(userID)).
+		Only(ctx)
+}
+
+// publicSettlement builds the redacted spectator view of a settlement.
+func publicSettlement(s *ent.Settlement, population []*ent.Survivor, timeline []*ent.TimelineEvent) *model.PublicSettlement {
+	survivors := make([]*model.PublicSurvivor, len(population))
+	for i, sv := range population {
+		survivors[i] = &model.PublicSurvivor{
+			Name:             sv.Name,
+			Born:             sv.Born,
+			Gender:           sv.Gender,
+			Huntxp:           sv.Huntxp,
+			Survival:         sv.Survival,
+			Movement:         sv.Movement,
+			Accuracy:         sv.Accuracy,
+			Strength:         sv.Strength,
+			Evasion:          sv.Evasion,
+			Luck:             sv.Luck,
+			Speed:            sv.Speed,
+			Insanity:         sv.Insanity,
+			Courage:          sv.Courage,
+			Understanding:    sv.Understanding,
+			Status:           sv.Status,
+			StatusChangeYear: sv.StatusChangeYear,
+		}
+	}
+	events := make([]*model.PublicTimelineEvent, len(timeline))
+	for i, e := range timeline {
+		events[i] = &model.PublicTimelineEvent{
+			Year:      e.Year,
+			Type:      e.Type,
+			Name:      e.Name,
+			Completed: e.Completed,
+		}
+	}
+	return &model.PublicSettlement{
+		Name:                s.Name,
+		SurvivalLimit:       s.SurvivalLimit,
+		DepartingSurvival:   s.DepartingSurvival,
+		CollectiveCognition: s.CollectiveCognition,
+		CurrentYear:         s.CurrentYear,
+		Population:          survivors,
+		Timeline:            events,
+	}
+}
@@ -0,0 +1,52 @@
+package graph_test
+
+import (
+	"reflect"
+	"testing"
+)
+
+const createSurvivor = `mutation($i: CreateSurvivorInput!) { createSurvivor(input: $i) { id } }`
+
+func TestValidationErrors(t *testing.T) {
+	a := newAPI(t)
+	settlementID, _ := a.createSettlement("alice", "Lantern Hollow")
+
+	for name, tc := range map[string]struct {
+		query string
+		vars  map[string]any
+		want  map[string]any
+	}{
+		"nested input": {
+			query: `mutation { createSettlement(input: {owner: "alice", name: "Dusk", createSurvivors: [{name: "Zed"}, {name: "Ann", born: 40}]}) { id } }`,
+			want:  map[string]any{"field": "born", "rule": "max", "limit": 35.0, "path": []any{"input", "createSurvivors", 1.0, "born"}},
+		},
+		"empty name": {
+			query: createSurvivor,
+			vars:  map[string]any{"i": map[string]any{"name": "", "settlementID": settlementID}},
+			want:  map[string]any{"field": "name", "rule": "minLength", "limit": 1.0, "path": []any{"input", "name"}},
+		},
+		"below minimum": {
+			query: createSurvivor,
+			vars:  map[string]any{"i": map[string]any{"name": "Zed", "movement": -30, "settlementID": settlementID}},
+			want:  map[string]any{"field": "movement", "rule": "min", "limit": -20.0, "path": []any{"input", "movement"}},
+		},
+		"renamed field": {
+			query: `mutation($s: ID!, $y: Int) { updateSettlement(id: $s, input: {currentyear: $y}) { id } }`,
+			vars:  map[string]any{"s": settlementID, "y": 99},
+			want:  map[string]any{"field": "currentYear", "rule": "max", "limit": 35.0, "path": []any{"input", "currentyear"}},
+		},
+	} {
+		res := a.reject("alice", tc.query, tc.vars, "INVALID_FIELD")
+		for key, want := range tc.want {
+			if got := res.Errors[0].Extensions[key]; !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: %s is %v, want %v", name, key, got, want)
+			}
+		}
+	}
+
+	addBob := `mutation($s: ID!) { addCollaborator(settlementID: $s, userID: "bob", role: editor) { id } }`
+	vars := map[string]any{"s": settlementID}
+	a.run("alice", addBob, vars, nil)
+	a.reject("alice", addBob, vars, "CONFLICT")
+	a.reject("alice", `{ node(id: 12345) { id } }`, nil, "NOT_FOUND")
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often Memory drops buckets that have refilled.
+const sweepInterval = time.Minute
+
+// Memory is an in-process Store. Buckets are lost on restart and not shared
+// between replicas.
+type Memory struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   Limit
+}
+
+func NewMemory() *Memory {
+	return &Memory{buckets: map[string]*bucket{}}
+}
+
+func (m *Memory) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	m.sweep(now)
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updated: now, limit: limit}
+		m.buckets[key] = b
+	}
+	allowed, retryAfter := b.check(now)
+	if allowed {
+		b.tokens--
+	}
+	return allowed, retryAfter, nil
+}
+
+func (m *Memory) Peek(_ context.Context, key string, _ Limit) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b, ok := m.buckets[key]
+	if !ok {
+		return true, 0, nil
+	}
+	allowed, retryAfter := b.check(time.Now())
+	return allowed, retryAfter, nil
+}
+
+// sweep drops full buckets, a new bucket behaves the same and the map stays
+// bounded by the number of recent callers.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Requests) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+// check refills the bucket and reports whether it holds a token, or how long
+// until it does.
+func (b *bucket) check(now time.Time) (bool, time.Duration) {
+	b.refill(now)
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / b.rate() * float64(time.Second))
+	}
+	return true, 0
+}
+
+// rate is the number of tokens added per second.
+func (b *bucket) rate() float64 {
+	return float64(b.limit.Requests) / b.limit.Per.Seconds()
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	b.tokens = min(float64(b.limit.Requests), b.tokens+elapsed*b.rate())
+	b.updated = now
+}
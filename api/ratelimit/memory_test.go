@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryTake(t *testing.T) {
+	m := NewMemory()
+	limit := Limit{Requests: 2, Per: time.Minute}
+	for i := 0; i < 2; i++ {
+		if ok, _, _ := m.Take(context.Background(), "alice", limit); !ok {
+			t.Fatalf("take %d refused within the burst", i+1)
+		}
+	}
+	ok, retryAfter, err := m.Take(context.Background(), "alice", limit)
+	if ok || err != nil {
+		t.Fatalf("take 3: %v %v", ok, err)
+	}
+	// Two tokens a minute refill one every 30 seconds.
+	if retryAfter <= 29*time.Second || retryAfter > 30*time.Second {
+		t.Errorf("retry after %s, want about 30s", retryAfter)
+	}
+	if ok, _, _ := m.Take(context.Background(), "bob", limit); !ok {
+		t.Error("bob shares alice's bucket")
+	}
+}
+
+func TestMemoryRefill(t *testing.T) {
+	m := NewMemory()
+	limit := Limit{Requests: 1, Per: 20 * time.Millisecond}
+	if ok, _, _ := m.Take(context.Background(), "alice", limit); !ok {
+		t.Fatal("first take refused")
+	}
+	if ok, _, _ := m.Take(context.Background(), "alice", limit); ok {
+		t.Fatal("second take allowed before the refill")
+	}
+	time.Sleep(25 * time.Millisecond)
+	if ok, _, _ := m.Take(context.Background(), "alice", limit); !ok {
+		t.Fatal("take refused after the refill")
+	}
+}
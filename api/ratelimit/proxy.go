@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ParseProxies parses the addresses of the proxies allowed to report the
+// client address, each an IP or a CIDR range.
+func ParseProxies(values []string) ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		if addr, err := netip.ParseAddr(value); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is neither an IP nor a CIDR range", value)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+// RealIP replaces the request's remote address with the client address from
+// X-Forwarded-For or X-Real-IP, but only when the request comes from one of
+// proxies. Anyone else could put any address in those headers, so their
+// requests keep the address they connected from. Of the X-Forwarded-For
+// entries, the last one that isn't a proxy is the client, the ones before it
+// were supplied by the client.
+func RealIP(proxies []netip.Prefix) func(http.Handler) http.Handler {
+	trusted := func(value string) bool {
+		addr, err := netip.ParseAddr(strings.TrimSpace(value))
+		if err != nil {
+			return false
+		}
+		for _, p := range proxies {
+			if p.Contains(addr.Unmap()) {
+				return true
+			}
+		}
+		return false
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !trusted(clientIP(r)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			client := ""
+			if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+				hops := strings.Split(strings.Join(forwarded, ","), ",")
+				for i := len(hops) - 1; i >= 0; i-- {
+					hop := strings.TrimSpace(hops[i])
+					if _, err := netip.ParseAddr(hop); err != nil {
+						break
+					}
+					client = hop
+					if !trusted(hop) {
+						break
+					}
+				}
+			} else if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+				client = realIP
+			}
+			if client != "" {
+				r.RemoteAddr = client
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
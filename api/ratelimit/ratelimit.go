@@ -0,0 +1,144 @@
+// Package ratelimit throttles GraphQL operations with token buckets. Each
+// caller has one bucket for queries and one for mutations, keyed by user id
+// or, for anonymous requests, by client IP. Failed authentications draw from a
+// third bucket per client IP.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Limit allows Requests operations per Per, refilled continuously. A bucket
+// holds at most Requests tokens, so that is also the largest burst.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Disabled reports whether the limit lets everything through.
+func (l Limit) Disabled() bool {
+	return l.Requests <= 0 || l.Per <= 0
+}
+
+// Store keeps the token buckets. Memory keeps them in the process; a shared
+// store lets several API replicas enforce a single budget.
+type Store interface {
+	// Take removes a token from the bucket at key. When the bucket is empty it
+	// reports false and how long until the next token is available.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+	// Peek reports what Take would, without removing a token.
+	Peek(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// Limiter applies the query, mutation and failed authentication budgets.
+type Limiter struct {
+	store     Store
+	queries   Limit
+	mutations Limit
+	failures  Limit
+}
+
+func New(store Store, queries, mutations, failures Limit) *Limiter {
+	return &Limiter{store: store, queries: queries, mutations: mutations, failures: failures}
+}
+
+type callerKey struct{}
+
+type caller struct {
+	key string
+	w   http.ResponseWriter
+}
+
+// Middleware records who is calling so AroundOperations can charge them. Mount
+// it after auth.Middleware so authenticated users are keyed by their id.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := "ip:" + clientIP(r)
+		if userID, ok := config.UserID(r.Context()); ok {
+			key = "user:" + userID
+		}
+		ctx := context.WithValue(r.Context(), callerKey{}, caller{key: key, w: w})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Authentication charges responses with a 401 to the client IP, and turns
+// clients that used up that budget away with a 429 before their credentials
+// are checked, so guessing tokens can't run up calls to the auth provider.
+// Mount it before apperr.Status and auth.Middleware.
+func (l *Limiter) Authentication(next http.Handler) http.Handler {
+	if l.failures.Disabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := "auth:ip:" + clientIP(r)
+		allowed, retryAfter, err := l.store.Peek(r.Context(), key, l.failures)
+		if err != nil {
+			log.Println("rate limiting", err)
+		} else if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			apperr.Write(w, apperr.RateLimited(fmt.Sprintf("too many failed authentications, retry in %ds", seconds)))
+			return
+		}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+		if ww.Status() == http.StatusUnauthorized {
+			if _, _, err := l.store.Take(r.Context(), key, l.failures); err != nil {
+				log.Println("rate limiting", err)
+			}
+		}
+	})
+}
+
+// AroundOperations is a gqlgen operation interceptor that rejects operations
+// over budget with a 429 and a Retry-After header. Operations are let through
+// if the store fails, throttling is not worth an outage.
+func (l *Limiter) AroundOperations(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	c, ok := ctx.Value(callerKey{}).(caller)
+	oc := graphql.GetOperationContext(ctx)
+	if !ok || oc.Operation == nil {
+		return next(ctx)
+	}
+	kind, limit := "query", l.queries
+	if oc.Operation.Operation == ast.Mutation {
+		kind, limit = "mutation", l.mutations
+	}
+	if limit.Disabled() {
+		return next(ctx)
+	}
+	allowed, retryAfter, err := l.store.Take(ctx, kind+":"+c.key, limit)
+	if err != nil {
+		log.Println("rate limiting", err)
+		return next(ctx)
+	}
+	if !allowed {
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		c.w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		return apperr.Reject(ctx, apperr.RateLimited(fmt.Sprintf("too many %s operations, retry in %ds", kind, seconds)))
+	}
+	return next(ctx)
+}
+
+// clientIP returns the request's address without the port. Mount RealIP
+// first when the API runs behind a proxy.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
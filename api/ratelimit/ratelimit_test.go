@@ -0,0 +1,149 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	l := ratelimit.New(ratelimit.NewMemory(),
+		ratelimit.Limit{Requests: 2, Per: time.Minute},
+		ratelimit.Limit{Requests: 1, Per: time.Minute},
+		ratelimit.Limit{},
+	)
+	srv.AroundOperations(l.AroundOperations)
+	h := l.Middleware(srv)
+
+	post := func(userID, ip, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"`+query+`"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = ip + ":1234"
+		if userID != "" {
+			req = req.WithContext(context.WithValue(req.Context(), config.UserIDKey, userID))
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+	limited := func(rec *httptest.ResponseRecorder) bool {
+		return strings.Contains(rec.Body.String(), `"RATE_LIMITED"`)
+	}
+
+	for i := 0; i < 2; i++ {
+		if rec := post("alice", "10.0.0.1", "{ name }"); limited(rec) {
+			t.Fatalf("query %d limited: %s", i+1, rec.Body.String())
+		}
+	}
+	rec := post("alice", "10.0.0.1", "{ name }")
+	if !limited(rec) || rec.Header().Get("Retry-After") != "30" {
+		t.Fatalf("third query: %s, Retry-After %q", rec.Body.String(), rec.Header().Get("Retry-After"))
+	}
+	// Mutations have their own budget.
+	if rec := post("alice", "10.0.0.1", "mutation { name }"); limited(rec) {
+		t.Fatalf("mutation limited: %s", rec.Body.String())
+	}
+	if rec := post("alice", "10.0.0.1", "mutation { name }"); !limited(rec) {
+		t.Fatalf("second mutation allowed: %s", rec.Body.String())
+	}
+	// Users are keyed by id, anonymous callers by IP.
+	if rec := post("bob", "10.0.0.1", "{ name }"); limited(rec) {
+		t.Fatalf("bob limited by alice's budget: %s", rec.Body.String())
+	}
+	post("", "10.0.0.2", "{ name }")
+	post("", "10.0.0.2", "{ name }")
+	if rec := post("", "10.0.0.2", "{ name }"); !limited(rec) {
+		t.Fatalf("anonymous caller not limited: %s", rec.Body.String())
+	}
+	if rec := post("", "10.0.0.3", "{ name }"); limited(rec) {
+		t.Fatalf("anonymous caller limited by another IP: %s", rec.Body.String())
+	}
+}
+
+// TestAuthentication checks that failed authentications are charged to the
+// client IP and that clients over budget are turned away before the
+// authenticator sees their credentials.
+func TestAuthentication(t *testing.T) {
+	l := ratelimit.New(ratelimit.NewMemory(), ratelimit.Limit{}, ratelimit.Limit{}, ratelimit.Limit{Requests: 2, Per: time.Minute})
+	checked := 0
+	h := l.Authentication(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checked++
+		if r.Header.Get("Authorization") != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	get := func(ip, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 5; i++ {
+		if rec := get("10.0.0.1", "valid"); rec.Code != http.StatusOK {
+			t.Fatalf("valid token %d: status %d", i+1, rec.Code)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if rec := get("10.0.0.1", "guess"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("guess %d: status %d", i+1, rec.Code)
+		}
+	}
+	checked = 0
+	rec := get("10.0.0.1", "guess")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "30" || checked != 0 {
+		t.Fatalf("third guess: status %d, Retry-After %q, checked %d times", rec.Code, rec.Header().Get("Retry-After"), checked)
+	}
+	if rec := get("10.0.0.2", "guess"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("another IP: status %d", rec.Code)
+	}
+}
+
+func TestRealIP(t *testing.T) {
+	proxies, err := ratelimit.ParseProxies([]string{"127.0.0.1", "10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ratelimit.ParseProxies([]string{"proxy.internal"}); err == nil {
+		t.Error("parsed a host name as a proxy")
+	}
+	var got string
+	h := ratelimit.RealIP(proxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.RemoteAddr
+	}))
+	for name, tc := range map[string]struct {
+		remote, forwardedFor, realIP, want string
+	}{
+		"direct client":             {remote: "203.0.113.5:1234", forwardedFor: "198.51.100.1", want: "203.0.113.5:1234"},
+		"through a proxy":           {remote: "127.0.0.1:1234", forwardedFor: "198.51.100.1", want: "198.51.100.1"},
+		"spoofed through a proxy":   {remote: "127.0.0.1:1234", forwardedFor: "198.51.100.1, 203.0.113.5", want: "203.0.113.5"},
+		"through two proxies":       {remote: "127.0.0.1:1234", forwardedFor: "198.51.100.1, 203.0.113.5, 10.1.2.3", want: "203.0.113.5"},
+		"X-Real-IP through a proxy": {remote: "10.1.2.3:1234", realIP: "198.51.100.2", want: "198.51.100.2"},
+		"proxy without headers":     {remote: "127.0.0.1:1234", want: "127.0.0.1:1234"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tc.remote
+		if tc.forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", tc.forwardedFor)
+		}
+		if tc.realIP != "" {
+			req.Header.Set("X-Real-IP", tc.realIP)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		if got != tc.want {
+			t.Errorf("%s: remote address %q, want %q", name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/failuretoload/datamonster/ent/membership"
+)
+
+func TestMembershipRoles(t *testing.T) {
+	client := open(t)
+	alice, bob := viewer("alice"), viewer("bob")
+	s, err := client.Settlement.Create().SetOwner("alice").SetName("Lantern Hollow").Save(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Membership.Query().CountX(alice); n != 1 {
+		t.Fatalf("the owner has %d memberships, want 1", n)
+	}
+	sv, err := client.Survivor.Create().SetName("Zachary").SetSettlementID(s.ID).Save(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := client.Membership.Create().SetSettlementID(s.ID).SetUserID("bob").SetRole(membership.RoleViewer).Save(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Settlement.Query().CountX(bob); n != 1 {
+		t.Errorf("a viewer sees %d settlements, want 1", n)
+	}
+	if n := client.Survivor.Query().CountX(bob); n != 1 {
+		t.Errorf("a viewer sees %d survivors, want 1", n)
+	}
+	if _, err := client.Survivor.UpdateOneID(sv.ID).SetName("Renamed").Save(bob); err == nil {
+		t.Error("a viewer edited a survivor")
+	}
+	if _, err := client.Membership.Create().SetSettlementID(s.ID).SetUserID("carol").Save(bob); err == nil {
+		t.Error("a viewer added a member")
+	}
+
+	if _, err := client.Membership.UpdateOne(m).SetRole(membership.RoleEditor).Save(alice); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Survivor.UpdateOneID(sv.ID).SetName("Renamed").Save(bob); err != nil {
+		t.Errorf("an editor can't edit a survivor: %v", err)
+	}
+	if _, err := client.Settlement.UpdateOneID(s.ID).SetName("Renamed").Save(bob); err != nil {
+		t.Errorf("an editor can't edit the settlement: %v", err)
+	}
+	if err := client.Settlement.DeleteOneID(s.ID).Exec(bob); err == nil {
+		t.Error("an editor deleted the settlement")
+	}
+
+	owner := client.Membership.Query().Where(membership.UserID("alice")).OnlyX(alice)
+	if _, err := client.Membership.UpdateOne(owner).SetRole(membership.RoleViewer).Save(alice); err == nil {
+		t.Error("the owner demoted themselves")
+	}
+	if err := client.Membership.DeleteOne(m).Exec(alice); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.Settlement.Query().CountX(bob); n != 0 {
+		t.Errorf("a removed member sees %d settlements", n)
+	}
+}
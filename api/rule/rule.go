@@ -0,0 +1,544 @@
+// Package rule holds the privacy rules shared by the ent schemas. Every rule
+// resolves the caller from config.UserIDKey, so a settlement and its population
+// are only reachable by the settlement's members, and only editable by members
+// whose role allows it.
+package rule
+
+import (
+	"context"
+
+	"github.com/failuretoload/datamonster/apperr"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/accesstoken"
+	"github.com/failuretoload/datamonster/ent/auditlog"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/predicate"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/privacy"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// DenyIfNoViewer rejects any query or mutation made without an authenticated user.
+func DenyIfNoViewer() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if _, ok := config.UserID(ctx); !ok {
+			return &apperr.Error{
+				Code:    apperr.CodeUnauthenticated,
+				Message: "viewer is missing from context",
+				Err:     privacy.Deny,
+			}
+		}
+		return privacy.Skip
+	})
+}
+
+// MemberOf matches the settlements userID can see. The settlement's owner
+// field always counts, so campaigns created before memberships existed stay
+// reachable.
+func MemberOf(userID string) predicate.Settlement {
+	return HasRole(userID, membership.RoleViewer)
+}
+
+// HasRole matches the settlements where userID holds role or a stronger one.
+// Settlements in the trash never match: edges to a settlement skip its soft
+// delete interceptor, and the trash must not be readable or editable through
+// the records it holds.
+func HasRole(userID string, role membership.Role) predicate.Settlement {
+	return settlement.And(settlement.DeletedAtIsNil(), hasRole(userID, role))
+}
+
+// hasRole is HasRole including the settlements in the trash.
+func hasRole(userID string, role membership.Role) predicate.Settlement {
+	roles := []membership.Role{membership.RoleOwner}
+	switch role {
+	case membership.RoleViewer:
+		roles = append(roles, membership.RoleEditor, membership.RoleViewer)
+	case membership.RoleEditor:
+		roles = append(roles, membership.RoleEditor)
+	}
+	return settlement.Or(
+		settlement.Owner(userID),
+		settlement.HasMembersWith(membership.UserID(userID), membership.RoleIn(roles...)),
+	)
+}
+
+// FilterSettlementMember limits settlement queries to the caller's settlements.
+// The soft delete interceptor decides whether the trash is included.
+func FilterSettlementMember() privacy.QueryRule {
+	return privacy.SettlementQueryRuleFunc(func(ctx context.Context, q *ent.SettlementQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(hasRole(userID, membership.RoleViewer))
+		return privacy.Skip
+	})
+}
+
+// FilterSurvivorMember limits survivor queries to survivors living in the caller's settlements.
+func FilterSurvivorMember() privacy.QueryRule {
+	return privacy.SurvivorQueryRuleFunc(func(ctx context.Context, q *ent.SurvivorQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(survivor.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterMembershipMember limits membership queries to the caller's settlements.
+func FilterMembershipMember() privacy.QueryRule {
+	return privacy.MembershipQueryRuleFunc(func(ctx context.Context, q *ent.MembershipQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(membership.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterInviteOwner limits invite queries to settlements the caller owns.
+func FilterInviteOwner() privacy.QueryRule {
+	return privacy.InviteQueryRuleFunc(func(ctx context.Context, q *ent.InviteQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(invite.HasSettlementWith(HasRole(userID, membership.RoleOwner)))
+		return privacy.Skip
+	})
+}
+
+// FilterAccessTokenOwner limits access token queries to the caller's tokens.
+func FilterAccessTokenOwner() privacy.QueryRule {
+	return privacy.AccessTokenQueryRuleFunc(func(ctx context.Context, q *ent.AccessTokenQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(accesstoken.UserID(userID))
+		return privacy.Skip
+	})
+}
+
+// FilterAuditLogMember limits audit log queries to the caller's settlements.
+func FilterAuditLogMember() privacy.QueryRule {
+	return privacy.AuditLogQueryRuleFunc(func(ctx context.Context, q *ent.AuditLogQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(auditlog.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterTimelineMember limits timeline queries to the caller's settlements.
+func FilterTimelineMember() privacy.QueryRule {
+	return privacy.TimelineEventQueryRuleFunc(func(ctx context.Context, q *ent.TimelineEventQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(timelineevent.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterStorageMember limits storage queries to the caller's settlements.
+func FilterStorageMember() privacy.QueryRule {
+	return privacy.StorageItemQueryRuleFunc(func(ctx context.Context, q *ent.StorageItemQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(storageitem.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterInnovationMember limits innovation queries to the caller's settlements.
+func FilterInnovationMember() privacy.QueryRule {
+	return privacy.InnovationQueryRuleFunc(func(ctx context.Context, q *ent.InnovationQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(innovation.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterPrincipleMember limits principle queries to the caller's settlements.
+func FilterPrincipleMember() privacy.QueryRule {
+	return privacy.PrincipleQueryRuleFunc(func(ctx context.Context, q *ent.PrincipleQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(principle.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterMilestoneMember limits milestone queries to the caller's settlements.
+func FilterMilestoneMember() privacy.QueryRule {
+	return privacy.MilestoneQueryRuleFunc(func(ctx context.Context, q *ent.MilestoneQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(milestone.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// FilterMonsterProgressMember limits monster progress queries to the caller's settlements.
+func FilterMonsterProgressMember() privacy.QueryRule {
+	return privacy.MonsterProgressQueryRuleFunc(func(ctx context.Context, q *ent.MonsterProgressQuery) error {
+		userID, _ := config.UserID(ctx)
+		q.Where(monsterprogress.HasSettlementWith(MemberOf(userID)))
+		return privacy.Skip
+	})
+}
+
+// AllowIfSettlementEditor allows creating settlements for the caller, editing
+// them as an editor and deleting, restoring or sharing them as an owner.
+// Survivors moved into the settlement must be editable by the caller as well.
+func AllowIfSettlementEditor() privacy.MutationRule {
+	return privacy.SettlementMutationRuleFunc(func(ctx context.Context, m *ent.SettlementMutation) error {
+		userID, _ := config.UserID(ctx)
+		if owner, ok := m.Owner(); ok && owner != userID {
+			return privacy.Denyf("settlement must be owned by the caller")
+		}
+		role := membership.RoleEditor
+		if ownerOnly(m) {
+			role = membership.RoleOwner
+		}
+		switch {
+		case m.Op().Is(ent.OpCreate):
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, ok := m.ID()
+			if !ok {
+				return privacy.Denyf("settlement id is missing")
+			}
+			// Only restoring a settlement reaches into the trash.
+			allowed := HasRole(userID, role)
+			if m.DeletedAtCleared() {
+				allowed = hasRole(userID, role)
+			}
+			if err := checkSettlement(ctx, m.Client(), id, role, allowed); err != nil {
+				return err
+			}
+		default:
+			m.Where(HasRole(userID, role))
+		}
+		if err := checkSurvivors(ctx, m.Client(), userID, m.PopulationIDs()); err != nil {
+			return err
+		}
+		return privacy.Allow
+	})
+}
+
+// ownerOnly reports whether the settlement mutation needs the owner role.
+// Moving a settlement to the trash and restoring it count as deleting it.
+func ownerOnly(m *ent.SettlementMutation) bool {
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		return true
+	}
+	_, owner := m.Owner()
+	_, shared := m.ShareTokenHash()
+	_, deleted := m.DeletedAt()
+	return owner || shared || m.ShareTokenHashCleared() || deleted || m.DeletedAtCleared()
+}
+
+// AllowIfSurvivorEditor allows survivor mutations when the caller can edit both
+// the survivor's current settlement and any settlement it is moved to.
+func AllowIfSurvivorEditor() privacy.MutationRule {
+	return privacy.SurvivorMutationRuleFunc(func(ctx context.Context, m *ent.SurvivorMutation) error {
+		userID, _ := config.UserID(ctx)
+		switch {
+		case m.Op().Is(ent.OpCreate):
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, ok := m.ID()
+			if !ok {
+				return privacy.Denyf("survivor id is missing")
+			}
+			editable, err := m.Client().Survivor.Query().
+				Where(survivor.ID(id), survivor.HasSettlementWith(HasRole(userID, membership.RoleEditor))).
+				Exist(Bypass(ctx))
+			if err != nil {
+				return err
+			}
+			if !editable {
+				return privacy.Denyf("survivor %d is not editable by the caller", id)
+			}
+		default:
+			m.Where(survivor.HasSettlementWith(HasRole(userID, membership.RoleEditor)))
+		}
+		if id, ok := m.SettlementID(); ok {
+			if err := CheckSettlementRole(ctx, m.Client(), userID, id, membership.RoleEditor); err != nil {
+				return err
+			}
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfMembershipOwner allows settlement owners to manage collaborators. The
+// user recorded as the settlement's owner can't be demoted or removed.
+func AllowIfMembershipOwner() privacy.MutationRule {
+	return privacy.MembershipMutationRuleFunc(func(ctx context.Context, m *ent.MembershipMutation) error {
+		userID, _ := config.UserID(ctx)
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			id, ok := m.SettlementID()
+			if !ok {
+				return privacy.Denyf("settlement id is missing")
+			}
+			if err := CheckSettlementRole(ctx, m.Client(), userID, id, membership.RoleOwner); err != nil {
+				return err
+			}
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, ok := m.ID()
+			if !ok {
+				return privacy.Denyf("membership id is missing")
+			}
+			if _, ok := m.SettlementID(); ok {
+				return privacy.Denyf("memberships can't move between settlements")
+			}
+			current, err := m.Client().Membership.Query().
+				Where(membership.ID(id)).
+				WithSettlement().
+				Only(Bypass(ctx))
+			if err != nil {
+				return err
+			}
+			if current.UserID == current.Edges.Settlement.Owner {
+				return privacy.Denyf("the settlement owner can't be changed")
+			}
+			if err := CheckSettlementRole(ctx, m.Client(), userID, current.SettlementID, membership.RoleOwner); err != nil {
+				return err
+			}
+		default:
+			m.Where(membership.HasSettlementWith(HasRole(userID, membership.RoleOwner)))
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfInviteOwner allows settlement owners to issue and revoke invites.
+func AllowIfInviteOwner() privacy.MutationRule {
+	return privacy.InviteMutationRuleFunc(func(ctx context.Context, m *ent.InviteMutation) error {
+		userID, _ := config.UserID(ctx)
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			id, ok := m.SettlementID()
+			if !ok {
+				return privacy.Denyf("settlement id is missing")
+			}
+			if err := CheckSettlementRole(ctx, m.Client(), userID, id, membership.RoleOwner); err != nil {
+				return err
+			}
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, ok := m.ID()
+			if !ok {
+				return privacy.Denyf("invite id is missing")
+			}
+			owned, err := m.Client().Invite.Query().
+				Where(invite.ID(id), invite.HasSettlementWith(HasRole(userID, membership.RoleOwner))).
+				Exist(Bypass(ctx))
+			if err != nil {
+				return err
+			}
+			if !owned {
+				return privacy.Denyf("invite %d is not managed by the caller", id)
+			}
+		default:
+			m.Where(invite.HasSettlementWith(HasRole(userID, membership.RoleOwner)))
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfAccessTokenOwner lets users issue and revoke only their own tokens.
+func AllowIfAccessTokenOwner() privacy.MutationRule {
+	return privacy.AccessTokenMutationRuleFunc(func(ctx context.Context, m *ent.AccessTokenMutation) error {
+		userID, _ := config.UserID(ctx)
+		switch {
+		case m.Op().Is(ent.OpCreate):
+			if owner, _ := m.UserID(); owner != userID {
+				return privacy.Denyf("access tokens must belong to the caller")
+			}
+		case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+			id, ok := m.ID()
+			if !ok {
+				return privacy.Denyf("access token id is missing")
+			}
+			owned, err := m.Client().AccessToken.Query().
+				Where(accesstoken.ID(id), accesstoken.UserID(userID)).
+				Exist(Bypass(ctx))
+			if err != nil {
+				return err
+			}
+			if !owned {
+				return privacy.Denyf("access token %d does not belong to the caller", id)
+			}
+		default:
+			m.Where(accesstoken.UserID(userID))
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfTimelineEditor allows editors to plan and update their settlements' timelines.
+func AllowIfTimelineEditor() privacy.MutationRule {
+	return privacy.TimelineEventMutationRuleFunc(func(ctx context.Context, m *ent.TimelineEventMutation) error {
+		stored := func(ctx context.Context, id int) (int, error) {
+			e, err := m.Client().TimelineEvent.Get(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return e.SettlementID, nil
+		}
+		restrict := func(p predicate.Settlement) {
+			m.Where(timelineevent.HasSettlementWith(p))
+		}
+		return checkSettlementRecord(ctx, m, stored, restrict)
+	})
+}
+
+// AllowIfStorageEditor allows editors to stock and draw from their settlements' storage.
+func AllowIfStorageEditor() privacy.MutationRule {
+	return privacy.StorageItemMutationRuleFunc(func(ctx context.Context, m *ent.StorageItemMutation) error {
+		stored := func(ctx context.Context, id int) (int, error) {
+			item, err := m.Client().StorageItem.Get(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return item.SettlementID, nil
+		}
+		restrict := func(p predicate.Settlement) {
+			m.Where(storageitem.HasSettlementWith(p))
+		}
+		return checkSettlementRecord(ctx, m, stored, restrict)
+	})
+}
+
+// AllowIfInnovationEditor allows editors to record their settlements' innovations.
+func AllowIfInnovationEditor() privacy.MutationRule {
+	return privacy.InnovationMutationRuleFunc(func(ctx context.Context, m *ent.InnovationMutation) error {
+		stored := func(ctx context.Context, id int) (int, error) {
+			i, err := m.Client().Innovation.Get(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return i.SettlementID, nil
+		}
+		restrict := func(p predicate.Settlement) {
+			m.Where(innovation.HasSettlementWith(p))
+		}
+		return checkSettlementRecord(ctx, m, stored, restrict)
+	})
+}
+
+// AllowIfPrincipleEditor allows editors to choose their settlements' principles.
+func AllowIfPrincipleEditor() privacy.MutationRule {
+	return privacy.PrincipleMutationRuleFunc(func(ctx context.Context, m *ent.PrincipleMutation) error {
+		stored := func(ctx context.Context, id int) (int, error) {
+			p, err := m.Client().Principle.Get(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return p.SettlementID, nil
+		}
+		restrict := func(p predicate.Settlement) {
+			m.Where(principle.HasSettlementWith(p))
+		}
+		return checkSettlementRecord(ctx, m, stored, restrict)
+	})
+}
+
+// AllowIfMonsterProgressEditor allows editors to record their settlements' hunts.
+func AllowIfMonsterProgressEditor() privacy.MutationRule {
+	return privacy.MonsterProgressMutationRuleFunc(func(ctx context.Context, m *ent.MonsterProgressMutation) error {
+		stored := func(ctx context.Context, id int) (int, error) {
+			p, err := m.Client().MonsterProgress.Get(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return p.SettlementID, nil
+		}
+		restrict := func(p predicate.Settlement) {
+			m.Where(monsterprogress.HasSettlementWith(p))
+		}
+		return checkSettlementRecord(ctx, m, stored, restrict)
+	})
+}
+
+// settlementRecord is the mutation of an entity that belongs to a settlement
+// through an immutable settlement_id.
+type settlementRecord interface {
+	ent.Mutation
+	ID() (int, bool)
+	SettlementID() (int, bool)
+	Client() *ent.Client
+}
+
+// checkSettlementRecord allows m when the caller can edit the settlement the
+// record belongs to. stored looks up the settlement of an existing record and
+// restrict narrows bulk mutations to the settlements the caller can edit.
+func checkSettlementRecord(ctx context.Context, m settlementRecord, stored func(context.Context, int) (int, error), restrict func(predicate.Settlement)) error {
+	userID, _ := config.UserID(ctx)
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		id, ok := m.SettlementID()
+		if !ok {
+			return privacy.Denyf("settlement id is missing")
+		}
+		if err := CheckSettlementRole(ctx, m.Client(), userID, id, membership.RoleEditor); err != nil {
+			return err
+		}
+	case m.Op().Is(ent.OpUpdateOne | ent.OpDeleteOne):
+		id, ok := m.ID()
+		if !ok {
+			return privacy.Denyf("%s id is missing", m.Type())
+		}
+		settlementID, err := stored(Bypass(ctx), id)
+		if err != nil {
+			return err
+		}
+		if err := CheckSettlementRole(ctx, m.Client(), userID, settlementID, membership.RoleEditor); err != nil {
+			return err
+		}
+	default:
+		restrict(HasRole(userID, membership.RoleEditor))
+	}
+	return privacy.Allow
+}
+
+// CheckSettlementRole denies unless the settlement exists and userID holds at
+// least role on it.
+func CheckSettlementRole(ctx context.Context, client *ent.Client, userID string, id int, role membership.Role) error {
+	return checkSettlement(ctx, client, id, role, HasRole(userID, role))
+}
+
+func checkSettlement(ctx context.Context, client *ent.Client, id int, role membership.Role, p predicate.Settlement) error {
+	allowed, err := client.Settlement.Query().
+		Where(settlement.ID(id), p).
+		Exist(Bypass(ctx))
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return privacy.Denyf("settlement %d requires the %s role", id, role)
+	}
+	return nil
+}
+
+// checkSurvivors denies if any of the survivors live in a settlement the caller
+// can't edit. Survivors without a settlement may be adopted, which is how
+// createSurvivors attaches a new population to a settlement.
+func checkSurvivors(ctx context.Context, client *ent.Client, userID string, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	foreign, err := client.Survivor.Query().
+		Where(
+			survivor.IDIn(ids...),
+			survivor.HasSettlement(),
+			survivor.Not(survivor.HasSettlementWith(HasRole(userID, membership.RoleEditor))),
+		).
+		Exist(Bypass(ctx))
+	if err != nil {
+		return err
+	}
+	if foreign {
+		return privacy.Denyf("survivors must belong to the caller")
+	}
+	return nil
+}
+
+// Bypass skips the privacy policies. The rules use it to read across owners
+// while they make a decision, resolvers for work the caller is entitled to but
+// can't see yet, such as redeeming an invite.
+func Bypass(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
@@ -0,0 +1,93 @@
+package rule_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/enttest"
+	"github.com/failuretoload/datamonster/ent/migrate"
+	_ "github.com/failuretoload/datamonster/ent/runtime"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var databases atomic.Int64
+
+func open(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:rule%d?mode=memory&cache=shared&_fk=1", databases.Add(1))
+	client := enttest.Open(t, dialect.SQLite, dsn, enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func viewer(userID string) context.Context {
+	return context.WithValue(context.Background(), config.UserIDKey, userID)
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	client := open(t)
+	alice, bob := viewer("alice"), viewer("bob")
+	s, err := client.Settlement.Create().SetOwner("alice").SetName("Lantern Hollow").Save(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sv, err := client.Survivor.Create().SetName("Zachary").SetSettlementID(s.ID).Save(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Settlement.Create().SetOwner("alice").SetName("Forged").Save(bob); err == nil {
+		t.Error("bob created a settlement owned by alice")
+	}
+	if _, err := client.Survivor.Create().SetName("Stowaway").SetSettlementID(s.ID).Save(bob); err == nil {
+		t.Error("bob added a survivor to alice's settlement")
+	}
+	if _, err := client.Survivor.UpdateOneID(sv.ID).SetName("Taken").Save(bob); err == nil {
+		t.Error("bob renamed alice's survivor")
+	}
+	if err := client.Survivor.DeleteOneID(sv.ID).Exec(bob); err == nil {
+		t.Error("bob deleted alice's survivor")
+	}
+	if _, err := client.Settlement.UpdateOneID(s.ID).SetName("Taken").Save(bob); err == nil {
+		t.Error("bob renamed alice's settlement")
+	}
+
+	if n := client.Settlement.Query().CountX(bob); n != 0 {
+		t.Errorf("bob sees %d settlements", n)
+	}
+	if n := client.Survivor.Query().CountX(bob); n != 0 {
+		t.Errorf("bob sees %d survivors", n)
+	}
+	if _, err := client.Noder(bob, sv.ID); err == nil {
+		t.Error("bob loaded alice's survivor as a node")
+	}
+	if n := client.Survivor.Query().CountX(alice); n != 1 {
+		t.Errorf("alice sees %d survivors, want 1", n)
+	}
+	if _, err := client.Noder(alice, sv.ID); err != nil {
+		t.Error(err)
+	}
+
+	theirs, err := client.Settlement.Create().SetOwner("bob").SetName("Bob's Rest").Save(bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Settlement.UpdateOneID(theirs.ID).AddPopulationIDs(sv.ID).Save(bob); err == nil {
+		t.Error("bob moved alice's survivor into their own settlement")
+	}
+}
+
+func TestNoViewer(t *testing.T) {
+	client := open(t)
+	if _, err := client.Settlement.Create().SetOwner("alice").SetName("Lantern Hollow").Save(context.Background()); err == nil {
+		t.Error("created a settlement without a viewer")
+	}
+	if _, err := client.Settlement.Query().All(context.Background()); err == nil {
+		t.Error("listed settlements without a viewer")
+	}
+}
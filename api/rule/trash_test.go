@@ -0,0 +1,32 @@
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+)
+
+// TestTrashedSettlement checks that the records of a settlement in the trash
+// can't be reached through their settlement edge until it is restored.
+func TestTrashedSettlement(t *testing.T) {
+	client := open(t)
+	alice := viewer("alice")
+	s := client.Settlement.Create().SetOwner("alice").SetName("Lantern Hollow").SaveX(alice)
+	event := client.TimelineEvent.Create().
+		SetSettlementID(s.ID).SetYear(1).SetType(timelineevent.TypeStoryEvent).SetName("Returning Survivors").
+		SaveX(alice)
+	client.Settlement.DeleteOneID(s.ID).ExecX(alice)
+
+	if n := client.TimelineEvent.Query().CountX(alice); n != 0 {
+		t.Errorf("alice sees %d events of a deleted settlement", n)
+	}
+	if _, err := client.TimelineEvent.UpdateOneID(event.ID).SetCompleted(true).Save(alice); err == nil {
+		t.Error("alice completed an event of a deleted settlement")
+	}
+	if _, err := client.Survivor.Create().SetName("Zachary").SetSettlementID(s.ID).Save(alice); err == nil {
+		t.Error("alice added a survivor to a deleted settlement")
+	}
+	if _, err := client.Settlement.UpdateOneID(s.ID).SetName("Renamed").Save(alice); err == nil {
+		t.Error("alice renamed a deleted settlement")
+	}
+}
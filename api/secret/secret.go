@@ -0,0 +1,32 @@
+// Package secret generates the random tokens handed out to users, such as
+// invite codes. Only a token's hash is ever stored.
+package secret
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+)
+
+const tokenBytes = 20
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// New returns a random token starting with prefix, along with the hash to persist.
+func New(prefix string) (token string, hash string, err error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generating token: %w", err)
+	}
+	token = prefix + encoding.EncodeToString(b)
+	return token, Hash(token), nil
+}
+
+// Hash returns the stored form of a token. Tokens carry enough entropy that
+// an unsalted SHA-256 can't be brute forced.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,113 @@
+// Package trash purges soft deleted settlements and survivors once they have
+// been in the trash longer than the retention period.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/failuretoload/datamonster/ent"
+	"github.com/failuretoload/datamonster/ent/innovation"
+	"github.com/failuretoload/datamonster/ent/invite"
+	"github.com/failuretoload/datamonster/ent/membership"
+	"github.com/failuretoload/datamonster/ent/milestone"
+	"github.com/failuretoload/datamonster/ent/monsterprogress"
+	"github.com/failuretoload/datamonster/ent/principle"
+	"github.com/failuretoload/datamonster/ent/schema"
+	"github.com/failuretoload/datamonster/ent/settlement"
+	"github.com/failuretoload/datamonster/ent/storageitem"
+	"github.com/failuretoload/datamonster/ent/survivor"
+	"github.com/failuretoload/datamonster/ent/timelineevent"
+	"github.com/failuretoload/datamonster/rule"
+)
+
+// purgeInterval is how often Run looks for expired records.
+const purgeInterval = time.Hour
+
+// Run purges records deleted longer than retention ago until ctx is done.
+func Run(ctx context.Context, client *ent.Client, retention time.Duration) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	for {
+		if err := Purge(ctx, client, time.Now().Add(-retention)); err != nil {
+			log.Println("purging trash", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes the settlements and survivors moved to the trash before
+// cutoff for good. A purged settlement takes its population, memberships,
+// invites and every record of the campaign with it.
+func Purge(ctx context.Context, client *ent.Client, cutoff time.Time) error {
+	// The purge runs on behalf of nobody and has to see deleted records.
+	ctx = schema.SkipSoftDelete(rule.Bypass(ctx))
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := purge(ctx, tx, cutoff); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func purge(ctx context.Context, tx *ent.Tx, cutoff time.Time) error {
+	ids, err := tx.Settlement.Query().Where(settlement.DeletedAtLT(cutoff)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		if _, err := tx.Invite.Delete().Where(invite.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Membership.Delete().Where(membership.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Survivor.Delete().Where(survivor.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.TimelineEvent.Delete().Where(timelineevent.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.StorageItem.Delete().Where(storageitem.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Innovation.Delete().Where(innovation.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Principle.Delete().Where(principle.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Milestone.Delete().Where(milestone.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.MonsterProgress.Delete().Where(monsterprogress.SettlementIDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.Settlement.Delete().Where(settlement.IDIn(ids...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	survivors, err := tx.Survivor.Delete().Where(survivor.DeletedAtLT(cutoff)).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 || survivors > 0 {
+		log.Printf("purged %d settlements and %d survivors from the trash", len(ids), survivors)
+	}
+	return nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back: %v", err, rerr)
+	}
+	return err
+}
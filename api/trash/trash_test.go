@@ -0,0 +1,56 @@
+package trash_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/failuretoload/datamonster/config"
+	"github.com/failuretoload/datamonster/ent/enttest"
+	"github.com/failuretoload/datamonster/ent/migrate"
+	_ "github.com/failuretoload/datamonster/ent/runtime"
+	"github.com/failuretoload/datamonster/ent/schema"
+	"github.com/failuretoload/datamonster/rule"
+	"github.com/failuretoload/datamonster/trash"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestPurge(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:trash?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	alice := context.WithValue(context.Background(), config.UserIDKey, "alice")
+
+	kept := client.Settlement.Create().SetOwner("alice").SetName("Lantern Hollow").SaveX(alice)
+	gone := client.Settlement.Create().SetOwner("alice").SetName("Dusk").SaveX(alice)
+	client.Survivor.Create().SetName("Zachary").SetSettlementID(gone.ID).SaveX(alice)
+	client.Survivor.Create().SetName("Erza").SetSettlementID(kept.ID).SaveX(alice)
+	deleted := client.Survivor.Create().SetName("Lucy").SetSettlementID(kept.ID).SaveX(alice)
+	client.Membership.Create().SetSettlementID(gone.ID).SetUserID("bob").SetRole("viewer").SaveX(alice)
+	client.Settlement.DeleteOneID(gone.ID).ExecX(alice)
+	client.Survivor.DeleteOneID(deleted.ID).ExecX(alice)
+
+	// Nothing was deleted before the cutoff yet.
+	if err := trash.Purge(context.Background(), client, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	all := schema.SkipSoftDelete(rule.Bypass(context.Background()))
+	if n := client.Survivor.Query().CountX(all); n != 3 {
+		t.Fatalf("%d survivors left, want 3", n)
+	}
+
+	if err := trash.Purge(context.Background(), client, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	settlements := client.Settlement.Query().IDsX(all)
+	if len(settlements) != 1 || settlements[0] != kept.ID {
+		t.Errorf("settlements left %v, want %d", settlements, kept.ID)
+	}
+	survivors := client.Survivor.Query().AllX(all)
+	if len(survivors) != 1 || survivors[0].Name != "Erza" {
+		t.Errorf("survivors left %+v", survivors)
+	}
+	if n := client.Membership.Query().CountX(all); n != 1 {
+		t.Errorf("%d memberships left, want only the owner's", n)
+	}
+}
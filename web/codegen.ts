@@ -1,4 +1,5 @@
 import {CodegenConfig} from '@graphql-codegen/cli';
+import {addTypenameSelectionDocumentTransform} from '@graphql-codegen/client-preset';
 
 const config: CodegenConfig = {
   schema: 'http://localhost:8080/graphql',
@@ -10,7 +11,14 @@ const config: CodegenConfig = {
       plugins: [],
       presetConfig: {
         gqlTagName: 'gql',
+        // Writes persisted-documents.json, the API's trusted documents manifest.
+        persistedDocuments: {
+          hashAlgorithm: 'sha256',
+        },
       },
+      // Apollo's cache adds __typename to every selection before sending an
+      // operation, so the manifest has to list the documents with it.
+      documentTransforms: [addTypenameSelectionDocumentTransform],
     },
   },
   ignoreNoDocuments: true,
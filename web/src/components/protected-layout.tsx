@@ -8,12 +8,23 @@ import {
   InMemoryCache,
   ApolloProvider,
   createHttpLink,
+  type DocumentNode,
 } from '@apollo/client';
 import {setContext} from '@apollo/client/link/context';
+import {createPersistedQueryLink} from '@apollo/client/link/persisted-queries';
 
+// Requests authenticate with a bearer token, so no cookies are sent, matching
+// the API's default CORS_ALLOW_CREDENTIALS=false.
 const httpLink = createHttpLink({
   uri: '/graphql',
-  credentials: 'include',
+});
+
+// The API only runs the operations in its trusted documents manifest, so send
+// the hash codegen computed for each one instead of the query text.
+type PersistedDocument = DocumentNode & {__meta__: {hash: string}};
+
+const persistedQueryLink = createPersistedQueryLink({
+  generateHash: (document) => (document as PersistedDocument).__meta__.hash,
 });
 
 export default function ProtectedLayout() {
@@ -26,7 +37,7 @@ export default function ProtectedLayout() {
   });
   const apolloClient = new ApolloClient({
     cache: new InMemoryCache(),
-    link: authLink.concat(httpLink),
+    link: authLink.concat(persistedQueryLink).concat(httpLink),
   });
 
   if (isLoaded && !userId) {